@@ -1,18 +1,24 @@
 package main
 
 import (
+	"context"
+	"github.com/golang_falcon_task/booking-service/internal/cancellation"
 	"github.com/golang_falcon_task/booking-service/internal/config"
 	"github.com/golang_falcon_task/booking-service/internal/db"
+	"github.com/golang_falcon_task/booking-service/internal/dispatch"
 	"github.com/golang_falcon_task/booking-service/internal/logging"
 	"github.com/golang_falcon_task/booking-service/internal/metrics"
-	"github.com/golang_falcon_task/booking-service/internal/middleware"
-	"github.com/golang_falcon_task/booking-service/internal/service"
-	"github.com/golang_falcon_task/booking-service/internal/store"
-	"net"
-
-	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/payments"
+	"github.com/golang_falcon_task/booking-service/internal/pricing"
+	"github.com/golang_falcon_task/booking-service/internal/schedule"
+	"github.com/golang_falcon_task/booking-service/internal/surge"
+	"github.com/golang_falcon_task/booking-service/server"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/credentials/insecure"
+	"net"
+	"net/http"
 )
 
 func main() {
@@ -30,35 +36,161 @@ func main() {
 	metrics.InitMetrics()
 	metrics.StartMetricsServer(":9006")
 
-	// Initialize database
-	database, err := db.InitDB(cfg)
+	// Initialize database, unless running on the in-memory store
+	var database *pgxpool.Pool
+	if cfg.StoreBackend == config.StoreMemory {
+		log.Println("Using in-memory store")
+	} else {
+		database, err = db.InitDB(cfg, log)
+		if err != nil {
+			log.Fatalf("failed to connect to database: %v", err)
+		}
+		defer database.Close()
+	}
+
+	// Pick the broker outbox events are relayed to
+	var broker outbox.Broker
+	if cfg.EventBroker == config.BrokerFile {
+		fileBroker, err := outbox.NewFileBroker(cfg.EventFile)
+		if err != nil {
+			log.Fatalf("failed to open event file: %v", err)
+		}
+		defer fileBroker.Close()
+		log.Printf("Publishing events to %s", cfg.EventFile)
+		broker = fileBroker
+	} else {
+		broker = outbox.NewInProcessBroker()
+	}
+
+	// Load the tariff bookings are priced with
+	tariff := pricing.Default
+	if cfg.TariffFile != "" {
+		tariff, err = pricing.Load(cfg.TariffFile)
+		if err != nil {
+			log.Fatalf("failed to load tariff: %v", err)
+		}
+	}
+	log.Printf("Pricing bookings with tariff %s", tariff.Version)
+
+	// Load the zones fares surge in
+	zones := surge.DefaultZones
+	if cfg.SurgeZonesFile != "" {
+		zones, err = surge.LoadZones(cfg.SurgeZonesFile)
+		if err != nil {
+			log.Fatalf("failed to load surge zones: %v", err)
+		}
+	}
+	log.Printf("Surging fares in %d zones", len(zones))
+
+	// Payments are charged through the fake provider until a real one is
+	// integrated
+	log.Printf("Charging completed rides through the fake payment provider, keeping a %g%% commission", cfg.CommissionRate*100)
+	log.Printf("Charging %g for cancelling after a driver is assigned and %s have passed, and %g for no-shows",
+		cfg.CancellationFee, cfg.CancellationFreeWindow, cfg.NoShowFee)
+
+	// Connect to the services the booking saga and dispatch call
+	users, err := grpc.NewClient(cfg.UserServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to create user-service client: %v", err)
+	}
+	defer users.Close()
+	rides, err := grpc.NewClient(cfg.RideServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		log.Fatalf("failed to connect to database: %v", err)
+		log.Fatalf("failed to create ride-service client: %v", err)
 	}
-	defer database.Close()
+	defer rides.Close()
+	drivers, err := grpc.NewClient(cfg.DriverServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to create driver-service client: %v", err)
+	}
+	defer drivers.Close()
+
+	srv, err := server.New(server.Options{
+		Logger:             log,
+		DB:                 database,
+		Broker:             broker,
+		OutboxPollInterval: cfg.OutboxPollInterval,
+		Users:              users,
+		Rides:              rides,
+		Drivers:            drivers,
+		Tariff:             tariff,
+		SurgeZones:         zones,
+		Surge: surge.Config{
+			Window:        cfg.SurgeWindow,
+			MaxMultiplier: cfg.SurgeMaxMultiplier,
+			Smoothing:     cfg.SurgeSmoothing,
+		},
+		Payments: payments.Config{
+			Provider:       payments.NewFakeProvider(),
+			CommissionRate: cfg.CommissionRate,
+			Cancellation: cancellation.Policy{
+				FreeWindow:        cfg.CancellationFreeWindow,
+				DriverAssignedFee: cfg.CancellationFee,
+				NoShowFee:         cfg.NoShowFee,
+			},
+		},
+		SagaRecoveryInterval: cfg.SagaRecoveryInterval,
+		SagaStaleAfter:       cfg.SagaStaleAfter,
+		Dispatch: dispatch.Config{
+			SearchRadiusKm: cfg.DispatchSearchRadiusKm,
+			Candidates:     cfg.DispatchCandidates,
+			OfferTimeout:   cfg.DispatchOfferTimeout,
+			BookingTimeout: cfg.DispatchBookingTimeout,
+			Interval:       cfg.DispatchInterval,
+		},
+		Schedule: schedule.Config{
+			MinLead:      cfg.ScheduleMinLead,
+			MaxLead:      cfg.ScheduleMaxLead,
+			DispatchLead: cfg.ScheduleDispatchLead,
+			ReminderLead: cfg.ScheduleReminderLead,
+			Interval:     cfg.ScheduleInterval,
+		},
+	})
+	if err != nil {
+		log.Fatalf("failed to create server: %v", err)
+	}
+
+	// Relay outbox events in the background
+	go srv.Relay.Run(context.Background())
+
+	// Wake booking watchers when events are recorded
+	go srv.ListenForChanges(context.Background())
 
-	bookingStore := store.NewPGBookingStore(database)
-	bookingService := service.NewBookingService(bookingStore, log)
+	// Resume booking sagas interrupted by a crash or an outage
+	go srv.RunSagaRecovery(context.Background())
 
-	// Start gRPC server
+	// Offer pending bookings to nearby drivers
+	go srv.Dispatcher.Run(context.Background())
+
+	// Remind riders of scheduled bookings and release them to dispatch
+	go srv.Scheduler.Run(context.Background())
+
+	// Listen for gRPC, Connect and gRPC-Web on one port
 	lis, err := net.Listen("tcp", ":50052")
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			middleware.LoggingInterceptor(log), // Logs all requests and responses
-			middleware.MetricsInterceptor(),    // Captures Prometheus metrics
-		),
-	)
-	pb.RegisterBookingServiceServer(grpcServer, bookingService)
+	// Start the REST/JSON gateway, which forwards to the gRPC server
+	conn, err := grpc.NewClient("localhost:50052", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to create gateway client: %v", err)
+	}
+	defer conn.Close()
 
-	// Enable reflection for testing
-	reflection.Register(grpcServer)
+	gateway, err := server.NewGateway(context.Background(), conn)
+	if err != nil {
+		log.Fatalf("failed to create gateway: %v", err)
+	}
+	go func() {
+		log.Println("BookingService REST gateway is running on port 8052")
+		if err := http.ListenAndServe(":8052", gateway); err != nil {
+			log.Fatalf("failed to serve gateway: %v", err)
+		}
+	}()
 
-	log.Println("BookingService is running on port 50052")
-	if err := grpcServer.Serve(lis); err != nil {
+	log.Println("BookingService is running on port 50052 (gRPC, Connect and gRPC-Web)")
+	if err := http.Serve(lis, srv.Handler()); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
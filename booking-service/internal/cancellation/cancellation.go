@@ -0,0 +1,71 @@
+// Package cancellation decides what riders pay for cancelling bookings and
+// for not showing up to them.
+package cancellation
+
+import (
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+)
+
+// Policy sets the cancellation fees. Fees are in major units of the fare's
+// currency, e.g. rupees, and never exceed the fare.
+type Policy struct {
+	// FreeWindow is how long the rider can cancel for free once a driver
+	// accepted the booking, counted from the pickup time if that is later.
+	FreeWindow time.Duration
+
+	// DriverAssignedFee is charged for cancelling after FreeWindow once a
+	// driver accepted the booking. Until then cancelling is free.
+	DriverAssignedFee float64
+
+	// NoShowFee is charged when the driver reports that the rider did not
+	// show up.
+	NoShowFee float64
+}
+
+// DefaultPolicy is the policy the service runs with unless configured
+// otherwise.
+var DefaultPolicy = Policy{
+	FreeWindow:        2 * time.Minute,
+	DriverAssignedFee: 100,
+	NoShowFee:         200,
+}
+
+// Fee returns the fee for cancelling booking, whose ride costs cost, at now
+// for reason, one of the model.Cancelled* reasons.
+func (p Policy) Fee(booking *model.Booking, cost money.Money, reason string, now time.Time) (money.Money, error) {
+	var fee float64
+	switch {
+	case reason == model.CancelledNoShow:
+		fee = p.NoShowFee
+	case booking.Status == model.BookingConfirmed && now.Sub(freeFrom(booking)) >= p.FreeWindow:
+		fee = p.DriverAssignedFee
+	}
+	if fee == 0 || cost.IsZero() {
+		return money.Zero(cost.Currency), nil
+	}
+
+	amount, err := money.FromMajor(cost.Currency, fee)
+	if err != nil {
+		return money.Money{}, err
+	}
+	if amount.Minor > cost.Minor {
+		return cost, nil
+	}
+	return amount, nil
+}
+
+// freeFrom is when the free window of booking starts: the latest of its
+// booking, pickup and driver assignment times. Bookings confirmed before
+// assignment times were kept count from their booking time.
+func freeFrom(booking *model.Booking) time.Time {
+	from := booking.Timestamp
+	for _, t := range []time.Time{booking.PickupTime, booking.AssignedAt} {
+		if t.After(from) {
+			from = t
+		}
+	}
+	return from
+}
@@ -0,0 +1,73 @@
+package cancellation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/stretchr/testify/require"
+)
+
+func pkr(minor int64) money.Money {
+	return money.Money{Currency: "PKR", Minor: minor}
+}
+
+func TestPolicy_Fee(t *testing.T) {
+	booked := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	policy := Policy{FreeWindow: 2 * time.Minute, DriverAssignedFee: 100, NoShowFee: 200}
+	// A ride booked a day ahead, accepted by a driver 15 minutes before pickup.
+	pickup := booked.Add(24 * time.Hour)
+	scheduled := func(b *model.Booking) { b.PickupTime, b.AssignedAt = pickup, pickup.Add(-15*time.Minute) }
+	// A ride a driver accepted 5 minutes after it was booked.
+	assignedLate := func(b *model.Booking) { b.PickupTime, b.AssignedAt = booked, booked.Add(5*time.Minute) }
+
+	tests := []struct {
+		name        string
+		status      string
+		booking     func(b *model.Booking)
+		reason      string
+		after       time.Duration
+		cost        money.Money
+		expectedFee money.Money
+	}{
+		{name: "Pending Is Free", status: model.BookingPending, reason: model.CancelledByRider, after: time.Hour,
+			cost: pkr(62500), expectedFee: pkr(0)},
+		{name: "Confirmed Within Free Window", status: model.BookingConfirmed, reason: model.CancelledByRider, after: time.Minute,
+			cost: pkr(62500), expectedFee: pkr(0)},
+		{name: "Confirmed After Free Window", status: model.BookingConfirmed, reason: model.CancelledByRider, after: 2 * time.Minute,
+			cost: pkr(62500), expectedFee: pkr(10000)},
+		{name: "Assigned Within Free Window", status: model.BookingConfirmed, booking: assignedLate, reason: model.CancelledByRider,
+			after: 6 * time.Minute, cost: pkr(62500), expectedFee: pkr(0)},
+		{name: "Assigned After Free Window", status: model.BookingConfirmed, booking: assignedLate, reason: model.CancelledByRider,
+			after: 7 * time.Minute, cost: pkr(62500), expectedFee: pkr(10000)},
+		{name: "Scheduled Before Pickup", status: model.BookingConfirmed, booking: scheduled, reason: model.CancelledByRider,
+			after: 24*time.Hour - 5*time.Minute, cost: pkr(62500), expectedFee: pkr(0)},
+		{name: "Scheduled Within Free Window", status: model.BookingConfirmed, booking: scheduled, reason: model.CancelledByRider,
+			after: 24*time.Hour + time.Minute, cost: pkr(62500), expectedFee: pkr(0)},
+		{name: "Scheduled After Free Window", status: model.BookingConfirmed, booking: scheduled, reason: model.CancelledByRider,
+			after: 24*time.Hour + 2*time.Minute, cost: pkr(62500), expectedFee: pkr(10000)},
+		{name: "No-Show", status: model.BookingConfirmed, reason: model.CancelledNoShow, after: time.Minute,
+			cost: pkr(62500), expectedFee: pkr(20000)},
+		{name: "Fee Capped At Fare", status: model.BookingConfirmed, reason: model.CancelledNoShow, after: time.Hour,
+			cost: pkr(15000), expectedFee: pkr(15000)},
+		{name: "Free Ride", status: model.BookingConfirmed, reason: model.CancelledNoShow, after: time.Hour,
+			cost: pkr(0), expectedFee: pkr(0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := &model.Booking{ID: 1001, Status: tt.status, Timestamp: booked}
+			if tt.booking != nil {
+				tt.booking(booking)
+			}
+			fee, err := policy.Fee(booking, tt.cost, tt.reason, booked.Add(tt.after))
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedFee, fee)
+		})
+	}
+
+	fee, err := Policy{}.Fee(&model.Booking{Status: model.BookingConfirmed, Timestamp: booked}, pkr(62500), model.CancelledNoShow, booked)
+	require.NoError(t, err)
+	require.True(t, fee.IsZero())
+}
@@ -1,16 +1,114 @@
 package config
 
 import (
+	"fmt"
+	"github.com/golang_falcon_task/booking-service/internal/cancellation"
+	"github.com/golang_falcon_task/booking-service/internal/dispatch"
+	"github.com/golang_falcon_task/booking-service/internal/payments"
+	"github.com/golang_falcon_task/booking-service/internal/schedule"
+	"github.com/golang_falcon_task/booking-service/internal/service"
+	"github.com/golang_falcon_task/booking-service/internal/surge"
 	"github.com/joho/godotenv"
 	"os"
+	"strconv"
+	"time"
+)
+
+// Store backends selectable with STORE_BACKEND.
+const (
+	StorePostgres = "postgres"
+	StoreMemory   = "memory"
+)
+
+// Event brokers selectable with EVENT_BROKER.
+const (
+	BrokerInProcess = "inprocess"
+	BrokerFile      = "file"
 )
 
 type Config struct {
+	// StoreBackend selects the store implementation, StorePostgres or StoreMemory.
+	StoreBackend string
+
 	DBHost     string
 	DBPort     string
 	DBUser     string
 	DBPassword string
 	DBName     string
+	DBSSLMode  string
+
+	// Connection pool tuning
+	DBMaxConns          int32
+	DBMinConns          int32
+	DBMaxConnLifetime   time.Duration
+	DBMaxConnIdleTime   time.Duration
+	DBHealthCheckPeriod time.Duration
+
+	// DBStatementTimeout bounds how long Postgres runs any single query.
+	DBStatementTimeout time.Duration
+
+	// DBConnectTimeout is the total time to wait for Postgres at startup.
+	DBConnectTimeout time.Duration
+
+	// EventBroker selects where outbox events are published, BrokerInProcess or BrokerFile.
+	EventBroker string
+
+	// EventFile is the file BrokerFile appends events to.
+	EventFile string
+
+	// OutboxPollInterval is how often the relay publishes pending events.
+	OutboxPollInterval time.Duration
+
+	// UserServiceAddr and RideServiceAddr are the services the booking saga calls.
+	UserServiceAddr string
+	RideServiceAddr string
+
+	// DriverServiceAddr is the service dispatch finds drivers with.
+	DriverServiceAddr string
+
+	// TariffFile is the JSON tariff bookings are priced with. When empty,
+	// the tariff shipped with the service is used.
+	TariffFile string
+
+	// SurgeZonesFile is the GeoJSON file of the zones fares surge in. When
+	// empty, the zones shipped with the service are used.
+	SurgeZonesFile string
+
+	// Surge tunes how booking demand in a zone becomes a fare multiplier.
+	SurgeWindow        time.Duration
+	SurgeMaxMultiplier float64
+	SurgeSmoothing     time.Duration
+
+	// CommissionRate is the share of each completed ride's fare the
+	// platform keeps.
+	CommissionRate float64
+
+	// Cancellation fees, in major units of the fare's currency.
+	CancellationFreeWindow time.Duration
+	CancellationFee        float64
+	NoShowFee              float64
+
+	// Dispatch tunes the engine that offers bookings to drivers.
+	DispatchSearchRadiusKm float64
+	DispatchCandidates     int32
+	DispatchOfferTimeout   time.Duration
+	DispatchBookingTimeout time.Duration
+	DispatchInterval       time.Duration
+
+	// Schedule bounds how far ahead bookings can be scheduled, and when
+	// riders are reminded of them and they are released to dispatch.
+	ScheduleMinLead      time.Duration
+	ScheduleMaxLead      time.Duration
+	ScheduleDispatchLead time.Duration
+	ScheduleReminderLead time.Duration
+	ScheduleInterval     time.Duration
+
+	// SagaRecoveryInterval is how often stale booking sagas are looked for.
+	SagaRecoveryInterval time.Duration
+
+	// SagaStaleAfter is how long an active saga must go without progress
+	// before it is resumed. It must exceed the saga timeout.
+	SagaStaleAfter time.Duration
 }
 
 func LoadConfig() (*Config, error) {
@@ -19,11 +117,232 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
-	return &Config{
-		DBHost:     os.Getenv("DB_HOST"),
-		DBPort:     os.Getenv("DB_PORT"),
-		DBUser:     os.Getenv("DB_USER"),
-		DBPassword: os.Getenv("DB_PASSWORD"),
-		DBName:     os.Getenv("DB_NAME"),
-	}, nil
+	cfg := &Config{
+		StoreBackend: getEnv("STORE_BACKEND", StorePostgres),
+		DBHost:       os.Getenv("DB_HOST"),
+		DBPort:       os.Getenv("DB_PORT"),
+		DBUser:       os.Getenv("DB_USER"),
+		DBPassword:   os.Getenv("DB_PASSWORD"),
+		DBName:       os.Getenv("DB_NAME"),
+		DBSSLMode:    getEnv("DB_SSLMODE", "disable"),
+		EventBroker:  getEnv("EVENT_BROKER", BrokerInProcess),
+		EventFile:    getEnv("EVENT_FILE", "events/booking-service.jsonl"),
+
+		UserServiceAddr: getEnv("USER_SERVICE_ADDR", "localhost:50051"),
+		RideServiceAddr: getEnv("RIDE_SERVICE_ADDR", "localhost:50053"),
+
+		DriverServiceAddr: getEnv("DRIVER_SERVICE_ADDR", "localhost:50054"),
+
+		TariffFile:     os.Getenv("TARIFF_FILE"),
+		SurgeZonesFile: os.Getenv("SURGE_ZONES_FILE"),
+	}
+
+	if cfg.DBMaxConns, err = getEnvInt32("DB_MAX_CONNS", 10); err != nil {
+		return nil, err
+	}
+	if cfg.DBMinConns, err = getEnvInt32("DB_MIN_CONNS", 0); err != nil {
+		return nil, err
+	}
+	if cfg.DBMaxConnLifetime, err = getEnvDuration("DB_MAX_CONN_LIFETIME", time.Hour); err != nil {
+		return nil, err
+	}
+	if cfg.DBMaxConnIdleTime, err = getEnvDuration("DB_MAX_CONN_IDLE_TIME", 30*time.Minute); err != nil {
+		return nil, err
+	}
+	if cfg.DBHealthCheckPeriod, err = getEnvDuration("DB_HEALTH_CHECK_PERIOD", time.Minute); err != nil {
+		return nil, err
+	}
+	if cfg.DBStatementTimeout, err = getEnvDuration("DB_STATEMENT_TIMEOUT", 5*time.Second); err != nil {
+		return nil, err
+	}
+	if cfg.DBConnectTimeout, err = getEnvDuration("DB_CONNECT_TIMEOUT", 30*time.Second); err != nil {
+		return nil, err
+	}
+	if cfg.OutboxPollInterval, err = getEnvDuration("OUTBOX_POLL_INTERVAL", time.Second); err != nil {
+		return nil, err
+	}
+
+	if cfg.SagaRecoveryInterval, err = getEnvDuration("SAGA_RECOVERY_INTERVAL", 5*time.Second); err != nil {
+		return nil, err
+	}
+	if cfg.SagaStaleAfter, err = getEnvDuration("SAGA_STALE_AFTER", 30*time.Second); err != nil {
+		return nil, err
+	}
+
+	if cfg.DispatchSearchRadiusKm, err = getEnvFloat("DISPATCH_SEARCH_RADIUS_KM", dispatch.DefaultConfig.SearchRadiusKm); err != nil {
+		return nil, err
+	}
+	if cfg.DispatchCandidates, err = getEnvInt32("DISPATCH_CANDIDATES", dispatch.DefaultConfig.Candidates); err != nil {
+		return nil, err
+	}
+	if cfg.DispatchOfferTimeout, err = getEnvDuration("DISPATCH_OFFER_TIMEOUT", dispatch.DefaultConfig.OfferTimeout); err != nil {
+		return nil, err
+	}
+	if cfg.DispatchBookingTimeout, err = getEnvDuration("DISPATCH_BOOKING_TIMEOUT", dispatch.DefaultConfig.BookingTimeout); err != nil {
+		return nil, err
+	}
+	if cfg.DispatchInterval, err = getEnvDuration("DISPATCH_INTERVAL", dispatch.DefaultConfig.Interval); err != nil {
+		return nil, err
+	}
+
+	if cfg.ScheduleMinLead, err = getEnvDuration("SCHEDULE_MIN_LEAD", schedule.DefaultConfig.MinLead); err != nil {
+		return nil, err
+	}
+	if cfg.ScheduleMaxLead, err = getEnvDuration("SCHEDULE_MAX_LEAD", schedule.DefaultConfig.MaxLead); err != nil {
+		return nil, err
+	}
+	if cfg.ScheduleDispatchLead, err = getEnvDuration("SCHEDULE_DISPATCH_LEAD", schedule.DefaultConfig.DispatchLead); err != nil {
+		return nil, err
+	}
+	if cfg.ScheduleReminderLead, err = getEnvDuration("SCHEDULE_REMINDER_LEAD", schedule.DefaultConfig.ReminderLead); err != nil {
+		return nil, err
+	}
+	if cfg.ScheduleInterval, err = getEnvDuration("SCHEDULE_INTERVAL", schedule.DefaultConfig.Interval); err != nil {
+		return nil, err
+	}
+
+	if cfg.SurgeWindow, err = getEnvDuration("SURGE_WINDOW", surge.DefaultConfig.Window); err != nil {
+		return nil, err
+	}
+	if cfg.SurgeMaxMultiplier, err = getEnvFloat("SURGE_MAX_MULTIPLIER", surge.DefaultConfig.MaxMultiplier); err != nil {
+		return nil, err
+	}
+	if cfg.SurgeSmoothing, err = getEnvDuration("SURGE_SMOOTHING", surge.DefaultConfig.Smoothing); err != nil {
+		return nil, err
+	}
+
+	if cfg.CommissionRate, err = getEnvFloat("PLATFORM_COMMISSION_RATE", payments.DefaultCommissionRate); err != nil {
+		return nil, err
+	}
+	if cfg.CancellationFreeWindow, err = getEnvDuration("CANCELLATION_FREE_WINDOW", cancellation.DefaultPolicy.FreeWindow); err != nil {
+		return nil, err
+	}
+	if cfg.CancellationFee, err = getEnvFloat("CANCELLATION_FEE", cancellation.DefaultPolicy.DriverAssignedFee); err != nil {
+		return nil, err
+	}
+	if cfg.NoShowFee, err = getEnvFloat("NO_SHOW_FEE", cancellation.DefaultPolicy.NoShowFee); err != nil {
+		return nil, err
+	}
+
+	if cfg.StoreBackend != StorePostgres && cfg.StoreBackend != StoreMemory {
+		return nil, fmt.Errorf("STORE_BACKEND must be %q or %q, got %q", StorePostgres, StoreMemory, cfg.StoreBackend)
+	}
+	if cfg.EventBroker != BrokerInProcess && cfg.EventBroker != BrokerFile {
+		return nil, fmt.Errorf("EVENT_BROKER must be %q or %q, got %q", BrokerInProcess, BrokerFile, cfg.EventBroker)
+	}
+	if cfg.OutboxPollInterval <= 0 {
+		return nil, fmt.Errorf("OUTBOX_POLL_INTERVAL must be positive, got %s", cfg.OutboxPollInterval)
+	}
+	if cfg.SagaRecoveryInterval <= 0 {
+		return nil, fmt.Errorf("SAGA_RECOVERY_INTERVAL must be positive, got %s", cfg.SagaRecoveryInterval)
+	}
+	if cfg.SagaStaleAfter <= service.SagaTimeout {
+		return nil, fmt.Errorf("SAGA_STALE_AFTER must exceed %s, got %s", service.SagaTimeout, cfg.SagaStaleAfter)
+	}
+	if cfg.DispatchSearchRadiusKm <= 0 {
+		return nil, fmt.Errorf("DISPATCH_SEARCH_RADIUS_KM must be positive, got %g", cfg.DispatchSearchRadiusKm)
+	}
+	if cfg.DispatchCandidates <= 0 {
+		return nil, fmt.Errorf("DISPATCH_CANDIDATES must be positive, got %d", cfg.DispatchCandidates)
+	}
+	if cfg.DispatchOfferTimeout <= 0 {
+		return nil, fmt.Errorf("DISPATCH_OFFER_TIMEOUT must be positive, got %s", cfg.DispatchOfferTimeout)
+	}
+	if cfg.DispatchBookingTimeout < cfg.DispatchOfferTimeout {
+		return nil, fmt.Errorf("DISPATCH_BOOKING_TIMEOUT must be at least DISPATCH_OFFER_TIMEOUT, got %s", cfg.DispatchBookingTimeout)
+	}
+	if cfg.DispatchInterval <= 0 {
+		return nil, fmt.Errorf("DISPATCH_INTERVAL must be positive, got %s", cfg.DispatchInterval)
+	}
+	if cfg.ScheduleDispatchLead <= 0 {
+		return nil, fmt.Errorf("SCHEDULE_DISPATCH_LEAD must be positive, got %s", cfg.ScheduleDispatchLead)
+	}
+	if cfg.ScheduleMinLead < cfg.ScheduleDispatchLead {
+		return nil, fmt.Errorf("SCHEDULE_MIN_LEAD must be at least SCHEDULE_DISPATCH_LEAD, got %s", cfg.ScheduleMinLead)
+	}
+	if cfg.ScheduleMaxLead < cfg.ScheduleMinLead {
+		return nil, fmt.Errorf("SCHEDULE_MAX_LEAD must be at least SCHEDULE_MIN_LEAD, got %s", cfg.ScheduleMaxLead)
+	}
+	if cfg.ScheduleReminderLead <= 0 {
+		return nil, fmt.Errorf("SCHEDULE_REMINDER_LEAD must be positive, got %s", cfg.ScheduleReminderLead)
+	}
+	if cfg.ScheduleInterval <= 0 {
+		return nil, fmt.Errorf("SCHEDULE_INTERVAL must be positive, got %s", cfg.ScheduleInterval)
+	}
+	if cfg.SurgeWindow <= 0 {
+		return nil, fmt.Errorf("SURGE_WINDOW must be positive, got %s", cfg.SurgeWindow)
+	}
+	if cfg.SurgeMaxMultiplier < 1 {
+		return nil, fmt.Errorf("SURGE_MAX_MULTIPLIER must be at least 1, got %g", cfg.SurgeMaxMultiplier)
+	}
+	if cfg.SurgeSmoothing < 0 {
+		return nil, fmt.Errorf("SURGE_SMOOTHING must not be negative, got %s", cfg.SurgeSmoothing)
+	}
+	if cfg.CommissionRate <= 0 || cfg.CommissionRate > 1 {
+		return nil, fmt.Errorf("PLATFORM_COMMISSION_RATE must be greater than 0 and at most 1, got %g", cfg.CommissionRate)
+	}
+	if cfg.CancellationFreeWindow < 0 {
+		return nil, fmt.Errorf("CANCELLATION_FREE_WINDOW must not be negative, got %s", cfg.CancellationFreeWindow)
+	}
+	if cfg.CancellationFee < 0 {
+		return nil, fmt.Errorf("CANCELLATION_FEE must not be negative, got %g", cfg.CancellationFee)
+	}
+	if cfg.NoShowFee < 0 {
+		return nil, fmt.Errorf("NO_SHOW_FEE must not be negative, got %g", cfg.NoShowFee)
+	}
+	if cfg.DBMaxConns <= 0 {
+		return nil, fmt.Errorf("DB_MAX_CONNS must be positive, got %d", cfg.DBMaxConns)
+	}
+	if cfg.DBMinConns < 0 || cfg.DBMinConns > cfg.DBMaxConns {
+		return nil, fmt.Errorf("DB_MIN_CONNS must be between 0 and DB_MAX_CONNS, got %d", cfg.DBMinConns)
+	}
+
+	return cfg, nil
+}
+
+// getEnv returns the value of an environment variable or a fallback if it is unset.
+func getEnv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
+
+// getEnvInt32 parses an integer environment variable, returning fallback if it is unset.
+func getEnvInt32(key string, fallback int32) (int32, error) {
+	v := getEnv(key, "")
+	if v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", key, v, err)
+	}
+	return int32(n), nil
+}
+
+// getEnvFloat parses a number environment variable, returning fallback if it is unset.
+func getEnvFloat(key string, fallback float64) (float64, error) {
+	v := getEnv(key, "")
+	if v == "" {
+		return fallback, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", key, v, err)
+	}
+	return f, nil
+}
+
+// getEnvDuration parses a duration environment variable (e.g. "30s"), returning fallback if it is unset.
+func getEnvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	v := getEnv(key, "")
+	if v == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", key, v, err)
+	}
+	return d, nil
 }
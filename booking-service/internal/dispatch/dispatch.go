@@ -0,0 +1,251 @@
+// Package dispatch matches pending bookings with drivers. It offers each
+// booking to the nearest available driver, one driver at a time, moves on to
+// the next nearest when a driver declines or lets the offer expire, and
+// expires the booking if no driver accepts it in time, releasing its wallet
+// hold. Drivers accept and decline offers through
+// BookingService.RespondToOffer.
+package dispatch
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/metrics"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// batchSize is how many pending bookings a pass reads at once.
+const batchSize = 100
+
+// Store is the part of the booking store dispatch works on.
+type Store interface {
+	ListPendingBookings(ctx context.Context, afterID int32, limit int) ([]model.PendingBooking, error)
+	CreateOffer(ctx context.Context, offer *model.Offer) error
+	ExpireOffers(ctx context.Context, now time.Time) ([]model.Offer, error)
+	ExpireBooking(ctx context.Context, bookingID int32) error
+}
+
+// Config tunes the engine. Zero fields take the defaults of DefaultConfig.
+type Config struct {
+	// SearchRadiusKm is how far from the pickup drivers are looked for.
+	SearchRadiusKm float64
+
+	// Candidates is how many of the nearest drivers are considered at once.
+	Candidates int32
+
+	// OfferTimeout is how long a driver has to respond to an offer.
+	OfferTimeout time.Duration
+
+	// BookingTimeout is how long past its pickup time a booking may wait
+	// for a driver before it expires.
+	BookingTimeout time.Duration
+
+	// Interval is how often pending bookings are looked at when no event
+	// wakes the engine earlier.
+	Interval time.Duration
+}
+
+// DefaultConfig is the configuration used for unset Config fields.
+var DefaultConfig = Config{
+	SearchRadiusKm: 5,
+	Candidates:     10,
+	OfferTimeout:   30 * time.Second,
+	BookingTimeout: 5 * time.Minute,
+	Interval:       5 * time.Second,
+}
+
+// Engine offers pending bookings to drivers. Several engines may share a
+// store: the store lets a booking and a driver have one pending offer each,
+// so they never make conflicting offers.
+type Engine struct {
+	store   Store
+	feed    outbox.Feed
+	drivers driverpb.DriverServiceClient
+	users   userpb.UserServiceClient
+	cfg     Config
+	now     func() time.Time
+	log     *logrus.Logger
+}
+
+// New creates an Engine that finds drivers with the given driver service
+// client, releases the wallet holds of expired bookings with the user
+// service client and wakes up when feed records an event.
+func New(store Store, feed outbox.Feed, drivers driverpb.DriverServiceClient, users userpb.UserServiceClient, cfg Config,
+	logger *logrus.Logger) *Engine {
+	if cfg.SearchRadiusKm == 0 {
+		cfg.SearchRadiusKm = DefaultConfig.SearchRadiusKm
+	}
+	if cfg.Candidates == 0 {
+		cfg.Candidates = DefaultConfig.Candidates
+	}
+	if cfg.OfferTimeout == 0 {
+		cfg.OfferTimeout = DefaultConfig.OfferTimeout
+	}
+	if cfg.BookingTimeout == 0 {
+		cfg.BookingTimeout = DefaultConfig.BookingTimeout
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultConfig.Interval
+	}
+	return &Engine{store: store, feed: feed, drivers: drivers, users: users, cfg: cfg, now: time.Now, log: logger}
+}
+
+// Run dispatches pending bookings until ctx is canceled, whenever an event
+// is recorded, such as a booking being created or an offer declined, and
+// every interval in case a notification was missed or an offer expired.
+func (e *Engine) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		// Take the channel first, so events recorded during the pass wake
+		// the next one.
+		changed := e.feed.Changed()
+		if err := e.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			e.log.Error("Dispatch pass failed", "error", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce expires overdue offers, then offers every pending booking without
+// a pending offer to the nearest available driver it was not offered to yet,
+// or expires the booking if it has waited too long. It reads the pending
+// bookings a batch at a time, so bookings that cannot be matched never keep
+// newer ones from being offered.
+func (e *Engine) RunOnce(ctx context.Context) error {
+	now := e.now()
+	expired, err := e.store.ExpireOffers(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, o := range expired {
+		metrics.DispatchDecisions.WithLabelValues(metrics.DecisionOfferExpired).Inc()
+		e.log.Info("Offer expired", "offer_id", o.ID, "booking_id", o.BookingID, "driver_id", o.DriverID)
+	}
+
+	// Drivers with a pending offer cannot take another one. The store
+	// refuses offers to drivers whose pending offer is in a later batch.
+	busy := make(map[int32]bool)
+	var afterID int32
+	for {
+		pending, err := e.store.ListPendingBookings(ctx, afterID, batchSize)
+		if err != nil {
+			return err
+		}
+		for _, b := range pending {
+			if o, ok := b.OpenOffer(); ok {
+				busy[o.DriverID] = true
+			}
+		}
+
+		for i := range pending {
+			if err := e.dispatch(ctx, &pending[i], busy, now); err != nil {
+				return err
+			}
+		}
+		if len(pending) < batchSize {
+			return nil
+		}
+		afterID = pending[len(pending)-1].Booking.ID
+	}
+}
+
+// dispatch makes the next offer for one pending booking, marking the driver
+// it offers the booking to as busy.
+func (e *Engine) dispatch(ctx context.Context, b *model.PendingBooking, busy map[int32]bool, now time.Time) error {
+	if _, ok := b.OpenOffer(); ok {
+		return nil
+	}
+	booking := &b.Booking
+
+	if now.Sub(booking.PickupTime) >= e.cfg.BookingTimeout {
+		err := e.store.ExpireBooking(ctx, booking.ID)
+		if errors.Is(err, store.ErrBookingNotPending) {
+			// Confirmed or offered by another engine meanwhile.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		metrics.DispatchDecisions.WithLabelValues(metrics.DecisionBookingExpired).Inc()
+		e.log.Info("Booking expired without a driver", "booking_id", booking.ID, "offers", len(b.Offers))
+		if booking.HoldID != 0 {
+			_, err := e.users.ReleaseHold(ctx, &userpb.ReleaseHoldRequest{HoldId: booking.HoldID})
+			if err != nil && status.Code(err) != codes.NotFound {
+				e.log.Error("Failed to release wallet hold", "booking_id", booking.ID, "hold_id", booking.HoldID, "error", err.Error())
+			}
+		}
+		return nil
+	}
+
+	if b.Pickup == nil {
+		// Without a pickup there is nobody to offer the booking to.
+		metrics.DispatchDecisions.WithLabelValues(metrics.DecisionNoCandidates).Inc()
+		return nil
+	}
+
+	offered := make(map[int32]bool, len(b.Offers))
+	for _, o := range b.Offers {
+		offered[o.DriverID] = true
+	}
+
+	res, err := e.drivers.FindNearbyDrivers(ctx, &driverpb.FindNearbyDriversRequest{
+		Location: &driverpb.Location{Latitude: b.Pickup.Lat, Longitude: b.Pickup.Lng},
+		RadiusKm: e.cfg.SearchRadiusKm,
+		Limit:    e.cfg.Candidates,
+	})
+	if err != nil {
+		e.log.Error("Failed to find nearby drivers", "booking_id", booking.ID, "error", err.Error())
+		return nil
+	}
+
+	for _, candidate := range res.Drivers {
+		driverID := candidate.Driver.GetDriverId()
+		if offered[driverID] || busy[driverID] {
+			continue
+		}
+
+		offer := &model.Offer{
+			BookingID:  booking.ID,
+			DriverID:   driverID,
+			DistanceKm: candidate.DistanceKm,
+			CreatedAt:  now,
+			ExpiresAt:  now.Add(e.cfg.OfferTimeout),
+		}
+		err := e.store.CreateOffer(ctx, offer)
+		switch {
+		case errors.Is(err, store.ErrAlreadyExists):
+			// Another engine offered the driver a booking meanwhile.
+			busy[driverID] = true
+			continue
+		case errors.Is(err, store.ErrBookingNotPending):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		busy[driverID] = true
+		metrics.DispatchDecisions.WithLabelValues(metrics.DecisionOffered).Inc()
+		e.log.Info("Booking offered to driver", "booking_id", booking.ID, "offer_id", offer.ID, "driver_id", driverID,
+			"distance_km", offer.DistanceKm)
+		return nil
+	}
+
+	metrics.DispatchDecisions.WithLabelValues(metrics.DecisionNoCandidates).Inc()
+	return nil
+}
@@ -0,0 +1,291 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+var liberty = &model.LatLng{Lat: 31.5102, Lng: 74.3441} // Liberty Market, Lahore
+
+// fakeDrivers is a DriverServiceClient whose FindNearbyDrivers returns
+// nearby, nearest first, and records the requests it receives.
+type fakeDrivers struct {
+	driverpb.DriverServiceClient
+	nearby   []*driverpb.NearbyDriver
+	requests []*driverpb.FindNearbyDriversRequest
+}
+
+func (f *fakeDrivers) FindNearbyDrivers(ctx context.Context, req *driverpb.FindNearbyDriversRequest, opts ...grpc.CallOption) (*driverpb.FindNearbyDriversResponse, error) {
+	f.requests = append(f.requests, req)
+	return &driverpb.FindNearbyDriversResponse{Drivers: f.nearby}, nil
+}
+
+// fakeUsers is a UserServiceClient that records the holds it releases.
+type fakeUsers struct {
+	userpb.UserServiceClient
+	released []int32
+}
+
+func (f *fakeUsers) ReleaseHold(ctx context.Context, req *userpb.ReleaseHoldRequest, opts ...grpc.CallOption) (*userpb.ReleaseHoldResponse, error) {
+	f.released = append(f.released, req.HoldId)
+	return &userpb.ReleaseHoldResponse{Hold: &userpb.Hold{HoldId: req.HoldId, Status: userpb.HoldStatus_HOLD_STATUS_RELEASED}}, nil
+}
+
+func nearby(driverID int32, distanceKm float64) *driverpb.NearbyDriver {
+	return &driverpb.NearbyDriver{
+		Driver:     &driverpb.Driver{DriverId: driverID, Status: driverpb.DriverStatus_DRIVER_STATUS_AVAILABLE},
+		DistanceKm: distanceKm,
+	}
+}
+
+// newTestEngine returns an engine over a fresh store with a clock the test
+// moves with advance.
+func newTestEngine(drivers *fakeDrivers) (*Engine, *store.MemBookingStore, func(time.Duration)) {
+	s := store.NewMemBookingStore()
+	e := New(s, s.Outbox(), drivers, &fakeUsers{}, Config{OfferTimeout: 30 * time.Second, BookingTimeout: 2 * time.Minute}, logrus.New())
+	now := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+	e.now = func() time.Time { return now }
+	return e, s, func(d time.Duration) { now = now.Add(d) }
+}
+
+// newBooking stores a pending booking made at e's current time.
+func newBooking(t *testing.T, e *Engine, s *store.MemBookingStore, pickup *model.LatLng) int32 {
+	t.Helper()
+	return newWalletBooking(t, e, s, pickup, 0)
+}
+
+// newWalletBooking stores a pending booking made at e's current time and
+// paid by the wallet hold holdID, or by card if holdID is 0.
+func newWalletBooking(t *testing.T, e *Engine, s *store.MemBookingStore, pickup *model.LatLng, holdID int32) int32 {
+	t.Helper()
+	ctx := context.Background()
+
+	userID, err := s.CreateUser(ctx, "John Doe")
+	require.NoError(t, err)
+	cost := money.Money{Currency: "PKR", Minor: 15000}
+	rideID, err := s.CreateRide(ctx, "Downtown", "Airport", 15, cost)
+	require.NoError(t, err)
+	saga := &model.BookingSaga{
+		ID:     uuid.NewString(),
+		UserID: userID,
+		Ride:   model.Ride{ID: rideID, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: cost, Pickup: pickup},
+		Status: model.SagaRunning,
+		Step:   model.StepCreateBooking,
+		HoldID: holdID,
+	}
+	if holdID != 0 {
+		saga.PaymentMethod = model.PaymentWallet
+	}
+	require.NoError(t, s.CreateSaga(ctx, saga))
+	bookingID, err := s.CompleteSaga(ctx, saga, e.now())
+	require.NoError(t, err)
+	return bookingID
+}
+
+// offers returns the offers of a pending booking as driver ID and status
+// pairs, oldest first.
+func offers(t *testing.T, s *store.MemBookingStore, bookingID int32) []string {
+	t.Helper()
+
+	pending, err := s.ListPendingBookings(context.Background(), bookingID-1, 1)
+	require.NoError(t, err)
+	out := []string{}
+	for _, b := range pending {
+		if b.Booking.ID != bookingID {
+			continue
+		}
+		for _, o := range b.Offers {
+			out = append(out, fmt.Sprintf("%d/%s", o.DriverID, o.Status))
+		}
+	}
+	return out
+}
+
+func TestEngine_RunOnce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Offers Nearest Driver", func(t *testing.T) {
+		drivers := &fakeDrivers{nearby: []*driverpb.NearbyDriver{nearby(2, 0.4), nearby(1, 1.5)}}
+		e, s, _ := newTestEngine(drivers)
+		bookingID := newBooking(t, e, s, liberty)
+
+		require.NoError(t, e.RunOnce(ctx))
+		require.Equal(t, []string{"2/PENDING"}, offers(t, s, bookingID))
+		require.Len(t, drivers.requests, 1)
+		require.Equal(t, liberty.Lat, drivers.requests[0].Location.Latitude)
+		require.Equal(t, DefaultConfig.SearchRadiusKm, drivers.requests[0].RadiusKm)
+
+		// A booking with a pending offer waits for the driver.
+		require.NoError(t, e.RunOnce(ctx))
+		require.Equal(t, []string{"2/PENDING"}, offers(t, s, bookingID))
+		require.Len(t, drivers.requests, 1)
+
+		offered, err := s.ListDriverOffers(ctx, 2, e.now())
+		require.NoError(t, err)
+		require.Len(t, offered, 1)
+		require.InDelta(t, 0.4, offered[0].DistanceKm, 1e-9)
+		require.Equal(t, e.now().Add(30*time.Second), offered[0].ExpiresAt)
+	})
+
+	t.Run("Re-offers On Decline", func(t *testing.T) {
+		e, s, _ := newTestEngine(&fakeDrivers{nearby: []*driverpb.NearbyDriver{nearby(2, 0.4), nearby(1, 1.5)}})
+		bookingID := newBooking(t, e, s, liberty)
+
+		require.NoError(t, e.RunOnce(ctx))
+		offered, err := s.ListDriverOffers(ctx, 2, e.now())
+		require.NoError(t, err)
+		_, _, err = s.RespondToOffer(ctx, offered[0].ID, 2, false, e.now())
+		require.NoError(t, err)
+
+		require.NoError(t, e.RunOnce(ctx))
+		require.Equal(t, []string{"2/DECLINED", "1/PENDING"}, offers(t, s, bookingID))
+	})
+
+	t.Run("Re-offers On Expiry", func(t *testing.T) {
+		e, s, advance := newTestEngine(&fakeDrivers{nearby: []*driverpb.NearbyDriver{nearby(2, 0.4), nearby(1, 1.5)}})
+		bookingID := newBooking(t, e, s, liberty)
+
+		require.NoError(t, e.RunOnce(ctx))
+		advance(30 * time.Second)
+		require.NoError(t, e.RunOnce(ctx))
+		require.Equal(t, []string{"2/EXPIRED", "1/PENDING"}, offers(t, s, bookingID))
+	})
+
+	t.Run("One Offer Per Driver", func(t *testing.T) {
+		e, s, _ := newTestEngine(&fakeDrivers{nearby: []*driverpb.NearbyDriver{nearby(2, 0.4), nearby(1, 1.5)}})
+		first := newBooking(t, e, s, liberty)
+		second := newBooking(t, e, s, liberty)
+		third := newBooking(t, e, s, liberty)
+
+		require.NoError(t, e.RunOnce(ctx))
+		require.Equal(t, []string{"2/PENDING"}, offers(t, s, first))
+		require.Equal(t, []string{"1/PENDING"}, offers(t, s, second))
+		require.Equal(t, []string{}, offers(t, s, third))
+	})
+
+	t.Run("Booking Expires Without Driver", func(t *testing.T) {
+		e, s, advance := newTestEngine(&fakeDrivers{nearby: []*driverpb.NearbyDriver{nearby(2, 0.4)}})
+		bookingID := newBooking(t, e, s, liberty)
+
+		require.NoError(t, e.RunOnce(ctx))
+		advance(time.Minute)
+		// The only driver let the offer expire, so nobody is left to offer
+		// the booking to.
+		require.NoError(t, e.RunOnce(ctx))
+		require.Equal(t, []string{"2/EXPIRED"}, offers(t, s, bookingID))
+
+		advance(time.Minute)
+		require.NoError(t, e.RunOnce(ctx))
+		booking, _, _, err := s.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, model.BookingExpired, booking.Status)
+		require.Empty(t, e.users.(*fakeUsers).released)
+	})
+
+	t.Run("Expired Booking Releases Wallet Hold", func(t *testing.T) {
+		e, s, advance := newTestEngine(&fakeDrivers{})
+		bookingID := newWalletBooking(t, e, s, liberty, 9)
+
+		advance(2 * time.Minute)
+		require.NoError(t, e.RunOnce(ctx))
+		booking, _, _, err := s.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, model.BookingExpired, booking.Status)
+		require.Equal(t, []int32{9}, e.users.(*fakeUsers).released)
+
+		// Expired bookings are not looked at again.
+		require.NoError(t, e.RunOnce(ctx))
+		require.Equal(t, []int32{9}, e.users.(*fakeUsers).released)
+	})
+
+	t.Run("Scheduled Booking Waits From Its Pickup Time", func(t *testing.T) {
+		e, s, advance := newTestEngine(&fakeDrivers{})
+		userID, err := s.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		cost := money.Money{Currency: "PKR", Minor: 15000}
+		rideID, err := s.CreateRide(ctx, "Downtown", "Airport", 15, cost)
+		require.NoError(t, err)
+		saga := &model.BookingSaga{
+			ID:         uuid.NewString(),
+			UserID:     userID,
+			Ride:       model.Ride{ID: rideID, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: cost, Pickup: liberty},
+			Status:     model.SagaRunning,
+			Step:       model.StepCreateBooking,
+			PickupTime: e.now().Add(time.Hour),
+		}
+		require.NoError(t, s.CreateSaga(ctx, saga))
+		bookingID, err := s.CompleteSaga(ctx, saga, e.now())
+		require.NoError(t, err)
+
+		// Released to dispatch ahead of its pickup, the booking was made
+		// long ago but only expires once it has waited the booking timeout
+		// past its pickup time.
+		advance(50 * time.Minute)
+		require.NoError(t, s.ReleaseBooking(ctx, bookingID))
+		require.NoError(t, e.RunOnce(ctx))
+		booking, _, _, err := s.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, model.BookingPending, booking.Status)
+
+		advance(12 * time.Minute)
+		require.NoError(t, e.RunOnce(ctx))
+		booking, _, _, err = s.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, model.BookingExpired, booking.Status)
+	})
+
+	t.Run("No Pickup", func(t *testing.T) {
+		drivers := &fakeDrivers{nearby: []*driverpb.NearbyDriver{nearby(2, 0.4)}}
+		e, s, _ := newTestEngine(drivers)
+		bookingID := newBooking(t, e, s, nil)
+
+		require.NoError(t, e.RunOnce(ctx))
+		require.Equal(t, []string{}, offers(t, s, bookingID))
+		require.Empty(t, drivers.requests)
+	})
+
+	t.Run("Unmatched Bookings Do Not Hold Up Later Ones", func(t *testing.T) {
+		drivers := &fakeDrivers{nearby: []*driverpb.NearbyDriver{nearby(2, 0.4)}}
+		e, s, _ := newTestEngine(drivers)
+		// A full batch of bookings nobody can be offered.
+		for range batchSize {
+			newBooking(t, e, s, nil)
+		}
+		bookingID := newBooking(t, e, s, liberty)
+
+		require.NoError(t, e.RunOnce(ctx))
+		require.Equal(t, []string{"2/PENDING"}, offers(t, s, bookingID))
+	})
+
+	t.Run("Skips Confirmed Bookings", func(t *testing.T) {
+		e, s, _ := newTestEngine(&fakeDrivers{nearby: []*driverpb.NearbyDriver{nearby(2, 0.4)}})
+		bookingID := newBooking(t, e, s, liberty)
+
+		require.NoError(t, e.RunOnce(ctx))
+		offered, err := s.ListDriverOffers(ctx, 2, e.now())
+		require.NoError(t, err)
+		_, booking, err := s.RespondToOffer(ctx, offered[0].ID, 2, true, e.now())
+		require.NoError(t, err)
+		require.Equal(t, model.BookingConfirmed, booking.Status)
+
+		require.NoError(t, e.RunOnce(ctx))
+		pending, err := s.ListPendingBookings(ctx, 0, batchSize)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+		booking, _, _, err = s.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, int32(2), booking.DriverID)
+	})
+}
@@ -0,0 +1,102 @@
+// Package grpcerr builds gRPC status errors carrying google.rpc error details
+// (ErrorInfo, BadRequest, RetryInfo) so clients can act on failures without
+// parsing messages.
+package grpcerr
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain identifies this service in ErrorInfo details.
+const Domain = "booking.v1.BookingService"
+
+// Stable ErrorInfo reasons. Clients may switch on these, so never rename them.
+const (
+	ReasonInvalidRequest        = "INVALID_REQUEST"
+	ReasonValidationRule        = "VALIDATION_RULE_ERROR"
+	ReasonBookingNotFound       = "BOOKING_NOT_FOUND"
+	ReasonAlreadyExists         = "ALREADY_EXISTS"
+	ReasonForeignKeyViolation   = "FOREIGN_KEY_VIOLATION"
+	ReasonSerializationFailure  = "SERIALIZATION_FAILURE"
+	ReasonDatabaseTimeout       = "DATABASE_TIMEOUT"
+	ReasonCanceled              = "CANCELED"
+	ReasonDatabaseError         = "DATABASE_ERROR"
+	ReasonGatewayError          = "GATEWAY_ERROR"
+	ReasonSagaNotFound          = "SAGA_NOT_FOUND"
+	ReasonSagaConflict          = "SAGA_CONFLICT"
+	ReasonSagaPending           = "SAGA_PENDING"
+	ReasonSagaFailed            = "SAGA_FAILED"
+	ReasonBookingNotPending     = "BOOKING_NOT_PENDING"
+	ReasonOfferNotFound         = "OFFER_NOT_FOUND"
+	ReasonOfferClosed           = "OFFER_CLOSED"
+	ReasonQuoteNotFound         = "QUOTE_NOT_FOUND"
+	ReasonQuoteExpired          = "QUOTE_EXPIRED"
+	ReasonQuoteUsed             = "QUOTE_USED"
+	ReasonPromoNotFound         = "PROMO_NOT_FOUND"
+	ReasonPromoNotActive        = "PROMO_NOT_ACTIVE"
+	ReasonPromoNotEligible      = "PROMO_NOT_ELIGIBLE"
+	ReasonPromoExhausted        = "PROMO_EXHAUSTED"
+	ReasonPromoLimitReached     = "PROMO_LIMIT_REACHED"
+	ReasonBookingNotConfirmed   = "BOOKING_NOT_CONFIRMED"
+	ReasonPaymentDeclined       = "PAYMENT_DECLINED"
+	ReasonPaymentFailed         = "PAYMENT_FAILED"
+	ReasonLedgerError           = "LEDGER_ERROR"
+	ReasonBookingNotCancellable = "BOOKING_NOT_CANCELLABLE"
+	ReasonBookingNotRefundable  = "BOOKING_NOT_REFUNDABLE"
+	ReasonRefundConflict        = "REFUND_CONFLICT"
+	ReasonNoReceipt             = "NO_RECEIPT"
+	ReasonRenderError           = "RENDER_ERROR"
+)
+
+// DefaultRetryDelay is the back-off suggested to clients for transient failures.
+const DefaultRetryDelay = 100 * time.Millisecond
+
+// FieldViolation describes why a single request field is invalid.
+func FieldViolation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: description}
+}
+
+// InvalidArgument returns an InvalidArgument error with a BadRequest listing
+// every violation. The message reads "invalid <field>: <description>" for each;
+// a violation with an empty field applies to the request as a whole.
+func InvalidArgument(violations ...*errdetails.BadRequest_FieldViolation) error {
+	msgs := make([]string, 0, len(violations))
+	for _, v := range violations {
+		field := v.Field
+		if field == "" {
+			field = "request"
+		}
+		msgs = append(msgs, fmt.Sprintf("invalid %s: %s", field, v.Description))
+	}
+	return New(codes.InvalidArgument, ReasonInvalidRequest, strings.Join(msgs, "; "),
+		&errdetails.BadRequest{FieldViolations: violations})
+}
+
+// Retry suggests that the client retry after delay.
+func Retry(delay time.Duration) *errdetails.RetryInfo {
+	return &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}
+}
+
+// New returns a status error with an ErrorInfo for reason followed by details.
+func New(code codes.Code, reason, msg string, details ...protoadapt.MessageV1) error {
+	return NewWithMetadata(code, reason, msg, nil, details...)
+}
+
+// NewWithMetadata is like New but attaches metadata to the ErrorInfo.
+func NewWithMetadata(code codes.Code, reason, msg string, metadata map[string]string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, msg)
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: Domain, Metadata: metadata}
+	all := append([]protoadapt.MessageV1{info}, details...)
+	if withDetails, err := st.WithDetails(all...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
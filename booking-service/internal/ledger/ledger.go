@@ -0,0 +1,154 @@
+// Package ledger builds the double-entry journal entries that record money
+// moving between riders, drivers, the platform and promo codes. Every entry
+// balances: its postings sum to zero in each currency.
+package ledger
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+)
+
+// ErrUnbalanced is returned by Validate for an entry that would create or
+// destroy money.
+var ErrUnbalanced = errors.New("journal entry does not balance")
+
+// Platform and Promo are the shared accounts.
+var (
+	Platform = model.Account{Type: model.AccountPlatform}
+	Promo    = model.Account{Type: model.AccountPromo}
+)
+
+// Rider returns the account of a rider.
+func Rider(userID int32) model.Account {
+	return model.Account{Type: model.AccountRider, OwnerID: userID}
+}
+
+// Driver returns the account of a driver.
+func Driver(driverID int32) model.Account {
+	return model.Account{Type: model.AccountDriver, OwnerID: driverID}
+}
+
+// RideCompleted returns the entry for a completed booking, whose rider was
+// charged paid under the payment provider's chargeID. The promo account
+// funds the booking's discount, and the driver earns the fare before it,
+// less the platform's commission: that fare scaled by commissionRate. Zero
+// postings are left out.
+func RideCompleted(booking *model.Booking, paid money.Money, commissionRate float64, chargeID string) (*model.JournalEntry, error) {
+	discount := money.Zero(paid.Currency)
+	if booking.Discount.Applied() {
+		discount = booking.Discount.Amount
+	}
+	fare, err := paid.Add(discount)
+	if err != nil {
+		return nil, err
+	}
+	commission, err := fare.Mul(commissionRate)
+	if err != nil {
+		return nil, err
+	}
+	earnings, err := fare.Sub(commission)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &model.JournalEntry{BookingID: booking.ID, Kind: model.EntryRideCompleted, Reference: chargeID}
+	for _, p := range []model.Posting{
+		{Account: Rider(booking.UserID), Amount: money.Money{Currency: paid.Currency, Minor: -paid.Minor}},
+		{Account: Promo, Amount: money.Money{Currency: discount.Currency, Minor: -discount.Minor}},
+		{Account: Driver(booking.DriverID), Amount: earnings},
+		{Account: Platform, Amount: commission},
+	} {
+		if !p.Amount.IsZero() {
+			entry.Postings = append(entry.Postings, p)
+		}
+	}
+	if err := Validate(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// CancellationFee returns the entry for the fee the rider of a cancelled
+// booking was charged under chargeID. The booking's driver earns the fee
+// less the platform's commission, the fee scaled by commissionRate; without
+// a driver the platform keeps all of it.
+func CancellationFee(booking *model.Booking, fee money.Money, commissionRate float64, chargeID string) (*model.JournalEntry, error) {
+	commission := fee
+	if booking.DriverID != 0 {
+		var err error
+		if commission, err = fee.Mul(commissionRate); err != nil {
+			return nil, err
+		}
+	}
+	earnings, err := fee.Sub(commission)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &model.JournalEntry{BookingID: booking.ID, Kind: model.EntryCancellationFee, Reference: chargeID}
+	for _, p := range []model.Posting{
+		{Account: Rider(booking.UserID), Amount: money.Money{Currency: fee.Currency, Minor: -fee.Minor}},
+		{Account: Driver(booking.DriverID), Amount: earnings},
+		{Account: Platform, Amount: commission},
+	} {
+		if !p.Amount.IsZero() {
+			entry.Postings = append(entry.Postings, p)
+		}
+	}
+	if err := Validate(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Refund returns the entry for giving amount back to the rider of booking
+// under refundID. The platform funds refunds; what the driver earned and
+// what promo codes gave away are left as they were.
+func Refund(booking *model.Booking, amount money.Money, refundID string) (*model.JournalEntry, error) {
+	entry := &model.JournalEntry{
+		BookingID: booking.ID,
+		Kind:      model.EntryRefund,
+		Reference: refundID,
+		Postings: []model.Posting{
+			{Account: Rider(booking.UserID), Amount: amount},
+			{Account: Platform, Amount: money.Money{Currency: amount.Currency, Minor: -amount.Minor}},
+		},
+	}
+	if err := Validate(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Validate checks that entry can be stored: it has postings, none of them
+// zero or to the same account twice, and they sum to zero in each currency.
+func Validate(entry *model.JournalEntry) error {
+	if len(entry.Postings) == 0 {
+		return fmt.Errorf("%w: no postings", ErrUnbalanced)
+	}
+	sums := map[string]int64{}
+	seen := map[model.Account]bool{}
+	for _, p := range entry.Postings {
+		if p.Amount.IsZero() {
+			return fmt.Errorf("%w: zero posting to %s", ErrUnbalanced, p.Account)
+		}
+		if seen[p.Account] {
+			return fmt.Errorf("%w: %s is posted to twice", ErrUnbalanced, p.Account)
+		}
+		seen[p.Account] = true
+		sum, err := money.Money{Currency: p.Amount.Currency, Minor: sums[p.Amount.Currency]}.Add(p.Amount)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrUnbalanced, err)
+		}
+		sums[p.Amount.Currency] = sum.Minor
+	}
+	for currency, sum := range sums {
+		if sum != 0 {
+			return fmt.Errorf("%w: postings sum to %s", ErrUnbalanced, money.Money{Currency: currency, Minor: sum})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/stretchr/testify/require"
+)
+
+func pkr(minor int64) money.Money {
+	return money.Money{Currency: "PKR", Minor: minor}
+}
+
+func TestRideCompleted(t *testing.T) {
+	booking := &model.Booking{ID: 1001, UserID: 1, DriverID: 7}
+	discounted := &model.Booking{ID: 1002, UserID: 1, DriverID: 7,
+		Discount: model.Discount{PromoCode: "WELCOME20", Fare: pkr(62500), Amount: pkr(12500)}}
+	free := &model.Booking{ID: 1003, UserID: 1, DriverID: 7,
+		Discount: model.Discount{PromoCode: "FREE", Fare: pkr(30000), Amount: pkr(30000)}}
+
+	tests := []struct {
+		name             string
+		booking          *model.Booking
+		paid             money.Money
+		rate             float64
+		expectedPostings []model.Posting
+	}{
+		{
+			name: "Commission", booking: booking, paid: pkr(62500), rate: 0.2,
+			expectedPostings: []model.Posting{
+				{Account: Rider(1), Amount: pkr(-62500)},
+				{Account: Driver(7), Amount: pkr(50000)},
+				{Account: Platform, Amount: pkr(12500)},
+			},
+		},
+		{
+			name: "Commission Rounds To Nearest Paisa", booking: booking, paid: pkr(62503), rate: 0.25,
+			expectedPostings: []model.Posting{
+				{Account: Rider(1), Amount: pkr(-62503)},
+				{Account: Driver(7), Amount: pkr(46877)},
+				{Account: Platform, Amount: pkr(15626)},
+			},
+		},
+		{
+			name: "No Commission", booking: booking, paid: pkr(62500), rate: 0,
+			expectedPostings: []model.Posting{
+				{Account: Rider(1), Amount: pkr(-62500)},
+				{Account: Driver(7), Amount: pkr(62500)},
+			},
+		},
+		{
+			name: "Promo Funds Discount", booking: discounted, paid: pkr(50000), rate: 0.2,
+			expectedPostings: []model.Posting{
+				{Account: Rider(1), Amount: pkr(-50000)},
+				{Account: Promo, Amount: pkr(-12500)},
+				{Account: Driver(7), Amount: pkr(50000)},
+				{Account: Platform, Amount: pkr(12500)},
+			},
+		},
+		{
+			name: "Free Ride", booking: free, paid: pkr(0), rate: 0.2,
+			expectedPostings: []model.Posting{
+				{Account: Promo, Amount: pkr(-30000)},
+				{Account: Driver(7), Amount: pkr(24000)},
+				{Account: Platform, Amount: pkr(6000)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := RideCompleted(tt.booking, tt.paid, tt.rate, "ch_1")
+			require.NoError(t, err)
+			require.Equal(t, tt.booking.ID, entry.BookingID)
+			require.Equal(t, model.EntryRideCompleted, entry.Kind)
+			require.Equal(t, "ch_1", entry.Reference)
+			require.Equal(t, tt.expectedPostings, entry.Postings)
+		})
+	}
+
+	_, err := RideCompleted(discounted, money.Money{Currency: "USD", Minor: 100}, 0.2, "ch_1")
+	require.ErrorIs(t, err, money.ErrCurrencyMismatch)
+}
+
+func TestCancellationFee(t *testing.T) {
+	tests := []struct {
+		name             string
+		booking          *model.Booking
+		expectedPostings []model.Posting
+	}{
+		{
+			name: "Driver Earns Fee Less Commission", booking: &model.Booking{ID: 1001, UserID: 1, DriverID: 7},
+			expectedPostings: []model.Posting{
+				{Account: Rider(1), Amount: pkr(-10000)},
+				{Account: Driver(7), Amount: pkr(8000)},
+				{Account: Platform, Amount: pkr(2000)},
+			},
+		},
+		{
+			name: "Platform Keeps Fee Without Driver", booking: &model.Booking{ID: 1001, UserID: 1},
+			expectedPostings: []model.Posting{
+				{Account: Rider(1), Amount: pkr(-10000)},
+				{Account: Platform, Amount: pkr(10000)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := CancellationFee(tt.booking, pkr(10000), 0.2, "ch_2")
+			require.NoError(t, err)
+			require.Equal(t, model.EntryCancellationFee, entry.Kind)
+			require.Equal(t, "ch_2", entry.Reference)
+			require.Equal(t, tt.expectedPostings, entry.Postings)
+		})
+	}
+}
+
+func TestRefund(t *testing.T) {
+	entry, err := Refund(&model.Booking{ID: 1001, UserID: 1, DriverID: 7}, pkr(2500), "re_1")
+	require.NoError(t, err)
+	require.Equal(t, model.EntryRefund, entry.Kind)
+	require.Equal(t, "re_1", entry.Reference)
+	require.Equal(t, []model.Posting{
+		{Account: Rider(1), Amount: pkr(2500)},
+		{Account: Platform, Amount: pkr(-2500)},
+	}, entry.Postings)
+
+	_, err = Refund(&model.Booking{ID: 1001, UserID: 1}, pkr(0), "re_2")
+	require.ErrorIs(t, err, ErrUnbalanced)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		postings []model.Posting
+		valid    bool
+	}{
+		{name: "Balanced", postings: []model.Posting{{Account: Rider(1), Amount: pkr(-100)}, {Account: Driver(7), Amount: pkr(100)}}, valid: true},
+		{name: "Balanced Per Currency", postings: []model.Posting{
+			{Account: Rider(1), Amount: pkr(-100)}, {Account: Driver(7), Amount: pkr(100)},
+			{Account: Rider(2), Amount: money.Money{Currency: "USD", Minor: -5}}, {Account: Platform, Amount: money.Money{Currency: "USD", Minor: 5}},
+		}, valid: true},
+		{name: "No Postings"},
+		{name: "Unbalanced", postings: []model.Posting{{Account: Rider(1), Amount: pkr(-100)}, {Account: Driver(7), Amount: pkr(90)}}},
+		{name: "Across Currencies", postings: []model.Posting{
+			{Account: Rider(1), Amount: pkr(-100)}, {Account: Driver(7), Amount: money.Money{Currency: "USD", Minor: 100}},
+		}},
+		{name: "Zero Posting", postings: []model.Posting{
+			{Account: Rider(1), Amount: pkr(-100)}, {Account: Driver(7), Amount: pkr(100)}, {Account: Platform, Amount: pkr(0)},
+		}},
+		{name: "Same Account Twice", postings: []model.Posting{
+			{Account: Rider(1), Amount: pkr(-100)}, {Account: Rider(1), Amount: pkr(100)},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&model.JournalEntry{Postings: tt.postings})
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrUnbalanced)
+			}
+		})
+	}
+}
@@ -6,6 +6,16 @@ import (
 	"net/http"
 )
 
+// Dispatch decisions, the values of the decision label of DispatchDecisions.
+const (
+	DecisionOffered        = "offered"         // A booking was offered to a driver
+	DecisionAccepted       = "accepted"        // A driver accepted an offer
+	DecisionDeclined       = "declined"        // A driver declined an offer
+	DecisionOfferExpired   = "offer_expired"   // A driver did not respond in time
+	DecisionNoCandidates   = "no_candidates"   // No driver near the pickup could be offered a booking
+	DecisionBookingExpired = "booking_expired" // No driver accepted a booking in time
+)
+
 var (
 	RequestCount = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -14,10 +24,41 @@ var (
 		},
 		[]string{"method", "status"},
 	)
+
+	DispatchDecisions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dispatch_decisions_total",
+			Help: "Total number of dispatch decisions, by decision",
+		},
+		[]string{"decision"},
+	)
+
+	DispatchMatchDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "dispatch_match_duration_seconds",
+			Help:    "Time from booking to a driver accepting it",
+			Buckets: []float64{5, 15, 30, 60, 120, 300, 600},
+		},
+	)
+
+	ScheduleFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "schedule_failures_total",
+			Help: "Total number of scheduled bookings the scheduler failed to remind of or release",
+		},
+	)
+
+	SurgeMultiplier = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "surge_multiplier",
+			Help: "Surge multiplier last quoted in each zone",
+		},
+		[]string{"zone"},
+	)
 )
 
 func InitMetrics() {
-	prometheus.MustRegister(RequestCount)
+	prometheus.MustRegister(RequestCount, DispatchDecisions, DispatchMatchDuration, ScheduleFailures, SurgeMultiplier)
 }
 
 // StartMetricsServer starts a Prometheus metrics server.
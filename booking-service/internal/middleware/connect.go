@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ConnectInterceptor runs gRPC unary interceptors for calls served over the
+// Connect and gRPC-Web protocols, so those calls are logged, measured and
+// validated exactly like native gRPC calls. gRPC status errors returned by the
+// chain are converted to Connect errors, keeping their details.
+func ConnectInterceptor(interceptors ...grpc.UnaryServerInterceptor) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			info := &grpc.UnaryServerInfo{FullMethod: req.Spec().Procedure}
+
+			var res connect.AnyResponse
+			handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+				var err error
+				if res, err = next(ctx, req); err != nil {
+					return nil, err
+				}
+				return res.Any(), nil
+			}
+			for i := len(interceptors) - 1; i >= 0; i-- {
+				handler = chainHandler(interceptors[i], info, handler)
+			}
+
+			if _, err := handler(ctx, req.Any()); err != nil {
+				return nil, connectError(err)
+			}
+			return res, nil
+		}
+	})
+}
+
+func chainHandler(interceptor grpc.UnaryServerInterceptor, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
+// ConnectStreamInterceptor runs gRPC stream interceptors for streaming calls
+// served over the Connect and gRPC-Web protocols, as ConnectInterceptor does
+// for unary calls.
+func ConnectStreamInterceptor(interceptors ...grpc.StreamServerInterceptor) connect.Interceptor {
+	return streamInterceptor(interceptors)
+}
+
+type streamInterceptor []grpc.StreamServerInterceptor
+
+func (i streamInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return next
+}
+
+func (i streamInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i streamInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		streamType := conn.Spec().StreamType
+		info := &grpc.StreamServerInfo{
+			FullMethod:     conn.Spec().Procedure,
+			IsClientStream: streamType&connect.StreamTypeClient != 0,
+			IsServerStream: streamType&connect.StreamTypeServer != 0,
+		}
+		handler := func(_ interface{}, ss grpc.ServerStream) error {
+			return next(ss.Context(), &interceptedConn{StreamingHandlerConn: conn, ss: ss})
+		}
+		for j := len(i) - 1; j >= 0; j-- {
+			handler = chainStreamHandler(i[j], info, handler)
+		}
+		if err := handler(nil, &connectServerStream{ctx: ctx, conn: conn}); err != nil {
+			return connectError(err)
+		}
+		return nil
+	}
+}
+
+func chainStreamHandler(interceptor grpc.StreamServerInterceptor, info *grpc.StreamServerInfo, handler grpc.StreamHandler) grpc.StreamHandler {
+	return func(srv interface{}, ss grpc.ServerStream) error {
+		return interceptor(srv, ss, info, handler)
+	}
+}
+
+// connectServerStream presents a Connect stream to gRPC stream interceptors.
+type connectServerStream struct {
+	ctx  context.Context
+	conn connect.StreamingHandlerConn
+}
+
+func (s *connectServerStream) SetHeader(md metadata.MD) error {
+	for k, v := range md {
+		s.conn.ResponseHeader()[http.CanonicalHeaderKey(k)] = append(s.conn.ResponseHeader()[http.CanonicalHeaderKey(k)], v...)
+	}
+	return nil
+}
+
+func (s *connectServerStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *connectServerStream) SetTrailer(md metadata.MD) {
+	for k, v := range md {
+		s.conn.ResponseTrailer()[http.CanonicalHeaderKey(k)] = append(s.conn.ResponseTrailer()[http.CanonicalHeaderKey(k)], v...)
+	}
+}
+
+func (s *connectServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *connectServerStream) SendMsg(m interface{}) error {
+	return s.conn.Send(m)
+}
+
+func (s *connectServerStream) RecvMsg(m interface{}) error {
+	return s.conn.Receive(m)
+}
+
+// interceptedConn routes a Connect handler's messages through the stream
+// the interceptors wrapped.
+type interceptedConn struct {
+	connect.StreamingHandlerConn
+	ss grpc.ServerStream
+}
+
+func (c *interceptedConn) Receive(m any) error {
+	return c.ss.RecvMsg(m)
+}
+
+func (c *interceptedConn) Send(m any) error {
+	return c.ss.SendMsg(m)
+}
+
+// connectError converts a gRPC status error to the equivalent Connect error.
+func connectError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	connectErr := connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, d := range st.Proto().GetDetails() {
+		if detail, err := connect.NewErrorDetail(d); err == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+	return connectErr
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"time"
 )
 
 func LoggingInterceptor(logger *logrus.Logger) grpc.UnaryServerInterceptor {
@@ -38,3 +39,38 @@ func LoggingInterceptor(logger *logrus.Logger) grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+// StreamLoggingInterceptor logs the start and end of streaming calls.
+func StreamLoggingInterceptor(logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// Log stream start
+		logger.WithFields(logrus.Fields{
+			"method": info.FullMethod,
+		}).Info("gRPC Stream Started")
+
+		// Handle the stream
+		start := time.Now()
+		err := handler(srv, ss)
+
+		// Log stream end or error
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"method":   info.FullMethod,
+				"duration": time.Since(start).String(),
+				"error":    err.Error(),
+			}).Error("gRPC Stream Error")
+		} else {
+			logger.WithFields(logrus.Fields{
+				"method":   info.FullMethod,
+				"duration": time.Since(start).String(),
+			}).Info("gRPC Stream Ended")
+		}
+
+		return err
+	}
+}
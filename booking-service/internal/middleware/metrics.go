@@ -25,3 +25,23 @@ func MetricsInterceptor() grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+// StreamMetricsInterceptor captures Prometheus metrics for streaming gRPC
+// calls, counting each stream once when it ends.
+func StreamMetricsInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// Handle stream
+		err := handler(srv, ss)
+
+		// Update metrics
+		st, _ := status.FromError(err)
+		metrics.RequestCount.WithLabelValues(info.FullMethod, st.Code().String()).Inc()
+
+		return err
+	}
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidationInterceptor rejects requests that violate the buf.validate
+// constraints declared in the .proto files with a structured InvalidArgument
+// error, before they reach the handler.
+func ValidationInterceptor() grpc.UnaryServerInterceptor {
+	validator := validation.New()
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if err := validate(validator, msg); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// validate returns the error rejecting msg, or nil if msg is valid.
+func validate(validator *validation.Validator, msg proto.Message) error {
+	violations, err := validator.Validate(msg)
+	if err != nil {
+		return grpcerr.New(codes.Internal, grpcerr.ReasonValidationRule, err.Error())
+	}
+	if len(violations) > 0 {
+		fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0, len(violations))
+		for _, v := range violations {
+			fieldViolations = append(fieldViolations, grpcerr.FieldViolation(v.Field, v.Message))
+		}
+		return grpcerr.InvalidArgument(fieldViolations...)
+	}
+	return nil
+}
+
+// StreamValidationInterceptor applies the same buf.validate checks as
+// ValidationInterceptor to every message a streaming call receives.
+func StreamValidationInterceptor() grpc.StreamServerInterceptor {
+	validator := validation.New()
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &validatingStream{ServerStream: ss, validator: validator})
+	}
+}
+
+// validatingStream validates each message it receives.
+type validatingStream struct {
+	grpc.ServerStream
+	validator *validation.Validator
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	return validate(s.validator, msg)
+}
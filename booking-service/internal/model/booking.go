@@ -1,10 +1,134 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/money"
+)
+
+// Booking statuses. A booking made for a later pickup is scheduled until
+// shortly before it. A booking is pending until dispatch finds a driver, and
+// confirmed until the driver completes it. The rider can cancel it until then,
+// and the driver can cancel a confirmed booking whose rider did not show up.
+const (
+	BookingScheduled = "SCHEDULED"
+	BookingPending   = "PENDING"
+	BookingConfirmed = "CONFIRMED"
+	BookingExpired   = "EXPIRED"
+	BookingCompleted = "COMPLETED"
+	BookingCancelled = "CANCELLED"
+)
+
+// Payment methods.
+const (
+	PaymentCard   = "CARD"   // Charged through the payment provider on completion
+	PaymentWallet = "WALLET" // Held in the rider's wallet when booking, captured on completion
+)
+
+// Cancellation reasons.
+const (
+	CancelledByRider = "RIDER"   // The rider cancelled
+	CancelledNoShow  = "NO_SHOW" // The driver reported that the rider did not show up
+)
+
+// Refund reasons.
+const (
+	RefundServiceIssue = "SERVICE_ISSUE" // Something went wrong with the ride
+	RefundFareDispute  = "FARE_DISPUTE"  // The rider disputed the fare
+	RefundFeeWaived    = "FEE_WAIVED"    // A cancellation or no-show fee was waived
+	RefundOther        = "OTHER"
+)
 
 type Booking struct {
 	ID        int32
 	UserID    int32
 	RideID    int32
+	DriverID  int32 // Assigned driver, 0 if none
 	Timestamp time.Time
+	Status    string // One of the Booking* statuses
+
+	// PickupTime is when the rider is picked up: the booking time, or later
+	// for a scheduled booking. RemindedAt is when the rider of a scheduled
+	// booking was reminded of it, zero until then. AssignedAt is when a
+	// driver accepted the booking, zero until one did.
+	PickupTime time.Time
+	RemindedAt time.Time
+	AssignedAt time.Time
+
+	// TariffVersion is the tariff the ride's cost was computed with, empty
+	// for bookings made before fares were computed by the service.
+	TariffVersion string
+
+	// QuoteID is the fare quote the booking was made with, empty for
+	// bookings made before quotes.
+	QuoteID string
+
+	// Discount is what a promo code took off the fare; the ride's cost is
+	// its total.
+	Discount Discount
+
+	// Cost is what the rider pays for the ride: the ride's cost when it was
+	// booked, fixed from then on. It has no currency for bookings made
+	// before it was kept.
+	Cost money.Money
+
+	PaymentMethod string // One of the Payment* methods
+	HoldID        int32  // Wallet hold of the ride's cost, 0 if none
+
+	CancellationReason string      // One of the Cancelled* reasons, empty unless cancelled
+	CancellationFee    money.Money // Charged for cancelling; zero if nothing was
+
+	// ChargeID is the reference of what the rider was charged, the fare or
+	// the cancellation fee, empty if nothing was charged. Refunded is what
+	// they were given back of it, and RefundReason one of the Refund*
+	// reasons of the latest refund.
+	ChargeID     string
+	Refunded     money.Money
+	RefundReason string
+}
+
+// Fare returns what the rider pays for the booked ride: the booking's Cost,
+// or for bookings made before it was kept, the ride's current cost.
+func (b *Booking) Fare(ride *Ride) money.Money {
+	if b.Cost.Currency == "" {
+		return ride.Cost
+	}
+	return b.Cost
+}
+
+// Cancellation asks to cancel a booking: for its rider, or for its driver
+// when the rider did not show up.
+type Cancellation struct {
+	BookingID int32
+	Reason    string      // One of the Cancelled* reasons
+	UserID    int32       // Rider cancelling, for CancelledByRider
+	DriverID  int32       // Driver reporting the no-show, for CancelledNoShow
+	Fee       money.Money // Charged for cancelling; zero if none
+	ChargeID  string      // Reference of the fee's charge
+}
+
+// Permits reports whether c may cancel b. A rider can cancel their own
+// scheduled, pending or confirmed booking, and a driver a booking confirmed
+// with them. Either can cancel it again once cancelled.
+func (c *Cancellation) Permits(b *Booking) bool {
+	switch c.Reason {
+	case CancelledByRider:
+		return b.UserID == c.UserID && (b.Status == BookingScheduled || b.Status == BookingPending ||
+			b.Status == BookingConfirmed || b.Status == BookingCancelled)
+	case CancelledNoShow:
+		return b.DriverID == c.DriverID && (b.Status == BookingConfirmed || b.Status == BookingCancelled)
+	}
+	return false
+}
+
+// Refund gives the rider of a completed or cancelled booking back part of
+// what they were charged for it.
+type Refund struct {
+	BookingID int32
+	Amount    money.Money
+	Reason    string // One of the Refund* reasons
+
+	// Previous is what was refunded of the booking when the refund was
+	// made. Storing the refund fails if another refund was stored since.
+	Previous money.Money
 }
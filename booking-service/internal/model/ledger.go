@@ -0,0 +1,64 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/money"
+)
+
+// Ledger account types. Riders and drivers each have an account; the
+// platform and promo accounts are shared and have no owner.
+const (
+	AccountRider    = "RIDER"    // What a rider paid, as a debit
+	AccountDriver   = "DRIVER"   // What a driver earned
+	AccountPlatform = "PLATFORM" // Commission the platform earned
+	AccountPromo    = "PROMO"    // What promo codes gave away, as a debit
+)
+
+// Journal entry kinds.
+const (
+	EntryRideCompleted   = "RIDE_COMPLETED"   // The rider paid for a completed ride
+	EntryCancellationFee = "CANCELLATION_FEE" // The rider paid for cancelling or not showing up
+	EntryRefund          = "REFUND"           // The rider was refunded
+)
+
+// Account is a ledger account.
+type Account struct {
+	Type    string // One of the Account* types
+	OwnerID int32  // User or driver ID; 0 for the platform and promo accounts
+}
+
+func (a Account) String() string {
+	if a.OwnerID == 0 {
+		return a.Type
+	}
+	return fmt.Sprintf("%s:%d", a.Type, a.OwnerID)
+}
+
+// Posting credits Amount to an account, or debits it if Amount is negative.
+type Posting struct {
+	Account Account
+	Amount  money.Money
+}
+
+// JournalEntry records money moving between accounts. Its postings sum to
+// zero in each currency, and once stored it is never changed.
+type JournalEntry struct {
+	ID        int64
+	BookingID int32
+	Kind      string // One of the Entry* kinds
+	Reference string // Payment provider charge or refund ID, empty if no money moved through it
+	Postings  []Posting
+	CreatedAt time.Time
+}
+
+// StatementLine is one posting to an account, with the entry it belongs to.
+type StatementLine struct {
+	EntryID   int64
+	BookingID int32
+	Kind      string
+	Reference string
+	Amount    money.Money
+	CreatedAt time.Time
+}
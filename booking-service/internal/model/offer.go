@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// Offer statuses. Only a pending offer can be accepted or declined.
+const (
+	OfferPending  = "PENDING"
+	OfferAccepted = "ACCEPTED"
+	OfferDeclined = "DECLINED"
+	OfferExpired  = "EXPIRED"
+)
+
+// Offer is a pending booking offered to one driver by dispatch.
+type Offer struct {
+	ID         int32
+	BookingID  int32
+	DriverID   int32
+	Status     string  // One of the Offer* statuses
+	DistanceKm float64 // From the driver to the pickup when offered
+	CreatedAt  time.Time
+	ExpiresAt  time.Time // A pending offer expires at this time
+}
+
+// PendingBooking is a booking waiting for a driver, with what dispatch needs
+// to find one.
+type PendingBooking struct {
+	Booking Booking
+	Pickup  *LatLng // Nil if the booking was made without one
+	Offers  []Offer // Every offer made for the booking, oldest first
+}
+
+// OpenOffer returns the pending offer of the booking, if any.
+func (b *PendingBooking) OpenOffer() (Offer, bool) {
+	for _, o := range b.Offers {
+		if o.Status == OfferPending {
+			return o, true
+		}
+	}
+	return Offer{}, false
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/money"
+)
+
+// Promo discount types.
+const (
+	PromoPercent = "PERCENT" // PercentOff of the fare, up to MaxDiscount
+	PromoFlat    = "FLAT"    // AmountOff, up to the whole fare
+)
+
+// Promo is a promo code campaign. Its amounts are all in Currency, which is
+// empty if it has none, and zero amounts mean none. Limits of 0 mean no limit.
+type Promo struct {
+	Code           string      // Upper-cased
+	Type           string      // One of the Promo* discount types
+	Currency       string      // ISO 4217 code of the amounts; if set, only fares in it are eligible
+	PercentOff     int32       // For PromoPercent, 1 to 100
+	AmountOff      money.Money // For PromoFlat
+	MaxDiscount    money.Money // Caps a percent discount; zero for no cap
+	MinFare        money.Money // Smaller fares are not eligible; zero for none
+	VehicleClasses []string    // Eligible pricing.Class* classes; empty for all
+	StartsAt       time.Time
+	EndsAt         time.Time // The code cannot be redeemed from this time on
+	MaxRedemptions int32     // Across all riders
+	MaxPerUser     int32     // Per rider
+	Redemptions    int32     // Sagas the code was redeemed for
+	CreatedAt      time.Time
+}
+
+// Discount is what a promo code took off a fare.
+type Discount struct {
+	PromoCode string      // Empty if no promo code was applied
+	Fare      money.Money // Before the discount
+	Amount    money.Money // Taken off Fare
+}
+
+// Applied reports whether the discount comes from a promo code.
+func (d Discount) Applied() bool {
+	return d.PromoCode != ""
+}
+
+// Total returns what the rider is charged after the discount.
+func (d Discount) Total() money.Money {
+	total, err := d.Fare.Sub(d.Amount)
+	if err != nil {
+		// Discounts are computed in the fare's currency and never exceed it.
+		return d.Fare
+	}
+	return total
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/money"
+)
+
+// Quote is a fare offered by EstimateFare. CreateBooking charges it if the
+// rider books the same ride before it expires, and each quote books at
+// most one ride.
+type Quote struct {
+	ID            string
+	Pickup        LatLng
+	Dropoff       LatLng
+	VehicleClass  string      // One of the pricing.Class* vehicle classes
+	DistanceKm    int32       // As RideService computes it
+	Cost          money.Money // What the ride costs, surge included
+	Surge         float64     // Surge multiplier the cost includes, 1 without surge
+	Zone          string      // Surge zone of the pickup, empty if none
+	TariffVersion string
+	CreatedAt     time.Time
+	ExpiresAt     time.Time // The quote cannot be booked from this time on
+	Used          bool      // Whether a booking saga was started with it
+
+	// How the tariff priced Cost: the base, distance and time fares were
+	// summed and scaled by the vehicle class's Multiplier and by Surge, then
+	// raised to the minimum fare if MinimumApplied. Multiplier is 0 for
+	// quotes made before the breakdown was kept.
+	BaseFare        money.Money
+	DistanceFare    money.Money
+	TimeFare        money.Money
+	DurationMinutes int32
+	Multiplier      float64
+	MinimumApplied  bool
+}
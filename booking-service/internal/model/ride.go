@@ -1,10 +1,20 @@
 package model
 
+import "github.com/golang_falcon_task/booking-service/internal/money"
+
 // Ride represents a ride entity.
 type Ride struct {
-	ID          int32  // Ride ID
-	Source      string // Source location
-	Destination string // Destination location
-	Distance    int32  // Distance in kilometers
-	Cost        int32  // Cost in currency units
+	ID          int32       // Ride ID
+	Source      string      // Source location
+	Destination string      // Destination location
+	Distance    int32       // Distance in kilometers
+	Cost        money.Money // What the ride costs
+	Pickup      *LatLng     // Where the rider is picked up, nil if unknown
+	Dropoff     *LatLng     // Where the rider is dropped off, nil if unknown
+}
+
+// LatLng is a point on the Earth in degrees (WGS84).
+type LatLng struct {
+	Lat float64
+	Lng float64
 }
@@ -0,0 +1,64 @@
+package model
+
+import "time"
+
+// Booking saga statuses.
+const (
+	SagaRunning      = "RUNNING"
+	SagaCompensating = "COMPENSATING"
+	SagaCompleted    = "COMPLETED"
+	SagaFailed       = "FAILED"
+)
+
+// Booking saga steps. StepDeleteRide compensates StepCreateRide, and
+// StepReleaseHold compensates StepHoldFunds.
+const (
+	StepValidateUser  = "VALIDATE_USER"
+	StepHoldFunds     = "HOLD_FUNDS"
+	StepCreateRide    = "CREATE_RIDE"
+	StepCreateBooking = "CREATE_BOOKING"
+	StepDeleteRide    = "DELETE_RIDE"
+	StepReleaseHold   = "RELEASE_HOLD"
+	StepDone          = "DONE"
+)
+
+// BookingSaga is the persisted state of one booking orchestration.
+type BookingSaga struct {
+	ID        string    // Saga ID, also the idempotency key for creating the ride
+	UserID    int32     // User the ride is booked for
+	Ride      Ride      // Requested ride; ID is set once the ride is created
+	Status    string    // One of the Saga* statuses
+	Step      string    // Next step to execute, one of the Step* constants
+	BookingID int32     // Set when the saga completes
+	Error     string    // Why the saga failed, or the last error it is retrying after
+	Version   int32     // Incremented on every update, for optimistic locking
+	CreatedAt time.Time // When the saga started
+	UpdatedAt time.Time // When the saga was last updated
+
+	// TariffVersion is the tariff Ride.Cost was computed with.
+	TariffVersion string
+
+	// QuoteID is the fare quote the saga was started with, empty for sagas
+	// started before quotes were required.
+	QuoteID string
+
+	// Discount is what a promo code takes off the quoted fare; Ride.Cost is
+	// its total. The saga redeems the code, and gives the redemption back if
+	// it fails.
+	Discount Discount
+
+	// PaymentMethod is one of the Payment* methods. Wallet sagas hold
+	// Ride.Cost in the user's wallet, setting HoldID, and release the hold
+	// if they fail.
+	PaymentMethod string
+	HoldID        int32
+
+	// PickupTime is when a scheduled booking's rider is picked up, zero for
+	// bookings made for now.
+	PickupTime time.Time
+}
+
+// Active reports whether the saga still has steps to execute.
+func (s *BookingSaga) Active() bool {
+	return s.Status == SagaRunning || s.Status == SagaCompensating
+}
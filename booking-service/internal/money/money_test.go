@@ -0,0 +1,115 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+func pkr(minor int64) Money {
+	return Money{Currency: "PKR", Minor: minor}
+}
+
+func TestFromMajor(t *testing.T) {
+	tests := []struct {
+		name     string
+		currency string
+		amount   float64
+		expected Money
+		err      error
+	}{
+		{name: "Whole", currency: "PKR", amount: 625, expected: pkr(62500)},
+		{name: "Half Paisa Rounds Up", currency: "PKR", amount: 812.505, expected: pkr(81251)},
+		{name: "Below Half Rounds Down", currency: "PKR", amount: 812.504, expected: pkr(81250)},
+		{name: "Negative Half Rounds Away From Zero", currency: "PKR", amount: -0.125, expected: pkr(-13)},
+		{name: "No Minor Unit", currency: "JPY", amount: 99.5, expected: Money{Currency: "JPY", Minor: 100}},
+		{name: "Three Decimals", currency: "KWD", amount: 1.2345, expected: Money{Currency: "KWD", Minor: 1235}},
+		{name: "Unknown Currency", currency: "XXX", amount: 1, err: ErrUnknownCurrency},
+		{name: "Out Of Range", currency: "PKR", amount: 1e18, err: ErrOverflow},
+		{name: "Not A Number", currency: "PKR", amount: math.NaN(), err: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := FromMajor(tt.currency, tt.amount)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.expected, m)
+		})
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	sum, err := pkr(62500).Add(pkr(18750))
+	require.NoError(t, err)
+	require.Equal(t, pkr(81250), sum)
+
+	diff, err := pkr(100).Sub(pkr(250))
+	require.NoError(t, err)
+	require.Equal(t, pkr(-150), diff)
+	require.True(t, diff.IsNegative())
+
+	scaled, err := pkr(62500).Mul(1.3)
+	require.NoError(t, err)
+	require.Equal(t, pkr(81250), scaled)
+
+	cmp, err := pkr(1).Cmp(pkr(2))
+	require.NoError(t, err)
+	require.Equal(t, -1, cmp)
+
+	_, err = pkr(1).Add(Money{Currency: "USD", Minor: 1})
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = pkr(1).Cmp(Money{Currency: "USD", Minor: 1})
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = pkr(math.MaxInt64).Add(pkr(1))
+	require.ErrorIs(t, err, ErrOverflow)
+	_, err = pkr(math.MinInt64).Sub(pkr(1))
+	require.ErrorIs(t, err, ErrOverflow)
+	_, err = pkr(0).Sub(pkr(math.MinInt64))
+	require.ErrorIs(t, err, ErrOverflow)
+	_, err = pkr(math.MaxInt64).Mul(2)
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestString(t *testing.T) {
+	require.Equal(t, "PKR 625.50", pkr(62550).String())
+	require.Equal(t, "PKR 0.05", pkr(5).String())
+	require.Equal(t, "PKR -1.50", pkr(-150).String())
+	require.Equal(t, "PKR -92233720368547758.08", pkr(math.MinInt64).String())
+	require.Equal(t, "JPY 100", Money{Currency: "JPY", Minor: 100}.String())
+	require.Equal(t, "KWD 1.235", Money{Currency: "KWD", Minor: 1235}.String())
+}
+
+func TestFromProto(t *testing.T) {
+	tests := []struct {
+		name     string
+		proto    *moneypb.Money
+		expected Money
+		err      error
+	}{
+		{name: "Units And Nanos", proto: &moneypb.Money{CurrencyCode: "PKR", Units: 812, Nanos: 500_000_000}, expected: pkr(81250)},
+		{name: "Negative", proto: &moneypb.Money{CurrencyCode: "PKR", Units: -1, Nanos: -500_000_000}, expected: pkr(-150)},
+		{name: "Only Nanos", proto: &moneypb.Money{CurrencyCode: "PKR", Nanos: -10_000_000}, expected: pkr(-1)},
+		{name: "Three Decimals", proto: &moneypb.Money{CurrencyCode: "KWD", Units: 1, Nanos: 235_000_000}, expected: Money{Currency: "KWD", Minor: 1235}},
+		{name: "Missing Currency", proto: &moneypb.Money{Units: 1}, err: ErrUnknownCurrency},
+		{name: "Unknown Currency", proto: &moneypb.Money{CurrencyCode: "XXX", Units: 1}, err: ErrUnknownCurrency},
+		{name: "Signs Differ", proto: &moneypb.Money{CurrencyCode: "PKR", Units: 1, Nanos: -500_000_000}, err: ErrInvalid},
+		{name: "Nanos Out Of Range", proto: &moneypb.Money{CurrencyCode: "PKR", Nanos: 1_000_000_000}, err: ErrInvalid},
+		{name: "Finer Than Paisa", proto: &moneypb.Money{CurrencyCode: "PKR", Units: 1, Nanos: 5_000_000}, err: ErrPrecision},
+		{name: "Fractional Yen", proto: &moneypb.Money{CurrencyCode: "JPY", Units: 1, Nanos: 500_000_000}, err: ErrPrecision},
+		{name: "Out Of Range", proto: &moneypb.Money{CurrencyCode: "PKR", Units: math.MaxInt64}, err: ErrOverflow},
+		{name: "Out Of Range After Nanos", proto: &moneypb.Money{CurrencyCode: "PKR", Units: math.MaxInt64 / 100, Nanos: 990_000_000}, err: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := FromProto(tt.proto)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.expected, m)
+			if err == nil {
+				require.Equal(t, tt.proto.String(), m.Proto().String())
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestInProcessBroker(t *testing.T) {
+	ctx := context.Background()
+	broker := NewInProcessBroker()
+
+	var got []string
+	broker.Subscribe("google.protobuf.StringValue", func(_ context.Context, msg Message) error {
+		var v wrapperspb.StringValue
+		require.NoError(t, proto.Unmarshal(msg.Payload, &v))
+		got = append(got, v.Value)
+		return nil
+	})
+	failing := errors.New("consumer failed")
+	broker.Subscribe("google.protobuf.Int32Value", func(context.Context, Message) error { return failing })
+
+	msg, err := NewMessage(wrapperspb.String("hello"))
+	require.NoError(t, err)
+	require.NoError(t, broker.Publish(ctx, msg))
+	require.Equal(t, []string{"hello"}, got)
+
+	// A failing consumer fails the publish so the relay retries it.
+	msg, err = NewMessage(wrapperspb.Int32(1))
+	require.NoError(t, err)
+	require.ErrorIs(t, broker.Publish(ctx, msg), failing)
+
+	// Topics without subscribers are dropped.
+	msg, err = NewMessage(wrapperspb.Bool(true))
+	require.NoError(t, err)
+	require.NoError(t, broker.Publish(ctx, msg))
+}
+
+func TestFileBroker(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events", "events.jsonl")
+
+	var want []Message
+	for _, v := range []string{"first", "second"} {
+		msg, err := NewMessage(wrapperspb.String(v))
+		require.NoError(t, err)
+		want = append(want, msg)
+	}
+
+	// Reopening appends rather than truncates.
+	for _, msg := range want {
+		broker, err := NewFileBroker(path)
+		require.NoError(t, err)
+		require.NoError(t, broker.Publish(ctx, msg))
+		require.NoError(t, broker.Close())
+	}
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	got, err := ReadMessages(f)
+	require.NoError(t, err)
+	require.Len(t, got, len(want))
+	for i := range want {
+		require.Equal(t, want[i].ID, got[i].ID)
+		require.Equal(t, want[i].Topic, got[i].Topic)
+		require.Equal(t, want[i].Payload, got[i].Payload)
+		require.True(t, want[i].CreatedAt.Equal(got[i].CreatedAt))
+	}
+}
@@ -0,0 +1,157 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+)
+
+// NotifyChannel is the Postgres channel the outbox insert trigger in
+// docker/init.sql notifies.
+const NotifyChannel = "outbox"
+
+// Feed reads recorded messages back in order, for consumers that follow
+// changes as they happen rather than relay them. Unlike Store it returns
+// messages whether or not they were published.
+type Feed interface {
+	// Since returns up to limit messages recorded after the one at seq,
+	// oldest first, with Seq set.
+	Since(ctx context.Context, seq int64, limit int) ([]Message, error)
+
+	// Head returns the Seq of the newest message, or 0 if there is none.
+	Head(ctx context.Context) (int64, error)
+
+	// Changed returns a channel that is closed when a message is recorded
+	// after the call. Take it before calling Since so no message is missed.
+	Changed() <-chan struct{}
+}
+
+// notifier wakes every goroutine waiting on a Changed channel.
+type notifier struct {
+	mu sync.Mutex
+	ch chan struct{}
+}
+
+func newNotifier() *notifier {
+	return &notifier{ch: make(chan struct{})}
+}
+
+func (n *notifier) changed() <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.ch
+}
+
+func (n *notifier) notify() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	close(n.ch)
+	n.ch = make(chan struct{})
+}
+
+// PGFeed follows the outbox table, including the messages of the other
+// services that share the database. It learns of new messages through
+// LISTEN/NOTIFY while Listen runs.
+//
+// Since orders messages by their row ID, which is assigned on insert, so a
+// transaction that commits after a later one can be passed over by a reader
+// that already read beyond it. Readers that must not miss a change re-read
+// the state the messages describe when they wake.
+type PGFeed struct {
+	db       *pgxpool.Pool
+	notifier *notifier
+}
+
+// NewPGFeed creates a new PGFeed instance.
+func NewPGFeed(db *pgxpool.Pool) *PGFeed {
+	return &PGFeed{db: db, notifier: newNotifier()}
+}
+
+// Since returns up to limit messages recorded after the one at seq, oldest first.
+func (f *PGFeed) Since(ctx context.Context, seq int64, limit int) ([]Message, error) {
+	rows, err := f.db.Query(ctx, `
+        SELECT id, event_id, topic, payload, created_at
+        FROM outbox
+        WHERE id > $1
+        ORDER BY id
+        LIMIT $2
+    `, seq, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+	defer rows.Close()
+
+	msgs := []Message{}
+	for rows.Next() {
+		var m Message
+		if err := rows.Scan(&m.Seq, &m.ID, &m.Topic, &m.Payload, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read outbox: %w", err)
+		}
+		msgs = append(msgs, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+	return msgs, nil
+}
+
+// Head returns the Seq of the newest message, or 0 if there is none.
+func (f *PGFeed) Head(ctx context.Context) (int64, error) {
+	var seq int64
+	if err := f.db.QueryRow(ctx, `SELECT COALESCE(MAX(id), 0) FROM outbox`).Scan(&seq); err != nil {
+		return 0, fmt.Errorf("failed to read outbox: %w", err)
+	}
+	return seq, nil
+}
+
+// Changed returns a channel that is closed when a message is recorded after the call.
+func (f *PGFeed) Changed() <-chan struct{} {
+	return f.notifier.changed()
+}
+
+// Listen holds a connection listening on NotifyChannel until ctx is
+// canceled, reconnecting after errors. Without it Changed never fires.
+func (f *PGFeed) Listen(ctx context.Context, logger *logrus.Logger) {
+	for {
+		err := f.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Error("Outbox listener failed, reconnecting: ", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (f *PGFeed) listen(ctx context.Context) error {
+	pooled, err := f.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// A listening connection must not go back to the pool.
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+NotifyChannel); err != nil {
+		return err
+	}
+	// Notifications sent while not listening were lost, so wake readers.
+	f.notifier.notify()
+
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return err
+		}
+		f.notifier.notify()
+	}
+}
@@ -0,0 +1,66 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemStore_Feed(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemStore()
+
+	head, err := store.Head(ctx)
+	require.NoError(t, err)
+	require.Zero(t, head)
+
+	changed := store.Changed()
+	select {
+	case <-changed:
+		t.Fatal("Changed fired before a message was added")
+	default:
+	}
+
+	ids := newTestMessages(t, store, 3)
+	select {
+	case <-changed:
+	default:
+		t.Fatal("Changed did not fire after a message was added")
+	}
+
+	msgs, err := store.Since(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	for i, m := range msgs {
+		require.Equal(t, ids[i], m.ID)
+		require.Equal(t, int64(i+1), m.Seq)
+	}
+
+	// The feed keeps published messages.
+	require.NoError(t, store.MarkPublished(ctx, ids))
+	msgs, err = store.Since(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, ids[1], msgs[0].ID)
+
+	head, err = store.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), head)
+	msgs, err = store.Since(ctx, head, 10)
+	require.NoError(t, err)
+	require.Empty(t, msgs)
+}
+
+func TestMemStore_FeedDropsOldestMessages(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemStore()
+
+	ids := newTestMessages(t, store, memFeedSize+2)
+
+	msgs, err := store.Since(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, ids[2], msgs[0].ID)
+	require.Equal(t, int64(3), msgs[0].Seq)
+}
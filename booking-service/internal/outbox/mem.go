@@ -0,0 +1,113 @@
+package outbox
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+)
+
+// memFeedSize is how many recent messages a MemStore keeps for its Feed.
+const memFeedSize = 10_000
+
+// MemStore is an in-memory outbox for the in-memory stores. They call Add
+// while holding their own lock, so a message is recorded atomically with the
+// write that produced it. It is also the Feed of its own messages, keeping
+// the most recent memFeedSize of them.
+type MemStore struct {
+	mu       sync.Mutex
+	messages []Message
+	history  []Message
+	seq      int64
+	notifier *notifier
+}
+
+// NewMemStore creates an empty MemStore.
+func NewMemStore() *MemStore {
+	return &MemStore{notifier: newNotifier()}
+}
+
+// Add records msg.
+func (s *MemStore) Add(msg Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, msg)
+
+	s.seq++
+	msg.Seq = s.seq
+	if len(s.history) == memFeedSize {
+		s.history = append(s.history[:0], s.history[1:]...)
+	}
+	s.history = append(s.history, msg)
+	s.notifier.notify()
+}
+
+// Since returns up to limit messages recorded after the one at seq, oldest
+// first. Messages that no longer fit in the history are skipped.
+func (s *MemStore) Since(ctx context.Context, seq int64, limit int) ([]Message, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, _ := slices.BinarySearchFunc(s.history, seq+1, func(m Message, seq int64) int { return cmp.Compare(m.Seq, seq) })
+	msgs := s.history[i:]
+	return append([]Message{}, msgs[:min(limit, len(msgs))]...), nil
+}
+
+// Head returns the Seq of the newest message, or 0 if there is none.
+func (s *MemStore) Head(ctx context.Context) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.seq, nil
+}
+
+// Changed returns a channel that is closed when a message is recorded after the call.
+func (s *MemStore) Changed() <-chan struct{} {
+	return s.notifier.changed()
+}
+
+// Pending returns up to limit unpublished messages, oldest first.
+func (s *MemStore) Pending(ctx context.Context, limit int) ([]Message, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := min(limit, len(s.messages))
+	return append([]Message{}, s.messages[:n]...), nil
+}
+
+// MarkPublished drops the messages with the given IDs.
+func (s *MemStore) MarkPublished(ctx context.Context, ids []string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	published := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		published[id] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.messages[:0]
+	for _, m := range s.messages {
+		if !published[m.ID] {
+			kept = append(kept, m)
+		}
+	}
+	s.messages = kept
+	return nil
+}
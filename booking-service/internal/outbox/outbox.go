@@ -0,0 +1,66 @@
+// Package outbox implements the transactional outbox. Stores record domain
+// events as Messages in the same transaction as the write that caused them,
+// and a Relay publishes recorded messages to a Broker. Delivery is at least
+// once: a message is published again if the relay stops before marking it
+// published, so consumers must drop messages whose ID they have already seen.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// Source identifies this service's messages in the shared outbox table.
+const Source = "booking-service"
+
+// Message is an encoded domain event.
+type Message struct {
+	// ID is unique per event and is the dedupe key for consumers.
+	ID string `json:"id"`
+
+	// Topic is the full protobuf name of the event, e.g. "booking.v1.BookingCreated".
+	Topic string `json:"topic"`
+
+	// Payload is the protobuf encoding of the event.
+	Payload []byte `json:"payload"`
+
+	CreatedAt time.Time `json:"created_at"`
+
+	// Seq is the message's position in the Feed. It is only set on messages
+	// read from a Feed.
+	Seq int64 `json:"seq,omitempty"`
+}
+
+// NewMessage encodes event into a Message with a fresh ID.
+func NewMessage(event proto.Message) (Message, error) {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return Message{}, fmt.Errorf("failed to encode %s: %v", proto.MessageName(event), err)
+	}
+	return Message{
+		ID:        uuid.NewString(),
+		Topic:     string(proto.MessageName(event)),
+		Payload:   payload,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// Store is the outbox as seen by the Relay.
+type Store interface {
+	// Pending returns up to limit unpublished messages, oldest first.
+	Pending(ctx context.Context, limit int) ([]Message, error)
+
+	// MarkPublished records that the messages with the given IDs were published.
+	MarkPublished(ctx context.Context, ids []string) error
+}
+
+// Broker delivers messages to consumers.
+type Broker interface {
+	// Publish delivers msg. A nil error means the broker has taken ownership
+	// of the message; otherwise the relay retries it later.
+	Publish(ctx context.Context, msg Message) error
+}
@@ -0,0 +1,190 @@
+// Package payments charges and refunds riders through a payment provider.
+// Providers are pluggable; FakeProvider charges nothing and is used for
+// local runs and tests.
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/cancellation"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+)
+
+// Config sets how riders are charged for completed rides and cancellations.
+type Config struct {
+	Provider Provider
+
+	// CommissionRate is the share of each fare and cancellation fee the
+	// platform keeps, from 0 to 1; the driver earns the rest.
+	CommissionRate float64
+
+	// Cancellation sets the fees for cancelling and not showing up. The
+	// zero Policy charges none.
+	Cancellation cancellation.Policy
+}
+
+// DefaultCommissionRate is the commission kept by default, 20% of fares.
+const DefaultCommissionRate = 0.2
+
+// ErrDeclined is returned when the provider refuses a charge, e.g. for
+// insufficient funds, or a refund. Retrying will not help.
+var ErrDeclined = errors.New("payment declined")
+
+// Provider charges and refunds riders. Charges and refunds are idempotent:
+// one with the IdempotencyKey of an earlier one returns that one instead of
+// moving money again, so a caller can retry after a failure without charging
+// or refunding twice.
+type Provider interface {
+	Charge(ctx context.Context, req ChargeRequest) (*Charge, error)
+	Refund(ctx context.Context, req RefundRequest) (*Refund, error)
+}
+
+// ChargeRequest asks a provider to charge a rider.
+type ChargeRequest struct {
+	IdempotencyKey string
+	UserID         int32
+	Amount         money.Money // Positive
+	Description    string
+}
+
+// Charge is money a provider took from a rider.
+type Charge struct {
+	ID        string
+	UserID    int32
+	Amount    money.Money
+	CreatedAt time.Time
+}
+
+// RefundRequest asks a provider to give back part or all of a charge.
+type RefundRequest struct {
+	IdempotencyKey string
+	ChargeID       string
+	Amount         money.Money // Positive, at most what is left of the charge
+	Reason         string
+}
+
+// Refund is money a provider gave back to a rider.
+type Refund struct {
+	ID        string
+	ChargeID  string
+	Amount    money.Money
+	Reason    string
+	CreatedAt time.Time
+}
+
+// FakeProvider is a thread-safe in-memory Provider that accepts every charge
+// of riders not marked with Decline, and every refund of what is left of a
+// charge.
+type FakeProvider struct {
+	mu            sync.Mutex
+	charges       []Charge
+	byKey         map[string]int // Index in charges
+	declined      map[int32]bool
+	refunds       []Refund
+	refundsByKey  map[string]int   // Index in refunds
+	refundedTotal map[string]int64 // By charge ID
+}
+
+// NewFakeProvider creates a FakeProvider without charges.
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		byKey:         make(map[string]int),
+		declined:      make(map[int32]bool),
+		refundsByKey:  make(map[string]int),
+		refundedTotal: make(map[string]int64),
+	}
+}
+
+// Charge records a charge with ID "fake_ch_<n>".
+func (p *FakeProvider) Charge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if req.Amount.Minor <= 0 {
+		return nil, fmt.Errorf("charge amount must be positive, got %s", req.Amount)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if i, ok := p.byKey[req.IdempotencyKey]; ok {
+		charge := p.charges[i]
+		return &charge, nil
+	}
+	if p.declined[req.UserID] {
+		return nil, fmt.Errorf("%w: card of user %d was declined", ErrDeclined, req.UserID)
+	}
+	charge := Charge{
+		ID:        fmt.Sprintf("fake_ch_%d", len(p.charges)+1),
+		UserID:    req.UserID,
+		Amount:    req.Amount,
+		CreatedAt: time.Now(),
+	}
+	p.byKey[req.IdempotencyKey] = len(p.charges)
+	p.charges = append(p.charges, charge)
+	return &charge, nil
+}
+
+// Refund records a refund with ID "fake_re_<n>", failing unless the charge
+// exists and has at least the amount left to refund.
+func (p *FakeProvider) Refund(ctx context.Context, req RefundRequest) (*Refund, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if req.Amount.Minor <= 0 {
+		return nil, fmt.Errorf("refund amount must be positive, got %s", req.Amount)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if i, ok := p.refundsByKey[req.IdempotencyKey]; ok {
+		refund := p.refunds[i]
+		return &refund, nil
+	}
+	i := slices.IndexFunc(p.charges, func(c Charge) bool { return c.ID == req.ChargeID })
+	if i < 0 {
+		return nil, fmt.Errorf("charge %q not found", req.ChargeID)
+	}
+	charge := p.charges[i]
+	if req.Amount.Currency != charge.Amount.Currency || p.refundedTotal[charge.ID]+req.Amount.Minor > charge.Amount.Minor {
+		return nil, fmt.Errorf("%w: refunding %s of %s would exceed the charge", ErrDeclined, req.Amount, charge.ID)
+	}
+	refund := Refund{
+		ID:        fmt.Sprintf("fake_re_%d", len(p.refunds)+1),
+		ChargeID:  charge.ID,
+		Amount:    req.Amount,
+		Reason:    req.Reason,
+		CreatedAt: time.Now(),
+	}
+	p.refundsByKey[req.IdempotencyKey] = len(p.refunds)
+	p.refunds = append(p.refunds, refund)
+	p.refundedTotal[charge.ID] += req.Amount.Minor
+	return &refund, nil
+}
+
+// Decline makes charges of a user fail with ErrDeclined.
+func (p *FakeProvider) Decline(userID int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.declined[userID] = true
+}
+
+// Charges returns the charges made, oldest first.
+func (p *FakeProvider) Charges() []Charge {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Charge(nil), p.charges...)
+}
+
+// Refunds returns the refunds made, oldest first.
+func (p *FakeProvider) Refunds() []Refund {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Refund(nil), p.refunds...)
+}
@@ -0,0 +1,62 @@
+package payments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeProvider(t *testing.T) {
+	ctx := context.Background()
+	p := NewFakeProvider()
+	fare := money.Money{Currency: "PKR", Minor: 62500}
+
+	charge, err := p.Charge(ctx, ChargeRequest{IdempotencyKey: "booking-1", UserID: 1, Amount: fare})
+	require.NoError(t, err)
+	require.Equal(t, "fake_ch_1", charge.ID)
+	require.Equal(t, fare, charge.Amount)
+
+	// Retrying with the same key returns the first charge.
+	again, err := p.Charge(ctx, ChargeRequest{IdempotencyKey: "booking-1", UserID: 1, Amount: fare})
+	require.NoError(t, err)
+	require.Equal(t, charge, again)
+	require.Len(t, p.Charges(), 1)
+
+	_, err = p.Charge(ctx, ChargeRequest{IdempotencyKey: "booking-2", UserID: 1, Amount: money.Money{Currency: "PKR"}})
+	require.Error(t, err)
+
+	p.Decline(2)
+	_, err = p.Charge(ctx, ChargeRequest{IdempotencyKey: "booking-3", UserID: 2, Amount: fare})
+	require.ErrorIs(t, err, ErrDeclined)
+	require.Len(t, p.Charges(), 1)
+}
+
+func TestFakeProvider_Refund(t *testing.T) {
+	ctx := context.Background()
+	p := NewFakeProvider()
+	fare := money.Money{Currency: "PKR", Minor: 62500}
+	charge, err := p.Charge(ctx, ChargeRequest{IdempotencyKey: "booking-1", UserID: 1, Amount: fare})
+	require.NoError(t, err)
+
+	part := money.Money{Currency: "PKR", Minor: 20000}
+	refund, err := p.Refund(ctx, RefundRequest{IdempotencyKey: "booking-1-refund-20000", ChargeID: charge.ID, Amount: part})
+	require.NoError(t, err)
+	require.Equal(t, "fake_re_1", refund.ID)
+	require.Equal(t, charge.ID, refund.ChargeID)
+
+	// Retrying with the same key returns the first refund.
+	again, err := p.Refund(ctx, RefundRequest{IdempotencyKey: "booking-1-refund-20000", ChargeID: charge.ID, Amount: part})
+	require.NoError(t, err)
+	require.Equal(t, refund, again)
+
+	// Refunds cannot exceed what is left of the charge.
+	_, err = p.Refund(ctx, RefundRequest{IdempotencyKey: "booking-1-refund-62501", ChargeID: charge.ID, Amount: money.Money{Currency: "PKR", Minor: 42501}})
+	require.ErrorIs(t, err, ErrDeclined)
+	_, err = p.Refund(ctx, RefundRequest{IdempotencyKey: "booking-1-refund-62500", ChargeID: charge.ID, Amount: money.Money{Currency: "PKR", Minor: 42500}})
+	require.NoError(t, err)
+	_, err = p.Refund(ctx, RefundRequest{IdempotencyKey: "booking-2-refund-100", ChargeID: "fake_ch_9", Amount: part})
+	require.Error(t, err)
+	require.Len(t, p.Refunds(), 2)
+}
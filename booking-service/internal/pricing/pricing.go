@@ -0,0 +1,171 @@
+// Package pricing computes ride fares from a versioned tariff.
+package pricing
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+
+	"github.com/golang_falcon_task/booking-service/internal/money"
+)
+
+// Vehicle classes every tariff prices.
+const (
+	ClassEconomy = "economy"
+	ClassComfort = "comfort"
+	ClassXL      = "xl"
+)
+
+var classes = []string{ClassEconomy, ClassComfort, ClassXL}
+
+// ErrUnknownVehicleClass is returned when quoting a class the tariff does
+// not price.
+var ErrUnknownVehicleClass = errors.New("unknown vehicle class")
+
+//go:embed tariff.json
+var defaultTariff []byte
+
+// Default is the tariff shipped with the service, from tariff.json.
+var Default = mustParse(defaultTariff)
+
+// Tariff sets the price of rides. Amounts are in major units of Currency,
+// e.g. rupees, and may have as many decimals as its minor unit. Version is
+// recorded on every booking priced with the tariff, so change it whenever
+// the tariff changes.
+type Tariff struct {
+	Version         string             `json:"version"`
+	Currency        string             `json:"currency"`          // ISO 4217 code rides are priced in
+	BaseFare        float64            `json:"base_fare"`         // Charged for every ride
+	PerKm           float64            `json:"per_km"`            // Charged per kilometer
+	PerMinute       float64            `json:"per_minute"`        // Charged per estimated minute
+	MinimumFare     float64            `json:"minimum_fare"`      // No ride costs less
+	AverageSpeedKmh float64            `json:"average_speed_kmh"` // Used to estimate how long a ride takes
+	VehicleClasses  map[string]float64 `json:"vehicle_classes"`   // Fare multiplier of each vehicle class
+}
+
+// Fare is the price of a ride, broken down into its parts.
+type Fare struct {
+	TariffVersion   string
+	VehicleClass    string
+	DistanceKm      int32
+	DurationMinutes int32
+	BaseFare        money.Money
+	DistanceFare    money.Money
+	TimeFare        money.Money
+	Multiplier      float64     // Of the vehicle class
+	Surge           float64     // Demand multiplier, 1 without surge
+	MinimumApplied  bool        // Whether the fare was raised to the minimum
+	Cost            money.Money // What the ride costs
+}
+
+// Load reads a tariff from a JSON file.
+func Load(path string) (*Tariff, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tariff: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes and validates a JSON tariff.
+func Parse(data []byte) (*Tariff, error) {
+	var t Tariff
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&t); err != nil {
+		return nil, fmt.Errorf("invalid tariff: %w", err)
+	}
+	if err := t.validate(); err != nil {
+		return nil, fmt.Errorf("invalid tariff %q: %w", t.Version, err)
+	}
+	return &t, nil
+}
+
+func mustParse(data []byte) *Tariff {
+	t, err := Parse(data)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func (t *Tariff) validate() error {
+	switch {
+	case t.Version == "":
+		return errors.New("version is required")
+	case t.BaseFare < 0 || t.PerKm < 0 || t.PerMinute < 0 || t.MinimumFare < 0:
+		return errors.New("fares must not be negative")
+	case t.AverageSpeedKmh <= 0:
+		return errors.New("average_speed_kmh must be positive")
+	}
+	for _, class := range classes {
+		if t.VehicleClasses[class] <= 0 {
+			return fmt.Errorf("vehicle class %q needs a positive multiplier", class)
+		}
+	}
+	for class := range t.VehicleClasses {
+		if !slices.Contains(classes, class) {
+			return fmt.Errorf("%w %q", ErrUnknownVehicleClass, class)
+		}
+	}
+	_, err := money.Exponent(t.Currency)
+	return err
+}
+
+// Quote prices a ride of distanceKm in a vehicle of class while demand
+// surges by surge. The base, distance and time fares are each rounded to the
+// currency's minor unit and summed; the sum is scaled by the class and surge
+// multipliers, rounded again, and raised to the minimum fare. Rounding is to
+// the nearest minor unit with halves away from zero.
+func (t *Tariff) Quote(distanceKm int32, class string, surge float64) (Fare, error) {
+	multiplier, ok := t.VehicleClasses[class]
+	if !ok {
+		return Fare{}, fmt.Errorf("%w %q", ErrUnknownVehicleClass, class)
+	}
+
+	minutes := int32(math.Ceil(float64(distanceKm) / t.AverageSpeedKmh * 60))
+	fare := Fare{
+		TariffVersion:   t.Version,
+		VehicleClass:    class,
+		DistanceKm:      distanceKm,
+		DurationMinutes: minutes,
+		Multiplier:      multiplier,
+		Surge:           surge,
+	}
+
+	var err error
+	if fare.BaseFare, err = money.FromMajor(t.Currency, t.BaseFare); err != nil {
+		return Fare{}, err
+	}
+	if fare.DistanceFare, err = money.FromMajor(t.Currency, float64(distanceKm)*t.PerKm); err != nil {
+		return Fare{}, err
+	}
+	if fare.TimeFare, err = money.FromMajor(t.Currency, float64(minutes)*t.PerMinute); err != nil {
+		return Fare{}, err
+	}
+	minimum, err := money.FromMajor(t.Currency, t.MinimumFare)
+	if err != nil {
+		return Fare{}, err
+	}
+
+	total, err := fare.BaseFare.Add(fare.DistanceFare)
+	if err == nil {
+		total, err = total.Add(fare.TimeFare)
+	}
+	if err == nil {
+		total, err = total.Mul(multiplier * surge)
+	}
+	if err != nil {
+		return Fare{}, err
+	}
+	if total.Minor < minimum.Minor {
+		total, fare.MinimumApplied = minimum, true
+	}
+	fare.Cost = total
+	return fare, nil
+}
@@ -0,0 +1,134 @@
+package pricing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTariff_Quote(t *testing.T) {
+	tariff := &Tariff{
+		Version:         "test",
+		Currency:        "PKR",
+		BaseFare:        100,
+		PerKm:           25,
+		PerMinute:       5,
+		MinimumFare:     150,
+		AverageSpeedKmh: 30,
+		VehicleClasses:  map[string]float64{ClassEconomy: 1, ClassComfort: 1.3, ClassXL: 1.6},
+	}
+
+	tests := []struct {
+		name            string
+		distanceKm      int32
+		class           string
+		surge           float64
+		expectedMinutes int32
+		expectedCost    int64
+		expectedMinimum bool
+		expectedErr     error
+	}{
+		{name: "Economy", distanceKm: 15, class: ClassEconomy, surge: 1, expectedMinutes: 30, expectedCost: 62500},
+		{name: "Comfort Keeps Paisa", distanceKm: 15, class: ClassComfort, surge: 1, expectedMinutes: 30, expectedCost: 81250},
+		{name: "XL", distanceKm: 10, class: ClassXL, surge: 1, expectedMinutes: 20, expectedCost: 72000},
+		{name: "Surge", distanceKm: 10, class: ClassXL, surge: 1.5, expectedMinutes: 20, expectedCost: 108000},
+		{name: "Surge Rounds To Nearest Paisa", distanceKm: 15, class: ClassEconomy, surge: 1.00001, expectedMinutes: 30, expectedCost: 62501},
+		{name: "Minutes Round Up", distanceKm: 4, class: ClassEconomy, surge: 1, expectedMinutes: 8, expectedCost: 24000},
+		{name: "Minimum Fare", distanceKm: 1, class: ClassEconomy, surge: 1, expectedMinutes: 2, expectedCost: 15000, expectedMinimum: true},
+		{name: "Minimum Fare Ignores Surge", distanceKm: 1, class: ClassEconomy, surge: 1.1, expectedMinutes: 2, expectedCost: 15000, expectedMinimum: true},
+		{name: "Unknown Class", distanceKm: 15, class: "limo", surge: 1, expectedErr: ErrUnknownVehicleClass},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fare, err := tariff.Quote(tt.distanceKm, tt.class, tt.surge)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "test", fare.TariffVersion)
+			require.Equal(t, tt.distanceKm, fare.DistanceKm)
+			require.Equal(t, tt.expectedMinutes, fare.DurationMinutes)
+			require.Equal(t, money.Money{Currency: "PKR", Minor: tt.expectedCost}, fare.Cost)
+			require.Equal(t, tt.expectedMinimum, fare.MinimumApplied)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		expectedErr string
+	}{
+		{
+			name: "Valid",
+			data: `{"version": "v2", "currency": "PKR", "base_fare": 50, "per_km": 20, "per_minute": 3, "minimum_fare": 100, "average_speed_kmh": 25,
+				"vehicle_classes": {"economy": 1, "comfort": 1.2, "xl": 1.5}}`,
+		},
+		{
+			name:        "Missing Version",
+			data:        `{"average_speed_kmh": 25, "vehicle_classes": {"economy": 1, "comfort": 1.2, "xl": 1.5}}`,
+			expectedErr: "version is required",
+		},
+		{
+			name:        "Negative Fare",
+			data:        `{"version": "v2", "per_km": -1, "average_speed_kmh": 25, "vehicle_classes": {"economy": 1, "comfort": 1.2, "xl": 1.5}}`,
+			expectedErr: "fares must not be negative",
+		},
+		{
+			name:        "No Average Speed",
+			data:        `{"version": "v2", "vehicle_classes": {"economy": 1, "comfort": 1.2, "xl": 1.5}}`,
+			expectedErr: "average_speed_kmh must be positive",
+		},
+		{
+			name:        "Missing Class",
+			data:        `{"version": "v2", "average_speed_kmh": 25, "vehicle_classes": {"economy": 1, "comfort": 1.2}}`,
+			expectedErr: `vehicle class "xl" needs a positive multiplier`,
+		},
+		{
+			name:        "Unknown Class",
+			data:        `{"version": "v2", "average_speed_kmh": 25, "vehicle_classes": {"economy": 1, "comfort": 1.2, "xl": 1.5, "limo": 3}}`,
+			expectedErr: `unknown vehicle class "limo"`,
+		},
+		{
+			name:        "Unknown Currency",
+			data:        `{"version": "v2", "currency": "XYZ", "average_speed_kmh": 25, "vehicle_classes": {"economy": 1, "comfort": 1.2, "xl": 1.5}}`,
+			expectedErr: `unknown currency "XYZ"`,
+		},
+		{
+			name:        "Unknown Field",
+			data:        `{"version": "v2", "per_mile": 40}`,
+			expectedErr: `unknown field "per_mile"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tariff, err := Parse([]byte(tt.data))
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "v2", tariff.Version)
+			require.Equal(t, 1.2, tariff.VehicleClasses[ClassComfort])
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tariff.json")
+	require.NoError(t, os.WriteFile(path, defaultTariff, 0o644))
+
+	tariff, err := Load(path)
+	require.NoError(t, err)
+	require.Equal(t, Default, tariff)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorContains(t, err, "failed to read tariff")
+}
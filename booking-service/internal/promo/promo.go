@@ -0,0 +1,101 @@
+// Package promo checks promo codes and computes the discounts they give.
+// Redemption limits are enforced by the store, atomically with counting
+// the redemption.
+package promo
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+)
+
+// ErrInvalid is returned by Validate for a malformed promo.
+var ErrInvalid = errors.New("invalid promo")
+
+// ErrNotActive is returned when a promo code is redeemed outside its
+// validity window.
+var ErrNotActive = errors.New("promo code is not active")
+
+// ErrNotEligible is returned when a fare does not meet a promo code's
+// eligibility rules.
+var ErrNotEligible = errors.New("fare is not eligible for the promo code")
+
+var codePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// Normalize returns code as promos are stored, so codes match
+// case-insensitively.
+func Normalize(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate checks that p is a promo that can be created. Its code must be
+// normalized.
+func Validate(p *model.Promo) error {
+	switch {
+	case !codePattern.MatchString(p.Code):
+		return fmt.Errorf("%w: code must be 3 to 32 letters, digits, dashes or underscores", ErrInvalid)
+	case p.Type == model.PromoPercent && (p.PercentOff < 1 || p.PercentOff > 100):
+		return fmt.Errorf("%w: percent_off must be between 1 and 100", ErrInvalid)
+	case p.Type == model.PromoFlat && p.AmountOff.Minor <= 0:
+		return fmt.Errorf("%w: amount_off must be positive", ErrInvalid)
+	case p.Type != model.PromoPercent && p.Type != model.PromoFlat:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalid, p.Type)
+	case p.AmountOff.IsNegative() || p.MaxDiscount.IsNegative() || p.MinFare.IsNegative():
+		return fmt.Errorf("%w: amounts must not be negative", ErrInvalid)
+	case !p.EndsAt.After(p.StartsAt):
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalid)
+	case p.MaxRedemptions < 0 || p.MaxPerUser < 0:
+		return fmt.Errorf("%w: redemption limits must not be negative", ErrInvalid)
+	}
+	for _, amount := range []money.Money{p.AmountOff, p.MaxDiscount, p.MinFare} {
+		if amount.Currency != p.Currency {
+			return fmt.Errorf("%w: amounts must all be in %q", ErrInvalid, p.Currency)
+		}
+	}
+	if p.Currency != "" {
+		if _, err := money.Exponent(p.Currency); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalid, err)
+		}
+	}
+	return nil
+}
+
+// Apply returns the discount p gives fare for a ride in vehicle class,
+// booked at now. A percent discount is rounded to the nearest minor unit,
+// halves away from zero, and no discount exceeds the fare. It fails with
+// ErrNotActive outside the promo's validity window and with ErrNotEligible
+// if the fare does not meet the promo's rules.
+func Apply(p *model.Promo, fare money.Money, class string, now time.Time) (model.Discount, error) {
+	if now.Before(p.StartsAt) || !now.Before(p.EndsAt) {
+		return model.Discount{}, fmt.Errorf("%w: %s is valid from %s until %s", ErrNotActive, p.Code,
+			p.StartsAt.Format(time.RFC3339), p.EndsAt.Format(time.RFC3339))
+	}
+	if p.Currency != "" && fare.Currency != p.Currency {
+		return model.Discount{}, fmt.Errorf("%w: %s applies to fares in %s", ErrNotEligible, p.Code, p.Currency)
+	}
+	if len(p.VehicleClasses) > 0 && !slices.Contains(p.VehicleClasses, class) {
+		return model.Discount{}, fmt.Errorf("%w: %s applies to %s rides", ErrNotEligible, p.Code, strings.Join(p.VehicleClasses, ", "))
+	}
+	if fare.Minor < p.MinFare.Minor {
+		return model.Discount{}, fmt.Errorf("%w: %s applies to fares of %s or more", ErrNotEligible, p.Code, p.MinFare)
+	}
+
+	amount := money.Money{Currency: fare.Currency, Minor: p.AmountOff.Minor}
+	if p.Type == model.PromoPercent {
+		// Scaling by at most 1 cannot overflow.
+		amount, _ = fare.Mul(float64(p.PercentOff) / 100)
+		if !p.MaxDiscount.IsZero() && amount.Minor > p.MaxDiscount.Minor {
+			amount.Minor = p.MaxDiscount.Minor
+		}
+	}
+	if amount.Minor > fare.Minor {
+		amount.Minor = fare.Minor
+	}
+	return model.Discount{PromoCode: p.Code, Fare: fare, Amount: amount}, nil
+}
@@ -0,0 +1,117 @@
+package promo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	start = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end   = start.Add(30 * 24 * time.Hour)
+)
+
+func pkr(minor int64) money.Money {
+	return money.Money{Currency: "PKR", Minor: minor}
+}
+
+func TestApply(t *testing.T) {
+	percent := &model.Promo{Code: "WELCOME20", Type: model.PromoPercent, PercentOff: 20, StartsAt: start, EndsAt: end}
+	capped := &model.Promo{Code: "HALF", Type: model.PromoPercent, Currency: "PKR", PercentOff: 50,
+		AmountOff: pkr(0), MaxDiscount: pkr(10000), MinFare: pkr(0), StartsAt: start, EndsAt: end}
+	flat := &model.Promo{Code: "FLAT200", Type: model.PromoFlat, Currency: "PKR", AmountOff: pkr(20000),
+		MaxDiscount: pkr(0), MinFare: pkr(30000), VehicleClasses: []string{"comfort", "xl"}, StartsAt: start, EndsAt: end}
+	now := start.Add(time.Hour)
+
+	tests := []struct {
+		name           string
+		promo          *model.Promo
+		fare           money.Money
+		class          string
+		now            time.Time
+		expectedAmount int64
+		expectedErr    error
+	}{
+		{name: "Percent", promo: percent, fare: pkr(62500), class: "economy", now: now, expectedAmount: 12500},
+		{name: "Percent Rounds To Nearest Paisa", promo: percent, fare: pkr(62503), class: "economy", now: now, expectedAmount: 12501},
+		{name: "Percent Without Currency Applies To Any", promo: percent, fare: money.Money{Currency: "USD", Minor: 1000}, class: "economy", now: now, expectedAmount: 200},
+		{name: "Percent Capped", promo: capped, fare: pkr(62500), class: "economy", now: now, expectedAmount: 10000},
+		{name: "Percent Under Cap", promo: capped, fare: pkr(15000), class: "economy", now: now, expectedAmount: 7500},
+		{name: "Flat", promo: flat, fare: pkr(81250), class: "comfort", now: now, expectedAmount: 20000},
+		{name: "Flat Never Exceeds Fare", promo: &model.Promo{Code: "FREE", Type: model.PromoFlat, Currency: "PKR", AmountOff: pkr(100000),
+			StartsAt: start, EndsAt: end}, fare: pkr(62500), class: "economy", now: now, expectedAmount: 62500},
+		{name: "Starts At Is Inclusive", promo: percent, fare: pkr(62500), class: "economy", now: start, expectedAmount: 12500},
+		{name: "Not Started", promo: percent, fare: pkr(62500), class: "economy", now: start.Add(-time.Second), expectedErr: ErrNotActive},
+		{name: "Ended", promo: percent, fare: pkr(62500), class: "economy", now: end, expectedErr: ErrNotActive},
+		{name: "Vehicle Class Not Eligible", promo: flat, fare: pkr(81250), class: "economy", now: now, expectedErr: ErrNotEligible},
+		{name: "Below Minimum Fare", promo: flat, fare: pkr(29999), class: "xl", now: now, expectedErr: ErrNotEligible},
+		{name: "Other Currency", promo: flat, fare: money.Money{Currency: "USD", Minor: 81250}, class: "xl", now: now, expectedErr: ErrNotEligible},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discount, err := Apply(tt.promo, tt.fare, tt.class, tt.now)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.promo.Code, discount.PromoCode)
+			require.Equal(t, tt.fare, discount.Fare)
+			require.Equal(t, money.Money{Currency: tt.fare.Currency, Minor: tt.expectedAmount}, discount.Amount)
+			require.Equal(t, money.Money{Currency: tt.fare.Currency, Minor: tt.fare.Minor - tt.expectedAmount}, discount.Total())
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *model.Promo {
+		return &model.Promo{Code: "FLAT200", Type: model.PromoFlat, Currency: "PKR", AmountOff: pkr(20000),
+			MaxDiscount: pkr(0), MinFare: pkr(30000), StartsAt: start, EndsAt: end}
+	}
+
+	tests := []struct {
+		name        string
+		change      func(p *model.Promo)
+		expectedErr string
+	}{
+		{name: "Valid", change: func(p *model.Promo) {}},
+		{name: "Percent Without Currency", change: func(p *model.Promo) {
+			*p = model.Promo{Code: "WELCOME20", Type: model.PromoPercent, PercentOff: 20, StartsAt: start, EndsAt: end}
+		}},
+		{name: "Lower Case Code", change: func(p *model.Promo) { p.Code = "flat200" }, expectedErr: "code must be"},
+		{name: "Short Code", change: func(p *model.Promo) { p.Code = "AB" }, expectedErr: "code must be"},
+		{name: "Unknown Type", change: func(p *model.Promo) { p.Type = "BOGO" }, expectedErr: "unknown type"},
+		{name: "Percent Over 100", change: func(p *model.Promo) { p.Type, p.PercentOff = model.PromoPercent, 101 }, expectedErr: "percent_off"},
+		{name: "Flat Without Amount", change: func(p *model.Promo) { p.AmountOff = pkr(0) }, expectedErr: "amount_off must be positive"},
+		{name: "Negative Minimum", change: func(p *model.Promo) { p.MinFare = pkr(-1) }, expectedErr: "must not be negative"},
+		{name: "Ends Before Start", change: func(p *model.Promo) { p.EndsAt = p.StartsAt }, expectedErr: "ends_at must be after starts_at"},
+		{name: "Negative Limit", change: func(p *model.Promo) { p.MaxPerUser = -1 }, expectedErr: "redemption limits"},
+		{name: "Mixed Currencies", change: func(p *model.Promo) { p.MinFare = money.Money{Currency: "USD", Minor: 100} }, expectedErr: "must all be in"},
+		{name: "Unknown Currency", change: func(p *model.Promo) {
+			p.Currency = "XYZ"
+			p.AmountOff.Currency, p.MaxDiscount.Currency, p.MinFare.Currency = "XYZ", "XYZ", "XYZ"
+		}, expectedErr: "unknown currency"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := valid()
+			tt.change(p)
+			err := Validate(p)
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalid)
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	require.Equal(t, "WELCOME20", Normalize(" welcome20 "))
+}
@@ -0,0 +1,121 @@
+package receipt
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+	_ "time/tzdata" // Time zones load wherever the service runs
+
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+var (
+	// ErrInvalidLocale is returned for a locale that is not a BCP 47
+	// language tag.
+	ErrInvalidLocale = errors.New("invalid locale")
+
+	// ErrUnknownTimeZone is returned for a time zone missing from the IANA
+	// database.
+	ErrUnknownTimeZone = errors.New("unknown time zone")
+)
+
+// locales are the locales receipts are formatted for, with the layout of
+// their times. The first is the default. Receipts are only formatted for
+// languages in Latin script, which the PDF fonts cover.
+var locales = []struct {
+	tag    language.Tag
+	layout string
+}{
+	{language.English, "January 2, 2006 3:04 PM MST"},
+	{language.AmericanEnglish, "January 2, 2006 3:04 PM MST"},
+	{language.BritishEnglish, "2 January 2006 15:04 MST"},
+	{language.MustParse("en-PK"), "2 January 2006 3:04 PM MST"},
+	{language.MustParse("en-IN"), "2 January 2006 3:04 PM MST"},
+	{language.German, "02.01.2006 15:04 MST"},
+	{language.French, "02/01/2006 15:04 MST"},
+	{language.Spanish, "02/01/2006 15:04 MST"},
+}
+
+var matcher = language.NewMatcher(func() []language.Tag {
+	tags := make([]language.Tag, len(locales))
+	for i, l := range locales {
+		tags[i] = l.tag
+	}
+	return tags
+}())
+
+// Locale formats money and times for readers of a language in a time zone.
+type Locale struct {
+	tag      language.Tag
+	printer  *message.Printer
+	layout   string
+	location *time.Location
+}
+
+// NewLocale returns the locale closest to tag, a BCP 47 language tag such as
+// "en-PK", showing times in zone, an IANA time zone such as "Asia/Karachi".
+// An empty tag is English and an empty zone UTC. It fails with
+// ErrInvalidLocale or ErrUnknownTimeZone.
+func NewLocale(tag, zone string) (*Locale, error) {
+	i := 0
+	if tag != "" {
+		t, err := language.Parse(tag)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %v", ErrInvalidLocale, tag, err)
+		}
+		_, i, _ = matcher.Match(t)
+	}
+	location := time.UTC
+	if zone != "" {
+		var err error
+		if location, err = time.LoadLocation(zone); err != nil {
+			return nil, fmt.Errorf("%w %q", ErrUnknownTimeZone, zone)
+		}
+	}
+	return &Locale{
+		tag:      locales[i].tag,
+		printer:  message.NewPrinter(locales[i].tag),
+		layout:   locales[i].layout,
+		location: location,
+	}, nil
+}
+
+// Language returns the BCP 47 tag of l's language.
+func (l *Locale) Language() string {
+	return l.tag.String()
+}
+
+// Money formats m with its currency's local symbol and l's digit grouping
+// and decimal separator, e.g. "Rs 1,250.00" in en-PK and "PKR 1.250,00" in
+// German.
+func (l *Locale) Money(m money.Money) string {
+	exp, err := money.Exponent(m.Currency)
+	if err != nil {
+		return m.String()
+	}
+	unit, err := currency.ParseISO(m.Currency)
+	if err != nil {
+		return m.String()
+	}
+	sign, minor := "", m.Minor
+	if minor < 0 {
+		sign, minor = "-", -minor
+	}
+	return l.printer.Sprintf("%s%v %v", sign, currency.Symbol(unit),
+		number.Decimal(float64(minor)/math.Pow10(exp), number.Scale(exp)))
+}
+
+// Time formats t in l's time zone.
+func (l *Locale) Time(t time.Time) string {
+	return t.In(l.location).Format(l.layout)
+}
+
+// Distance formats a distance in kilometers.
+func (l *Locale) Distance(km int32) string {
+	return l.printer.Sprintf("%d km", km)
+}
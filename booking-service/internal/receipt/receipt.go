@@ -0,0 +1,172 @@
+// Package receipt breaks down what riders paid for bookings and renders it
+// as HTML and PDF receipts, with money and times formatted for the reader.
+package receipt
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/pricing"
+)
+
+// ErrNoReceipt is returned for a booking the rider paid nothing for: one
+// neither completed nor cancelled for a fee.
+var ErrNoReceipt = errors.New("booking has no receipt")
+
+// Receipt is what a rider paid for a booking.
+type Receipt struct {
+	Number        string
+	BookingID     int32
+	Rider         string
+	Source        string
+	Destination   string
+	DistanceKm    int32
+	BookedAt      time.Time
+	PickupAt      time.Time // Zero unless the booking was made for a later pickup
+	DriverID      int32     // 0 if none was assigned
+	PaymentMethod string    // One of the model.Payment* methods
+	Lines         []Line    // What makes up Charged
+	Charged       money.Money
+	Refunded      money.Money // Zero if nothing was
+	RefundReason  string      // One of the model.Refund* reasons, empty if nothing was refunded
+	Total         money.Money // Charged less Refunded
+}
+
+// Line is an item of a receipt's breakdown. Discounts are negative.
+type Line struct {
+	Label  string
+	Amount money.Money
+}
+
+// Number returns the receipt number of booking: the UTC date it was made and
+// its ID, e.g. "R-20250101-000042". It never changes, however often the
+// receipt is rendered.
+func Number(booking *model.Booking) string {
+	return fmt.Sprintf("R-%s-%06d", booking.Timestamp.UTC().Format("20060102"), booking.ID)
+}
+
+// vehicleClasses label the pricing vehicle classes on receipts.
+var vehicleClasses = map[string]string{
+	pricing.ClassEconomy: "Economy",
+	pricing.ClassComfort: "Comfort",
+	pricing.ClassXL:      "XL",
+}
+
+// New returns the receipt of booking, made by user for ride with quote, nil
+// if it was booked without one. A completed booking is charged its fare,
+// broken down as the quote priced it, less any promo discount, and a
+// cancelled one its cancellation fee. It fails with ErrNoReceipt if the
+// rider was charged nothing.
+func New(booking *model.Booking, user *model.User, ride *model.Ride, quote *model.Quote) (*Receipt, error) {
+	r := &Receipt{
+		Number:        Number(booking),
+		BookingID:     booking.ID,
+		Rider:         user.Name,
+		Source:        ride.Source,
+		Destination:   ride.Destination,
+		DistanceKm:    ride.Distance,
+		BookedAt:      booking.Timestamp,
+		DriverID:      booking.DriverID,
+		PaymentMethod: booking.PaymentMethod,
+		RefundReason:  booking.RefundReason,
+	}
+	if booking.PickupTime.After(booking.Timestamp) {
+		r.PickupAt = booking.PickupTime
+	}
+
+	switch {
+	case booking.Status == model.BookingCompleted:
+		r.Charged = booking.Fare(ride)
+		fare := r.Charged
+		if booking.Discount.Applied() {
+			fare = booking.Discount.Fare
+		}
+		r.Lines = fareLines(quote, fare)
+		if r.Lines == nil {
+			r.Lines = []Line{{Label: "Fare", Amount: fare}}
+		}
+		if booking.Discount.Applied() {
+			r.Lines = append(r.Lines, Line{Label: "Promo code " + booking.Discount.PromoCode,
+				Amount: money.Money{Currency: booking.Discount.Amount.Currency, Minor: -booking.Discount.Amount.Minor}})
+		}
+	case booking.Status == model.BookingCancelled && !booking.CancellationFee.IsZero():
+		r.Charged = booking.CancellationFee
+		label := "Cancellation fee"
+		if booking.CancellationReason == model.CancelledNoShow {
+			label = "No-show fee"
+		}
+		r.Lines = []Line{{Label: label, Amount: booking.CancellationFee}}
+	default:
+		return nil, ErrNoReceipt
+	}
+
+	r.Total = r.Charged
+	if !booking.Refunded.IsZero() {
+		total, err := r.Charged.Sub(booking.Refunded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to subtract refunds: %w", err)
+		}
+		r.Refunded, r.Total = booking.Refunded, total
+	}
+	return r, nil
+}
+
+// fareLines breaks fare down as quote priced it: the base, distance and time
+// fares, then what the vehicle class and surge multipliers and the minimum
+// fare added to them. It returns nil if quote is nil, has no breakdown, or
+// was not for fare.
+func fareLines(quote *model.Quote, fare money.Money) []Line {
+	if quote == nil || quote.Multiplier == 0 || quote.Cost != fare {
+		return nil
+	}
+
+	sum, err := quote.BaseFare.Add(quote.DistanceFare)
+	if err == nil {
+		sum, err = sum.Add(quote.TimeFare)
+	}
+	if err != nil {
+		return nil
+	}
+	// The multipliers are applied together, as the tariff does, so each line
+	// is what its multiplier adds after rounding.
+	classed, err := sum.Mul(quote.Multiplier)
+	if err != nil {
+		return nil
+	}
+	surged, err := sum.Mul(quote.Multiplier * quote.Surge)
+	if err != nil {
+		return nil
+	}
+
+	lines := []Line{
+		{Label: "Base fare", Amount: quote.BaseFare},
+		{Label: fmt.Sprintf("Distance, %d km", quote.DistanceKm), Amount: quote.DistanceFare},
+		{Label: fmt.Sprintf("Time, %d min", quote.DurationMinutes), Amount: quote.TimeFare},
+	}
+	if quote.Multiplier != 1 {
+		class := vehicleClasses[quote.VehicleClass]
+		if class == "" {
+			class = quote.VehicleClass
+		}
+		lines = append(lines, Line{
+			Label:  fmt.Sprintf("%s class \u00d7%s", class, strconv.FormatFloat(quote.Multiplier, 'f', -1, 64)),
+			Amount: money.Money{Currency: sum.Currency, Minor: classed.Minor - sum.Minor},
+		})
+	}
+	if quote.Surge != 1 {
+		lines = append(lines, Line{
+			Label:  fmt.Sprintf("Surge \u00d7%s", strconv.FormatFloat(quote.Surge, 'f', -1, 64)),
+			Amount: money.Money{Currency: sum.Currency, Minor: surged.Minor - classed.Minor},
+		})
+	}
+	if quote.MinimumApplied {
+		lines = append(lines, Line{Label: "Minimum fare", Amount: money.Money{Currency: sum.Currency, Minor: fare.Minor - surged.Minor}})
+	} else if surged != fare {
+		return nil
+	}
+	return lines
+}
@@ -0,0 +1,152 @@
+package receipt
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/stretchr/testify/require"
+)
+
+func pkr(minor int64) money.Money {
+	return money.Money{Currency: "PKR", Minor: minor}
+}
+
+// bookedAt is 21:30 in Karachi, already the next day.
+var bookedAt = time.Date(2025, 3, 14, 16, 30, 0, 0, time.UTC)
+
+func TestNew(t *testing.T) {
+	completed := func() *model.Booking {
+		return &model.Booking{ID: 42, UserID: 1, RideID: 7, DriverID: 3, Timestamp: bookedAt, Status: model.BookingCompleted,
+			PaymentMethod: model.PaymentCard, ChargeID: "ch_1"}
+	}
+	user := &model.User{ID: 1, Name: "Ayesha Khan"}
+	ride := &model.Ride{ID: 7, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(62500)}
+	// A comfort ride quoted at 1.2x surge: PKR 650 scaled by 1.25 and 1.2.
+	comfort := &model.Quote{VehicleClass: "comfort", DistanceKm: 15, Cost: pkr(97500), Surge: 1.2,
+		BaseFare: pkr(10000), DistanceFare: pkr(45000), TimeFare: pkr(10000), DurationMinutes: 20, Multiplier: 1.25}
+	itemized := []Line{{"Base fare", pkr(10000)}, {"Distance, 15 km", pkr(45000)}, {"Time, 20 min", pkr(10000)},
+		{"Comfort class \u00d71.25", pkr(16250)}, {"Surge \u00d71.2", pkr(16250)}}
+	// A short economy ride raised to the PKR 250 minimum.
+	short := &model.Quote{VehicleClass: "economy", DistanceKm: 2, Cost: pkr(25000), Surge: 1,
+		BaseFare: pkr(10000), DistanceFare: pkr(6000), TimeFare: pkr(4000), DurationMinutes: 4, Multiplier: 1, MinimumApplied: true}
+
+	tests := []struct {
+		name             string
+		booking          func(b *model.Booking)
+		quote            *model.Quote
+		expectedLines    []Line
+		expectedTotal    money.Money
+		expectedPickupAt time.Time
+		expectedErr      error
+	}{
+		{name: "Completed", booking: func(b *model.Booking) {}, expectedLines: []Line{{"Fare", pkr(62500)}}, expectedTotal: pkr(62500)},
+		{name: "Promo Code", booking: func(b *model.Booking) {
+			b.Discount = model.Discount{PromoCode: "WELCOME20", Fare: pkr(78125), Amount: pkr(15625)}
+		}, expectedLines: []Line{{"Fare", pkr(78125)}, {"Promo code WELCOME20", pkr(-15625)}}, expectedTotal: pkr(62500)},
+		{name: "Itemized", booking: func(b *model.Booking) { b.Cost = pkr(97500) }, quote: comfort,
+			expectedLines: itemized, expectedTotal: pkr(97500)},
+		{name: "Itemized Promo Code", booking: func(b *model.Booking) {
+			b.Cost, b.Discount = pkr(78000), model.Discount{PromoCode: "WELCOME20", Fare: pkr(97500), Amount: pkr(19500)}
+		}, quote: comfort, expectedLines: append(itemized[:len(itemized):len(itemized)], Line{"Promo code WELCOME20", pkr(-19500)}), expectedTotal: pkr(78000)},
+		{name: "Minimum Fare", booking: func(b *model.Booking) { b.Cost = pkr(25000) }, quote: short,
+			expectedLines: []Line{{"Base fare", pkr(10000)}, {"Distance, 2 km", pkr(6000)}, {"Time, 4 min", pkr(4000)}, {"Minimum fare", pkr(5000)}},
+			expectedTotal: pkr(25000)},
+		{name: "Quote Without Breakdown", booking: func(b *model.Booking) { b.Cost = pkr(62500) },
+			quote:         &model.Quote{VehicleClass: "economy", DistanceKm: 15, Cost: pkr(62500), Surge: 1},
+			expectedLines: []Line{{"Fare", pkr(62500)}}, expectedTotal: pkr(62500)},
+		{name: "Scheduled", booking: func(b *model.Booking) { b.PickupTime = bookedAt.Add(26 * time.Hour) },
+			expectedLines: []Line{{"Fare", pkr(62500)}}, expectedTotal: pkr(62500), expectedPickupAt: bookedAt.Add(26 * time.Hour)},
+		{name: "Picked Up When Booked", booking: func(b *model.Booking) { b.PickupTime = bookedAt },
+			expectedLines: []Line{{"Fare", pkr(62500)}}, expectedTotal: pkr(62500)},
+		{name: "Refunded", booking: func(b *model.Booking) { b.Refunded, b.RefundReason = pkr(12500), model.RefundServiceIssue },
+			expectedLines: []Line{{"Fare", pkr(62500)}}, expectedTotal: pkr(50000)},
+		{name: "Cancellation Fee", booking: func(b *model.Booking) {
+			b.Status, b.CancellationReason, b.CancellationFee = model.BookingCancelled, model.CancelledByRider, pkr(10000)
+		}, expectedLines: []Line{{"Cancellation fee", pkr(10000)}}, expectedTotal: pkr(10000)},
+		{name: "No-show Fee", booking: func(b *model.Booking) {
+			b.Status, b.CancellationReason, b.CancellationFee = model.BookingCancelled, model.CancelledNoShow, pkr(20000)
+		}, expectedLines: []Line{{"No-show fee", pkr(20000)}}, expectedTotal: pkr(20000)},
+		{name: "Cancelled For Free", booking: func(b *model.Booking) { b.Status = model.BookingCancelled }, expectedErr: ErrNoReceipt},
+		{name: "Confirmed", booking: func(b *model.Booking) { b.Status = model.BookingConfirmed }, expectedErr: ErrNoReceipt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := completed()
+			tt.booking(booking)
+			r, err := New(booking, user, ride, tt.quote)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "R-20250314-000042", r.Number)
+			require.Equal(t, "Ayesha Khan", r.Rider)
+			require.Equal(t, tt.expectedLines, r.Lines)
+			require.Equal(t, tt.expectedTotal, r.Total)
+			require.Equal(t, tt.expectedPickupAt, r.PickupAt)
+		})
+	}
+}
+
+func TestLocale(t *testing.T) {
+	tests := []struct {
+		locale        string
+		zone          string
+		expectedMoney string
+		expectedTime  string
+		expectedErr   error
+	}{
+		{locale: "", expectedMoney: "PKR 1,234,567.50", expectedTime: "March 14, 2025 4:30 PM UTC"},
+		{locale: "en-PK", zone: "Asia/Karachi", expectedMoney: "Rs 1,234,567.50", expectedTime: "14 March 2025 9:30 PM PKT"},
+		{locale: "en-IN", expectedMoney: "PKR 12,34,567.50", expectedTime: "14 March 2025 4:30 PM UTC"},
+		{locale: "de-DE", zone: "Europe/Berlin", expectedMoney: "PKR 1.234.567,50", expectedTime: "14.03.2025 17:30 CET"},
+		{locale: "ur-PK", expectedMoney: "Rs 1,234,567.50", expectedTime: "14 March 2025 4:30 PM UTC"}, // Falls back to en-PK
+		{locale: "ja", expectedMoney: "PKR 1,234,567.50", expectedTime: "March 14, 2025 4:30 PM UTC"},  // Falls back to English
+		{locale: "not a tag!", expectedErr: ErrInvalidLocale},
+		{locale: "en", zone: "Mars/Olympus_Mons", expectedErr: ErrUnknownTimeZone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+" "+tt.zone, func(t *testing.T) {
+			l, err := NewLocale(tt.locale, tt.zone)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedMoney, l.Money(pkr(123456750)))
+			require.Equal(t, "-"+tt.expectedMoney, l.Money(pkr(-123456750)))
+			require.Equal(t, tt.expectedTime, l.Time(bookedAt))
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	booking := &model.Booking{ID: 42, UserID: 1, RideID: 7, DriverID: 3, Timestamp: bookedAt, Status: model.BookingCompleted,
+		PickupTime: bookedAt.Add(time.Hour), PaymentMethod: model.PaymentWallet, ChargeID: "wallet-hold-9", Refunded: pkr(12500),
+		RefundReason: model.RefundFareDispute}
+	quote := &model.Quote{VehicleClass: "comfort", DistanceKm: 15, Cost: pkr(62500), Surge: 1,
+		BaseFare: pkr(10000), DistanceFare: pkr(30000), TimeFare: pkr(10000), DurationMinutes: 20, Multiplier: 1.25}
+	r, err := New(booking, &model.User{ID: 1, Name: "Ayesha <Khan>"}, &model.Ride{ID: 7, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(62500)}, quote)
+	require.NoError(t, err)
+	l, err := NewLocale("en-PK", "Asia/Karachi")
+	require.NoError(t, err)
+
+	var html bytes.Buffer
+	require.NoError(t, r.HTML(&html, l))
+	for _, s := range []string{`<html lang="en-PK">`, "R-20250314-000042", "Ayesha &lt;Khan&gt;", "14 March 2025 9:30 PM PKT",
+		"Pickup", "14 March 2025 10:30 PM PKT", "Downtown - Airport", "Wallet", "Base fare", "Comfort class \u00d71.25", "Rs 125.00",
+		"Rs 625.00", "Refunded (fare dispute)", "-Rs 125.00", "Rs 500.00"} {
+		require.Contains(t, html.String(), s)
+	}
+
+	var first, second bytes.Buffer
+	require.NoError(t, r.PDF(&first, l))
+	require.NoError(t, r.PDF(&second, l))
+	require.True(t, bytes.HasPrefix(first.Bytes(), []byte("%PDF-")))
+	require.Equal(t, first.Bytes(), second.Bytes(), "rendering a receipt again should give the same PDF")
+}
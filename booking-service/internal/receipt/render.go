@@ -0,0 +1,171 @@
+package receipt
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+)
+
+//go:embed receipt.html.tmpl
+var htmlTemplate string
+
+var page = template.Must(template.New("receipt").Parse(htmlTemplate))
+
+// Content types of the rendered receipts.
+const (
+	ContentTypeHTML = "text/html; charset=utf-8"
+	ContentTypePDF  = "application/pdf"
+)
+
+// view is a receipt with everything formatted for a locale, as rendered.
+type view struct {
+	Language      string
+	Number        string
+	BookingID     int32
+	Rider         string
+	BookedAt      string
+	PickupAt      string // Empty unless booked for a later pickup
+	Route         string
+	Distance      string
+	Driver        string // Empty if none was assigned
+	PaymentMethod string
+	Lines         []viewLine
+	Charged       string
+	Refunded      string // Empty if nothing was
+	RefundReason  string
+	Total         string
+}
+
+type viewLine struct {
+	Label  string
+	Amount string
+}
+
+// paymentMethods and refundReasons label the model's values on receipts.
+var (
+	paymentMethods = map[string]string{
+		model.PaymentCard:   "Card",
+		model.PaymentWallet: "Wallet",
+	}
+	refundReasons = map[string]string{
+		model.RefundServiceIssue: "service issue",
+		model.RefundFareDispute:  "fare dispute",
+		model.RefundFeeWaived:    "fee waived",
+		model.RefundOther:        "other",
+	}
+)
+
+func (r *Receipt) view(l *Locale) view {
+	v := view{
+		Language:      l.Language(),
+		Number:        r.Number,
+		BookingID:     r.BookingID,
+		Rider:         r.Rider,
+		BookedAt:      l.Time(r.BookedAt),
+		Route:         r.Source + " - " + r.Destination,
+		Distance:      l.Distance(r.DistanceKm),
+		PaymentMethod: paymentMethods[r.PaymentMethod],
+		Charged:       l.Money(r.Charged),
+		Total:         l.Money(r.Total),
+	}
+	if v.PaymentMethod == "" {
+		v.PaymentMethod = paymentMethods[model.PaymentCard]
+	}
+	if !r.PickupAt.IsZero() {
+		v.PickupAt = l.Time(r.PickupAt)
+	}
+	if r.DriverID != 0 {
+		v.Driver = fmt.Sprintf("#%d", r.DriverID)
+	}
+	for _, line := range r.Lines {
+		v.Lines = append(v.Lines, viewLine{Label: line.Label, Amount: l.Money(line.Amount)})
+	}
+	if !r.Refunded.IsZero() {
+		v.Refunded = l.Money(money.Money{Currency: r.Refunded.Currency, Minor: -r.Refunded.Minor})
+		v.RefundReason = refundReasons[r.RefundReason]
+	}
+	return v
+}
+
+// HTML writes r as an HTML page formatted for l.
+func (r *Receipt) HTML(w io.Writer, l *Locale) error {
+	return page.Execute(w, r.view(l))
+}
+
+// PDF writes r as a one-page A4 PDF formatted for l. The document is dated
+// when the booking was made and its catalogs are sorted, so rendering a
+// receipt again gives the same bytes.
+func (r *Receipt) PDF(w io.Writer, l *Locale) error {
+	v := r.view(l)
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetCatalogSort(true)
+	pdf.SetCreationDate(r.BookedAt)
+	pdf.SetModificationDate(r.BookedAt)
+	pdf.SetTitle("Receipt "+v.Number, true)
+	pdf.SetMargins(25, 25, 25)
+	pdf.AddPage()
+	// The core fonts cover code page 1252, which lacks the narrow no-break
+	// space French groups digits with; a no-break space stands in.
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+	tr := func(s string) string {
+		return translate(strings.ReplaceAll(s, "\u202f", "\u00a0"))
+	}
+	pageWidth, _ := pdf.GetPageSize()
+	left, _, right, _ := pdf.GetMargins()
+	width := pageWidth - left - right
+
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(width, 10, "Receipt", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.SetTextColor(102, 102, 102)
+	pdf.CellFormat(width, 6, tr(v.Number), "", 1, "L", false, 0, "")
+	pdf.SetTextColor(34, 34, 34)
+	pdf.Ln(6)
+
+	row := func(label, value string) {
+		pdf.CellFormat(width/2, 7, tr(label), "", 0, "L", false, 0, "")
+		pdf.CellFormat(width/2, 7, tr(value), "", 1, "R", false, 0, "")
+	}
+	total := func(label, value string) {
+		pdf.SetFont("Helvetica", "B", 11)
+		pdf.CellFormat(width/2, 8, tr(label), "T", 0, "L", false, 0, "")
+		pdf.CellFormat(width/2, 8, tr(value), "T", 1, "R", false, 0, "")
+		pdf.SetFont("Helvetica", "", 11)
+	}
+
+	pdf.SetFont("Helvetica", "", 11)
+	row("Rider", v.Rider)
+	row("Booked", v.BookedAt)
+	if v.PickupAt != "" {
+		row("Pickup", v.PickupAt)
+	}
+	row("Route", v.Route)
+	row("Distance", v.Distance)
+	if v.Driver != "" {
+		row("Driver", v.Driver)
+	}
+	row("Paid by", v.PaymentMethod)
+	pdf.Ln(8)
+
+	for _, line := range v.Lines {
+		row(line.Label, line.Amount)
+	}
+	if v.Refunded != "" {
+		total("Charged", v.Charged)
+		row(fmt.Sprintf("Refunded (%s)", v.RefundReason), v.Refunded)
+	}
+	total("Total", v.Total)
+	pdf.Ln(8)
+
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.SetTextColor(102, 102, 102)
+	pdf.CellFormat(width, 6, fmt.Sprintf("Booking %d", v.BookingID), "", 1, "L", false, 0, "")
+	return pdf.Output(w)
+}
@@ -0,0 +1,194 @@
+// Package schedule handles bookings made for a later pickup. Such a booking
+// is scheduled rather than pending: the scheduler reminds its rider ahead of
+// the pickup and releases it to dispatch shortly before, so drivers are only
+// looked for once the ride is near.
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/metrics"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	"github.com/sirupsen/logrus"
+)
+
+// batchSize is how many scheduled bookings a pass reads at once.
+const batchSize = 100
+
+// ErrPickupTooSoon and ErrPickupTooLate are returned by Config.Check for a
+// pickup time outside the allowed lead time.
+var (
+	ErrPickupTooSoon = errors.New("pickup time is too soon")
+	ErrPickupTooLate = errors.New("pickup time is too far ahead")
+)
+
+// Store is the part of the booking store the scheduler works on.
+type Store interface {
+	ListScheduledBookings(ctx context.Context, remindBy, releaseBy time.Time, afterID int32, limit int) ([]model.Booking, error)
+	RemindBooking(ctx context.Context, bookingID int32, now time.Time) error
+	ReleaseBooking(ctx context.Context, bookingID int32) error
+}
+
+// Config tunes scheduling. Zero fields take the defaults of DefaultConfig.
+type Config struct {
+	// MinLead and MaxLead bound how far ahead of its pickup a booking can
+	// be scheduled.
+	MinLead time.Duration
+	MaxLead time.Duration
+
+	// DispatchLead is how long before its pickup a booking is released to
+	// dispatch.
+	DispatchLead time.Duration
+
+	// ReminderLead is how long before its pickup the rider is reminded of a
+	// booking.
+	ReminderLead time.Duration
+
+	// Interval is how often scheduled bookings are looked at.
+	Interval time.Duration
+}
+
+// DefaultConfig is the configuration used for unset Config fields.
+var DefaultConfig = Config{
+	MinLead:      30 * time.Minute,
+	MaxLead:      7 * 24 * time.Hour,
+	DispatchLead: 15 * time.Minute,
+	ReminderLead: time.Hour,
+	Interval:     30 * time.Second,
+}
+
+// withDefaults returns cfg with its zero fields set from DefaultConfig.
+func (cfg Config) withDefaults() Config {
+	if cfg.MinLead == 0 {
+		cfg.MinLead = DefaultConfig.MinLead
+	}
+	if cfg.MaxLead == 0 {
+		cfg.MaxLead = DefaultConfig.MaxLead
+	}
+	if cfg.DispatchLead == 0 {
+		cfg.DispatchLead = DefaultConfig.DispatchLead
+	}
+	if cfg.ReminderLead == 0 {
+		cfg.ReminderLead = DefaultConfig.ReminderLead
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultConfig.Interval
+	}
+	return cfg
+}
+
+// Check returns an error wrapping ErrPickupTooSoon or ErrPickupTooLate if a
+// booking made at now cannot be scheduled for pickup.
+func (cfg Config) Check(pickup, now time.Time) error {
+	cfg = cfg.withDefaults()
+	lead := pickup.Sub(now)
+	if lead < cfg.MinLead {
+		return fmt.Errorf("%w: must be at least %s from now", ErrPickupTooSoon, cfg.MinLead)
+	}
+	if lead > cfg.MaxLead {
+		return fmt.Errorf("%w: must be at most %s from now", ErrPickupTooLate, cfg.MaxLead)
+	}
+	return nil
+}
+
+// Scheduler reminds riders of their scheduled bookings and releases the
+// bookings to dispatch. Several schedulers may share a store: the store
+// reminds and releases a booking once, so the others skip it.
+type Scheduler struct {
+	store Store
+	cfg   Config
+	now   func() time.Time
+	log   *logrus.Logger
+}
+
+// New creates a Scheduler over store.
+func New(store Store, cfg Config, logger *logrus.Logger) *Scheduler {
+	return &Scheduler{store: store, cfg: cfg.withDefaults(), now: time.Now, log: logger}
+}
+
+// Run handles scheduled bookings every interval until ctx is canceled.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			s.log.Error("Schedule pass failed", "error", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce reminds the riders of scheduled bookings whose pickup is within
+// the reminder lead, and releases to dispatch those whose pickup is within
+// the dispatch lead. It reads the due bookings in batches until none are
+// left, so bookings that keep failing do not hold up later ones. A booking
+// that fails is logged and counted, and tried again on the next pass; only
+// failing to list the bookings fails the pass.
+func (s *Scheduler) RunOnce(ctx context.Context) error {
+	now := s.now()
+	remindBy, releaseBy := now.Add(s.cfg.ReminderLead), now.Add(s.cfg.DispatchLead)
+
+	var afterID int32
+	for {
+		scheduled, err := s.store.ListScheduledBookings(ctx, remindBy, releaseBy, afterID, batchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range scheduled {
+			b := &scheduled[i]
+			if err := s.handle(ctx, b, now); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				metrics.ScheduleFailures.Inc()
+				s.log.Error("Failed to handle scheduled booking", "booking_id", b.ID, "pickup_time", b.PickupTime, "error", err.Error())
+			}
+		}
+
+		if len(scheduled) < batchSize {
+			return nil
+		}
+		afterID = scheduled[len(scheduled)-1].ID
+	}
+}
+
+// handle reminds the rider of one scheduled booking and releases it when
+// due.
+func (s *Scheduler) handle(ctx context.Context, b *model.Booking, now time.Time) error {
+	untilPickup := b.PickupTime.Sub(now)
+
+	if b.RemindedAt.IsZero() && untilPickup <= s.cfg.ReminderLead {
+		err := s.store.RemindBooking(ctx, b.ID, now)
+		if errors.Is(err, store.ErrBookingNotScheduled) {
+			// Cancelled, or handled by another scheduler meanwhile.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s.log.Info("Rider reminded of scheduled booking", "booking_id", b.ID, "user_id", b.UserID, "pickup_time", b.PickupTime)
+	}
+
+	if untilPickup <= s.cfg.DispatchLead {
+		err := s.store.ReleaseBooking(ctx, b.ID)
+		if errors.Is(err, store.ErrBookingNotScheduled) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s.log.Info("Scheduled booking released to dispatch", "booking_id", b.ID, "pickup_time", b.PickupTime)
+	}
+	return nil
+}
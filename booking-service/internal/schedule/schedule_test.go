@@ -0,0 +1,231 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/metrics"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestScheduler returns a scheduler over a fresh store with a clock the
+// test moves with advance.
+func newTestScheduler() (*Scheduler, *store.MemBookingStore, func(time.Duration)) {
+	s := store.NewMemBookingStore()
+	sch := New(s, Config{DispatchLead: 15 * time.Minute, ReminderLead: time.Hour}, logrus.New())
+	now := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+	sch.now = func() time.Time { return now }
+	return sch, s, func(d time.Duration) { now = now.Add(d) }
+}
+
+// newScheduledBooking stores a booking made at sch's current time for a
+// pickup after lead.
+func newScheduledBooking(t *testing.T, sch *Scheduler, s *store.MemBookingStore, lead time.Duration) int32 {
+	t.Helper()
+	ctx := context.Background()
+
+	userID, err := s.CreateUser(ctx, "John Doe")
+	require.NoError(t, err)
+	cost := money.Money{Currency: "PKR", Minor: 15000}
+	rideID, err := s.CreateRide(ctx, "Downtown", "Airport", 15, cost)
+	require.NoError(t, err)
+	saga := &model.BookingSaga{
+		ID:         uuid.NewString(),
+		UserID:     userID,
+		Ride:       model.Ride{ID: rideID, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: cost},
+		Status:     model.SagaRunning,
+		Step:       model.StepCreateBooking,
+		PickupTime: sch.now().Add(lead),
+	}
+	require.NoError(t, s.CreateSaga(ctx, saga))
+	bookingID, err := s.CompleteSaga(ctx, saga, sch.now())
+	require.NoError(t, err)
+	return bookingID
+}
+
+// topics returns the topics of the events s recorded since the last call.
+func topics(t *testing.T, s *store.MemBookingStore) []string {
+	t.Helper()
+	ctx := context.Background()
+
+	msgs, err := s.Outbox().Pending(ctx, 100)
+	require.NoError(t, err)
+	out, ids := []string{}, []string{}
+	for _, m := range msgs {
+		out = append(out, m.Topic)
+		ids = append(ids, m.ID)
+	}
+	require.NoError(t, s.Outbox().MarkPublished(ctx, ids))
+	return out
+}
+
+// failingStore fails to list scheduled bookings with listErr, and to remind
+// the rider of booking failRemind.
+type failingStore struct {
+	*store.MemBookingStore
+	listErr    error
+	failRemind int32
+}
+
+func (s *failingStore) ListScheduledBookings(ctx context.Context, remindBy, releaseBy time.Time, afterID int32, limit int) ([]model.Booking, error) {
+	if s.listErr != nil {
+		return nil, s.listErr
+	}
+	return s.MemBookingStore.ListScheduledBookings(ctx, remindBy, releaseBy, afterID, limit)
+}
+
+func (s *failingStore) RemindBooking(ctx context.Context, bookingID int32, now time.Time) error {
+	if bookingID == s.failRemind {
+		return store.ErrDatabaseOperation
+	}
+	return s.MemBookingStore.RemindBooking(ctx, bookingID, now)
+}
+
+// status returns the status of a booking.
+func status(t *testing.T, s *store.MemBookingStore, bookingID int32) string {
+	t.Helper()
+
+	booking, _, _, err := s.GetBookingDetails(context.Background(), bookingID)
+	require.NoError(t, err)
+	return booking.Status
+}
+
+func TestScheduler_RunOnce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Reminds Then Releases", func(t *testing.T) {
+		sch, s, advance := newTestScheduler()
+		bookingID := newScheduledBooking(t, sch, s, 3*time.Hour)
+		topics(t, s)
+
+		require.NoError(t, sch.RunOnce(ctx))
+		require.Empty(t, topics(t, s))
+		require.Equal(t, model.BookingScheduled, status(t, s, bookingID))
+
+		advance(2 * time.Hour)
+		require.NoError(t, sch.RunOnce(ctx))
+		require.Equal(t, []string{"booking.v1.BookingReminder"}, topics(t, s))
+		require.Equal(t, model.BookingScheduled, status(t, s, bookingID))
+
+		// The rider is reminded once.
+		advance(30 * time.Minute)
+		require.NoError(t, sch.RunOnce(ctx))
+		require.Empty(t, topics(t, s))
+
+		advance(15 * time.Minute)
+		require.NoError(t, sch.RunOnce(ctx))
+		require.Equal(t, []string{"booking.v1.BookingStatusChanged"}, topics(t, s))
+		require.Equal(t, model.BookingPending, status(t, s, bookingID))
+
+		pending, err := s.ListPendingBookings(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+	})
+
+	t.Run("Overdue Booking Is Reminded And Released", func(t *testing.T) {
+		sch, s, advance := newTestScheduler()
+		bookingID := newScheduledBooking(t, sch, s, time.Hour)
+		topics(t, s)
+
+		// A scheduler that was down catches up in one pass.
+		advance(50 * time.Minute)
+		require.NoError(t, sch.RunOnce(ctx))
+		require.Equal(t, []string{"booking.v1.BookingReminder", "booking.v1.BookingStatusChanged"}, topics(t, s))
+		require.Equal(t, model.BookingPending, status(t, s, bookingID))
+	})
+
+	t.Run("Cancelled Booking Is Skipped", func(t *testing.T) {
+		sch, s, advance := newTestScheduler()
+		bookingID := newScheduledBooking(t, sch, s, time.Hour)
+		booking, _, _, err := s.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		_, _, err = s.CancelBooking(ctx, &model.Cancellation{BookingID: bookingID, Reason: model.CancelledByRider, UserID: booking.UserID}, nil, sch.now())
+		require.NoError(t, err)
+		topics(t, s)
+
+		advance(50 * time.Minute)
+		require.NoError(t, sch.RunOnce(ctx))
+		require.Empty(t, topics(t, s))
+		require.Equal(t, model.BookingCancelled, status(t, s, bookingID))
+	})
+
+	t.Run("Failed Booking Does Not Stop The Pass", func(t *testing.T) {
+		sch, s, advance := newTestScheduler()
+		failed := newScheduledBooking(t, sch, s, time.Hour)
+		released := newScheduledBooking(t, sch, s, time.Hour)
+		topics(t, s)
+		sch.store = &failingStore{MemBookingStore: s, failRemind: failed}
+		failures := testutil.ToFloat64(metrics.ScheduleFailures)
+
+		advance(50 * time.Minute)
+		require.NoError(t, sch.RunOnce(ctx))
+		require.Equal(t, []string{"booking.v1.BookingReminder", "booking.v1.BookingStatusChanged"}, topics(t, s))
+		require.Equal(t, model.BookingScheduled, status(t, s, failed))
+		require.Equal(t, model.BookingPending, status(t, s, released))
+		require.Equal(t, failures+1, testutil.ToFloat64(metrics.ScheduleFailures))
+
+		// The failed booking is tried again on the next pass.
+		sch.store = s
+		require.NoError(t, sch.RunOnce(ctx))
+		require.Equal(t, []string{"booking.v1.BookingReminder", "booking.v1.BookingStatusChanged"}, topics(t, s))
+		require.Equal(t, model.BookingPending, status(t, s, failed))
+	})
+
+	t.Run("Handles More Than A Batch", func(t *testing.T) {
+		sch, s, advance := newTestScheduler()
+		bookingIDs := []int32{}
+		for range batchSize + 1 {
+			bookingIDs = append(bookingIDs, newScheduledBooking(t, sch, s, time.Hour))
+		}
+
+		advance(50 * time.Minute)
+		require.NoError(t, sch.RunOnce(ctx))
+		for _, bookingID := range bookingIDs {
+			require.Equal(t, model.BookingPending, status(t, s, bookingID))
+		}
+	})
+
+	t.Run("List Failed", func(t *testing.T) {
+		sch, s, _ := newTestScheduler()
+		sch.store = &failingStore{MemBookingStore: s, listErr: errors.New("connection reset")}
+		require.Error(t, sch.RunOnce(ctx))
+	})
+}
+
+func TestConfig_Check(t *testing.T) {
+	now := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		cfg         Config
+		pickup      time.Time
+		expectedErr error
+	}{
+		{name: "Within Lead", pickup: now.Add(2 * time.Hour)},
+		{name: "At Min Lead", pickup: now.Add(30 * time.Minute)},
+		{name: "At Max Lead", pickup: now.Add(7 * 24 * time.Hour)},
+		{name: "Too Soon", pickup: now.Add(29 * time.Minute), expectedErr: ErrPickupTooSoon},
+		{name: "In The Past", pickup: now.Add(-time.Hour), expectedErr: ErrPickupTooSoon},
+		{name: "Too Late", pickup: now.Add(7*24*time.Hour + time.Second), expectedErr: ErrPickupTooLate},
+		{name: "Configured Lead", cfg: Config{MinLead: time.Hour, MaxLead: 2 * time.Hour}, pickup: now.Add(3 * time.Hour), expectedErr: ErrPickupTooLate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Check(tt.pickup, now)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
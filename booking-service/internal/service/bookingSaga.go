@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SagaTimeout bounds one run of a booking saga. A saga still active when its
+// run ends is resumed by RunSagaRecovery.
+const SagaTimeout = 10 * time.Second
+
+// sagaRecoveryBatch is how many stale sagas one recovery pass resumes at most.
+const sagaRecoveryBatch = 100
+
+// runSaga executes the remaining steps of saga, saving its progress after
+// every step. It returns the booking once the saga completes. Otherwise it
+// returns the error that failed the saga, or a SAGA_PENDING error if the
+// saga stopped on a transient error and will be resumed.
+func (s *BookingService) runSaga(ctx context.Context, saga *model.BookingSaga) (*model.Booking, error) {
+	var booking *model.Booking
+	var failure error
+	for saga.Active() {
+		var err error
+		booking, err = s.runStep(ctx, saga)
+		if err == nil {
+			continue
+		}
+
+		// Compensation must finish, so all of its errors are retried.
+		if saga.Status == model.SagaCompensating || !isPermanent(err) {
+			s.log.Error("Booking saga step failed, will retry", "saga_id", saga.ID, "step", saga.Step, "error", err.Error())
+			saga.Error = err.Error()
+			if err := s.bookingStore.UpdateSaga(ctx, saga); err != nil {
+				s.log.Error("Failed to save booking saga", "saga_id", saga.ID, "error", err.Error())
+			}
+			if failure != nil {
+				return nil, failure
+			}
+			return nil, sagaPending(saga, err)
+		}
+
+		s.log.Error("Booking saga step failed", "saga_id", saga.ID, "step", saga.Step, "error", err.Error())
+		failure = err
+		saga.Error = err.Error()
+		switch {
+		case saga.Ride.ID != 0:
+			saga.Status, saga.Step = model.SagaCompensating, model.StepDeleteRide
+		case saga.HoldID != 0:
+			saga.Status, saga.Step = model.SagaCompensating, model.StepReleaseHold
+		default:
+			saga.Status, saga.Step = model.SagaFailed, model.StepDone
+		}
+		if err := s.bookingStore.UpdateSaga(ctx, saga); err != nil {
+			s.log.Error("Failed to save booking saga", "saga_id", saga.ID, "error", err.Error())
+			return nil, failure
+		}
+	}
+
+	switch {
+	case saga.Status == model.SagaCompleted && booking != nil:
+		return booking, nil
+	case saga.Status == model.SagaCompleted:
+		// Completed by an earlier run.
+		return &model.Booking{ID: saga.BookingID, UserID: saga.UserID, RideID: saga.Ride.ID, Timestamp: saga.UpdatedAt}, nil
+	case failure != nil:
+		return nil, failure
+	default:
+		return nil, grpcerr.New(codes.FailedPrecondition, grpcerr.ReasonSagaFailed, fmt.Sprintf("booking saga %s failed: %s", saga.ID, saga.Error))
+	}
+}
+
+// runStep executes the next step of saga and saves its progress. It returns
+// the booking when the step completes the saga.
+func (s *BookingService) runStep(ctx context.Context, saga *model.BookingSaga) (*model.Booking, error) {
+	switch saga.Step {
+	case model.StepValidateUser:
+		if _, err := s.users.GetUser(ctx, &userpb.GetUserRequest{UserId: saga.UserID}); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, grpcerr.New(codes.FailedPrecondition, grpcerr.ReasonForeignKeyViolation,
+					fmt.Sprintf("user with id %d does not exist", saga.UserID))
+			}
+			return nil, err
+		}
+		saga.Step = model.StepCreateRide
+		if saga.PaymentMethod == model.PaymentWallet && !saga.Ride.Cost.IsZero() {
+			saga.Step = model.StepHoldFunds
+		}
+
+	case model.StepHoldFunds:
+		// The saga ID makes the hold idempotent, so a resumed saga never
+		// holds the cost twice.
+		res, err := s.users.HoldFunds(ctx, &userpb.HoldFundsRequest{
+			UserId:    saga.UserID,
+			Amount:    saga.Ride.Cost.Proto(),
+			Reference: holdReference(saga),
+		})
+		if err != nil {
+			return nil, err
+		}
+		saga.HoldID, saga.Step = res.Hold.HoldId, model.StepCreateRide
+
+	case model.StepCreateRide:
+		// The saga ID makes the call idempotent, so a resumed saga never
+		// creates a second ride.
+		res, err := s.rides.CreateRide(ctx, &ridepb.CreateRideRequest{
+			RequestId: saga.ID,
+			Ride: &ridepb.Ride{
+				Source:      saga.Ride.Source,
+				Destination: saga.Ride.Destination,
+				Distance:    saga.Ride.Distance,
+				Cost:        saga.Ride.Cost.Proto(),
+				Pickup:      store.LatLngToProto(saga.Ride.Pickup),
+				Dropoff:     store.LatLngToProto(saga.Ride.Dropoff),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		// ride-service computes the distance from the pickup and dropoff.
+		saga.Ride.ID, saga.Ride.Distance, saga.Step = res.Ride.RideId, res.Ride.Distance, model.StepCreateBooking
+
+	case model.StepCreateBooking:
+		// bookings.time has no time zone, so keep it in UTC.
+		bookingTime := time.Now().UTC()
+		bookingID, err := s.bookingStore.CompleteSaga(ctx, saga, bookingTime)
+		if err != nil {
+			return nil, err
+		}
+		s.log.Info("Booking saga completed", "saga_id", saga.ID, "booking_id", bookingID)
+		bookingStatus, pickupTime := model.BookingPending, bookingTime
+		if !saga.PickupTime.IsZero() {
+			bookingStatus, pickupTime = model.BookingScheduled, saga.PickupTime
+		}
+		return &model.Booking{
+			ID:            bookingID,
+			UserID:        saga.UserID,
+			RideID:        saga.Ride.ID,
+			Timestamp:     bookingTime,
+			Status:        bookingStatus,
+			PickupTime:    pickupTime,
+			TariffVersion: saga.TariffVersion,
+			Discount:      saga.Discount,
+			PaymentMethod: saga.PaymentMethod,
+			HoldID:        saga.HoldID,
+		}, nil
+
+	case model.StepDeleteRide:
+		_, err := s.rides.DeleteRide(ctx, &ridepb.DeleteRideRequest{RideId: saga.Ride.ID})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		if saga.HoldID != 0 {
+			saga.Step = model.StepReleaseHold
+			break
+		}
+		s.log.Info("Booking saga compensated", "saga_id", saga.ID, "ride_id", saga.Ride.ID)
+		saga.Status, saga.Step = model.SagaFailed, model.StepDone
+
+	case model.StepReleaseHold:
+		_, err := s.users.ReleaseHold(ctx, &userpb.ReleaseHoldRequest{HoldId: saga.HoldID})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		s.log.Info("Booking saga compensated", "saga_id", saga.ID, "ride_id", saga.Ride.ID, "hold_id", saga.HoldID)
+		saga.Status, saga.Step = model.SagaFailed, model.StepDone
+
+	default:
+		return nil, fmt.Errorf("booking saga %s has unknown step %q", saga.ID, saga.Step)
+	}
+
+	return nil, s.bookingStore.UpdateSaga(ctx, saga)
+}
+
+// holdReference returns the reference a saga holds funds under, unique per
+// saga.
+func holdReference(saga *model.BookingSaga) string {
+	return "booking-saga-" + saga.ID
+}
+
+// isPermanent reports whether err means a saga step can never succeed, so
+// the saga must be compensated. Any other error may be transient, or may
+// hide a step that did succeed, so the step is retried instead.
+func isPermanent(err error) bool {
+	if errors.Is(err, store.ErrForeignKeyViolation) || errors.Is(err, store.ErrAlreadyExists) {
+		return true
+	}
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.NotFound, codes.InvalidArgument, codes.FailedPrecondition, codes.AlreadyExists:
+			return true
+		}
+	}
+	return false
+}
+
+// sagaPending reports that saga stopped on err and will be resumed. The
+// saga ID is attached so clients can follow it with GetBookingSaga.
+func sagaPending(saga *model.BookingSaga, err error) error {
+	return grpcerr.NewWithMetadata(codes.Unavailable, grpcerr.ReasonSagaPending,
+		fmt.Sprintf("booking saga %s is pending: %v", saga.ID, err),
+		map[string]string{"saga_id": saga.ID}, grpcerr.Retry(grpcerr.DefaultRetryDelay))
+}
+
+// sagaError converts an error returned by runSaga into a gRPC status error.
+// Errors from the other services are returned unchanged.
+func sagaError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return storeError(err, "failed to create booking")
+}
+
+// GetBookingSaga reports the progress of a booking saga.
+func (s *BookingService) GetBookingSaga(ctx context.Context, req *pb.GetBookingSagaRequest) (*pb.GetBookingSagaResponse, error) {
+	// Input validation
+	if req.SagaId == "" {
+		s.log.Error("Invalid saga_id: must be provided")
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("saga_id", "must be provided"))
+	}
+
+	saga, err := s.bookingStore.GetSaga(ctx, req.SagaId)
+	if err != nil {
+		s.log.Error("Failed to fetch booking saga", "saga_id", req.SagaId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to fetch booking saga %s", req.SagaId))
+	}
+
+	return &pb.GetBookingSagaResponse{Saga: store.SagaToProto(saga)}, nil
+}
+
+// ResumeSagas resumes sagas that are still active but have not been updated
+// for staleAfter, such as those interrupted by a crash, and returns how many
+// it resumed. Each saga is claimed first, so concurrent callers skip it.
+func (s *BookingService) ResumeSagas(ctx context.Context, staleAfter time.Duration) (int, error) {
+	sagas, err := s.bookingStore.ListStaleSagas(ctx, time.Now().Add(-staleAfter), sagaRecoveryBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	resumed := 0
+	for i := range sagas {
+		saga := &sagas[i]
+		if err := s.bookingStore.UpdateSaga(ctx, saga); err != nil {
+			if errors.Is(err, store.ErrSagaConflict) {
+				continue
+			}
+			return resumed, err
+		}
+
+		s.log.Info("Resuming booking saga", "saga_id", saga.ID, "step", saga.Step)
+		runCtx, cancel := context.WithTimeout(ctx, SagaTimeout)
+		if _, err := s.runSaga(runCtx, saga); err != nil {
+			s.log.Error("Resumed booking saga did not complete", "saga_id", saga.ID, "error", err.Error())
+		}
+		cancel()
+		resumed++
+	}
+	return resumed, nil
+}
+
+// RunSagaRecovery resumes stale sagas every interval until ctx is canceled.
+func (s *BookingService) RunSagaRecovery(ctx context.Context, interval, staleAfter time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ResumeSagas(ctx, staleAfter); err != nil && ctx.Err() == nil {
+			s.log.Error("Failed to resume booking sagas: ", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/service/mocks"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"testing"
+	"time"
+)
+
+// fakeUsers is a UserServiceClient whose GetUser fails with err, if set. It
+// holds funds as hold holdID and records the wallet calls it receives.
+type fakeUsers struct {
+	userpb.UserServiceClient
+	err        error
+	holdID     int32
+	holdErr    error
+	captureErr error
+	releaseErr error
+	refundErr  error
+	holds      []*userpb.HoldFundsRequest
+	captured   []*userpb.CaptureHoldRequest
+	released   []int32
+	refunded   []*userpb.RefundHoldRequest
+}
+
+func (f *fakeUsers) GetUser(ctx context.Context, req *userpb.GetUserRequest, opts ...grpc.CallOption) (*userpb.GetUserResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &userpb.GetUserResponse{Name: "John Doe"}, nil
+}
+
+func (f *fakeUsers) HoldFunds(ctx context.Context, req *userpb.HoldFundsRequest, opts ...grpc.CallOption) (*userpb.HoldFundsResponse, error) {
+	f.holds = append(f.holds, req)
+	if f.holdErr != nil {
+		return nil, f.holdErr
+	}
+	return &userpb.HoldFundsResponse{Hold: &userpb.Hold{HoldId: f.holdID, UserId: req.UserId, Amount: req.Amount,
+		Status: userpb.HoldStatus_HOLD_STATUS_HELD, Reference: req.Reference}}, nil
+}
+
+func (f *fakeUsers) CaptureHold(ctx context.Context, req *userpb.CaptureHoldRequest, opts ...grpc.CallOption) (*userpb.CaptureHoldResponse, error) {
+	f.captured = append(f.captured, req)
+	if f.captureErr != nil {
+		return nil, f.captureErr
+	}
+	return &userpb.CaptureHoldResponse{Hold: &userpb.Hold{HoldId: req.HoldId, Captured: req.Amount, Status: userpb.HoldStatus_HOLD_STATUS_CAPTURED}}, nil
+}
+
+func (f *fakeUsers) ReleaseHold(ctx context.Context, req *userpb.ReleaseHoldRequest, opts ...grpc.CallOption) (*userpb.ReleaseHoldResponse, error) {
+	if f.releaseErr != nil {
+		return nil, f.releaseErr
+	}
+	f.released = append(f.released, req.HoldId)
+	return &userpb.ReleaseHoldResponse{Hold: &userpb.Hold{HoldId: req.HoldId, Status: userpb.HoldStatus_HOLD_STATUS_RELEASED}}, nil
+}
+
+func (f *fakeUsers) RefundHold(ctx context.Context, req *userpb.RefundHoldRequest, opts ...grpc.CallOption) (*userpb.RefundHoldResponse, error) {
+	f.refunded = append(f.refunded, req)
+	if f.refundErr != nil {
+		return nil, f.refundErr
+	}
+	return &userpb.RefundHoldResponse{Hold: &userpb.Hold{HoldId: req.HoldId, Refunded: req.Refunded, Status: userpb.HoldStatus_HOLD_STATUS_CAPTURED}}, nil
+}
+
+// fakeRides is a RideServiceClient that creates rides with ID rideID and
+// records the calls it receives. If distance is set, created rides get it
+// in place of the requested one, as ride-service computes its own.
+type fakeRides struct {
+	ridepb.RideServiceClient
+	rideID    int32
+	distance  int32
+	createErr error
+	deleteErr error
+	created   []*ridepb.CreateRideRequest
+	deleted   []int32
+	updated   []*ridepb.UpdateRideRequest
+}
+
+func (f *fakeRides) CreateRide(ctx context.Context, req *ridepb.CreateRideRequest, opts ...grpc.CallOption) (*ridepb.CreateRideResponse, error) {
+	f.created = append(f.created, req)
+	if f.createErr != nil {
+		return nil, f.createErr
+	}
+	ride := proto.Clone(req.Ride).(*ridepb.Ride)
+	ride.RideId = f.rideID
+	if f.distance != 0 {
+		ride.Distance = f.distance
+	}
+	return &ridepb.CreateRideResponse{Ride: ride}, nil
+}
+
+func (f *fakeRides) DeleteRide(ctx context.Context, req *ridepb.DeleteRideRequest, opts ...grpc.CallOption) (*ridepb.DeleteRideResponse, error) {
+	if f.deleteErr != nil {
+		return nil, f.deleteErr
+	}
+	f.deleted = append(f.deleted, req.RideId)
+	return &ridepb.DeleteRideResponse{}, nil
+}
+
+func (f *fakeRides) GetRide(ctx context.Context, req *ridepb.GetRideRequest, opts ...grpc.CallOption) (*ridepb.GetRideResponse, error) {
+	return &ridepb.GetRideResponse{Ride: &ridepb.Ride{RideId: req.RideId, Source: "Downtown", Destination: "Airport", Distance: 20, Cost: pkr(50000).Proto()}}, nil
+}
+
+func (f *fakeRides) UpdateRide(ctx context.Context, req *ridepb.UpdateRideRequest, opts ...grpc.CallOption) (*ridepb.UpdateRideResponse, error) {
+	f.updated = append(f.updated, req)
+	return &ridepb.UpdateRideResponse{Message: "Ride updated successfully"}, nil
+}
+
+// fakeDrivers is a DriverServiceClient that records the driver status
+// updates it receives.
+type fakeDrivers struct {
+	driverpb.DriverServiceClient
+	statuses []*driverpb.UpdateDriverStatusRequest
+}
+
+func (f *fakeDrivers) UpdateDriverStatus(ctx context.Context, req *driverpb.UpdateDriverStatusRequest, opts ...grpc.CallOption) (*driverpb.UpdateDriverStatusResponse, error) {
+	f.statuses = append(f.statuses, req)
+	return &driverpb.UpdateDriverStatusResponse{}, nil
+}
+
+// completeSaga marks the saga passed to a mocked CompleteSaga completed, as
+// the stores do.
+func completeSaga(args mock.Arguments) {
+	saga := args.Get(1).(*model.BookingSaga)
+	saga.Status, saga.Step, saga.BookingID = model.SagaCompleted, model.StepDone, 1001
+}
+
+func TestBookingService_ResumeSagas(t *testing.T) {
+	logger := logrus.New()
+	ride := model.Ride{Source: "Downtown", Destination: "Airport", Distance: 20, Cost: pkr(50000)}
+
+	tests := []struct {
+		name            string
+		saga            model.BookingSaga
+		rides           *fakeRides
+		claimErr        error
+		expectedResumed int
+		expectedStatus  string
+		expectDeleted   []int32
+		expectReleased  []int32
+	}{
+		{
+			name:            "Resumes Forward",
+			saga:            model.BookingSaga{ID: "saga-1", UserID: 1, Ride: ride, Status: model.SagaRunning, Step: model.StepCreateRide},
+			rides:           &fakeRides{rideID: 101, distance: 21},
+			expectedResumed: 1,
+			expectedStatus:  model.SagaCompleted,
+		},
+		{
+			name: "Finishes Compensation",
+			saga: model.BookingSaga{
+				ID:     "saga-2",
+				UserID: 1,
+				Ride:   model.Ride{ID: 101, Source: "Downtown", Destination: "Airport", Distance: 20, Cost: pkr(50000)},
+				Status: model.SagaCompensating,
+				Step:   model.StepDeleteRide,
+			},
+			rides:           &fakeRides{},
+			expectedResumed: 1,
+			expectedStatus:  model.SagaFailed,
+			expectDeleted:   []int32{101},
+		},
+		{
+			name: "Releases Hold After Deleting Ride",
+			saga: model.BookingSaga{
+				ID:            "saga-5",
+				UserID:        1,
+				Ride:          model.Ride{ID: 101, Source: "Downtown", Destination: "Airport", Distance: 20, Cost: pkr(50000)},
+				Status:        model.SagaCompensating,
+				Step:          model.StepDeleteRide,
+				PaymentMethod: model.PaymentWallet,
+				HoldID:        7,
+			},
+			rides:           &fakeRides{},
+			expectedResumed: 1,
+			expectedStatus:  model.SagaFailed,
+			expectDeleted:   []int32{101},
+			expectReleased:  []int32{7},
+		},
+		{
+			name:            "Compensation Keeps Retrying",
+			saga:            model.BookingSaga{ID: "saga-3", UserID: 1, Ride: model.Ride{ID: 101}, Status: model.SagaCompensating, Step: model.StepDeleteRide},
+			rides:           &fakeRides{deleteErr: status.Error(codes.FailedPrecondition, "ride is booked")},
+			expectedResumed: 1,
+			expectedStatus:  model.SagaCompensating,
+		},
+		{
+			name:            "Skips Saga Claimed Elsewhere",
+			saga:            model.BookingSaga{ID: "saga-4", UserID: 1, Ride: ride, Status: model.SagaRunning, Step: model.StepCreateRide},
+			rides:           &fakeRides{rideID: 101},
+			claimErr:        store.ErrSagaConflict,
+			expectedResumed: 0,
+			expectedStatus:  model.SagaRunning,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			saga := tt.saga
+			mockStore.On("ListStaleSagas", mock.Anything, mock.Anything, sagaRecoveryBatch).Return([]model.BookingSaga{tt.saga}, nil)
+			mockStore.On("UpdateSaga", mock.Anything, mock.Anything).Return(tt.claimErr).Run(func(args mock.Arguments) {
+				saga = *args.Get(1).(*model.BookingSaga)
+			})
+			mockStore.On("CompleteSaga", mock.Anything, mock.Anything, mock.Anything).Return(int32(1001), nil).Run(func(args mock.Arguments) {
+				completeSaga(args)
+				saga = *args.Get(1).(*model.BookingSaga)
+			}).Maybe()
+
+			users := &fakeUsers{}
+			service := NewBookingService(mockStore, outbox.NewMemStore(), users, tt.rides, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logger)
+			resumed, err := service.ResumeSagas(context.Background(), time.Minute)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResumed, resumed)
+			require.Equal(t, tt.expectedStatus, saga.Status)
+			require.Equal(t, tt.expectDeleted, tt.rides.deleted)
+			require.Equal(t, tt.expectReleased, users.released)
+			if tt.claimErr == nil && tt.saga.Step == model.StepCreateRide {
+				require.Equal(t, tt.saga.ID, tt.rides.created[0].RequestId)
+				require.Equal(t, tt.rides.distance, saga.Ride.Distance)
+			}
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBookingService_GetBookingSaga(t *testing.T) {
+	mockStore := new(mocks.BookingStore)
+	logger := logrus.New()
+	service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logger)
+
+	createdAt := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		sagaID       string
+		setupMock    func()
+		expectedCode codes.Code
+		expectedSaga *pb.BookingSaga
+	}{
+		{
+			name:   "Success",
+			sagaID: "saga-1",
+			setupMock: func() {
+				mockStore.On("GetSaga", mock.Anything, "saga-1").Return(&model.BookingSaga{
+					ID:        "saga-1",
+					UserID:    1,
+					Ride:      model.Ride{ID: 101, Source: "Downtown", Destination: "Airport", Distance: 20, Cost: pkr(50000)},
+					Status:    model.SagaCompensating,
+					Step:      model.StepDeleteRide,
+					Error:     "user with id 1 does not exist",
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+				}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedSaga: &pb.BookingSaga{
+				SagaId:    "saga-1",
+				UserId:    1,
+				Ride:      &pb.Ride{RideId: 101, Source: "Downtown", Destination: "Airport", Distance: 20, Cost: pkr(50000).Proto()},
+				Status:    pb.SagaStatus_SAGA_STATUS_COMPENSATING,
+				Step:      pb.SagaStep_SAGA_STEP_DELETE_RIDE,
+				Error:     "user with id 1 does not exist",
+				CreatedAt: "2024-12-01T10:30:00Z",
+				UpdatedAt: "2024-12-01T10:30:00Z",
+			},
+		},
+		{
+			name:         "Missing SagaID",
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:   "Saga Not Found",
+			sagaID: "saga-2",
+			setupMock: func() {
+				mockStore.On("GetSaga", mock.Anything, "saga-2").Return(nil, store.ErrSagaNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			resp, err := service.GetBookingSaga(context.Background(), &pb.GetBookingSagaRequest{SagaId: tt.sagaID})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				require.True(t, proto.Equal(tt.expectedSaga, resp.Saga), "expected %v, got %v", tt.expectedSaga, resp.Saga)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
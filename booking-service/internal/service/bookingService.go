@@ -2,70 +2,188 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
 	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/payments"
+	"github.com/golang_falcon_task/booking-service/internal/pricing"
+	"github.com/golang_falcon_task/booking-service/internal/schedule"
 	"github.com/golang_falcon_task/booking-service/internal/store"
+	"github.com/golang_falcon_task/booking-service/internal/surge"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"time"
 
 	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type BookingStore interface {
-	CreateRide(ctx context.Context, source, destination string, distance, cost int32) (int32, error)
-	CreateBooking(ctx context.Context, userID, rideID int32, bookingTime time.Time) (int32, error)
 	GetBookingDetails(ctx context.Context, bookingID int32) (*model.Booking, *model.User, *model.Ride, error)
+	ListBookings(ctx context.Context, userID int32) ([]model.Booking, error)
+	CreateSaga(ctx context.Context, saga *model.BookingSaga) error
+	GetSaga(ctx context.Context, sagaID string) (*model.BookingSaga, error)
+	UpdateSaga(ctx context.Context, saga *model.BookingSaga) error
+	ListStaleSagas(ctx context.Context, updatedBefore time.Time, limit int) ([]model.BookingSaga, error)
+	CompleteSaga(ctx context.Context, saga *model.BookingSaga, bookingTime time.Time) (int32, error)
+	ListDriverOffers(ctx context.Context, driverID int32, now time.Time) ([]model.Offer, error)
+	RespondToOffer(ctx context.Context, offerID, driverID int32, accept bool, now time.Time) (*model.Offer, *model.Booking, error)
+	CreateQuote(ctx context.Context, quote *model.Quote) error
+	GetQuote(ctx context.Context, quoteID string) (*model.Quote, error)
+	CreatePromo(ctx context.Context, promo *model.Promo) error
+	GetPromo(ctx context.Context, code string) (*model.Promo, error)
+	CompleteBooking(ctx context.Context, bookingID, driverID int32, entry *model.JournalEntry, now time.Time) (*model.Booking, error)
+	CancelBooking(ctx context.Context, c *model.Cancellation, entry *model.JournalEntry, now time.Time) (*model.Booking, string, error)
+	RefundBooking(ctx context.Context, refund *model.Refund, entry *model.JournalEntry, now time.Time) (*model.Booking, error)
+	GetBalance(ctx context.Context, account model.Account) ([]money.Money, error)
+	ListStatement(ctx context.Context, account model.Account, beforeEntryID int64, limit int) ([]model.StatementLine, error)
 }
 
 type BookingService struct {
 	bookingStore BookingStore
+	feed         outbox.Feed
+	users        userpb.UserServiceClient
+	rides        ridepb.RideServiceClient
+	drivers      driverpb.DriverServiceClient
+	tariff       *pricing.Tariff
+	surge        *surge.Tracker
+	payments     payments.Config
+	schedule     schedule.Config
 	log          *logrus.Logger
 	pb.UnimplementedBookingServiceServer
 }
 
-// NewBookingService initializes a new BookingService with a database connection pool.
-func NewBookingService(store BookingStore, logger *logrus.Logger) *BookingService {
-	return &BookingService{bookingStore: store, log: logger}
+// NewBookingService initializes a new BookingService that books rides
+// through the given user and ride service clients, priced with tariff and
+// surged by demand as tracked by surge, and assigns them to the drivers that
+// accept them. Completed rides are charged as configured by payments, or
+// from the rider's wallet in the user service. Bookings for a later pickup
+// must be made within the lead times of schedule. WatchBooking follows feed.
+func NewBookingService(store BookingStore, feed outbox.Feed, users userpb.UserServiceClient, rides ridepb.RideServiceClient,
+	drivers driverpb.DriverServiceClient, tariff *pricing.Tariff, surge *surge.Tracker, payments payments.Config, schedule schedule.Config,
+	logger *logrus.Logger) *BookingService {
+	return &BookingService{bookingStore: store, feed: feed, users: users, rides: rides, drivers: drivers, tariff: tariff, surge: surge,
+		payments: payments, schedule: schedule, log: logger}
 }
 
-// CreateBooking handles creating a new booking and its associated ride.
+// CreateBooking books a ride for a user by running a booking saga. The ride
+// is charged the fare quoted by EstimateFare under the request's quote_id,
+// less the discount of promo_code if one is given; any cost sent by the
+// client is ignored. The promo code is redeemed when the saga starts, and
+// bookings paid by wallet hold the cost in the rider's wallet. A booking with
+// a pickup_time is scheduled for that pickup. The saga keeps running if the
+// caller goes away, and a saga stopped by a transient failure is reported as
+// SAGA_PENDING and finished by RunSagaRecovery.
 func (s *BookingService) CreateBooking(ctx context.Context, req *pb.CreateBookingRequest) (*pb.CreateBookingResponse, error) {
 	// Input validation
 	if req.UserId <= 0 {
 		s.log.Error("Invalid user_id: must be a positive integer", "user_id", req.UserId)
-		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: must be a positive integer")
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("user_id", "must be a positive integer"))
 	}
 	if req.Ride == nil {
 		s.log.Error("Ride details must be provided", "user_id", req.UserId)
-		return nil, status.Errorf(codes.InvalidArgument, "ride details must be provided")
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride", "must be provided"))
+	}
+	if req.Ride.Pickup == nil || req.Ride.Dropoff == nil {
+		s.log.Error("Pickup and dropoff must be provided", "user_id", req.UserId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride.pickup", "must be provided"), grpcerr.FieldViolation("ride.dropoff", "must be provided"))
 	}
 
-	// Create a new ride
-	rideID, err := s.bookingStore.CreateRide(ctx, req.Ride.Source, req.Ride.Destination, req.Ride.Distance, req.Ride.Cost)
+	if _, ok := vehicleClasses[req.VehicleClass]; !ok {
+		s.log.Error("Unknown vehicle class", "user_id", req.UserId, "vehicle_class", req.VehicleClass)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("vehicle_class", "must be a known vehicle class"))
+	}
+	if _, ok := pb.PaymentMethod_name[int32(req.PaymentMethod)]; !ok {
+		s.log.Error("Unknown payment method", "user_id", req.UserId, "payment_method", req.PaymentMethod)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("payment_method", "must be a known payment method"))
+	}
+	if _, err := uuid.Parse(req.QuoteId); err != nil {
+		s.log.Error("Invalid quote_id: must be a UUID returned by EstimateFare", "user_id", req.UserId, "quote_id", req.QuoteId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("quote_id", "must be a UUID returned by EstimateFare"))
+	}
+	var pickupTime time.Time
+	if req.PickupTime != "" {
+		var err error
+		if pickupTime, err = time.Parse(time.RFC3339, req.PickupTime); err != nil {
+			s.log.Error("Invalid pickup_time: must be an RFC 3339 timestamp", "user_id", req.UserId, "pickup_time", req.PickupTime)
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("pickup_time", "must be an RFC 3339 timestamp"))
+		}
+	}
+
+	quote, err := s.bookingStore.GetQuote(ctx, req.QuoteId)
 	if err != nil {
-		s.log.Error("Failed to create ride", "source", req.Ride.Source, "destination", req.Ride.Destination, "error", err.Error())
-		return nil, status.Errorf(codes.Internal, "failed to create ride: %v", err)
+		s.log.Error("Failed to fetch fare quote", "user_id", req.UserId, "quote_id", req.QuoteId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to fetch fare quote %s", req.QuoteId))
+	}
+	now := time.Now()
+	if err := checkQuote(quote, req, now); err != nil {
+		s.log.Error("Fare quote cannot be booked", "user_id", req.UserId, "quote_id", req.QuoteId, "error", err.Error())
+		return nil, err
+	}
+	if !pickupTime.IsZero() {
+		if err := s.schedule.Check(pickupTime, now); err != nil {
+			s.log.Error("Pickup time cannot be scheduled", "user_id", req.UserId, "pickup_time", req.PickupTime, "error", err.Error())
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("pickup_time", err.Error()))
+		}
+	}
+
+	cost, discount := quote.Cost, model.Discount{}
+	if req.PromoCode != "" {
+		if discount, err = s.applyPromo(ctx, req.PromoCode, quote, now); err != nil {
+			s.log.Error("Promo code cannot be applied", "user_id", req.UserId, "promo_code", req.PromoCode, "error", err.Error())
+			return nil, err
+		}
+		cost = discount.Total()
 	}
 
-	// Create a new booking
-	bookingTime := time.Now()
-	bookingId, err := s.bookingStore.CreateBooking(ctx, req.UserId, rideID, bookingTime)
+	saga := &model.BookingSaga{
+		ID:     uuid.NewString(),
+		UserID: req.UserId,
+		Ride: model.Ride{
+			Source:      req.Ride.Source,
+			Destination: req.Ride.Destination,
+			Distance:    req.Ride.Distance,
+			Cost:        cost,
+			Pickup:      store.LatLngFromProto(req.Ride.Pickup),
+			Dropoff:     store.LatLngFromProto(req.Ride.Dropoff),
+		},
+		Status:        model.SagaRunning,
+		Step:          model.StepValidateUser,
+		TariffVersion: quote.TariffVersion,
+		QuoteID:       quote.ID,
+		Discount:      discount,
+		PaymentMethod: store.PaymentMethodFromProto(req.PaymentMethod),
+	}
+	if !pickupTime.IsZero() {
+		saga.PickupTime = pickupTime.UTC()
+	}
+	if err := s.bookingStore.CreateSaga(ctx, saga); err != nil {
+		s.log.Error("Failed to start booking saga", "user_id", req.UserId, "error", err.Error())
+		return nil, storeError(err, "failed to start booking saga")
+	}
+	// A scheduled booking is not demand for drivers now.
+	if pickupTime.IsZero() {
+		s.surge.Record(*saga.Ride.Pickup, now)
+	}
+
+	runCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), SagaTimeout)
+	defer cancel()
+	booking, err := s.runSaga(runCtx, saga)
 	if err != nil {
-		s.log.Error("Failed to create booking", "user_id", req.UserId, "ride_id", rideID, "error", err.Error())
-		return nil, status.Errorf(codes.Internal, "failed to create booking: %v", err)
+		return nil, sagaError(err)
 	}
 
-	s.log.Info("Booking created successfully", "booking_id", bookingId)
+	s.log.Info("Booking created successfully", "booking_id", booking.ID, "saga_id", saga.ID)
 
 	// Return the booking details
 	return &pb.CreateBookingResponse{
-		Booking: &pb.Booking{
-			BookingId: bookingId,
-			UserId:    req.UserId,
-			RideId:    rideID,
-			Time:      bookingTime.Format(time.RFC3339),
-		},
+		Booking: store.BookingToProto(booking),
+		SagaId:  saga.ID,
 	}, nil
 }
 
@@ -74,18 +192,18 @@ func (s *BookingService) GetBooking(ctx context.Context, req *pb.GetBookingReque
 	// Input validation
 	if req.BookingId <= 0 {
 		s.log.Error("Invalid booking_id: must be a positive integer", "booking_id", req.BookingId)
-		return nil, status.Errorf(codes.InvalidArgument, "invalid booking_id: must be a positive integer")
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("booking_id", "must be a positive integer"))
 	}
 
 	// Fetch booking details
 	booking, user, ride, err := s.bookingStore.GetBookingDetails(ctx, req.BookingId)
 	if err != nil {
-		if err == store.ErrBookingNotFound {
+		if errors.Is(err, store.ErrBookingNotFound) {
 			s.log.Error("Booking not found", "booking_id", req.BookingId)
-			return nil, status.Errorf(codes.NotFound, "booking with id %d not found", req.BookingId)
+		} else {
+			s.log.Error("Failed to fetch booking details", "booking_id", req.BookingId, "error", err.Error())
 		}
-		s.log.Error("Failed to fetch booking details", "booking_id", req.BookingId, "error", err.Error())
-		return nil, status.Errorf(codes.Internal, "failed to fetch booking: %v", err)
+		return nil, storeError(err, fmt.Sprintf("failed to fetch booking with id %d", req.BookingId))
 	}
 
 	s.log.Info("Booking details fetched successfully", "booking_id", req.BookingId, "user_id", booking.UserID, "ride_id", booking.RideID)
@@ -96,7 +214,42 @@ func (s *BookingService) GetBooking(ctx context.Context, req *pb.GetBookingReque
 		Source:      ride.Source,
 		Destination: ride.Destination,
 		Distance:    ride.Distance,
-		Cost:        ride.Cost,
+		Cost:        booking.Fare(ride).Proto(),
 		Time:        booking.Timestamp.Format(time.RFC3339),
+		PickupTime:  store.OptionalTimeToProto(booking.PickupTime),
+		DriverId:    booking.DriverID,
+		Status:      store.BookingStatusToProto(booking.Status),
+		Pickup:      store.LatLngToProto(ride.Pickup),
+		Dropoff:     store.LatLngToProto(ride.Dropoff),
+
+		TariffVersion: booking.TariffVersion,
+		Discount:      store.DiscountToProto(booking.Discount),
+		PaymentMethod: store.PaymentMethodToProto(booking.PaymentMethod),
+
+		CancellationReason: store.CancellationReasonToProto(booking.CancellationReason),
+		CancellationFee:    store.OptionalMoneyToProto(booking.CancellationFee),
+		Refunded:           store.OptionalMoneyToProto(booking.Refunded),
+		RefundReason:       store.RefundReasonToProto(booking.RefundReason),
 	}, nil
 }
+
+// ListBookings returns all bookings made by a user, oldest first.
+func (s *BookingService) ListBookings(ctx context.Context, req *pb.ListBookingsRequest) (*pb.ListBookingsResponse, error) {
+	// Input validation
+	if req.UserId <= 0 {
+		s.log.Error("Invalid user_id: must be a positive integer", "user_id", req.UserId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("user_id", "must be a positive integer"))
+	}
+
+	bookings, err := s.bookingStore.ListBookings(ctx, req.UserId)
+	if err != nil {
+		s.log.Error("Failed to list bookings", "user_id", req.UserId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to list bookings for user with id %d", req.UserId))
+	}
+
+	res := &pb.ListBookingsResponse{Bookings: make([]*pb.Booking, 0, len(bookings))}
+	for i := range bookings {
+		res.Bookings = append(res.Bookings, store.BookingToProto(&bookings[i]))
+	}
+	return res, nil
+}
@@ -3,9 +3,14 @@ package service
 import (
 	"context"
 	"errors"
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
 	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/pricing"
+	"github.com/golang_falcon_task/booking-service/internal/schedule"
 	"github.com/golang_falcon_task/booking-service/internal/service/mocks"
 	"github.com/golang_falcon_task/booking-service/internal/store"
+	"github.com/golang_falcon_task/booking-service/internal/surge"
 	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
@@ -16,80 +21,133 @@ import (
 	"time"
 )
 
+// testSchedule schedules bookings within the default lead times.
+var testSchedule = schedule.DefaultConfig
+
 func TestBookingService_CreateBooking(t *testing.T) {
 	logger := logrus.New()
+	req := &pb.CreateBookingRequest{
+		UserId: 1,
+		Ride: &pb.Ride{
+			Source:      "Downtown",
+			Destination: "Airport",
+			Cost:        pkr(50000).Proto(), // Ignored, the quote sets the cost
+			Pickup:      downtown,
+			Dropoff:     airport,
+		},
+		QuoteId: newQuote().ID,
+	}
 
 	tests := []struct {
-		name         string
-		req          *pb.CreateBookingRequest
-		setupMock    func(mockStore *mocks.BookingStore)
-		expectedCode codes.Code
-		expectedResp *pb.CreateBookingResponse
+		name          string
+		users         *fakeUsers
+		rides         *fakeRides
+		setupMock     func(mockStore *mocks.BookingStore)
+		expectedCode  codes.Code
+		expectedSteps []string // Saga status and step after each saved update
+		expectDeleted []int32  // Rides deleted by compensation
+		expectDemand  bool     // Whether the booking counted towards surge, as every saga started for an immediate pickup does
 	}{
 		{
-			name: "Success",
-			req: &pb.CreateBookingRequest{
-				UserId: 1,
-				Ride: &pb.Ride{
-					Source:      "Downtown",
-					Destination: "Airport",
-					Distance:    20,
-					Cost:        500,
-				},
+			name:  "Success",
+			users: &fakeUsers{},
+			rides: &fakeRides{rideID: 101},
+			setupMock: func(mockStore *mocks.BookingStore) {
+				mockStore.On("CreateSaga", mock.Anything, mock.MatchedBy(func(saga *model.BookingSaga) bool {
+					return saga.QuoteID == newQuote().ID
+				})).Return(nil)
+				mockStore.On("CompleteSaga", mock.Anything, mock.MatchedBy(func(saga *model.BookingSaga) bool {
+					return saga.UserID == 1 && saga.Ride.ID == 101 && saga.Ride.Cost == pkr(70000) && saga.TariffVersion == "test"
+				}), mock.Anything).Return(int32(1001), nil).Run(completeSaga)
 			},
+			expectedCode:  codes.OK,
+			expectedSteps: []string{"RUNNING/CREATE_RIDE", "RUNNING/CREATE_BOOKING"},
+			expectDemand:  true,
+		},
+		{
+			name:  "Quote Not Found",
+			users: &fakeUsers{},
+			rides: &fakeRides{},
 			setupMock: func(mockStore *mocks.BookingStore) {
-				mockStore.On("CreateRide", mock.Anything, "Downtown", "Airport", int32(20), int32(500)).
-					Return(int32(101), nil)
-				mockStore.On("CreateBooking", mock.Anything, int32(1), int32(101), mock.Anything).
-					Return(int32(1001), nil)
+				mockStore.On("GetQuote", mock.Anything, mock.Anything).Return(nil, store.ErrQuoteNotFound)
 			},
-			expectedCode: codes.OK,
-			expectedResp: &pb.CreateBookingResponse{
-				Booking: &pb.Booking{
-					BookingId: 1001,
-					UserId:    1,
-					RideId:    101,
-					Time:      time.Now().Format(time.RFC3339), // Mock dynamic time if needed
-				},
-			},
-		},
-		{
-			name: "Ride Creation Failure",
-			req: &pb.CreateBookingRequest{
-				UserId: 1,
-				Ride: &pb.Ride{
-					Source:      "Downtown",
-					Destination: "Airport",
-					Distance:    20,
-					Cost:        500,
-				},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:  "Quote Expired",
+			users: &fakeUsers{},
+			rides: &fakeRides{},
+			setupMock: func(mockStore *mocks.BookingStore) {
+				quote := newQuote()
+				quote.ExpiresAt = time.Now().Add(-time.Second)
+				mockStore.On("GetQuote", mock.Anything, mock.Anything).Return(quote, nil)
 			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "Quote For Another Ride",
+			users: &fakeUsers{},
+			rides: &fakeRides{},
 			setupMock: func(mockStore *mocks.BookingStore) {
-				mockStore.On("CreateRide", mock.Anything, "Downtown", "Airport", int32(20), int32(500)).
-					Return(int32(0), errors.New("ride creation failed"))
+				quote := newQuote()
+				quote.VehicleClass = pricing.ClassXL
+				mockStore.On("GetQuote", mock.Anything, mock.Anything).Return(quote, nil)
 			},
-			expectedCode: codes.Internal,
-			expectedResp: nil,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "Quote Already Used",
+			users: &fakeUsers{},
+			rides: &fakeRides{},
+			setupMock: func(mockStore *mocks.BookingStore) {
+				mockStore.On("CreateSaga", mock.Anything, mock.Anything).Return(store.ErrQuoteUsed)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "Unknown User",
+			users: &fakeUsers{err: status.Error(codes.NotFound, "user not found")},
+			rides: &fakeRides{rideID: 101},
+			setupMock: func(mockStore *mocks.BookingStore) {
+				mockStore.On("CreateSaga", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedCode:  codes.FailedPrecondition,
+			expectedSteps: []string{"FAILED/DONE"},
+			expectDemand:  true,
 		},
 		{
-			name: "Booking Creation Failure",
-			req: &pb.CreateBookingRequest{
-				UserId: 1,
-				Ride: &pb.Ride{
-					Source:      "Downtown",
-					Destination: "Airport",
-					Distance:    20,
-					Cost:        500,
-				},
+			name:  "Ride Service Unavailable",
+			users: &fakeUsers{},
+			rides: &fakeRides{createErr: status.Error(codes.Unavailable, "connection refused")},
+			setupMock: func(mockStore *mocks.BookingStore) {
+				mockStore.On("CreateSaga", mock.Anything, mock.Anything).Return(nil)
 			},
+			expectedCode:  codes.Unavailable,
+			expectedSteps: []string{"RUNNING/CREATE_RIDE", "RUNNING/CREATE_RIDE"},
+			expectDemand:  true,
+		},
+		{
+			name:  "Booking Creation Failure Deletes Ride",
+			users: &fakeUsers{},
+			rides: &fakeRides{rideID: 101},
 			setupMock: func(mockStore *mocks.BookingStore) {
-				mockStore.On("CreateRide", mock.Anything, "Downtown", "Airport", int32(20), int32(500)).
-					Return(int32(101), nil)
-				mockStore.On("CreateBooking", mock.Anything, int32(1), int32(101), mock.Anything).
-					Return(int32(0), errors.New("booking creation failed"))
+				mockStore.On("CreateSaga", mock.Anything, mock.Anything).Return(nil)
+				mockStore.On("CompleteSaga", mock.Anything, mock.Anything, mock.Anything).
+					Return(int32(0), store.ErrForeignKeyViolation)
+			},
+			expectedCode:  codes.FailedPrecondition,
+			expectedSteps: []string{"RUNNING/CREATE_RIDE", "RUNNING/CREATE_BOOKING", "COMPENSATING/DELETE_RIDE", "FAILED/DONE"},
+			expectDeleted: []int32{101},
+			expectDemand:  true,
+		},
+		{
+			name:  "Saga Creation Failure",
+			users: &fakeUsers{},
+			rides: &fakeRides{rideID: 101},
+			setupMock: func(mockStore *mocks.BookingStore) {
+				mockStore.On("CreateSaga", mock.Anything, mock.Anything).Return(errors.New("database error"))
 			},
 			expectedCode: codes.Internal,
-			expectedResp: nil,
 		},
 	}
 
@@ -98,12 +156,19 @@ func TestBookingService_CreateBooking(t *testing.T) {
 			// Create a new mockStore for each test case
 			mockStore := new(mocks.BookingStore)
 			tt.setupMock(mockStore)
+			mockStore.On("GetQuote", mock.Anything, newQuote().ID).Return(newQuote(), nil).Maybe()
+			var steps []string
+			mockStore.On("UpdateSaga", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				saga := args.Get(1).(*model.BookingSaga)
+				steps = append(steps, saga.Status+"/"+saga.Step)
+			}).Maybe()
 
 			// Create a new service for each test case
-			service := NewBookingService(mockStore, logger)
+			tracker := surge.New(testZones, surge.Config{MaxMultiplier: 3})
+			service := NewBookingService(mockStore, outbox.NewMemStore(), tt.users, tt.rides, &fakeDrivers{}, testTariff, tracker, testPayments, testSchedule, logger)
 
 			// Call the method
-			resp, err := service.CreateBooking(context.Background(), tt.req)
+			resp, err := service.CreateBooking(context.Background(), req)
 
 			// Validate the response
 			if tt.expectedCode != codes.OK {
@@ -113,10 +178,157 @@ func TestBookingService_CreateBooking(t *testing.T) {
 				require.Equal(t, tt.expectedCode, grpcErr.Code(), "Expected gRPC code mismatch")
 			} else {
 				require.NoError(t, err, "Expected no error but got one")
-				require.NotNil(t, resp, "Expected a valid response but got nil")
+				require.Equal(t, int32(1001), resp.Booking.BookingId)
+				require.Equal(t, int32(101), resp.Booking.RideId)
+				require.Equal(t, "test", resp.Booking.TariffVersion)
+				require.NotEmpty(t, resp.SagaId)
+				// The saga ID makes ride creation idempotent.
+				require.Equal(t, resp.SagaId, tt.rides.created[0].RequestId)
+			}
+			require.Equal(t, tt.expectedSteps, steps)
+			require.Equal(t, tt.expectDeleted, tt.rides.deleted)
+			multiplier, _ := tracker.Multiplier(model.LatLng{Lat: downtown.Latitude, Lng: downtown.Longitude}, time.Now())
+			require.Equal(t, tt.expectDemand, multiplier > 1)
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBookingService_CreateBooking_Wallet(t *testing.T) {
+	req := &pb.CreateBookingRequest{
+		UserId:        1,
+		Ride:          &pb.Ride{Source: "Downtown", Destination: "Airport", Pickup: downtown, Dropoff: airport},
+		QuoteId:       newQuote().ID,
+		PaymentMethod: pb.PaymentMethod_PAYMENT_METHOD_WALLET,
+	}
+
+	tests := []struct {
+		name           string
+		users          *fakeUsers
+		completeErr    error
+		expectedCode   codes.Code
+		expectedReason string
+		expectedSteps  []string
+		expectReleased []int32
+	}{
+		{
+			name:          "Success",
+			users:         &fakeUsers{holdID: 7},
+			expectedCode:  codes.OK,
+			expectedSteps: []string{"RUNNING/HOLD_FUNDS", "RUNNING/CREATE_RIDE", "RUNNING/CREATE_BOOKING"},
+		},
+		{
+			name:           "Insufficient Funds",
+			users:          &fakeUsers{holdErr: grpcerr.New(codes.FailedPrecondition, "INSUFFICIENT_FUNDS", "wallet has PKR 100.00 available")},
+			expectedCode:   codes.FailedPrecondition,
+			expectedReason: "INSUFFICIENT_FUNDS",
+			expectedSteps:  []string{"RUNNING/HOLD_FUNDS", "FAILED/DONE"},
+		},
+		{
+			name:           "Booking Creation Failure Releases Hold",
+			users:          &fakeUsers{holdID: 7},
+			completeErr:    store.ErrForeignKeyViolation,
+			expectedCode:   codes.FailedPrecondition,
+			expectedSteps:  []string{"RUNNING/HOLD_FUNDS", "RUNNING/CREATE_RIDE", "RUNNING/CREATE_BOOKING", "COMPENSATING/DELETE_RIDE", "COMPENSATING/RELEASE_HOLD", "FAILED/DONE"},
+			expectReleased: []int32{7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			mockStore.On("GetQuote", mock.Anything, newQuote().ID).Return(newQuote(), nil)
+			mockStore.On("CreateSaga", mock.Anything, mock.MatchedBy(func(saga *model.BookingSaga) bool {
+				return saga.PaymentMethod == model.PaymentWallet
+			})).Return(nil)
+			var steps []string
+			mockStore.On("UpdateSaga", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				saga := args.Get(1).(*model.BookingSaga)
+				steps = append(steps, saga.Status+"/"+saga.Step)
+			})
+			mockStore.On("CompleteSaga", mock.Anything, mock.MatchedBy(func(saga *model.BookingSaga) bool {
+				return saga.HoldID == 7
+			}), mock.Anything).Return(func(ctx context.Context, saga *model.BookingSaga, bookingTime time.Time) (int32, error) {
+				if tt.completeErr != nil {
+					return 0, tt.completeErr
+				}
+				saga.Status, saga.Step, saga.BookingID = model.SagaCompleted, model.StepDone, 1001
+				return 1001, nil
+			}).Maybe()
+			rides := &fakeRides{rideID: 101}
+			service := NewBookingService(mockStore, outbox.NewMemStore(), tt.users, rides, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logrus.New())
+
+			resp, err := service.CreateBooking(context.Background(), req)
+			require.Equal(t, tt.expectedSteps, steps)
+			require.Equal(t, tt.expectReleased, tt.users.released)
+
+			// The quoted fare is held under a reference unique to the saga.
+			require.Len(t, tt.users.holds, 1)
+			require.Equal(t, int32(1), tt.users.holds[0].UserId)
+			require.Equal(t, pkr(70000).Proto(), tt.users.holds[0].Amount)
+			require.Regexp(t, "^booking-saga-[0-9a-f-]{36}$", tt.users.holds[0].Reference)
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				if tt.expectedReason != "" {
+					require.Equal(t, tt.expectedReason, errorReason(t, err))
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, pb.PaymentMethod_PAYMENT_METHOD_WALLET, resp.Booking.PaymentMethod)
+			require.Equal(t, int32(7), resp.Booking.HoldId)
+		})
+	}
+}
+
+func TestBookingService_CreateBooking_Scheduled(t *testing.T) {
+	tests := []struct {
+		name         string
+		pickupTime   func(now time.Time) string
+		expectedCode codes.Code
+	}{
+		{name: "Scheduled", pickupTime: func(now time.Time) string { return now.Add(2 * time.Hour).Format(time.RFC3339) }, expectedCode: codes.OK},
+		{name: "Not A Timestamp", pickupTime: func(now time.Time) string { return "tomorrow at noon" }, expectedCode: codes.InvalidArgument},
+		{name: "Too Soon", pickupTime: func(now time.Time) string { return now.Add(10 * time.Minute).Format(time.RFC3339) }, expectedCode: codes.InvalidArgument},
+		{name: "Too Far Ahead", pickupTime: func(now time.Time) string { return now.Add(30 * 24 * time.Hour).Format(time.RFC3339) }, expectedCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.CreateBookingRequest{
+				UserId:     1,
+				Ride:       &pb.Ride{Source: "Downtown", Destination: "Airport", Pickup: downtown, Dropoff: airport},
+				QuoteId:    newQuote().ID,
+				PickupTime: tt.pickupTime(time.Now()),
 			}
+			pickupTime, _ := time.Parse(time.RFC3339, req.PickupTime)
+
+			mockStore := new(mocks.BookingStore)
+			mockStore.On("GetQuote", mock.Anything, newQuote().ID).Return(newQuote(), nil).Maybe()
+			mockStore.On("CreateSaga", mock.Anything, mock.MatchedBy(func(saga *model.BookingSaga) bool {
+				return saga.PickupTime.Equal(pickupTime)
+			})).Return(nil).Maybe()
+			mockStore.On("UpdateSaga", mock.Anything, mock.Anything).Return(nil).Maybe()
+			mockStore.On("CompleteSaga", mock.Anything, mock.Anything, mock.Anything).Return(int32(1001), nil).Run(completeSaga).Maybe()
+			tracker := surge.New(testZones, surge.Config{MaxMultiplier: 3})
+			service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{rideID: 101}, &fakeDrivers{}, testTariff, tracker,
+				testPayments, testSchedule, logrus.New())
 
+			resp, err := service.CreateBooking(context.Background(), req)
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				mockStore.AssertNotCalled(t, "CreateSaga", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
 			mockStore.AssertExpectations(t)
+			require.Equal(t, pb.BookingStatus_BOOKING_STATUS_SCHEDULED, resp.Booking.Status)
+			require.Equal(t, req.PickupTime, resp.Booking.PickupTime)
+
+			// A booking for later is not demand for drivers now.
+			multiplier, _ := tracker.Multiplier(model.LatLng{Lat: downtown.Latitude, Lng: downtown.Longitude}, time.Now())
+			require.Equal(t, 1.0, multiplier)
 		})
 	}
 }
@@ -124,7 +336,7 @@ func TestBookingService_CreateBooking(t *testing.T) {
 func TestBookingService_GetBooking(t *testing.T) {
 	mockStore := new(mocks.BookingStore)
 	logger := logrus.New()
-	service := NewBookingService(mockStore, logger)
+	service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logger)
 
 	tests := []struct {
 		name         string
@@ -140,7 +352,7 @@ func TestBookingService_GetBooking(t *testing.T) {
 				mockStore.On("GetBookingDetails", mock.Anything, int32(1)).Return(
 					&model.Booking{ID: 1, UserID: 10, RideID: 20, Timestamp: time.Now()},
 					&model.User{Name: "John Doe"},
-					&model.Ride{Source: "Downtown", Destination: "Airport", Distance: 20, Cost: 500},
+					&model.Ride{Source: "Downtown", Destination: "Airport", Distance: 20, Cost: pkr(50000)},
 					nil,
 				)
 			},
@@ -150,7 +362,7 @@ func TestBookingService_GetBooking(t *testing.T) {
 				Source:      "Downtown",
 				Destination: "Airport",
 				Distance:    20,
-				Cost:        500,
+				Cost:        pkr(50000).Proto(),
 				Time:        time.Now().Format(time.RFC3339), // This would vary
 			},
 		},
@@ -201,3 +413,79 @@ func TestBookingService_GetBooking(t *testing.T) {
 		})
 	}
 }
+
+func TestBookingService_ListBookings(t *testing.T) {
+	mockStore := new(mocks.BookingStore)
+	logger := logrus.New()
+	service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logger)
+
+	bookingTime := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		userID       int32
+		setupMock    func()
+		expectedCode codes.Code
+		expectedIDs  []int32
+	}{
+		{
+			name:   "Success",
+			userID: 1,
+			setupMock: func() {
+				mockStore.On("ListBookings", mock.Anything, int32(1)).Return([]model.Booking{
+					{ID: 1, UserID: 1, RideID: 10, Timestamp: bookingTime},
+					{ID: 4, UserID: 1, RideID: 40, Timestamp: bookingTime},
+				}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedIDs:  []int32{1, 4},
+		},
+		{
+			name:   "No Bookings",
+			userID: 2,
+			setupMock: func() {
+				mockStore.On("ListBookings", mock.Anything, int32(2)).Return([]model.Booking{}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Invalid User ID",
+			userID:       0,
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:   "Internal Error",
+			userID: 3,
+			setupMock: func() {
+				mockStore.On("ListBookings", mock.Anything, int32(3)).Return(nil, errors.New("database error"))
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			resp, err := service.ListBookings(context.Background(), &pb.ListBookingsRequest{UserId: tt.userID})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				var ids []int32
+				for _, b := range resp.Bookings {
+					ids = append(ids, b.BookingId)
+					require.Equal(t, bookingTime.Format(time.RFC3339), b.Time)
+				}
+				require.Equal(t, tt.expectedIDs, ids)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/ledger"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CancelBooking cancels a pending or confirmed booking for its rider and
+// charges the fee the cancellation policy sets, if any.
+func (s *BookingService) CancelBooking(ctx context.Context, req *pb.CancelBookingRequest) (*pb.CancelBookingResponse, error) {
+	// Input validation
+	if req.BookingId <= 0 {
+		s.log.Error("Invalid booking_id: must be a positive integer", "booking_id", req.BookingId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("booking_id", "must be a positive integer"))
+	}
+	if req.UserId <= 0 {
+		s.log.Error("Invalid user_id: must be a positive integer", "user_id", req.UserId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("user_id", "must be a positive integer"))
+	}
+
+	booking, entry, err := s.cancel(ctx, &model.Cancellation{BookingID: req.BookingId, Reason: model.CancelledByRider, UserID: req.UserId})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CancelBookingResponse{Booking: store.BookingToProto(booking), Entry: journalEntryToProto(entry)}, nil
+}
+
+// ReportNoShow cancels a confirmed booking for its driver, whose rider did
+// not show up, and charges the rider the cancellation policy's no-show fee.
+func (s *BookingService) ReportNoShow(ctx context.Context, req *pb.ReportNoShowRequest) (*pb.ReportNoShowResponse, error) {
+	// Input validation
+	if req.BookingId <= 0 {
+		s.log.Error("Invalid booking_id: must be a positive integer", "booking_id", req.BookingId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("booking_id", "must be a positive integer"))
+	}
+	if req.DriverId <= 0 {
+		s.log.Error("Invalid driver_id: must be a positive integer", "driver_id", req.DriverId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver_id", "must be a positive integer"))
+	}
+
+	booking, entry, err := s.cancel(ctx, &model.Cancellation{BookingID: req.BookingId, Reason: model.CancelledNoShow, DriverID: req.DriverId})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReportNoShowResponse{Booking: store.BookingToProto(booking), Entry: journalEntryToProto(entry)}, nil
+}
+
+// cancel charges the rider the fee for cancelling a booking as c asks, then
+// cancels it and posts the fee's journal entry, atomically. A fee is
+// captured from the booking's wallet hold, releasing the rest; without a
+// fee the hold is released. Cancelling a cancelled booking settles it
+// again, so a retry after a failure neither charges twice nor leaves money
+// held. The driver the booking was confirmed with is marked available
+// again, best effort. The entry is nil unless a fee was posted.
+func (s *BookingService) cancel(ctx context.Context, c *model.Cancellation) (*model.Booking, *model.JournalEntry, error) {
+	booking, _, ride, err := s.bookingStore.GetBookingDetails(ctx, c.BookingID)
+	if err != nil {
+		s.log.Error("Failed to fetch booking", "booking_id", c.BookingID, "error", err.Error())
+		return nil, nil, storeError(err, fmt.Sprintf("failed to fetch booking with id %d", c.BookingID))
+	}
+	// Checked before charging; the store checks again when cancelling.
+	if !c.Permits(booking) {
+		s.log.Error("Booking cannot be cancelled", "booking_id", booking.ID, "reason", c.Reason, "user_id", c.UserID, "driver_id", c.DriverID, "status", booking.Status)
+		return nil, nil, storeError(store.ErrBookingNotCancellable, fmt.Sprintf("failed to cancel booking with id %d", booking.ID))
+	}
+
+	fee := booking.CancellationFee
+	if booking.Status != model.BookingCancelled {
+		if fee, err = s.payments.Cancellation.Fee(booking, booking.Fare(ride), c.Reason, time.Now()); err != nil {
+			s.log.Error("Failed to compute cancellation fee", "booking_id", booking.ID, "error", err.Error())
+			return nil, nil, grpcerr.New(codes.Internal, grpcerr.ReasonLedgerError, fmt.Sprintf("failed to compute cancellation fee for booking %d: %v", booking.ID, err))
+		}
+	}
+	c.Fee = fee
+	if c.ChargeID, err = s.charge(ctx, booking, fee, fmt.Sprintf("booking-%d-cancellation", booking.ID),
+		fmt.Sprintf("Cancellation fee for booking %d", booking.ID)); err != nil {
+		s.log.Error("Failed to charge cancellation fee", "booking_id", booking.ID, "user_id", booking.UserID, "fee", fee.String(), "error", err.Error())
+		return nil, nil, err
+	}
+	var entry *model.JournalEntry
+	if !fee.IsZero() {
+		if entry, err = ledger.CancellationFee(booking, fee, s.payments.CommissionRate, c.ChargeID); err != nil {
+			s.log.Error("Failed to build journal entry", "booking_id", booking.ID, "error", err.Error())
+			return nil, nil, grpcerr.New(codes.Internal, grpcerr.ReasonLedgerError, fmt.Sprintf("failed to record cancellation fee for booking %d: %v", booking.ID, err))
+		}
+	}
+
+	cancelled, previous, err := s.bookingStore.CancelBooking(ctx, c, entry, time.Now())
+	if err != nil {
+		s.log.Error("Failed to cancel booking", "booking_id", c.BookingID, "reason", c.Reason, "error", err.Error())
+		return nil, nil, storeError(err, fmt.Sprintf("failed to cancel booking with id %d", c.BookingID))
+	}
+	if previous == model.BookingCancelled {
+		// Nothing new was posted.
+		entry = nil
+	}
+
+	if cancelled.HoldID != 0 && cancelled.CancellationFee.IsZero() {
+		_, err := s.users.ReleaseHold(ctx, &userpb.ReleaseHoldRequest{HoldId: cancelled.HoldID})
+		if err != nil && status.Code(err) != codes.NotFound {
+			s.log.Error("Failed to release wallet hold", "booking_id", cancelled.ID, "hold_id", cancelled.HoldID, "error", err.Error())
+			return nil, nil, err
+		}
+	}
+
+	s.log.Info("Booking cancelled", "booking_id", cancelled.ID, "user_id", cancelled.UserID, "reason", cancelled.CancellationReason,
+		"previous_status", previous, "fee", cancelled.CancellationFee.String())
+	if previous == model.BookingConfirmed {
+		if _, err := s.drivers.UpdateDriverStatus(ctx, &driverpb.UpdateDriverStatusRequest{
+			DriverId: cancelled.DriverID,
+			Status:   driverpb.DriverStatus_DRIVER_STATUS_AVAILABLE,
+		}); err != nil {
+			s.log.Error("Failed to mark driver available", "booking_id", cancelled.ID, "driver_id", cancelled.DriverID, "error", err.Error())
+		}
+	}
+	return cancelled, entry, nil
+}
+
+// journalEntryToProto converts a journal entry to its API representation,
+// nil if there is none.
+func journalEntryToProto(entry *model.JournalEntry) *pb.JournalEntry {
+	if entry == nil {
+		return nil
+	}
+	return store.JournalEntryToProto(entry)
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/cancellation"
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/ledger"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/payments"
+	"github.com/golang_falcon_task/booking-service/internal/service/mocks"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testPolicy lets riders cancel for free for two minutes, then charges PKR
+// 100 once a driver is assigned and PKR 200 for not showing up.
+var testPolicy = cancellation.Policy{FreeWindow: 2 * time.Minute, DriverAssignedFee: 100, NoShowFee: 200}
+
+// cancelStore returns a BookingStore mock holding booking, or failing to
+// fetch it with getErr, that cancels it as asked or fails with cancelErr.
+func cancelStore(booking *model.Booking, getErr, cancelErr error) *mocks.BookingStore {
+	mockStore := new(mocks.BookingStore)
+	ride := &model.Ride{ID: 101, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(62500)}
+	if getErr != nil {
+		mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(nil, nil, nil, getErr)
+		return mockStore
+	}
+	mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(booking, &model.User{ID: booking.UserID}, ride, nil)
+	mockStore.On("CancelBooking", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, c *model.Cancellation, entry *model.JournalEntry, now time.Time) (*model.Booking, string, error) {
+			if cancelErr != nil {
+				return nil, "", cancelErr
+			}
+			cancelled := *booking
+			if booking.Status != model.BookingCancelled {
+				cancelled.Status, cancelled.CancellationReason = model.BookingCancelled, c.Reason
+				cancelled.CancellationFee, cancelled.ChargeID = c.Fee, c.ChargeID
+				if entry != nil {
+					entry.ID, entry.CreatedAt = 1, now
+				}
+			}
+			return &cancelled, booking.Status, nil
+		}).Maybe()
+	return mockStore
+}
+
+func TestBookingService_CancelBooking(t *testing.T) {
+	booking := func(status string, driverID, holdID int32, age time.Duration) *model.Booking {
+		b := &model.Booking{ID: 1001, UserID: 1, RideID: 101, DriverID: driverID, Status: status, Timestamp: time.Now().Add(-age)}
+		if holdID != 0 {
+			b.PaymentMethod, b.HoldID = model.PaymentWallet, holdID
+		}
+		return b
+	}
+	cancelled := booking(model.BookingCancelled, 7, 9, time.Minute)
+	cancelled.CancellationReason = model.CancelledByRider
+	feeCancelled := booking(model.BookingCancelled, 7, 0, 5*time.Minute)
+	feeCancelled.CancellationReason, feeCancelled.CancellationFee, feeCancelled.ChargeID = model.CancelledByRider, pkr(10000), "fake_ch_1"
+	// Scheduled a day ahead and accepted by a driver 15 minutes before a
+	// pickup that was a minute or five ago.
+	scheduled := func(sincePickup time.Duration) *model.Booking {
+		b := booking(model.BookingConfirmed, 7, 9, 24*time.Hour)
+		b.PickupTime = time.Now().Add(-sincePickup)
+		b.AssignedAt = b.PickupTime.Add(-15 * time.Minute)
+		return b
+	}
+
+	tests := []struct {
+		name             string
+		userID           int32
+		booking          *model.Booking
+		getErr           error
+		cancelErr        error
+		releaseErr       error
+		declined         bool
+		expectedFee      int64
+		expectedCode     codes.Code
+		expectedReason   string
+		expectReleased   []int32
+		expectCaptured   []*userpb.CaptureHoldRequest
+		expectCharged    bool
+		expectEntry      bool
+		expectDriverFree bool
+	}{
+		{name: "Pending", userID: 1, booking: booking(model.BookingPending, 0, 9, 5*time.Minute), expectedCode: codes.OK,
+			expectReleased: []int32{9}},
+		{name: "Confirmed Within Free Window", userID: 1, booking: booking(model.BookingConfirmed, 7, 9, time.Minute), expectedCode: codes.OK,
+			expectReleased: []int32{9}, expectDriverFree: true},
+		{name: "Confirmed After Free Window Charges Card", userID: 1, booking: booking(model.BookingConfirmed, 7, 0, 5*time.Minute),
+			expectedCode: codes.OK, expectedFee: 10000, expectCharged: true, expectEntry: true, expectDriverFree: true},
+		{name: "Confirmed After Free Window Captures Hold", userID: 1, booking: booking(model.BookingConfirmed, 7, 9, 5*time.Minute),
+			expectedCode: codes.OK, expectedFee: 10000, expectEntry: true, expectDriverFree: true,
+			expectCaptured: []*userpb.CaptureHoldRequest{{HoldId: 9, Amount: pkr(10000).Proto()}}},
+		{name: "Scheduled Within Free Window", userID: 1, booking: scheduled(time.Minute), expectedCode: codes.OK,
+			expectReleased: []int32{9}, expectDriverFree: true},
+		{name: "Scheduled After Free Window", userID: 1, booking: scheduled(5 * time.Minute),
+			expectedCode: codes.OK, expectedFee: 10000, expectEntry: true, expectDriverFree: true,
+			expectCaptured: []*userpb.CaptureHoldRequest{{HoldId: 9, Amount: pkr(10000).Proto()}}},
+		{name: "Paid By Card", userID: 1, booking: booking(model.BookingPending, 0, 0, 5*time.Minute), expectedCode: codes.OK},
+		{name: "Already Cancelled Releases Again", userID: 1, booking: cancelled,
+			expectedCode: codes.OK, expectReleased: []int32{9}},
+		{name: "Already Cancelled Charges Fee Again", userID: 1, booking: feeCancelled, expectedCode: codes.OK, expectedFee: 10000,
+			expectCharged: true},
+		{name: "Release Failed", userID: 1, booking: booking(model.BookingPending, 0, 9, time.Minute),
+			releaseErr: status.Error(codes.Unavailable, "connection refused"), expectedCode: codes.Unavailable},
+		{name: "Fee Declined", userID: 1, booking: booking(model.BookingConfirmed, 7, 0, 5*time.Minute), declined: true,
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonPaymentDeclined},
+		{name: "Invalid User ID", userID: 0, expectedCode: codes.InvalidArgument},
+		{name: "Another Rider", userID: 2, booking: booking(model.BookingPending, 0, 9, time.Minute), expectedCode: codes.FailedPrecondition,
+			expectedReason: grpcerr.ReasonBookingNotCancellable},
+		{name: "Completed", userID: 1, booking: booking(model.BookingCompleted, 7, 0, time.Minute), expectedCode: codes.FailedPrecondition,
+			expectedReason: grpcerr.ReasonBookingNotCancellable},
+		{name: "Completed Concurrently", userID: 1, booking: booking(model.BookingPending, 0, 0, time.Minute),
+			cancelErr: store.ErrBookingNotCancellable, expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonBookingNotCancellable},
+		{name: "Booking Not Found", userID: 1, getErr: store.ErrBookingNotFound, expectedCode: codes.NotFound,
+			expectedReason: grpcerr.ReasonBookingNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			if tt.booking != nil || tt.getErr != nil {
+				mockStore = cancelStore(tt.booking, tt.getErr, tt.cancelErr)
+			}
+			provider := payments.NewFakeProvider()
+			if tt.declined {
+				provider.Decline(1)
+			}
+			users := &fakeUsers{releaseErr: tt.releaseErr}
+			drivers := &fakeDrivers{}
+			service := NewBookingService(mockStore, outbox.NewMemStore(), users, &fakeRides{}, drivers, testTariff, noSurge,
+				payments.Config{Provider: provider, CommissionRate: 0.2, Cancellation: testPolicy}, testSchedule, logrus.New())
+
+			resp, err := service.CancelBooking(context.Background(), &pb.CancelBookingRequest{BookingId: 1001, UserId: tt.userID})
+			require.Equal(t, tt.expectReleased, users.released)
+			require.Equal(t, tt.expectCaptured, users.captured)
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				if tt.expectedReason != "" {
+					require.Equal(t, tt.expectedReason, errorReason(t, err))
+				}
+				require.Empty(t, drivers.statuses)
+				if tt.cancelErr == nil && tt.releaseErr == nil {
+					mockStore.AssertNotCalled(t, "CancelBooking", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, pb.BookingStatus_BOOKING_STATUS_CANCELLED, resp.Booking.Status)
+			require.Equal(t, pb.CancellationReason_CANCELLATION_REASON_RIDER, resp.Booking.CancellationReason)
+
+			if tt.expectedFee == 0 {
+				require.Nil(t, resp.Booking.CancellationFee)
+			} else {
+				require.Equal(t, pkr(tt.expectedFee).Proto(), resp.Booking.CancellationFee)
+			}
+			charges := provider.Charges()
+			if tt.expectCharged {
+				require.Len(t, charges, 1)
+				require.Equal(t, pkr(tt.expectedFee), charges[0].Amount)
+			} else {
+				require.Empty(t, charges)
+			}
+			if tt.expectEntry {
+				require.Equal(t, pb.JournalEntryKind_JOURNAL_ENTRY_KIND_CANCELLATION_FEE, resp.Entry.Kind)
+				entry := mockStore.Calls[1].Arguments.Get(2).(*model.JournalEntry)
+				require.Equal(t, []model.Posting{
+					{Account: ledger.Rider(1), Amount: pkr(-tt.expectedFee)},
+					{Account: ledger.Driver(7), Amount: pkr(tt.expectedFee * 8 / 10)},
+					{Account: ledger.Platform, Amount: pkr(tt.expectedFee * 2 / 10)},
+				}, entry.Postings)
+			} else {
+				require.Nil(t, resp.Entry)
+			}
+			if tt.expectDriverFree {
+				require.Equal(t, []*driverpb.UpdateDriverStatusRequest{{DriverId: 7, Status: driverpb.DriverStatus_DRIVER_STATUS_AVAILABLE}}, drivers.statuses)
+			} else {
+				require.Empty(t, drivers.statuses)
+			}
+		})
+	}
+}
+
+func TestBookingService_ReportNoShow(t *testing.T) {
+	confirmed := func(status string, driverID int32) *model.Booking {
+		return &model.Booking{ID: 1001, UserID: 1, RideID: 101, DriverID: driverID, Status: status, Timestamp: time.Now()}
+	}
+
+	tests := []struct {
+		name           string
+		driverID       int32
+		booking        *model.Booking
+		expectedCode   codes.Code
+		expectedReason string
+	}{
+		{name: "Success", driverID: 7, booking: confirmed(model.BookingConfirmed, 7), expectedCode: codes.OK},
+		{name: "Invalid Driver ID", driverID: 0, expectedCode: codes.InvalidArgument},
+		{name: "Another Driver", driverID: 8, booking: confirmed(model.BookingConfirmed, 7), expectedCode: codes.FailedPrecondition,
+			expectedReason: grpcerr.ReasonBookingNotCancellable},
+		{name: "Pending", driverID: 7, booking: confirmed(model.BookingPending, 0), expectedCode: codes.FailedPrecondition,
+			expectedReason: grpcerr.ReasonBookingNotCancellable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			if tt.booking != nil {
+				mockStore = cancelStore(tt.booking, nil, nil)
+			}
+			provider := payments.NewFakeProvider()
+			drivers := &fakeDrivers{}
+			service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, drivers, testTariff, noSurge,
+				payments.Config{Provider: provider, CommissionRate: 0.2, Cancellation: testPolicy}, testSchedule, logrus.New())
+
+			resp, err := service.ReportNoShow(context.Background(), &pb.ReportNoShowRequest{BookingId: 1001, DriverId: tt.driverID})
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				if tt.expectedReason != "" {
+					require.Equal(t, tt.expectedReason, errorReason(t, err))
+				}
+				require.Empty(t, provider.Charges())
+				require.Empty(t, drivers.statuses)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, pb.BookingStatus_BOOKING_STATUS_CANCELLED, resp.Booking.Status)
+			require.Equal(t, pb.CancellationReason_CANCELLATION_REASON_NO_SHOW, resp.Booking.CancellationReason)
+			require.Equal(t, pkr(20000).Proto(), resp.Booking.CancellationFee)
+			require.Equal(t, pb.JournalEntryKind_JOURNAL_ENTRY_KIND_CANCELLATION_FEE, resp.Entry.Kind)
+
+			charges := provider.Charges()
+			require.Len(t, charges, 1)
+			require.Equal(t, pkr(20000), charges[0].Amount)
+			require.Equal(t, charges[0].ID, resp.Entry.Reference)
+			require.Equal(t, []*driverpb.UpdateDriverStatusRequest{{DriverId: 7, Status: driverpb.DriverStatus_DRIVER_STATUS_AVAILABLE}}, drivers.statuses)
+		})
+	}
+}
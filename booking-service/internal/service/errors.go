@@ -0,0 +1,61 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// storeErrorMapping describes how a typed store error surfaces over gRPC.
+type storeErrorMapping struct {
+	target    error
+	code      codes.Code
+	reason    string
+	retryable bool
+}
+
+var storeErrorMappings = []storeErrorMapping{
+	{store.ErrBookingNotFound, codes.NotFound, grpcerr.ReasonBookingNotFound, false},
+	{store.ErrSagaNotFound, codes.NotFound, grpcerr.ReasonSagaNotFound, false},
+	{store.ErrSagaConflict, codes.Aborted, grpcerr.ReasonSagaConflict, true},
+	{store.ErrBookingNotPending, codes.FailedPrecondition, grpcerr.ReasonBookingNotPending, false},
+	{store.ErrOfferNotFound, codes.NotFound, grpcerr.ReasonOfferNotFound, false},
+	{store.ErrOfferClosed, codes.FailedPrecondition, grpcerr.ReasonOfferClosed, false},
+	{store.ErrQuoteNotFound, codes.NotFound, grpcerr.ReasonQuoteNotFound, false},
+	{store.ErrQuoteUsed, codes.FailedPrecondition, grpcerr.ReasonQuoteUsed, false},
+	{store.ErrPromoNotFound, codes.NotFound, grpcerr.ReasonPromoNotFound, false},
+	{store.ErrPromoExhausted, codes.FailedPrecondition, grpcerr.ReasonPromoExhausted, false},
+	{store.ErrPromoLimitReached, codes.FailedPrecondition, grpcerr.ReasonPromoLimitReached, false},
+	{store.ErrBookingNotConfirmed, codes.FailedPrecondition, grpcerr.ReasonBookingNotConfirmed, false},
+	{store.ErrBookingNotCancellable, codes.FailedPrecondition, grpcerr.ReasonBookingNotCancellable, false},
+	{store.ErrBookingNotRefundable, codes.FailedPrecondition, grpcerr.ReasonBookingNotRefundable, false},
+	{store.ErrRefundConflict, codes.Aborted, grpcerr.ReasonRefundConflict, true},
+	{store.ErrAlreadyExists, codes.AlreadyExists, grpcerr.ReasonAlreadyExists, false},
+	{store.ErrForeignKeyViolation, codes.FailedPrecondition, grpcerr.ReasonForeignKeyViolation, false},
+	{store.ErrSerializationFailure, codes.Aborted, grpcerr.ReasonSerializationFailure, true},
+	{store.ErrTimeout, codes.DeadlineExceeded, grpcerr.ReasonDatabaseTimeout, true},
+	{store.ErrCanceled, codes.Canceled, grpcerr.ReasonCanceled, false},
+}
+
+// storeError converts an error returned by the BookingStore into a gRPC status
+// error carrying an ErrorInfo detail, plus RetryInfo when the failure is transient.
+// msg describes the failed operation and prefixes the status message.
+func storeError(err error, msg string) error {
+	code, reason, retryable := codes.Internal, grpcerr.ReasonDatabaseError, false
+	for _, m := range storeErrorMappings {
+		if errors.Is(err, m.target) {
+			code, reason, retryable = m.code, m.reason, m.retryable
+			break
+		}
+	}
+
+	var details []protoadapt.MessageV1
+	if retryable {
+		details = append(details, grpcerr.Retry(grpcerr.DefaultRetryDelay))
+	}
+	return grpcerr.New(code, reason, fmt.Sprintf("%s: %v", msg, err), details...)
+}
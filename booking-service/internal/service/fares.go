@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/geo"
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/pricing"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/grpc/codes"
+)
+
+// QuoteTTL is how long a fare quote can be booked for. It bounds how long a
+// rider can hold on to a fare after surge has moved on.
+const QuoteTTL = 2 * time.Minute
+
+// vehicleClasses maps API vehicle classes to the tariff's. Rides booked
+// without one go in economy.
+var vehicleClasses = map[pb.VehicleClass]string{
+	pb.VehicleClass_VEHICLE_CLASS_UNSPECIFIED: pricing.ClassEconomy,
+	pb.VehicleClass_VEHICLE_CLASS_ECONOMY:     pricing.ClassEconomy,
+	pb.VehicleClass_VEHICLE_CLASS_COMFORT:     pricing.ClassComfort,
+	pb.VehicleClass_VEHICLE_CLASS_XL:          pricing.ClassXL,
+}
+
+// EstimateFare quotes the fare of a ride under the current tariff and surge.
+// The quote is saved, and CreateBooking charges it if the ride is booked
+// with its quote_id within QuoteTTL.
+func (s *BookingService) EstimateFare(ctx context.Context, req *pb.EstimateFareRequest) (*pb.EstimateFareResponse, error) {
+	// Input validation
+	if req.Pickup == nil || req.Dropoff == nil {
+		s.log.Error("Pickup and dropoff must be provided")
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("pickup", "must be provided"), grpcerr.FieldViolation("dropoff", "must be provided"))
+	}
+
+	now := time.Now().UTC()
+	fare, zone, err := s.quote(req.Pickup, req.Dropoff, req.VehicleClass, now)
+	if err != nil {
+		s.log.Error("Failed to estimate fare", "vehicle_class", req.VehicleClass, "error", err.Error())
+		return nil, err
+	}
+
+	quote := &model.Quote{
+		ID:            uuid.NewString(),
+		Pickup:        *store.LatLngFromProto(req.Pickup),
+		Dropoff:       *store.LatLngFromProto(req.Dropoff),
+		VehicleClass:  fare.VehicleClass,
+		DistanceKm:    fare.DistanceKm,
+		Cost:          fare.Cost,
+		Surge:         fare.Surge,
+		Zone:          zone,
+		TariffVersion: fare.TariffVersion,
+		CreatedAt:     now,
+		ExpiresAt:     now.Add(QuoteTTL),
+
+		BaseFare:        fare.BaseFare,
+		DistanceFare:    fare.DistanceFare,
+		TimeFare:        fare.TimeFare,
+		DurationMinutes: fare.DurationMinutes,
+		Multiplier:      fare.Multiplier,
+		MinimumApplied:  fare.MinimumApplied,
+	}
+	if err := s.bookingStore.CreateQuote(ctx, quote); err != nil {
+		s.log.Error("Failed to save fare quote", "error", err.Error())
+		return nil, storeError(err, "failed to save fare quote")
+	}
+
+	s.log.Info("Fare estimated", "quote_id", quote.ID, "distance", fare.DistanceKm, "cost", fare.Cost,
+		"surge", fare.Surge, "zone", zone, "tariff_version", fare.TariffVersion)
+	return &pb.EstimateFareResponse{
+		Fare:      fareToProto(fare, zone, req.VehicleClass),
+		QuoteId:   quote.ID,
+		ExpiresAt: quote.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// quote prices a ride from pickup to dropoff over the distance RideService
+// computes for it, so the cost agrees with the distance the ride is stored
+// with, at the surge of the pickup's zone at now. It returns the zone, empty
+// if the pickup is in none.
+func (s *BookingService) quote(pickup, dropoff *latlng.LatLng, class pb.VehicleClass, now time.Time) (pricing.Fare, string, error) {
+	name, ok := vehicleClasses[class]
+	if !ok {
+		return pricing.Fare{}, "", grpcerr.InvalidArgument(grpcerr.FieldViolation("vehicle_class", "must be a known vehicle class"))
+	}
+	from, to := store.LatLngFromProto(pickup), store.LatLngFromProto(dropoff)
+	multiplier, zone := s.surge.Multiplier(*from, now)
+	fare, err := s.tariff.Quote(geo.Kilometers(geo.Distance(*from, *to)), name, multiplier)
+	if err != nil {
+		return pricing.Fare{}, "", grpcerr.InvalidArgument(grpcerr.FieldViolation("vehicle_class", err.Error()))
+	}
+	return fare, zone, nil
+}
+
+// checkQuote verifies that a booking request can be charged quote at now:
+// the quote must be for the requested ride and must not have expired.
+func checkQuote(quote *model.Quote, req *pb.CreateBookingRequest, now time.Time) error {
+	if *store.LatLngFromProto(req.Ride.Pickup) != quote.Pickup || *store.LatLngFromProto(req.Ride.Dropoff) != quote.Dropoff ||
+		vehicleClasses[req.VehicleClass] != quote.VehicleClass {
+		return grpcerr.InvalidArgument(grpcerr.FieldViolation("quote_id", "was quoted for another pickup, dropoff or vehicle class"))
+	}
+	if !now.Before(quote.ExpiresAt) {
+		return grpcerr.New(codes.FailedPrecondition, grpcerr.ReasonQuoteExpired,
+			fmt.Sprintf("fare quote %s expired at %s; estimate the fare again", quote.ID, quote.ExpiresAt.Format(time.RFC3339)))
+	}
+	return nil
+}
+
+// fareToProto converts a quote to its API representation.
+func fareToProto(fare pricing.Fare, zone string, class pb.VehicleClass) *pb.Fare {
+	if class == pb.VehicleClass_VEHICLE_CLASS_UNSPECIFIED {
+		class = pb.VehicleClass_VEHICLE_CLASS_ECONOMY
+	}
+	return &pb.Fare{
+		Cost:            fare.Cost.Proto(),
+		Distance:        fare.DistanceKm,
+		DurationMinutes: fare.DurationMinutes,
+		BaseFare:        fare.BaseFare.Proto(),
+		DistanceFare:    fare.DistanceFare.Proto(),
+		TimeFare:        fare.TimeFare.Proto(),
+		Multiplier:      fare.Multiplier,
+		MinimumApplied:  fare.MinimumApplied,
+		VehicleClass:    class,
+		TariffVersion:   fare.TariffVersion,
+		SurgeMultiplier: fare.Surge,
+		Zone:            zone,
+	}
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/pricing"
+	"github.com/golang_falcon_task/booking-service/internal/service/mocks"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	"github.com/golang_falcon_task/booking-service/internal/surge"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// pkr returns minor units of Pakistani rupees.
+func pkr(minor int64) money.Money {
+	return money.Money{Currency: "PKR", Minor: minor}
+}
+
+// testTariff prices a 15 km ride from downtown to the airport at PKR 625
+// in economy.
+var testTariff = &pricing.Tariff{
+	Version:         "test",
+	Currency:        "PKR",
+	BaseFare:        100,
+	PerKm:           25,
+	PerMinute:       5,
+	MinimumFare:     150,
+	AverageSpeedKmh: 30,
+	VehicleClasses:  map[string]float64{pricing.ClassEconomy: 1, pricing.ClassComfort: 1.3, pricing.ClassXL: 1.6},
+}
+
+var (
+	downtown = &latlng.LatLng{Latitude: 31.5497, Longitude: 74.2500}
+	airport  = &latlng.LatLng{Latitude: 31.5216, Longitude: 74.4036}
+)
+
+// noSurge has no zones, so fares never surge.
+var noSurge = surge.New(nil, surge.Config{})
+
+// testZones has a single zone around downtown whose fares surge by 2 for
+// every booking in the window.
+var testZones, _ = surge.ParseZones([]byte(`{"type": "FeatureCollection", "features": [{
+	"properties": {"id": "downtown", "baseline_demand": 0.5},
+	"geometry": {"type": "Polygon", "coordinates": [[[74.2, 31.5], [74.3, 31.5], [74.3, 31.6], [74.2, 31.6], [74.2, 31.5]]]}
+}]}`))
+
+// newQuote returns an unexpired economy quote from downtown to the airport.
+func newQuote() *model.Quote {
+	return &model.Quote{
+		ID:            "0b7e2f4c-5d1a-4e3b-9c8d-7f6a5b4c3d2e",
+		Pickup:        model.LatLng{Lat: downtown.Latitude, Lng: downtown.Longitude},
+		Dropoff:       model.LatLng{Lat: airport.Latitude, Lng: airport.Longitude},
+		VehicleClass:  pricing.ClassEconomy,
+		DistanceKm:    15,
+		Cost:          pkr(70000),
+		Surge:         1.12,
+		Zone:          "downtown",
+		TariffVersion: "test",
+		ExpiresAt:     time.Now().Add(time.Minute),
+	}
+}
+
+func TestBookingService_EstimateFare(t *testing.T) {
+	surging := surge.New(testZones, surge.Config{MaxMultiplier: 3})
+	surging.Record(model.LatLng{Lat: downtown.Latitude, Lng: downtown.Longitude}, time.Now())
+
+	tests := []struct {
+		name          string
+		surge         *surge.Tracker
+		req           *pb.EstimateFareRequest
+		saveErr       error
+		expectedCode  codes.Code
+		expectedClass pb.VehicleClass
+		expectedCost  money.Money
+		expectedSurge float64
+		expectedZone  string
+	}{
+		{
+			name:          "Economy By Default",
+			req:           &pb.EstimateFareRequest{Pickup: downtown, Dropoff: airport},
+			expectedCode:  codes.OK,
+			expectedClass: pb.VehicleClass_VEHICLE_CLASS_ECONOMY,
+			expectedCost:  pkr(62500),
+			expectedSurge: 1,
+		},
+		{
+			name:          "Comfort",
+			req:           &pb.EstimateFareRequest{Pickup: downtown, Dropoff: airport, VehicleClass: pb.VehicleClass_VEHICLE_CLASS_COMFORT},
+			expectedCode:  codes.OK,
+			expectedClass: pb.VehicleClass_VEHICLE_CLASS_COMFORT,
+			expectedCost:  pkr(81250),
+			expectedSurge: 1,
+		},
+		{
+			name:          "Surge",
+			surge:         surging,
+			req:           &pb.EstimateFareRequest{Pickup: downtown, Dropoff: airport},
+			expectedCode:  codes.OK,
+			expectedClass: pb.VehicleClass_VEHICLE_CLASS_ECONOMY,
+			expectedCost:  pkr(125000),
+			expectedSurge: 2,
+			expectedZone:  "downtown",
+		},
+		{
+			name:         "Quote Not Saved",
+			req:          &pb.EstimateFareRequest{Pickup: downtown, Dropoff: airport},
+			saveErr:      store.ErrTimeout,
+			expectedCode: codes.DeadlineExceeded,
+		},
+		{
+			name:         "Missing Dropoff",
+			req:          &pb.EstimateFareRequest{Pickup: downtown},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Unknown Vehicle Class",
+			req:          &pb.EstimateFareRequest{Pickup: downtown, Dropoff: airport, VehicleClass: 42},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := noSurge
+			if tt.surge != nil {
+				tracker = tt.surge
+			}
+			mockStore := new(mocks.BookingStore)
+			var saved *model.Quote
+			mockStore.On("CreateQuote", mock.Anything, mock.Anything).Return(tt.saveErr).Run(func(args mock.Arguments) {
+				saved = args.Get(1).(*model.Quote)
+			}).Maybe()
+			service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, tracker, testPayments, testSchedule, logrus.New())
+
+			resp, err := service.EstimateFare(context.Background(), tt.req)
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.expectedCost.Proto(), resp.Fare.Cost), "expected %v, got %v", tt.expectedCost, resp.Fare.Cost)
+			require.Equal(t, tt.expectedClass, resp.Fare.VehicleClass)
+			require.Equal(t, int32(15), resp.Fare.Distance)
+			require.Equal(t, int32(30), resp.Fare.DurationMinutes)
+			require.Equal(t, "test", resp.Fare.TariffVersion)
+			require.Equal(t, tt.expectedSurge, resp.Fare.SurgeMultiplier)
+			require.Equal(t, tt.expectedZone, resp.Fare.Zone)
+
+			// The quote is saved to be booked before it expires.
+			require.Equal(t, saved.ID, resp.QuoteId)
+			require.Equal(t, tt.expectedCost, saved.Cost)
+			require.Equal(t, tt.expectedZone, saved.Zone)
+			require.Equal(t, saved.CreatedAt.Add(QuoteTTL), saved.ExpiresAt)
+			require.Equal(t, saved.ExpiresAt.Format(time.RFC3339), resp.ExpiresAt)
+		})
+	}
+}
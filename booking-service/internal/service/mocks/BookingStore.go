@@ -8,6 +8,8 @@ import (
 	model "github.com/golang_falcon_task/booking-service/internal/model"
 	mock "github.com/stretchr/testify/mock"
 
+	money "github.com/golang_falcon_task/booking-service/internal/money"
+
 	time "time"
 )
 
@@ -16,27 +18,66 @@ type BookingStore struct {
 	mock.Mock
 }
 
-// CreateBooking provides a mock function with given fields: ctx, userID, rideID, bookingTime
-func (_m *BookingStore) CreateBooking(ctx context.Context, userID int32, rideID int32, bookingTime time.Time) (int32, error) {
-	ret := _m.Called(ctx, userID, rideID, bookingTime)
+// CancelBooking provides a mock function with given fields: ctx, c, entry, now
+func (_m *BookingStore) CancelBooking(ctx context.Context, c *model.Cancellation, entry *model.JournalEntry, now time.Time) (*model.Booking, string, error) {
+	ret := _m.Called(ctx, c, entry, now)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateBooking")
+		panic("no return value specified for CancelBooking")
 	}
 
-	var r0 int32
+	var r0 *model.Booking
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Cancellation, *model.JournalEntry, time.Time) (*model.Booking, string, error)); ok {
+		return rf(ctx, c, entry, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Cancellation, *model.JournalEntry, time.Time) *model.Booking); ok {
+		r0 = rf(ctx, c, entry, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Booking)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Cancellation, *model.JournalEntry, time.Time) string); ok {
+		r1 = rf(ctx, c, entry, now)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.Cancellation, *model.JournalEntry, time.Time) error); ok {
+		r2 = rf(ctx, c, entry, now)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CompleteBooking provides a mock function with given fields: ctx, bookingID, driverID, entry, now
+func (_m *BookingStore) CompleteBooking(ctx context.Context, bookingID int32, driverID int32, entry *model.JournalEntry, now time.Time) (*model.Booking, error) {
+	ret := _m.Called(ctx, bookingID, driverID, entry, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteBooking")
+	}
+
+	var r0 *model.Booking
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, time.Time) (int32, error)); ok {
-		return rf(ctx, userID, rideID, bookingTime)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, *model.JournalEntry, time.Time) (*model.Booking, error)); ok {
+		return rf(ctx, bookingID, driverID, entry, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, time.Time) int32); ok {
-		r0 = rf(ctx, userID, rideID, bookingTime)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, *model.JournalEntry, time.Time) *model.Booking); ok {
+		r0 = rf(ctx, bookingID, driverID, entry, now)
 	} else {
-		r0 = ret.Get(0).(int32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Booking)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, time.Time) error); ok {
-		r1 = rf(ctx, userID, rideID, bookingTime)
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, *model.JournalEntry, time.Time) error); ok {
+		r1 = rf(ctx, bookingID, driverID, entry, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -44,27 +85,111 @@ func (_m *BookingStore) CreateBooking(ctx context.Context, userID int32, rideID
 	return r0, r1
 }
 
-// CreateRide provides a mock function with given fields: ctx, source, destination, distance, cost
-func (_m *BookingStore) CreateRide(ctx context.Context, source string, destination string, distance int32, cost int32) (int32, error) {
-	ret := _m.Called(ctx, source, destination, distance, cost)
+// CompleteSaga provides a mock function with given fields: ctx, saga, bookingTime
+func (_m *BookingStore) CompleteSaga(ctx context.Context, saga *model.BookingSaga, bookingTime time.Time) (int32, error) {
+	ret := _m.Called(ctx, saga, bookingTime)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateRide")
+		panic("no return value specified for CompleteSaga")
 	}
 
 	var r0 int32
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) (int32, error)); ok {
-		return rf(ctx, source, destination, distance, cost)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.BookingSaga, time.Time) (int32, error)); ok {
+		return rf(ctx, saga, bookingTime)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) int32); ok {
-		r0 = rf(ctx, source, destination, distance, cost)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.BookingSaga, time.Time) int32); ok {
+		r0 = rf(ctx, saga, bookingTime)
 	} else {
 		r0 = ret.Get(0).(int32)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, int32) error); ok {
-		r1 = rf(ctx, source, destination, distance, cost)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.BookingSaga, time.Time) error); ok {
+		r1 = rf(ctx, saga, bookingTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePromo provides a mock function with given fields: ctx, promo
+func (_m *BookingStore) CreatePromo(ctx context.Context, promo *model.Promo) error {
+	ret := _m.Called(ctx, promo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePromo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Promo) error); ok {
+		r0 = rf(ctx, promo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateQuote provides a mock function with given fields: ctx, quote
+func (_m *BookingStore) CreateQuote(ctx context.Context, quote *model.Quote) error {
+	ret := _m.Called(ctx, quote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQuote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Quote) error); ok {
+		r0 = rf(ctx, quote)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateSaga provides a mock function with given fields: ctx, saga
+func (_m *BookingStore) CreateSaga(ctx context.Context, saga *model.BookingSaga) error {
+	ret := _m.Called(ctx, saga)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSaga")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.BookingSaga) error); ok {
+		r0 = rf(ctx, saga)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetBalance provides a mock function with given fields: ctx, account
+func (_m *BookingStore) GetBalance(ctx context.Context, account model.Account) ([]money.Money, error) {
+	ret := _m.Called(ctx, account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalance")
+	}
+
+	var r0 []money.Money
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Account) ([]money.Money, error)); ok {
+		return rf(ctx, account)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Account) []money.Money); ok {
+		r0 = rf(ctx, account)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]money.Money)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Account) error); ok {
+		r1 = rf(ctx, account)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -120,6 +245,303 @@ func (_m *BookingStore) GetBookingDetails(ctx context.Context, bookingID int32)
 	return r0, r1, r2, r3
 }
 
+// GetPromo provides a mock function with given fields: ctx, code
+func (_m *BookingStore) GetPromo(ctx context.Context, code string) (*model.Promo, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromo")
+	}
+
+	var r0 *model.Promo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Promo, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Promo); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Promo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetQuote provides a mock function with given fields: ctx, quoteID
+func (_m *BookingStore) GetQuote(ctx context.Context, quoteID string) (*model.Quote, error) {
+	ret := _m.Called(ctx, quoteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuote")
+	}
+
+	var r0 *model.Quote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Quote, error)); ok {
+		return rf(ctx, quoteID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Quote); ok {
+		r0 = rf(ctx, quoteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Quote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, quoteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSaga provides a mock function with given fields: ctx, sagaID
+func (_m *BookingStore) GetSaga(ctx context.Context, sagaID string) (*model.BookingSaga, error) {
+	ret := _m.Called(ctx, sagaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSaga")
+	}
+
+	var r0 *model.BookingSaga
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.BookingSaga, error)); ok {
+		return rf(ctx, sagaID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.BookingSaga); ok {
+		r0 = rf(ctx, sagaID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BookingSaga)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sagaID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBookings provides a mock function with given fields: ctx, userID
+func (_m *BookingStore) ListBookings(ctx context.Context, userID int32) ([]model.Booking, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBookings")
+	}
+
+	var r0 []model.Booking
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]model.Booking, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []model.Booking); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Booking)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDriverOffers provides a mock function with given fields: ctx, driverID, now
+func (_m *BookingStore) ListDriverOffers(ctx context.Context, driverID int32, now time.Time) ([]model.Offer, error) {
+	ret := _m.Called(ctx, driverID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDriverOffers")
+	}
+
+	var r0 []model.Offer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time) ([]model.Offer, error)); ok {
+		return rf(ctx, driverID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time) []model.Offer); ok {
+		r0 = rf(ctx, driverID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Offer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, time.Time) error); ok {
+		r1 = rf(ctx, driverID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStaleSagas provides a mock function with given fields: ctx, updatedBefore, limit
+func (_m *BookingStore) ListStaleSagas(ctx context.Context, updatedBefore time.Time, limit int) ([]model.BookingSaga, error) {
+	ret := _m.Called(ctx, updatedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStaleSagas")
+	}
+
+	var r0 []model.BookingSaga
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]model.BookingSaga, error)); ok {
+		return rf(ctx, updatedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []model.BookingSaga); ok {
+		r0 = rf(ctx, updatedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.BookingSaga)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, updatedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStatement provides a mock function with given fields: ctx, account, beforeEntryID, limit
+func (_m *BookingStore) ListStatement(ctx context.Context, account model.Account, beforeEntryID int64, limit int) ([]model.StatementLine, error) {
+	ret := _m.Called(ctx, account, beforeEntryID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatement")
+	}
+
+	var r0 []model.StatementLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Account, int64, int) ([]model.StatementLine, error)); ok {
+		return rf(ctx, account, beforeEntryID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Account, int64, int) []model.StatementLine); ok {
+		r0 = rf(ctx, account, beforeEntryID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.StatementLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Account, int64, int) error); ok {
+		r1 = rf(ctx, account, beforeEntryID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefundBooking provides a mock function with given fields: ctx, refund, entry, now
+func (_m *BookingStore) RefundBooking(ctx context.Context, refund *model.Refund, entry *model.JournalEntry, now time.Time) (*model.Booking, error) {
+	ret := _m.Called(ctx, refund, entry, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundBooking")
+	}
+
+	var r0 *model.Booking
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Refund, *model.JournalEntry, time.Time) (*model.Booking, error)); ok {
+		return rf(ctx, refund, entry, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Refund, *model.JournalEntry, time.Time) *model.Booking); ok {
+		r0 = rf(ctx, refund, entry, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Booking)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Refund, *model.JournalEntry, time.Time) error); ok {
+		r1 = rf(ctx, refund, entry, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RespondToOffer provides a mock function with given fields: ctx, offerID, driverID, accept, now
+func (_m *BookingStore) RespondToOffer(ctx context.Context, offerID int32, driverID int32, accept bool, now time.Time) (*model.Offer, *model.Booking, error) {
+	ret := _m.Called(ctx, offerID, driverID, accept, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RespondToOffer")
+	}
+
+	var r0 *model.Offer
+	var r1 *model.Booking
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, bool, time.Time) (*model.Offer, *model.Booking, error)); ok {
+		return rf(ctx, offerID, driverID, accept, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, bool, time.Time) *model.Offer); ok {
+		r0 = rf(ctx, offerID, driverID, accept, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Offer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, bool, time.Time) *model.Booking); ok {
+		r1 = rf(ctx, offerID, driverID, accept, now)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.Booking)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int32, int32, bool, time.Time) error); ok {
+		r2 = rf(ctx, offerID, driverID, accept, now)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UpdateSaga provides a mock function with given fields: ctx, saga
+func (_m *BookingStore) UpdateSaga(ctx context.Context, saga *model.BookingSaga) error {
+	ret := _m.Called(ctx, saga)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSaga")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.BookingSaga) error); ok {
+		r0 = rf(ctx, saga)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewBookingStore creates a new instance of BookingStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBookingStore(t interface {
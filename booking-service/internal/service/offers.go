@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/metrics"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+)
+
+// ListDriverOffers returns the bookings currently offered to a driver.
+func (s *BookingService) ListDriverOffers(ctx context.Context, req *pb.ListDriverOffersRequest) (*pb.ListDriverOffersResponse, error) {
+	// Input validation
+	if req.DriverId <= 0 {
+		s.log.Error("Invalid driver_id: must be a positive integer", "driver_id", req.DriverId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver_id", "must be a positive integer"))
+	}
+
+	offers, err := s.bookingStore.ListDriverOffers(ctx, req.DriverId, time.Now())
+	if err != nil {
+		s.log.Error("Failed to list driver offers", "driver_id", req.DriverId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to list offers for driver with id %d", req.DriverId))
+	}
+
+	res := &pb.ListDriverOffersResponse{Offers: make([]*pb.DriverOffer, 0, len(offers))}
+	for i := range offers {
+		res.Offers = append(res.Offers, store.OfferToProto(&offers[i]))
+	}
+	return res, nil
+}
+
+// RespondToOffer accepts or declines an offer. Once a driver accepts, the
+// ride is assigned to them and they are marked on a trip; both are best
+// effort, as the booking is already confirmed.
+func (s *BookingService) RespondToOffer(ctx context.Context, req *pb.RespondToOfferRequest) (*pb.RespondToOfferResponse, error) {
+	// Input validation
+	if req.OfferId <= 0 {
+		s.log.Error("Invalid offer_id: must be a positive integer", "offer_id", req.OfferId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("offer_id", "must be a positive integer"))
+	}
+	if req.DriverId <= 0 {
+		s.log.Error("Invalid driver_id: must be a positive integer", "driver_id", req.DriverId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver_id", "must be a positive integer"))
+	}
+
+	offer, booking, err := s.bookingStore.RespondToOffer(ctx, req.OfferId, req.DriverId, req.Accept, time.Now())
+	if err != nil {
+		if errors.Is(err, store.ErrOfferNotFound) || errors.Is(err, store.ErrOfferClosed) {
+			s.log.Error("Offer is not open to the driver", "offer_id", req.OfferId, "driver_id", req.DriverId, "error", err.Error())
+		} else {
+			s.log.Error("Failed to respond to offer", "offer_id", req.OfferId, "error", err.Error())
+		}
+		return nil, storeError(err, fmt.Sprintf("failed to respond to offer with id %d", req.OfferId))
+	}
+
+	if !req.Accept {
+		metrics.DispatchDecisions.WithLabelValues(metrics.DecisionDeclined).Inc()
+		s.log.Info("Offer declined", "offer_id", offer.ID, "booking_id", offer.BookingID, "driver_id", offer.DriverID)
+	} else {
+		metrics.DispatchDecisions.WithLabelValues(metrics.DecisionAccepted).Inc()
+		// A scheduled booking waited for its pickup time, not for a driver.
+		if !booking.PickupTime.After(booking.Timestamp) {
+			metrics.DispatchMatchDuration.Observe(time.Since(booking.Timestamp).Seconds())
+		}
+		s.log.Info("Offer accepted, booking confirmed", "offer_id", offer.ID, "booking_id", booking.ID, "driver_id", booking.DriverID)
+		s.assignDriver(ctx, booking)
+	}
+
+	return &pb.RespondToOfferResponse{
+		Offer:   store.OfferToProto(offer),
+		Booking: store.BookingToProto(booking),
+	}, nil
+}
+
+// assignDriver records the driver of a confirmed booking on its ride and
+// marks the driver on a trip, so dispatch stops offering them bookings.
+func (s *BookingService) assignDriver(ctx context.Context, booking *model.Booking) {
+	if _, err := s.drivers.UpdateDriverStatus(ctx, &driverpb.UpdateDriverStatusRequest{
+		DriverId: booking.DriverID,
+		Status:   driverpb.DriverStatus_DRIVER_STATUS_ON_TRIP,
+	}); err != nil {
+		s.log.Error("Failed to mark driver on trip", "booking_id", booking.ID, "driver_id", booking.DriverID, "error", err.Error())
+	}
+
+	res, err := s.rides.GetRide(ctx, &ridepb.GetRideRequest{RideId: booking.RideID})
+	if err != nil {
+		s.log.Error("Failed to fetch booked ride", "booking_id", booking.ID, "ride_id", booking.RideID, "error", err.Error())
+		return
+	}
+	ride := res.Ride
+	ride.DriverId = booking.DriverID
+	if _, err := s.rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{RideId: booking.RideID, Ride: ride}); err != nil {
+		s.log.Error("Failed to assign driver to ride", "booking_id", booking.ID, "ride_id", booking.RideID, "error", err.Error())
+	}
+}
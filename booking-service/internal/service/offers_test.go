@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/service/mocks"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBookingService_ListDriverOffers(t *testing.T) {
+	mockStore := new(mocks.BookingStore)
+	logger := logrus.New()
+	service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logger)
+
+	createdAt := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		driverID     int32
+		setupMock    func()
+		expectedCode codes.Code
+		expectedIDs  []int32
+	}{
+		{
+			name:     "Success",
+			driverID: 1,
+			setupMock: func() {
+				mockStore.On("ListDriverOffers", mock.Anything, int32(1), mock.Anything).Return([]model.Offer{
+					{ID: 7, BookingID: 4, DriverID: 1, Status: model.OfferPending, DistanceKm: 1.2, CreatedAt: createdAt, ExpiresAt: createdAt.Add(30 * time.Second)},
+				}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedIDs:  []int32{7},
+		},
+		{
+			name:         "Invalid Driver ID",
+			driverID:     0,
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Internal Error",
+			driverID: 2,
+			setupMock: func() {
+				mockStore.On("ListDriverOffers", mock.Anything, int32(2), mock.Anything).Return(nil, errors.New("database error"))
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			resp, err := service.ListDriverOffers(context.Background(), &pb.ListDriverOffersRequest{DriverId: tt.driverID})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				ids := make([]int32, 0, len(resp.Offers))
+				for _, o := range resp.Offers {
+					ids = append(ids, o.OfferId)
+					require.Equal(t, pb.OfferStatus_OFFER_STATUS_PENDING, o.Status)
+				}
+				require.Equal(t, tt.expectedIDs, ids)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBookingService_RespondToOffer(t *testing.T) {
+	logger := logrus.New()
+	bookingTime := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name             string
+		req              *pb.RespondToOfferRequest
+		setupMock        func(mockStore *mocks.BookingStore)
+		expectedCode     codes.Code
+		expectedStatus   pb.BookingStatus
+		expectedAssigned bool // Whether the ride and driver were updated
+	}{
+		{
+			name: "Accept",
+			req:  &pb.RespondToOfferRequest{OfferId: 7, DriverId: 1, Accept: true},
+			setupMock: func(mockStore *mocks.BookingStore) {
+				mockStore.On("RespondToOffer", mock.Anything, int32(7), int32(1), true, mock.Anything).Return(
+					&model.Offer{ID: 7, BookingID: 4, DriverID: 1, Status: model.OfferAccepted},
+					&model.Booking{ID: 4, UserID: 1, RideID: 40, DriverID: 1, Status: model.BookingConfirmed, Timestamp: bookingTime},
+					nil,
+				)
+			},
+			expectedCode:     codes.OK,
+			expectedStatus:   pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+			expectedAssigned: true,
+		},
+		{
+			name: "Decline",
+			req:  &pb.RespondToOfferRequest{OfferId: 7, DriverId: 1},
+			setupMock: func(mockStore *mocks.BookingStore) {
+				mockStore.On("RespondToOffer", mock.Anything, int32(7), int32(1), false, mock.Anything).Return(
+					&model.Offer{ID: 7, BookingID: 4, DriverID: 1, Status: model.OfferDeclined},
+					&model.Booking{ID: 4, UserID: 1, RideID: 40, Status: model.BookingPending, Timestamp: bookingTime},
+					nil,
+				)
+			},
+			expectedCode:   codes.OK,
+			expectedStatus: pb.BookingStatus_BOOKING_STATUS_PENDING,
+		},
+		{
+			name:         "Invalid Offer ID",
+			req:          &pb.RespondToOfferRequest{DriverId: 1, Accept: true},
+			setupMock:    func(mockStore *mocks.BookingStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Invalid Driver ID",
+			req:          &pb.RespondToOfferRequest{OfferId: 7, Accept: true},
+			setupMock:    func(mockStore *mocks.BookingStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Offer Not Found",
+			req:  &pb.RespondToOfferRequest{OfferId: 8, DriverId: 2, Accept: true},
+			setupMock: func(mockStore *mocks.BookingStore) {
+				mockStore.On("RespondToOffer", mock.Anything, int32(8), int32(2), true, mock.Anything).Return(nil, nil, store.ErrOfferNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "Offer Closed",
+			req:  &pb.RespondToOfferRequest{OfferId: 7, DriverId: 1, Accept: true},
+			setupMock: func(mockStore *mocks.BookingStore) {
+				mockStore.On("RespondToOffer", mock.Anything, int32(7), int32(1), true, mock.Anything).Return(nil, nil, store.ErrOfferClosed)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			tt.setupMock(mockStore)
+			rides, drivers := &fakeRides{}, &fakeDrivers{}
+			service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, rides, drivers, testTariff, noSurge, testPayments, testSchedule, logger)
+
+			resp, err := service.RespondToOffer(context.Background(), tt.req)
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedStatus, resp.Booking.Status)
+				require.Equal(t, tt.req.OfferId, resp.Offer.OfferId)
+			}
+
+			if tt.expectedAssigned {
+				require.Len(t, rides.updated, 1)
+				require.Equal(t, int32(40), rides.updated[0].RideId)
+				require.Equal(t, tt.req.DriverId, rides.updated[0].Ride.DriverId)
+				require.Len(t, drivers.statuses, 1)
+				require.Equal(t, driverpb.DriverStatus_DRIVER_STATUS_ON_TRIP, drivers.statuses[0].Status)
+			} else {
+				require.Empty(t, rides.updated)
+				require.Empty(t, drivers.statuses)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
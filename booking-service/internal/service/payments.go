@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/ledger"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/payments"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// Statement page sizes.
+const (
+	defaultStatementPageSize = 20
+	maxStatementPageSize     = 100
+)
+
+// CompleteBooking charges the rider of a booking its fare, then marks
+// the booking completed and posts the journal entry recording the charge,
+// atomically. Bookings paid by wallet capture their hold instead of
+// charging. The charge and the capture are idempotent per booking, so a
+// retry after a failure to store the completion does not charge the rider
+// twice. Marking the driver available again is best effort.
+func (s *BookingService) CompleteBooking(ctx context.Context, req *pb.CompleteBookingRequest) (*pb.CompleteBookingResponse, error) {
+	// Input validation
+	if req.BookingId <= 0 {
+		s.log.Error("Invalid booking_id: must be a positive integer", "booking_id", req.BookingId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("booking_id", "must be a positive integer"))
+	}
+	if req.DriverId <= 0 {
+		s.log.Error("Invalid driver_id: must be a positive integer", "driver_id", req.DriverId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver_id", "must be a positive integer"))
+	}
+
+	booking, _, ride, err := s.bookingStore.GetBookingDetails(ctx, req.BookingId)
+	if err != nil {
+		s.log.Error("Failed to fetch booking", "booking_id", req.BookingId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to fetch booking with id %d", req.BookingId))
+	}
+	// Checked before charging; the store checks again when completing.
+	if booking.Status != model.BookingConfirmed || booking.DriverID != req.DriverId {
+		s.log.Error("Booking is not confirmed with the driver", "booking_id", booking.ID, "driver_id", req.DriverId, "status", booking.Status)
+		return nil, storeError(store.ErrBookingNotConfirmed, fmt.Sprintf("failed to complete booking with id %d", booking.ID))
+	}
+
+	// The fare fixed when booking, whatever the ride costs now.
+	fare := booking.Fare(ride)
+	chargeID, err := s.charge(ctx, booking, fare, fmt.Sprintf("booking-%d", booking.ID),
+		fmt.Sprintf("Ride from %s to %s", ride.Source, ride.Destination))
+	if err != nil {
+		s.log.Error("Failed to charge rider", "booking_id", booking.ID, "user_id", booking.UserID, "amount", fare.String(), "error", err.Error())
+		return nil, err
+	}
+
+	entry, err := ledger.RideCompleted(booking, fare, s.payments.CommissionRate, chargeID)
+	if err != nil {
+		s.log.Error("Failed to build journal entry", "booking_id", booking.ID, "error", err.Error())
+		return nil, grpcerr.New(codes.Internal, grpcerr.ReasonLedgerError, fmt.Sprintf("failed to record payment for booking %d: %v", booking.ID, err))
+	}
+	completed, err := s.bookingStore.CompleteBooking(ctx, booking.ID, req.DriverId, entry, time.Now())
+	if err != nil {
+		s.log.Error("Failed to complete booking", "booking_id", booking.ID, "charge_id", chargeID, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to complete booking with id %d", booking.ID))
+	}
+
+	s.log.Info("Booking completed", "booking_id", completed.ID, "driver_id", completed.DriverID, "charge_id", chargeID, "amount", fare.String())
+	if _, err := s.drivers.UpdateDriverStatus(ctx, &driverpb.UpdateDriverStatusRequest{
+		DriverId: completed.DriverID,
+		Status:   driverpb.DriverStatus_DRIVER_STATUS_AVAILABLE,
+	}); err != nil {
+		s.log.Error("Failed to mark driver available", "booking_id", completed.ID, "driver_id", completed.DriverID, "error", err.Error())
+	}
+
+	return &pb.CompleteBookingResponse{
+		Booking: store.BookingToProto(completed),
+		Entry:   store.JournalEntryToProto(entry),
+	}, nil
+}
+
+// charge takes amount from the rider of booking: from the booking's wallet
+// hold, releasing the rest of it, or through the payment provider under
+// idempotencyKey. It returns the reference of the charge, empty if nothing
+// was charged.
+func (s *BookingService) charge(ctx context.Context, booking *model.Booking, amount money.Money, idempotencyKey, description string) (string, error) {
+	if amount.IsZero() {
+		return "", nil
+	}
+	if booking.PaymentMethod == model.PaymentWallet {
+		res, err := s.users.CaptureHold(ctx, &userpb.CaptureHoldRequest{HoldId: booking.HoldID, Amount: amount.Proto()})
+		if err != nil {
+			// The user service returns status errors.
+			return "", err
+		}
+		return fmt.Sprintf("wallet-hold-%d", res.Hold.HoldId), nil
+	}
+
+	charge, err := s.payments.Provider.Charge(ctx, payments.ChargeRequest{
+		IdempotencyKey: idempotencyKey,
+		UserID:         booking.UserID,
+		Amount:         amount,
+		Description:    description,
+	})
+	if err != nil {
+		return "", paymentError("charge", err)
+	}
+	return charge.ID, nil
+}
+
+// GetAccountBalance returns the balance of a ledger account in each currency
+// it was posted in.
+func (s *BookingService) GetAccountBalance(ctx context.Context, req *pb.GetAccountBalanceRequest) (*pb.GetAccountBalanceResponse, error) {
+	// Input validation
+	account, err := accountFromProto(req.Account)
+	if err != nil {
+		s.log.Error("Invalid account", "account", req.Account.String(), "error", err.Error())
+		return nil, err
+	}
+
+	balances, err := s.bookingStore.GetBalance(ctx, account)
+	if err != nil {
+		s.log.Error("Failed to fetch balance", "account", account.String(), "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to fetch balance of %s", account))
+	}
+
+	res := &pb.GetAccountBalanceResponse{Account: store.AccountToProto(account)}
+	for _, b := range balances {
+		res.Balances = append(res.Balances, b.Proto())
+	}
+	return res, nil
+}
+
+// GetAccountStatement lists the postings to a ledger account, newest first,
+// a page at a time. A page token is the ID of the last entry listed.
+func (s *BookingService) GetAccountStatement(ctx context.Context, req *pb.GetAccountStatementRequest) (*pb.GetAccountStatementResponse, error) {
+	// Input validation
+	account, err := accountFromProto(req.Account)
+	if err != nil {
+		s.log.Error("Invalid account", "account", req.Account.String(), "error", err.Error())
+		return nil, err
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 0 || pageSize > maxStatementPageSize {
+		s.log.Error("Invalid page_size", "page_size", req.PageSize)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("page_size", fmt.Sprintf("must be between 0 and %d", maxStatementPageSize)))
+	}
+	if pageSize == 0 {
+		pageSize = defaultStatementPageSize
+	}
+	var before int64
+	if req.PageToken != "" {
+		if before, err = strconv.ParseInt(req.PageToken, 10, 64); err != nil || before <= 0 {
+			s.log.Error("Invalid page_token", "page_token", req.PageToken)
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("page_token", "must be a next_page_token returned by GetAccountStatement"))
+		}
+	}
+
+	// Fetch one line more than a page to tell whether another page follows.
+	lines, err := s.bookingStore.ListStatement(ctx, account, before, pageSize+1)
+	if err != nil {
+		s.log.Error("Failed to list statement", "account", account.String(), "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to list statement of %s", account))
+	}
+
+	res := &pb.GetAccountStatementResponse{Lines: make([]*pb.StatementLine, 0, min(len(lines), pageSize))}
+	if len(lines) > pageSize {
+		lines = lines[:pageSize]
+		res.NextPageToken = strconv.FormatInt(lines[pageSize-1].EntryID, 10)
+	}
+	for i := range lines {
+		res.Lines = append(res.Lines, store.StatementLineToProto(&lines[i]))
+	}
+	return res, nil
+}
+
+// accountFromProto converts an API ledger account to the model, returning an
+// InvalidArgument error unless riders and drivers have an owner and the
+// shared accounts do not.
+func accountFromProto(a *pb.Account) (model.Account, error) {
+	if a == nil {
+		return model.Account{}, grpcerr.InvalidArgument(grpcerr.FieldViolation("account", "must be provided"))
+	}
+	account := store.AccountFromProto(a)
+	switch account.Type {
+	case "":
+		return model.Account{}, grpcerr.InvalidArgument(grpcerr.FieldViolation("account.type", "must be a known account type"))
+	case model.AccountRider, model.AccountDriver:
+		if account.OwnerID <= 0 {
+			return model.Account{}, grpcerr.InvalidArgument(grpcerr.FieldViolation("account.owner_id", "must be a positive integer"))
+		}
+	default:
+		if account.OwnerID != 0 {
+			return model.Account{}, grpcerr.InvalidArgument(grpcerr.FieldViolation("account.owner_id", "must be 0 for shared accounts"))
+		}
+	}
+	return account, nil
+}
+
+// paymentError converts an error returned by the payment provider when it
+// was asked to action ("charge" or "refund") the rider into a gRPC status
+// error. Declined payments are final; other failures may be retried.
+func paymentError(action string, err error) error {
+	if errors.Is(err, payments.ErrDeclined) {
+		return grpcerr.New(codes.FailedPrecondition, grpcerr.ReasonPaymentDeclined, fmt.Sprintf("failed to %s rider: %v", action, err))
+	}
+	return grpcerr.New(codes.Unavailable, grpcerr.ReasonPaymentFailed, fmt.Sprintf("failed to %s rider: %v", action, err),
+		grpcerr.Retry(grpcerr.DefaultRetryDelay))
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/ledger"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/payments"
+	"github.com/golang_falcon_task/booking-service/internal/service/mocks"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testPayments charges through a fake provider and keeps a 20% commission.
+var testPayments = payments.Config{Provider: payments.NewFakeProvider(), CommissionRate: 0.2}
+
+func TestBookingService_CompleteBooking(t *testing.T) {
+	confirmed := func() *model.Booking {
+		return &model.Booking{ID: 1001, UserID: 1, RideID: 101, DriverID: 7, Status: model.BookingConfirmed, Timestamp: time.Now()}
+	}
+	ride := &model.Ride{ID: 101, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(62500)}
+
+	tests := []struct {
+		name             string
+		booking          func(b *model.Booking)
+		rideCost         money.Money // The ride's cost if it changed since booking
+		getErr           error
+		declined         bool
+		completeErr      error
+		expectedPostings []model.Posting
+		expectedCode     codes.Code
+		expectedReason   string
+	}{
+		{
+			name:    "Success",
+			booking: func(b *model.Booking) {},
+			expectedPostings: []model.Posting{
+				{Account: ledger.Rider(1), Amount: pkr(-62500)},
+				{Account: ledger.Driver(7), Amount: pkr(50000)},
+				{Account: ledger.Platform, Amount: pkr(12500)},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "Success With Promo Code",
+			booking: func(b *model.Booking) {
+				b.Discount = model.Discount{PromoCode: "WELCOME20", Fare: pkr(78125), Amount: pkr(15625)}
+			},
+			expectedPostings: []model.Posting{
+				{Account: ledger.Rider(1), Amount: pkr(-62500)},
+				{Account: ledger.Promo, Amount: pkr(-15625)},
+				{Account: ledger.Driver(7), Amount: pkr(62500)},
+				{Account: ledger.Platform, Amount: pkr(15625)},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:     "Ride Repriced After Booking",
+			booking:  func(b *model.Booking) { b.Cost = pkr(62500) },
+			rideCost: pkr(90000),
+			expectedPostings: []model.Posting{
+				{Account: ledger.Rider(1), Amount: pkr(-62500)},
+				{Account: ledger.Driver(7), Amount: pkr(50000)},
+				{Account: ledger.Platform, Amount: pkr(12500)},
+			},
+			expectedCode: codes.OK,
+		},
+		{name: "Booking Not Found", getErr: store.ErrBookingNotFound, expectedCode: codes.NotFound, expectedReason: grpcerr.ReasonBookingNotFound},
+		{name: "Booking Pending", booking: func(b *model.Booking) { b.Status, b.DriverID = model.BookingPending, 0 },
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonBookingNotConfirmed},
+		{name: "Already Completed", booking: func(b *model.Booking) { b.Status = model.BookingCompleted },
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonBookingNotConfirmed},
+		{name: "Another Driver", booking: func(b *model.Booking) { b.DriverID = 8 },
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonBookingNotConfirmed},
+		{name: "Payment Declined", booking: func(b *model.Booking) {}, declined: true,
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonPaymentDeclined},
+		{name: "Completed Concurrently", booking: func(b *model.Booking) {}, completeErr: store.ErrBookingNotConfirmed,
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonBookingNotConfirmed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			if tt.getErr != nil {
+				mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(nil, nil, nil, tt.getErr)
+			} else {
+				booking := confirmed()
+				tt.booking(booking)
+				current := *ride
+				if !tt.rideCost.IsZero() {
+					current.Cost = tt.rideCost
+				}
+				mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(booking, &model.User{ID: 1}, &current, nil)
+			}
+			mockStore.On("CompleteBooking", mock.Anything, int32(1001), int32(7), mock.Anything, mock.Anything).
+				Return(func(ctx context.Context, bookingID, driverID int32, entry *model.JournalEntry, now time.Time) (*model.Booking, error) {
+					if tt.completeErr != nil {
+						return nil, tt.completeErr
+					}
+					entry.ID, entry.CreatedAt = 1, now
+					booking := confirmed()
+					tt.booking(booking)
+					booking.Status = model.BookingCompleted
+					return booking, nil
+				}).Maybe()
+
+			provider := payments.NewFakeProvider()
+			if tt.declined {
+				provider.Decline(1)
+			}
+			drivers := &fakeDrivers{}
+			service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, drivers, testTariff, noSurge,
+				payments.Config{Provider: provider, CommissionRate: 0.2}, testSchedule, logrus.New())
+
+			resp, err := service.CompleteBooking(context.Background(), &pb.CompleteBookingRequest{BookingId: 1001, DriverId: 7})
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				require.Equal(t, tt.expectedReason, errorReason(t, err))
+				require.Empty(t, drivers.statuses)
+				if tt.completeErr == nil {
+					require.Empty(t, provider.Charges())
+					mockStore.AssertNotCalled(t, "CompleteBooking", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, pb.BookingStatus_BOOKING_STATUS_COMPLETED, resp.Booking.Status)
+
+			charges := provider.Charges()
+			require.Len(t, charges, 1)
+			require.Equal(t, pkr(62500), charges[0].Amount)
+			require.Equal(t, int32(1), charges[0].UserID)
+
+			entry := mockStore.Calls[1].Arguments.Get(3).(*model.JournalEntry)
+			require.Equal(t, model.EntryRideCompleted, entry.Kind)
+			require.Equal(t, charges[0].ID, entry.Reference)
+			require.Equal(t, tt.expectedPostings, entry.Postings)
+			require.Equal(t, pb.JournalEntryKind_JOURNAL_ENTRY_KIND_RIDE_COMPLETED, resp.Entry.Kind)
+			require.Len(t, resp.Entry.Postings, len(tt.expectedPostings))
+
+			// The driver can be offered bookings again.
+			require.Equal(t, []*driverpb.UpdateDriverStatusRequest{{DriverId: 7, Status: driverpb.DriverStatus_DRIVER_STATUS_AVAILABLE}}, drivers.statuses)
+		})
+	}
+}
+
+func TestBookingService_CompleteBooking_Wallet(t *testing.T) {
+	booking := &model.Booking{ID: 1001, UserID: 1, RideID: 101, DriverID: 7, Status: model.BookingConfirmed, Timestamp: time.Now(),
+		PaymentMethod: model.PaymentWallet, HoldID: 9}
+	ride := &model.Ride{ID: 101, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(62500)}
+
+	tests := []struct {
+		name         string
+		captureErr   error
+		expectedCode codes.Code
+	}{
+		{name: "Captures Hold", expectedCode: codes.OK},
+		{name: "Hold Released", captureErr: status.Error(codes.FailedPrecondition, "hold is not held"), expectedCode: codes.FailedPrecondition},
+		{name: "User Service Unavailable", captureErr: status.Error(codes.Unavailable, "connection refused"), expectedCode: codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(booking, &model.User{ID: 1}, ride, nil)
+			mockStore.On("CompleteBooking", mock.Anything, int32(1001), int32(7), mock.Anything, mock.Anything).
+				Return(&model.Booking{ID: 1001, UserID: 1, DriverID: 7, Status: model.BookingCompleted, PaymentMethod: model.PaymentWallet, HoldID: 9}, nil).Maybe()
+			provider := payments.NewFakeProvider()
+			users := &fakeUsers{captureErr: tt.captureErr}
+			service := NewBookingService(mockStore, outbox.NewMemStore(), users, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge,
+				payments.Config{Provider: provider, CommissionRate: 0.2}, testSchedule, logrus.New())
+
+			_, err := service.CompleteBooking(context.Background(), &pb.CompleteBookingRequest{BookingId: 1001, DriverId: 7})
+			require.Empty(t, provider.Charges())
+			require.Len(t, users.captured, 1)
+			require.Equal(t, int32(9), users.captured[0].HoldId)
+			require.Equal(t, pkr(62500).Proto(), users.captured[0].Amount)
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				mockStore.AssertNotCalled(t, "CompleteBooking", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			entry := mockStore.Calls[1].Arguments.Get(3).(*model.JournalEntry)
+			require.Equal(t, "wallet-hold-9", entry.Reference)
+		})
+	}
+}
+
+func TestBookingService_GetAccountBalance(t *testing.T) {
+	mockStore := new(mocks.BookingStore)
+	mockStore.On("GetBalance", mock.Anything, ledger.Driver(7)).Return([]money.Money{pkr(50000), {Currency: "USD", Minor: 1200}}, nil)
+	service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logrus.New())
+
+	resp, err := service.GetAccountBalance(context.Background(), &pb.GetAccountBalanceRequest{
+		Account: &pb.Account{Type: pb.AccountType_ACCOUNT_TYPE_DRIVER, OwnerId: 7},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Balances, 2)
+	require.Equal(t, "PKR", resp.Balances[0].CurrencyCode)
+	require.Equal(t, int64(500), resp.Balances[0].Units)
+	require.Equal(t, "USD", resp.Balances[1].CurrencyCode)
+
+	invalid := []*pb.Account{
+		nil,
+		{Type: pb.AccountType_ACCOUNT_TYPE_UNSPECIFIED},
+		{Type: pb.AccountType_ACCOUNT_TYPE_RIDER},
+		{Type: pb.AccountType_ACCOUNT_TYPE_PLATFORM, OwnerId: 1},
+	}
+	for _, account := range invalid {
+		_, err := service.GetAccountBalance(context.Background(), &pb.GetAccountBalanceRequest{Account: account})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), "account %v", account)
+	}
+}
+
+func TestBookingService_GetAccountStatement(t *testing.T) {
+	lines := func(ids ...int64) []model.StatementLine {
+		var res []model.StatementLine
+		for _, id := range ids {
+			res = append(res, model.StatementLine{EntryID: id, BookingID: int32(id), Kind: model.EntryRideCompleted, Amount: pkr(-1000)})
+		}
+		return res
+	}
+
+	tests := []struct {
+		name              string
+		pageSize          int32
+		pageToken         string
+		expectedBefore    int64
+		expectedLimit     int
+		stored            []model.StatementLine
+		expectedEntries   []int64
+		expectedNextToken string
+		expectedCode      codes.Code
+	}{
+		{name: "Default Page Size", expectedLimit: 21, stored: lines(5, 4, 3), expectedEntries: []int64{5, 4, 3}, expectedCode: codes.OK},
+		{name: "More Pages", pageSize: 2, expectedLimit: 3, stored: lines(5, 4, 3), expectedEntries: []int64{5, 4},
+			expectedNextToken: "4", expectedCode: codes.OK},
+		{name: "Next Page", pageSize: 2, pageToken: "4", expectedBefore: 4, expectedLimit: 3, stored: lines(3),
+			expectedEntries: []int64{3}, expectedCode: codes.OK},
+		{name: "Invalid Page Token", pageToken: "abc", expectedCode: codes.InvalidArgument},
+		{name: "Negative Page Token", pageToken: "-1", expectedCode: codes.InvalidArgument},
+		{name: "Page Size Too Large", pageSize: 101, expectedCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			mockStore.On("ListStatement", mock.Anything, ledger.Rider(1), tt.expectedBefore, tt.expectedLimit).Return(tt.stored, nil).Maybe()
+			service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logrus.New())
+
+			resp, err := service.GetAccountStatement(context.Background(), &pb.GetAccountStatementRequest{
+				Account:   &pb.Account{Type: pb.AccountType_ACCOUNT_TYPE_RIDER, OwnerId: 1},
+				PageSize:  tt.pageSize,
+				PageToken: tt.pageToken,
+			})
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				mockStore.AssertNotCalled(t, "ListStatement", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			var entries []int64
+			for _, l := range resp.Lines {
+				entries = append(entries, l.EntryId)
+			}
+			require.Equal(t, tt.expectedEntries, entries)
+			require.Equal(t, tt.expectedNextToken, resp.NextPageToken)
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
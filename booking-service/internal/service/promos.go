@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/pricing"
+	"github.com/golang_falcon_task/booking-service/internal/promo"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+)
+
+var discountTypes = map[pb.DiscountType]string{
+	pb.DiscountType_DISCOUNT_TYPE_PERCENT: model.PromoPercent,
+	pb.DiscountType_DISCOUNT_TYPE_FLAT:    model.PromoFlat,
+}
+
+// vehicleClassProtos maps the tariff's vehicle classes to the API's.
+var vehicleClassProtos = map[string]pb.VehicleClass{
+	pricing.ClassEconomy: pb.VehicleClass_VEHICLE_CLASS_ECONOMY,
+	pricing.ClassComfort: pb.VehicleClass_VEHICLE_CLASS_COMFORT,
+	pricing.ClassXL:      pb.VehicleClass_VEHICLE_CLASS_XL,
+}
+
+// CreatePromo starts a promo code campaign. The code is stored upper-cased,
+// and its redemptions start at 0.
+func (s *BookingService) CreatePromo(ctx context.Context, req *pb.CreatePromoRequest) (*pb.CreatePromoResponse, error) {
+	// Input validation
+	if req.Promo == nil {
+		s.log.Error("Promo must be provided")
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("promo", "must be provided"))
+	}
+	p, err := promoFromProto(req.Promo)
+	if err != nil {
+		s.log.Error("Invalid promo", "code", req.Promo.Code, "error", err.Error())
+		return nil, err
+	}
+
+	if err := s.bookingStore.CreatePromo(ctx, p); err != nil {
+		s.log.Error("Failed to create promo", "code", p.Code, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to create promo %s", p.Code))
+	}
+
+	s.log.Info("Promo created", "code", p.Code, "type", p.Type, "starts_at", p.StartsAt, "ends_at", p.EndsAt)
+	return &pb.CreatePromoResponse{Promo: promoToProto(p)}, nil
+}
+
+// GetPromo returns a promo code and how often it was redeemed.
+func (s *BookingService) GetPromo(ctx context.Context, req *pb.GetPromoRequest) (*pb.GetPromoResponse, error) {
+	// Input validation
+	if req.Code == "" {
+		s.log.Error("Invalid code: must be provided")
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("code", "must be provided"))
+	}
+
+	code := promo.Normalize(req.Code)
+	p, err := s.bookingStore.GetPromo(ctx, code)
+	if err != nil {
+		s.log.Error("Failed to fetch promo", "code", code, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to fetch promo %s", code))
+	}
+	return &pb.GetPromoResponse{Promo: promoToProto(p)}, nil
+}
+
+// applyPromo returns the discount promo code gives the fare of quote at now.
+// Redemption limits are checked when the saga redeems the code.
+func (s *BookingService) applyPromo(ctx context.Context, code string, quote *model.Quote, now time.Time) (model.Discount, error) {
+	code = promo.Normalize(code)
+	p, err := s.bookingStore.GetPromo(ctx, code)
+	if err != nil {
+		return model.Discount{}, storeError(err, fmt.Sprintf("failed to fetch promo %s", code))
+	}
+
+	discount, err := promo.Apply(p, quote.Cost, quote.VehicleClass, now)
+	switch {
+	case errors.Is(err, promo.ErrNotActive):
+		return model.Discount{}, grpcerr.New(codes.FailedPrecondition, grpcerr.ReasonPromoNotActive, err.Error())
+	case err != nil:
+		return model.Discount{}, grpcerr.New(codes.FailedPrecondition, grpcerr.ReasonPromoNotEligible, err.Error())
+	}
+	return discount, nil
+}
+
+// promoFromProto converts an API promo to the model, returning an
+// InvalidArgument error if it is malformed. Its amounts must share a
+// currency, and those left unset are zero in it.
+func promoFromProto(req *pb.Promo) (*model.Promo, error) {
+	p := &model.Promo{
+		Code:           promo.Normalize(req.Code),
+		Type:           discountTypes[req.Type],
+		PercentOff:     req.PercentOff,
+		MaxRedemptions: req.MaxRedemptions,
+		MaxPerUser:     req.MaxRedemptionsPerUser,
+	}
+	if p.Type == "" {
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("promo.type", "must be PERCENT or FLAT"))
+	}
+
+	amounts := []struct {
+		field string
+		value *moneypb.Money
+		dest  *money.Money
+	}{
+		{"promo.amount_off", req.AmountOff, &p.AmountOff},
+		{"promo.max_discount", req.MaxDiscount, &p.MaxDiscount},
+		{"promo.min_fare", req.MinFare, &p.MinFare},
+	}
+	for _, a := range amounts {
+		if a.value == nil {
+			continue
+		}
+		m, err := money.FromProto(a.value)
+		if err != nil {
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation(a.field, err.Error()))
+		}
+		if p.Currency != "" && m.Currency != p.Currency {
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation(a.field, "must be in the same currency as the other amounts"))
+		}
+		p.Currency, *a.dest = m.Currency, m
+	}
+	for _, a := range amounts {
+		a.dest.Currency = p.Currency
+	}
+
+	for _, class := range req.VehicleClasses {
+		name, ok := vehicleClasses[class]
+		if !ok || class == pb.VehicleClass_VEHICLE_CLASS_UNSPECIFIED {
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("promo.vehicle_classes", "must be known vehicle classes"))
+		}
+		if !slices.Contains(p.VehicleClasses, name) {
+			p.VehicleClasses = append(p.VehicleClasses, name)
+		}
+	}
+
+	var err error
+	if p.StartsAt, err = time.Parse(time.RFC3339, req.StartsAt); err != nil {
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("promo.starts_at", "must be an RFC 3339 timestamp"))
+	}
+	if p.EndsAt, err = time.Parse(time.RFC3339, req.EndsAt); err != nil {
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("promo.ends_at", "must be an RFC 3339 timestamp"))
+	}
+
+	if err := promo.Validate(p); err != nil {
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("promo", err.Error()))
+	}
+	return p, nil
+}
+
+// promoToProto converts a promo to its API representation.
+func promoToProto(p *model.Promo) *pb.Promo {
+	res := &pb.Promo{
+		Code:                  p.Code,
+		PercentOff:            p.PercentOff,
+		StartsAt:              p.StartsAt.Format(time.RFC3339),
+		EndsAt:                p.EndsAt.Format(time.RFC3339),
+		MaxRedemptions:        p.MaxRedemptions,
+		MaxRedemptionsPerUser: p.MaxPerUser,
+		Redemptions:           p.Redemptions,
+	}
+	for t, name := range discountTypes {
+		if name == p.Type {
+			res.Type = t
+		}
+	}
+	if p.Type == model.PromoFlat {
+		res.AmountOff = p.AmountOff.Proto()
+	}
+	if !p.MaxDiscount.IsZero() {
+		res.MaxDiscount = p.MaxDiscount.Proto()
+	}
+	if !p.MinFare.IsZero() {
+		res.MinFare = p.MinFare.Proto()
+	}
+	for _, class := range p.VehicleClasses {
+		res.VehicleClasses = append(res.VehicleClasses, vehicleClassProtos[class])
+	}
+	return res
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/pricing"
+	"github.com/golang_falcon_task/booking-service/internal/service/mocks"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// newPromo returns a promo code taking 20% off fares, valid for an hour
+// either side of now.
+func newPromo() *model.Promo {
+	now := time.Now()
+	return &model.Promo{
+		Code: "WELCOME20", Type: model.PromoPercent, PercentOff: 20,
+		StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), MaxPerUser: 1,
+	}
+}
+
+// errorReason returns the ErrorInfo reason of a gRPC error.
+func errorReason(t *testing.T, err error) string {
+	t.Helper()
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	t.Fatalf("error %v has no ErrorInfo", err)
+	return ""
+}
+
+func TestBookingService_CreateBooking_Promo(t *testing.T) {
+	req := &pb.CreateBookingRequest{
+		UserId:    1,
+		Ride:      &pb.Ride{Source: "Downtown", Destination: "Airport", Pickup: downtown, Dropoff: airport},
+		QuoteId:   newQuote().ID,
+		PromoCode: "welcome20", // Codes are case-insensitive
+	}
+	discount := model.Discount{PromoCode: "WELCOME20", Fare: pkr(70000), Amount: pkr(14000)}
+
+	tests := []struct {
+		name           string
+		promo          func(p *model.Promo)
+		getErr         error
+		createErr      error
+		expectedCode   codes.Code
+		expectedReason string
+	}{
+		{name: "Success", promo: func(p *model.Promo) {}, expectedCode: codes.OK},
+		{name: "Promo Not Found", getErr: store.ErrPromoNotFound, expectedCode: codes.NotFound, expectedReason: grpcerr.ReasonPromoNotFound},
+		{name: "Promo Not Started", promo: func(p *model.Promo) { p.StartsAt = time.Now().Add(time.Minute) },
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonPromoNotActive},
+		{name: "Promo Ended", promo: func(p *model.Promo) { p.EndsAt = time.Now().Add(-time.Minute) },
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonPromoNotActive},
+		{name: "Vehicle Class Not Eligible", promo: func(p *model.Promo) { p.VehicleClasses = []string{pricing.ClassXL} },
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonPromoNotEligible},
+		{name: "Promo Exhausted", promo: func(p *model.Promo) {}, createErr: store.ErrPromoExhausted,
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonPromoExhausted},
+		{name: "Promo Limit Reached", promo: func(p *model.Promo) {}, createErr: store.ErrPromoLimitReached,
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonPromoLimitReached},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			mockStore.On("GetQuote", mock.Anything, newQuote().ID).Return(newQuote(), nil)
+			if tt.getErr != nil {
+				mockStore.On("GetPromo", mock.Anything, "WELCOME20").Return(nil, tt.getErr)
+			} else {
+				promo := newPromo()
+				tt.promo(promo)
+				mockStore.On("GetPromo", mock.Anything, "WELCOME20").Return(promo, nil)
+			}
+			mockStore.On("CreateSaga", mock.Anything, mock.MatchedBy(func(saga *model.BookingSaga) bool {
+				return saga.Discount == discount && saga.Ride.Cost == pkr(56000)
+			})).Return(tt.createErr).Maybe()
+			mockStore.On("UpdateSaga", mock.Anything, mock.Anything).Return(nil).Maybe()
+			mockStore.On("CompleteSaga", mock.Anything, mock.Anything, mock.Anything).Return(int32(1001), nil).Run(completeSaga).Maybe()
+
+			rides := &fakeRides{rideID: 101}
+			service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, rides, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logrus.New())
+
+			resp, err := service.CreateBooking(context.Background(), req)
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				require.Equal(t, tt.expectedReason, errorReason(t, err))
+				require.Empty(t, rides.created)
+				return
+			}
+			require.NoError(t, err)
+			expected := &pb.Discount{PromoCode: "WELCOME20", Fare: pkr(70000).Proto(), Amount: pkr(14000).Proto(), Total: pkr(56000).Proto()}
+			require.True(t, proto.Equal(expected, resp.Booking.Discount), "expected %v, got %v", expected, resp.Booking.Discount)
+			// The ride costs what the rider is charged.
+			require.True(t, proto.Equal(pkr(56000).Proto(), rides.created[0].Ride.Cost))
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBookingService_CreatePromo(t *testing.T) {
+	valid := func() *pb.Promo {
+		return &pb.Promo{
+			Code: "flat200", Type: pb.DiscountType_DISCOUNT_TYPE_FLAT,
+			AmountOff:      &money.Money{CurrencyCode: "PKR", Units: 200},
+			MinFare:        &money.Money{CurrencyCode: "PKR", Units: 300},
+			VehicleClasses: []pb.VehicleClass{pb.VehicleClass_VEHICLE_CLASS_COMFORT, pb.VehicleClass_VEHICLE_CLASS_XL, pb.VehicleClass_VEHICLE_CLASS_XL},
+			StartsAt:       "2025-01-01T00:00:00Z", EndsAt: "2025-02-01T00:00:00Z",
+			MaxRedemptions: 1000, MaxRedemptionsPerUser: 2,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		change        func(p *pb.Promo)
+		createErr     error
+		expectedCode  codes.Code
+		expectedField string
+	}{
+		{name: "Success", change: func(p *pb.Promo) {}, expectedCode: codes.OK},
+		{name: "Already Exists", change: func(p *pb.Promo) {}, createErr: store.ErrAlreadyExists, expectedCode: codes.AlreadyExists},
+		{name: "Unknown Type", change: func(p *pb.Promo) { p.Type = pb.DiscountType_DISCOUNT_TYPE_UNSPECIFIED },
+			expectedCode: codes.InvalidArgument, expectedField: "promo.type"},
+		{name: "Mixed Currencies", change: func(p *pb.Promo) { p.MinFare.CurrencyCode = "USD" },
+			expectedCode: codes.InvalidArgument, expectedField: "promo.min_fare"},
+		{name: "Sub-Paisa Amount", change: func(p *pb.Promo) { p.AmountOff.Nanos = 1 },
+			expectedCode: codes.InvalidArgument, expectedField: "promo.amount_off"},
+		{name: "Unspecified Vehicle Class", change: func(p *pb.Promo) { p.VehicleClasses = []pb.VehicleClass{pb.VehicleClass_VEHICLE_CLASS_UNSPECIFIED} },
+			expectedCode: codes.InvalidArgument, expectedField: "promo.vehicle_classes"},
+		{name: "Bad Timestamp", change: func(p *pb.Promo) { p.EndsAt = "next month" },
+			expectedCode: codes.InvalidArgument, expectedField: "promo.ends_at"},
+		{name: "Ends Before Start", change: func(p *pb.Promo) { p.EndsAt = "2024-12-01T00:00:00Z" },
+			expectedCode: codes.InvalidArgument, expectedField: "promo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			mockStore.On("CreatePromo", mock.Anything, mock.MatchedBy(func(p *model.Promo) bool {
+				return p.Code == "FLAT200" && p.Type == model.PromoFlat && p.Currency == "PKR" && p.AmountOff == pkr(20000) &&
+					p.MaxDiscount == pkr(0) && p.MinFare == pkr(30000) && len(p.VehicleClasses) == 2
+			})).Return(tt.createErr).Maybe()
+			service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logrus.New())
+
+			promo := valid()
+			tt.change(promo)
+			resp, err := service.CreatePromo(context.Background(), &pb.CreatePromoRequest{Promo: promo})
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				if tt.expectedField != "" {
+					var badRequest *errdetails.BadRequest
+					for _, d := range status.Convert(err).Details() {
+						if b, ok := d.(*errdetails.BadRequest); ok {
+							badRequest = b
+						}
+					}
+					require.NotNil(t, badRequest)
+					require.Equal(t, tt.expectedField, badRequest.FieldViolations[0].Field)
+					mockStore.AssertNotCalled(t, "CreatePromo", mock.Anything, mock.Anything)
+				}
+				return
+			}
+			require.NoError(t, err)
+			expected := valid()
+			expected.Code = "FLAT200"
+			expected.VehicleClasses = expected.VehicleClasses[:2]
+			require.True(t, proto.Equal(expected, resp.Promo), "expected %v, got %v", expected, resp.Promo)
+		})
+	}
+}
+
+func TestBookingService_GetPromo(t *testing.T) {
+	mockStore := new(mocks.BookingStore)
+	promo := newPromo()
+	promo.Redemptions = 3
+	mockStore.On("GetPromo", mock.Anything, "WELCOME20").Return(promo, nil)
+	mockStore.On("GetPromo", mock.Anything, "UNKNOWN").Return(nil, store.ErrPromoNotFound)
+	service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logrus.New())
+
+	resp, err := service.GetPromo(context.Background(), &pb.GetPromoRequest{Code: "Welcome20"})
+	require.NoError(t, err)
+	require.Equal(t, "WELCOME20", resp.Promo.Code)
+	require.Equal(t, pb.DiscountType_DISCOUNT_TYPE_PERCENT, resp.Promo.Type)
+	require.Equal(t, int32(20), resp.Promo.PercentOff)
+	require.Equal(t, int32(3), resp.Promo.Redemptions)
+	require.Nil(t, resp.Promo.AmountOff)
+	require.Nil(t, resp.Promo.MaxDiscount)
+
+	_, err = service.GetPromo(context.Background(), &pb.GetPromoRequest{Code: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, grpcerr.ReasonPromoNotFound, errorReason(t, err))
+}
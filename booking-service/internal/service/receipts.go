@@ -0,0 +1,92 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/receipt"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// GetReceipt renders the receipt of a booking the rider paid for as HTML or
+// PDF, with money and times formatted for the requested locale and time
+// zone. The receipt number is derived from the booking, so it is the same
+// however often the receipt is rendered.
+func (s *BookingService) GetReceipt(ctx context.Context, req *pb.GetReceiptRequest) (*httpbody.HttpBody, error) {
+	// Input validation
+	if req.BookingId <= 0 {
+		s.log.Error("Invalid booking_id: must be a positive integer", "booking_id", req.BookingId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("booking_id", "must be a positive integer"))
+	}
+	locale, err := receipt.NewLocale(req.Locale, req.TimeZone)
+	if err != nil {
+		s.log.Error("Invalid locale", "booking_id", req.BookingId, "locale", req.Locale, "time_zone", req.TimeZone, "error", err.Error())
+		if errors.Is(err, receipt.ErrUnknownTimeZone) {
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("time_zone", "must be an IANA time zone"))
+		}
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("locale", "must be a BCP 47 language tag"))
+	}
+
+	booking, user, ride, err := s.bookingStore.GetBookingDetails(ctx, req.BookingId)
+	if err != nil {
+		s.log.Error("Failed to fetch booking", "booking_id", req.BookingId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to fetch booking with id %d", req.BookingId))
+	}
+
+	var quote *model.Quote
+	if booking.QuoteID != "" {
+		if quote, err = s.bookingStore.GetQuote(ctx, booking.QuoteID); err != nil {
+			s.log.Error("Failed to fetch fare quote", "booking_id", booking.ID, "quote_id", booking.QuoteID, "error", err.Error())
+			return nil, storeError(err, fmt.Sprintf("failed to fetch fare quote %s", booking.QuoteID))
+		}
+	}
+
+	r, err := receipt.New(booking, user, ride, quote)
+	if errors.Is(err, receipt.ErrNoReceipt) {
+		s.log.Error("Booking has no receipt", "booking_id", req.BookingId, "status", booking.Status)
+		return nil, grpcerr.New(codes.FailedPrecondition, grpcerr.ReasonNoReceipt,
+			fmt.Sprintf("booking %d is %s and was not charged", req.BookingId, booking.Status))
+	}
+	if err != nil {
+		s.log.Error("Failed to make receipt", "booking_id", req.BookingId, "error", err.Error())
+		return nil, grpcerr.New(codes.Internal, grpcerr.ReasonRenderError, "failed to make receipt")
+	}
+
+	var body bytes.Buffer
+	contentType := receipt.ContentTypeHTML
+	if req.Format == pb.ReceiptFormat_RECEIPT_FORMAT_PDF {
+		contentType = receipt.ContentTypePDF
+		err = r.PDF(&body, locale)
+	} else {
+		err = r.HTML(&body, locale)
+	}
+	if err != nil {
+		s.log.Error("Failed to render receipt", "booking_id", req.BookingId, "format", req.Format.String(), "error", err.Error())
+		return nil, grpcerr.New(codes.Internal, grpcerr.ReasonRenderError, "failed to render receipt")
+	}
+
+	details, err := anypb.New(&pb.Receipt{
+		ReceiptNumber: r.Number,
+		BookingId:     r.BookingID,
+		Total:         r.Total.Proto(),
+	})
+	if err != nil {
+		s.log.Error("Failed to describe receipt", "booking_id", req.BookingId, "error", err.Error())
+		return nil, grpcerr.New(codes.Internal, grpcerr.ReasonRenderError, "failed to describe receipt")
+	}
+
+	s.log.Info("Receipt rendered", "booking_id", req.BookingId, "receipt_number", r.Number, "format", req.Format.String(), "locale", locale.Language())
+
+	return &httpbody.HttpBody{
+		ContentType: contentType,
+		Data:        body.Bytes(),
+		Extensions:  []*anypb.Any{details},
+	}, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/payments"
+	"github.com/golang_falcon_task/booking-service/internal/service/mocks"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBookingService_GetReceipt(t *testing.T) {
+	completed := func() *model.Booking {
+		return &model.Booking{ID: 1001, UserID: 1, RideID: 101, DriverID: 7, Status: model.BookingCompleted,
+			Timestamp: time.Date(2025, 3, 14, 16, 30, 0, 0, time.UTC), ChargeID: "fake_ch_1"}
+	}
+	ride := &model.Ride{ID: 101, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(62500)}
+	quote := &model.Quote{ID: "quote-1", VehicleClass: "economy", DistanceKm: 15, Cost: pkr(62500), Surge: 1,
+		BaseFare: pkr(10000), DistanceFare: pkr(45000), TimeFare: pkr(7500), DurationMinutes: 15, Multiplier: 1}
+
+	tests := []struct {
+		name                string
+		req                 *pb.GetReceiptRequest
+		booking             func(b *model.Booking)
+		storeErr            error
+		quoteErr            error
+		expectedCode        codes.Code
+		expectedReason      string
+		expectedContentType string
+		expectedTotal       int64
+		expectedBody        string
+	}{
+		{name: "HTML", req: &pb.GetReceiptRequest{BookingId: 1001, Locale: "en-PK", TimeZone: "Asia/Karachi"}, booking: func(b *model.Booking) {},
+			expectedCode: codes.OK, expectedContentType: "text/html; charset=utf-8", expectedTotal: 62500, expectedBody: "14 March 2025 9:30 PM PKT"},
+		{name: "PDF", req: &pb.GetReceiptRequest{BookingId: 1001, Format: pb.ReceiptFormat_RECEIPT_FORMAT_PDF}, booking: func(b *model.Booking) {},
+			expectedCode: codes.OK, expectedContentType: "application/pdf", expectedTotal: 62500, expectedBody: "%PDF-"},
+		{name: "Itemized", req: &pb.GetReceiptRequest{BookingId: 1001}, booking: func(b *model.Booking) { b.QuoteID, b.Cost = "quote-1", pkr(62500) },
+			expectedCode: codes.OK, expectedContentType: "text/html; charset=utf-8", expectedTotal: 62500, expectedBody: "Distance, 15 km"},
+		{name: "Quote Unavailable", req: &pb.GetReceiptRequest{BookingId: 1001}, booking: func(b *model.Booking) { b.QuoteID = "quote-1" },
+			quoteErr: errors.New("connection reset"), expectedCode: codes.Internal, expectedReason: grpcerr.ReasonDatabaseError},
+		{name: "Refunded Cancellation Fee", req: &pb.GetReceiptRequest{BookingId: 1001}, booking: func(b *model.Booking) {
+			b.Status, b.CancellationReason, b.CancellationFee = model.BookingCancelled, model.CancelledByRider, pkr(10000)
+			b.Refunded, b.RefundReason = pkr(4000), model.RefundFeeWaived
+		}, expectedCode: codes.OK, expectedContentType: "text/html; charset=utf-8", expectedTotal: 6000, expectedBody: "Cancellation fee"},
+		{name: "Invalid Booking ID", req: &pb.GetReceiptRequest{}, expectedCode: codes.InvalidArgument},
+		{name: "Invalid Locale", req: &pb.GetReceiptRequest{BookingId: 1001, Locale: "not a tag!"}, expectedCode: codes.InvalidArgument},
+		{name: "Unknown Time Zone", req: &pb.GetReceiptRequest{BookingId: 1001, TimeZone: "Mars/Olympus_Mons"}, expectedCode: codes.InvalidArgument},
+		{name: "Not Found", req: &pb.GetReceiptRequest{BookingId: 1001}, storeErr: store.ErrBookingNotFound,
+			expectedCode: codes.NotFound, expectedReason: grpcerr.ReasonBookingNotFound},
+		{name: "Not Charged", req: &pb.GetReceiptRequest{BookingId: 1001}, booking: func(b *model.Booking) { b.Status = model.BookingConfirmed },
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonNoReceipt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			if tt.storeErr != nil {
+				mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(nil, nil, nil, tt.storeErr)
+			} else if tt.booking != nil {
+				booking := completed()
+				tt.booking(booking)
+				mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(booking, &model.User{ID: 1, Name: "John Doe"}, ride, nil)
+				if booking.QuoteID != "" {
+					mockStore.On("GetQuote", mock.Anything, booking.QuoteID).Return(quote, tt.quoteErr)
+				}
+			}
+			service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge,
+				payments.Config{Provider: payments.NewFakeProvider()}, testSchedule, logrus.New())
+
+			resp, err := service.GetReceipt(context.Background(), tt.req)
+			mockStore.AssertExpectations(t)
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				if tt.expectedReason != "" {
+					require.Equal(t, tt.expectedReason, errorReason(t, err))
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedContentType, resp.ContentType)
+			require.True(t, bytes.Contains(resp.Data, []byte(tt.expectedBody)), "body should contain %q", tt.expectedBody)
+
+			require.Len(t, resp.Extensions, 1)
+			receipt := new(pb.Receipt)
+			require.NoError(t, resp.Extensions[0].UnmarshalTo(receipt))
+			require.Equal(t, "R-20250314-001001", receipt.ReceiptNumber)
+			require.Equal(t, int32(1001), receipt.BookingId)
+			require.Equal(t, pkr(tt.expectedTotal).Proto(), receipt.Total)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/ledger"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/payments"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// RefundBooking gives the rider of a completed or cancelled booking back
+// part or all of what is left of their charge, the fare or the cancellation
+// fee, and records the refund's reason on the booking. Wallet bookings are
+// refunded into the wallet, others through the payment provider. Refunds
+// are idempotent per total refunded, so a retry after a failure to store
+// the refund does not refund twice.
+func (s *BookingService) RefundBooking(ctx context.Context, req *pb.RefundBookingRequest) (*pb.RefundBookingResponse, error) {
+	// Input validation
+	if req.BookingId <= 0 {
+		s.log.Error("Invalid booking_id: must be a positive integer", "booking_id", req.BookingId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("booking_id", "must be a positive integer"))
+	}
+	reason := store.RefundReasonFromProto(req.Reason)
+	if reason == "" {
+		s.log.Error("Invalid reason", "booking_id", req.BookingId, "reason", req.Reason.String())
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("reason", "must be a known refund reason"))
+	}
+
+	booking, _, ride, err := s.bookingStore.GetBookingDetails(ctx, req.BookingId)
+	if err != nil {
+		s.log.Error("Failed to fetch booking", "booking_id", req.BookingId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to fetch booking with id %d", req.BookingId))
+	}
+	var charged money.Money
+	switch booking.Status {
+	case model.BookingCompleted:
+		charged = booking.Fare(ride)
+	case model.BookingCancelled:
+		charged = booking.CancellationFee
+	}
+	left := money.Money{Currency: charged.Currency, Minor: charged.Minor - booking.Refunded.Minor}
+	if booking.ChargeID == "" || left.Minor <= 0 {
+		s.log.Error("Booking has nothing left to refund", "booking_id", booking.ID, "status", booking.Status, "refunded", booking.Refunded.String())
+		return nil, storeError(store.ErrBookingNotRefundable, fmt.Sprintf("booking %d has nothing left to refund", booking.ID))
+	}
+	amount := left
+	if req.Amount != nil {
+		if amount, err = money.FromProto(req.Amount); err != nil {
+			s.log.Error("Invalid amount", "booking_id", booking.ID, "error", err.Error())
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("amount", err.Error()))
+		}
+		if amount.Currency != left.Currency || amount.Minor <= 0 || amount.Minor > left.Minor {
+			s.log.Error("Invalid amount", "booking_id", booking.ID, "amount", amount.String(), "left", left.String())
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("amount", fmt.Sprintf("must be positive and at most the %s left to refund", left)))
+		}
+	}
+
+	refundID, err := s.refund(ctx, booking, amount, reason)
+	if err != nil {
+		s.log.Error("Failed to refund rider", "booking_id", booking.ID, "user_id", booking.UserID, "amount", amount.String(), "error", err.Error())
+		return nil, err
+	}
+	entry, err := ledger.Refund(booking, amount, refundID)
+	if err != nil {
+		s.log.Error("Failed to build journal entry", "booking_id", booking.ID, "error", err.Error())
+		return nil, grpcerr.New(codes.Internal, grpcerr.ReasonLedgerError, fmt.Sprintf("failed to record refund for booking %d: %v", booking.ID, err))
+	}
+	refunded, err := s.bookingStore.RefundBooking(ctx, &model.Refund{
+		BookingID: booking.ID,
+		Amount:    amount,
+		Reason:    reason,
+		Previous:  booking.Refunded,
+	}, entry, time.Now())
+	if err != nil {
+		s.log.Error("Failed to store refund", "booking_id", booking.ID, "refund_id", refundID, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to refund booking with id %d", booking.ID))
+	}
+
+	s.log.Info("Booking refunded", "booking_id", refunded.ID, "refund_id", refundID, "amount", amount.String(), "reason", reason)
+	return &pb.RefundBookingResponse{
+		Booking: store.BookingToProto(refunded),
+		Entry:   store.JournalEntryToProto(entry),
+	}, nil
+}
+
+// refund gives amount back to the rider of booking: into their wallet from
+// the booking's captured hold, or through the payment provider. Both are
+// keyed by the total refunded once amount is, so retrying a refund does not
+// repeat it. It returns the reference of the refund.
+func (s *BookingService) refund(ctx context.Context, booking *model.Booking, amount money.Money, reason string) (string, error) {
+	total := money.Money{Currency: amount.Currency, Minor: booking.Refunded.Minor + amount.Minor}
+	if booking.PaymentMethod == model.PaymentWallet {
+		res, err := s.users.RefundHold(ctx, &userpb.RefundHoldRequest{HoldId: booking.HoldID, Refunded: total.Proto()})
+		if err != nil {
+			// The user service returns status errors.
+			return "", err
+		}
+		return fmt.Sprintf("wallet-hold-%d", res.Hold.HoldId), nil
+	}
+
+	refund, err := s.payments.Provider.Refund(ctx, payments.RefundRequest{
+		IdempotencyKey: fmt.Sprintf("booking-%d-refund-%d", booking.ID, total.Minor),
+		ChargeID:       booking.ChargeID,
+		Amount:         amount,
+		Reason:         reason,
+	})
+	if err != nil {
+		return "", paymentError("refund", err)
+	}
+	return refund.ID, nil
+}
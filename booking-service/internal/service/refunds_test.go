@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/ledger"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/payments"
+	"github.com/golang_falcon_task/booking-service/internal/service/mocks"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBookingService_RefundBooking(t *testing.T) {
+	completed := func() *model.Booking {
+		return &model.Booking{ID: 1001, UserID: 1, RideID: 101, DriverID: 7, Status: model.BookingCompleted, Timestamp: time.Now(),
+			ChargeID: "fake_ch_1"}
+	}
+	ride := &model.Ride{ID: 101, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(62500)}
+
+	tests := []struct {
+		name           string
+		booking        func(b *model.Booking)
+		amount         *moneypb.Money
+		reason         pb.RefundReason
+		refundErr      error
+		expectedCode   codes.Code
+		expectedReason string
+		expectedAmount int64
+		expectWallet   []*userpb.RefundHoldRequest
+	}{
+		{name: "Full Refund", booking: func(b *model.Booking) {}, reason: pb.RefundReason_REFUND_REASON_SERVICE_ISSUE,
+			expectedCode: codes.OK, expectedAmount: 62500},
+		{name: "Partial Refund", booking: func(b *model.Booking) {}, amount: pkr(10000).Proto(), reason: pb.RefundReason_REFUND_REASON_FARE_DISPUTE,
+			expectedCode: codes.OK, expectedAmount: 10000},
+		{name: "Rest After Partial Refund", booking: func(b *model.Booking) { b.Refunded, b.RefundReason = pkr(10000), model.RefundFareDispute },
+			reason: pb.RefundReason_REFUND_REASON_OTHER, expectedCode: codes.OK, expectedAmount: 52500},
+		{name: "Cancellation Fee", booking: func(b *model.Booking) {
+			b.Status, b.CancellationReason, b.CancellationFee = model.BookingCancelled, model.CancelledNoShow, pkr(20000)
+		}, reason: pb.RefundReason_REFUND_REASON_FEE_WAIVED, expectedCode: codes.OK, expectedAmount: 20000},
+		{name: "Wallet", booking: func(b *model.Booking) {
+			b.PaymentMethod, b.HoldID, b.ChargeID, b.Refunded = model.PaymentWallet, 9, "wallet-hold-9", pkr(10000)
+		}, amount: pkr(5000).Proto(), reason: pb.RefundReason_REFUND_REASON_SERVICE_ISSUE, expectedCode: codes.OK, expectedAmount: 5000,
+			expectWallet: []*userpb.RefundHoldRequest{{HoldId: 9, Refunded: pkr(15000).Proto()}}},
+		{name: "Invalid Reason", booking: func(b *model.Booking) {}, expectedCode: codes.InvalidArgument},
+		{name: "More Than Left", booking: func(b *model.Booking) { b.Refunded = pkr(60000) }, amount: pkr(5000).Proto(),
+			reason: pb.RefundReason_REFUND_REASON_OTHER, expectedCode: codes.InvalidArgument},
+		{name: "Other Currency", booking: func(b *model.Booking) {}, amount: money.Money{Currency: "USD", Minor: 100}.Proto(),
+			reason: pb.RefundReason_REFUND_REASON_OTHER, expectedCode: codes.InvalidArgument},
+		{name: "Fully Refunded", booking: func(b *model.Booking) { b.Refunded = pkr(62500) }, reason: pb.RefundReason_REFUND_REASON_OTHER,
+			expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonBookingNotRefundable},
+		{name: "Cancelled For Free", booking: func(b *model.Booking) { b.Status, b.ChargeID = model.BookingCancelled, "" },
+			reason: pb.RefundReason_REFUND_REASON_OTHER, expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonBookingNotRefundable},
+		{name: "Confirmed", booking: func(b *model.Booking) { b.Status, b.ChargeID = model.BookingConfirmed, "" },
+			reason: pb.RefundReason_REFUND_REASON_OTHER, expectedCode: codes.FailedPrecondition, expectedReason: grpcerr.ReasonBookingNotRefundable},
+		{name: "Provider Failed", booking: func(b *model.Booking) { b.ChargeID = "fake_ch_missing" }, reason: pb.RefundReason_REFUND_REASON_OTHER,
+			expectedCode: codes.Unavailable, expectedReason: grpcerr.ReasonPaymentFailed},
+		{name: "Refunded Concurrently", booking: func(b *model.Booking) {}, reason: pb.RefundReason_REFUND_REASON_OTHER,
+			refundErr: store.ErrRefundConflict, expectedCode: codes.Aborted, expectedReason: grpcerr.ReasonRefundConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := completed()
+			tt.booking(booking)
+			mockStore := new(mocks.BookingStore)
+			mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(booking, &model.User{ID: 1}, ride, nil).Maybe()
+			mockStore.On("RefundBooking", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(func(ctx context.Context, refund *model.Refund, entry *model.JournalEntry, now time.Time) (*model.Booking, error) {
+					if tt.refundErr != nil {
+						return nil, tt.refundErr
+					}
+					entry.ID, entry.CreatedAt = 1, now
+					refunded := *booking
+					refunded.Refunded = pkr(booking.Refunded.Minor + refund.Amount.Minor)
+					refunded.RefundReason = refund.Reason
+					return &refunded, nil
+				}).Maybe()
+
+			provider := payments.NewFakeProvider()
+			_, err := provider.Charge(context.Background(), payments.ChargeRequest{IdempotencyKey: "booking-1001", UserID: 1, Amount: pkr(62500)})
+			require.NoError(t, err)
+			users := &fakeUsers{}
+			service := NewBookingService(mockStore, outbox.NewMemStore(), users, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge,
+				payments.Config{Provider: provider, CommissionRate: 0.2}, testSchedule, logrus.New())
+
+			resp, err := service.RefundBooking(context.Background(), &pb.RefundBookingRequest{BookingId: 1001, Amount: tt.amount, Reason: tt.reason})
+			require.Equal(t, tt.expectWallet, users.refunded)
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				if tt.expectedReason != "" {
+					require.Equal(t, tt.expectedReason, errorReason(t, err))
+				}
+				if tt.refundErr == nil {
+					require.Empty(t, provider.Refunds())
+					mockStore.AssertNotCalled(t, "RefundBooking", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, pkr(booking.Refunded.Minor+tt.expectedAmount).Proto(), resp.Booking.Refunded)
+			require.Equal(t, tt.reason, resp.Booking.RefundReason)
+			require.Equal(t, pb.JournalEntryKind_JOURNAL_ENTRY_KIND_REFUND, resp.Entry.Kind)
+
+			entry := mockStore.Calls[1].Arguments.Get(2).(*model.JournalEntry)
+			require.Equal(t, []model.Posting{
+				{Account: ledger.Rider(1), Amount: pkr(tt.expectedAmount)},
+				{Account: ledger.Platform, Amount: pkr(-tt.expectedAmount)},
+			}, entry.Postings)
+			refund := mockStore.Calls[1].Arguments.Get(1).(*model.Refund)
+			require.Equal(t, booking.Refunded, refund.Previous)
+
+			if tt.expectWallet != nil {
+				require.Empty(t, provider.Refunds())
+				require.Equal(t, "wallet-hold-9", entry.Reference)
+				return
+			}
+			refunds := provider.Refunds()
+			require.Len(t, refunds, 1)
+			require.Equal(t, pkr(tt.expectedAmount), refunds[0].Amount)
+			require.Equal(t, "fake_ch_1", refunds[0].ChargeID)
+			require.Equal(t, refunds[0].ID, entry.Reference)
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/grpcerr"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// watchPollInterval is how long a watch waits for a change notification
+// before reading the feed anyway, in case a notification was lost.
+const watchPollInterval = 5 * time.Second
+
+// watchBatchSize is how many feed messages a watch reads at once.
+const watchBatchSize = 100
+
+var (
+	sagaUpdatedTopic          = string(proto.MessageName(&pb.BookingSagaUpdated{}))
+	bookingStatusChangedTopic = string(proto.MessageName(&pb.BookingStatusChanged{}))
+	rideUpdatedTopic          = string(proto.MessageName(&ridepb.RideUpdated{}))
+)
+
+// WatchBooking streams the state of a booking saga and its ride as they change.
+func (s *BookingService) WatchBooking(req *pb.WatchBookingRequest, stream grpc.ServerStreamingServer[pb.WatchBookingResponse]) error {
+	return s.Watch(stream.Context(), req, stream.Send)
+}
+
+// Watch implements WatchBooking for any transport, sending each update with
+// send. It follows the event feed: BookingSagaUpdated events of the saga,
+// BookingStatusChanged events of its booking and RideUpdated events of its
+// ride. A resume token is the feed position of the update that carried it.
+//
+// The feed can pass over an event whose transaction committed after a later
+// one was read, so each time the watch wakes it also re-reads the saga and
+// booking and sends whatever the events left out.
+func (s *BookingService) Watch(ctx context.Context, req *pb.WatchBookingRequest, send func(*pb.WatchBookingResponse) error) error {
+	// Input validation
+	if req.SagaId == "" {
+		s.log.Error("Invalid saga_id: must be provided")
+		return grpcerr.InvalidArgument(grpcerr.FieldViolation("saga_id", "must be provided"))
+	}
+	var seq int64
+	if req.ResumeToken != "" {
+		var err error
+		if seq, err = strconv.ParseInt(req.ResumeToken, 10, 64); err != nil || seq < 0 {
+			s.log.Error("Invalid resume_token", "resume_token", req.ResumeToken)
+			return grpcerr.InvalidArgument(grpcerr.FieldViolation("resume_token", "must be a token returned by WatchBooking"))
+		}
+	}
+
+	// Take the notification channel before reading anything, so a change
+	// made while reading still wakes the watch.
+	changed := s.feed.Changed()
+
+	if req.ResumeToken == "" {
+		head, err := s.feed.Head(ctx)
+		if err != nil {
+			s.log.Error("Failed to read event feed", "error", err.Error())
+			return grpcerr.New(codes.Unavailable, grpcerr.ReasonDatabaseError, fmt.Sprintf("failed to read event feed: %v", err),
+				grpcerr.Retry(grpcerr.DefaultRetryDelay))
+		}
+		seq = head
+	}
+
+	saga, err := s.bookingStore.GetSaga(ctx, req.SagaId)
+	if err != nil {
+		s.log.Error("Failed to fetch booking saga", "saga_id", req.SagaId, "error", err.Error())
+		return storeError(err, fmt.Sprintf("failed to fetch booking saga %s", req.SagaId))
+	}
+
+	current := store.SagaToProto(saga)
+	w := &bookingWatch{saga: current, ride: current.Ride, version: -1}
+	if saga.BookingID != 0 {
+		booking, _, _, err := s.bookingStore.GetBookingDetails(ctx, saga.BookingID)
+		if err != nil {
+			s.log.Error("Failed to fetch booking details", "saga_id", req.SagaId, "booking_id", saga.BookingID, "error", err.Error())
+			return storeError(err, fmt.Sprintf("failed to fetch booking with id %d", saga.BookingID))
+		}
+		w.bookingStatus = store.BookingStatusToProto(booking.Status)
+	}
+	if req.ResumeToken == "" {
+		// Start with the current state. Events up to head are already part of it.
+		if err := send(w.update(seq)); err != nil {
+			return err
+		}
+		w.version = saga.Version
+		if saga.Status == model.SagaFailed {
+			return nil
+		}
+	} else if saga.Status == model.SagaFailed {
+		// A client resuming after the final update has nothing left to see,
+		// but one that missed it gets it replayed from the feed.
+		w.endOnFailure = true
+	}
+
+	s.log.Info("Watching booking saga", "saga_id", req.SagaId, "seq", seq)
+	woken := false
+	for {
+		msgs, err := s.feed.Since(ctx, seq, watchBatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			s.log.Error("Failed to read event feed", "saga_id", req.SagaId, "error", err.Error())
+			return grpcerr.New(codes.Unavailable, grpcerr.ReasonDatabaseError, fmt.Sprintf("failed to read event feed: %v", err),
+				grpcerr.Retry(grpcerr.DefaultRetryDelay))
+		}
+
+		for _, msg := range msgs {
+			seq = msg.Seq
+			if !w.apply(msg.Topic, msg.Payload) {
+				continue
+			}
+			if err := send(w.update(seq)); err != nil {
+				return err
+			}
+			if w.saga.Status == pb.SagaStatus_SAGA_STATUS_FAILED {
+				return nil
+			}
+		}
+		if w.endOnFailure && len(msgs) < watchBatchSize {
+			return nil
+		}
+		if len(msgs) == watchBatchSize {
+			continue
+		}
+		if woken {
+			updated, err := s.reconcile(ctx, w)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			if updated {
+				if err := send(w.update(seq)); err != nil {
+					return err
+				}
+				if w.saga.Status == pb.SagaStatus_SAGA_STATUS_FAILED {
+					return nil
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		case <-time.After(watchPollInterval):
+		}
+		changed = s.feed.Changed()
+		woken = true
+	}
+}
+
+// reconcile re-reads the saga and its booking and folds whatever changed
+// since the last update into w, reporting whether the client sees a change.
+// It catches up on events the feed passed over.
+func (s *BookingService) reconcile(ctx context.Context, w *bookingWatch) (bool, error) {
+	saga, err := s.bookingStore.GetSaga(ctx, w.saga.SagaId)
+	if err != nil {
+		s.log.Error("Failed to fetch booking saga", "saga_id", w.saga.SagaId, "error", err.Error())
+		return false, storeError(err, fmt.Sprintf("failed to fetch booking saga %s", w.saga.SagaId))
+	}
+
+	updated := false
+	if saga.Version > w.version {
+		current := store.SagaToProto(saga)
+		w.saga, w.version = current, current.Version
+		if current.Ride.GetRideId() != w.ride.GetRideId() {
+			w.ride = current.Ride
+		}
+		updated = true
+	}
+	if saga.BookingID == 0 {
+		return updated, nil
+	}
+
+	booking, _, _, err := s.bookingStore.GetBookingDetails(ctx, saga.BookingID)
+	if err != nil {
+		s.log.Error("Failed to fetch booking details", "saga_id", saga.ID, "booking_id", saga.BookingID, "error", err.Error())
+		return false, storeError(err, fmt.Sprintf("failed to fetch booking with id %d", saga.BookingID))
+	}
+	if bookingStatus := store.BookingStatusToProto(booking.Status); bookingStatus != w.bookingStatus {
+		w.bookingStatus = bookingStatus
+		updated = true
+	}
+	return updated, nil
+}
+
+// bookingWatch is the state of one WatchBooking stream.
+type bookingWatch struct {
+	saga          *pb.BookingSaga
+	ride          *pb.Ride
+	bookingStatus pb.BookingStatus
+	version       int32 // Version of the last saga update sent, -1 if none
+
+	// endOnFailure ends a resumed watch of a failed saga once the feed is
+	// read, whether or not the failure was replayed.
+	endOnFailure bool
+}
+
+// apply folds a feed message into the watch and reports whether it changed
+// what the client sees.
+func (w *bookingWatch) apply(topic string, payload []byte) bool {
+	switch topic {
+	case sagaUpdatedTopic:
+		var event pb.BookingSagaUpdated
+		if proto.Unmarshal(payload, &event) != nil || event.Saga.GetSagaId() != w.saga.SagaId || event.Saga.Version <= w.version {
+			return false
+		}
+		w.saga, w.version = event.Saga, event.Saga.Version
+		if event.Saga.Ride.GetRideId() != w.ride.GetRideId() {
+			w.ride = event.Saga.Ride
+		}
+		if event.Saga.BookingId != 0 && w.bookingStatus == pb.BookingStatus_BOOKING_STATUS_UNSPECIFIED {
+			// Bookings are created pending, or scheduled for a later pickup.
+			w.bookingStatus = pb.BookingStatus_BOOKING_STATUS_PENDING
+			if event.Saga.PickupTime != "" {
+				w.bookingStatus = pb.BookingStatus_BOOKING_STATUS_SCHEDULED
+			}
+		}
+		return true
+
+	case bookingStatusChangedTopic:
+		var event pb.BookingStatusChanged
+		if proto.Unmarshal(payload, &event) != nil || w.saga.BookingId == 0 || event.BookingId != w.saga.BookingId {
+			return false
+		}
+		w.bookingStatus = event.Status
+		return true
+
+	case rideUpdatedTopic:
+		var event ridepb.RideUpdated
+		if proto.Unmarshal(payload, &event) != nil || w.saga.Ride.GetRideId() == 0 || event.Ride.GetRideId() != w.saga.Ride.GetRideId() {
+			return false
+		}
+		w.ride = &pb.Ride{
+			RideId:      event.Ride.RideId,
+			Source:      event.Ride.Source,
+			Destination: event.Ride.Destination,
+			Distance:    event.Ride.Distance,
+			Cost:        event.Ride.Cost,
+			DriverId:    event.Ride.DriverId,
+			Pickup:      event.Ride.Pickup,
+			Dropoff:     event.Ride.Dropoff,
+		}
+		return true
+	}
+	return false
+}
+
+// update is the response telling the client the current state at feed position seq.
+func (w *bookingWatch) update(seq int64) *pb.WatchBookingResponse {
+	return &pb.WatchBookingResponse{
+		ResumeToken:   strconv.FormatInt(seq, 10),
+		Saga:          w.saga,
+		Ride:          w.ride,
+		BookingStatus: w.bookingStatus,
+	}
+}
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/service/mocks"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"sync"
+	"testing"
+	"time"
+)
+
+// addEvent appends event to feed, as the stores do when they save a change.
+func addEvent(t *testing.T, feed *outbox.MemStore, event proto.Message) {
+	t.Helper()
+	msg, err := outbox.NewMessage(event)
+	require.NoError(t, err)
+	feed.Add(msg)
+}
+
+// sagaEvent is the BookingSagaUpdated event for saga.
+func sagaEvent(saga model.BookingSaga) *pb.BookingSagaUpdated {
+	return &pb.BookingSagaUpdated{Saga: store.SagaToProto(&saga)}
+}
+
+// watch runs Watch in the background and returns the updates it sends and
+// the error it returns.
+func watch(ctx context.Context, service *BookingService, req *pb.WatchBookingRequest) (<-chan *pb.WatchBookingResponse, <-chan error) {
+	updates := make(chan *pb.WatchBookingResponse, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- service.Watch(ctx, req, func(resp *pb.WatchBookingResponse) error {
+			updates <- resp
+			return nil
+		})
+	}()
+	return updates, done
+}
+
+// uncommittedFeed is a feed whose messages at the given seqs are not yet
+// visible, as if written by transactions that have not committed.
+type uncommittedFeed struct {
+	*outbox.MemStore
+	mu     sync.Mutex
+	hidden map[int64]bool
+}
+
+func (f *uncommittedFeed) Since(ctx context.Context, seq int64, limit int) ([]outbox.Message, error) {
+	msgs, err := f.MemStore.Since(ctx, seq, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	visible := msgs[:0]
+	for _, msg := range msgs {
+		if !f.hidden[msg.Seq] {
+			visible = append(visible, msg)
+		}
+	}
+	return visible, nil
+}
+
+func (f *uncommittedFeed) commit(seq int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.hidden, seq)
+}
+
+func receive(t *testing.T, updates <-chan *pb.WatchBookingResponse) *pb.WatchBookingResponse {
+	t.Helper()
+	select {
+	case resp := <-updates:
+		return resp
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for update")
+		return nil
+	}
+}
+
+func TestBookingService_WatchBooking(t *testing.T) {
+	logger := logrus.New()
+	ride := model.Ride{Source: "Downtown", Destination: "Airport", Distance: 20, Cost: pkr(50000)}
+	running := model.BookingSaga{ID: "saga-1", UserID: 1, Ride: ride, Status: model.SagaRunning, Step: model.StepCreateRide, Version: 1}
+
+	mockStore := new(mocks.BookingStore)
+	mockStore.On("GetSaga", mock.Anything, "saga-1").Return(&running, nil)
+	feed := outbox.NewMemStore()
+	addEvent(t, feed, sagaEvent(running))
+	service := NewBookingService(mockStore, feed, &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, done := watch(ctx, service, &pb.WatchBookingRequest{SagaId: "saga-1"})
+
+	// The watch starts with the current state, past the events already in the feed.
+	snapshot := receive(t, updates)
+	require.Equal(t, "1", snapshot.ResumeToken)
+	require.Equal(t, pb.SagaStep_SAGA_STEP_CREATE_RIDE, snapshot.Saga.Step)
+
+	rideCreated := running
+	rideCreated.Ride.ID, rideCreated.Step, rideCreated.Version = 101, model.StepCreateBooking, 2
+	addEvent(t, feed, sagaEvent(model.BookingSaga{ID: "saga-2", Status: model.SagaRunning, Step: model.StepCreateRide, Version: 1}))
+	addEvent(t, feed, sagaEvent(rideCreated))
+	addEvent(t, feed, &ridepb.RideUpdated{Ride: &ridepb.Ride{RideId: 102, Source: "Mall"}})
+	addEvent(t, feed, &ridepb.RideUpdated{Ride: &ridepb.Ride{RideId: 101, Source: "Downtown", Destination: "Harbour", Distance: 25, Cost: pkr(60000).Proto(), DriverId: 7}})
+
+	update := receive(t, updates)
+	require.Equal(t, "3", update.ResumeToken)
+	require.Equal(t, pb.SagaStep_SAGA_STEP_CREATE_BOOKING, update.Saga.Step)
+	require.Equal(t, int32(101), update.Ride.RideId)
+
+	update = receive(t, updates)
+	require.Equal(t, "5", update.ResumeToken)
+	require.Equal(t, "Harbour", update.Ride.Destination)
+	require.Equal(t, int32(7), update.Ride.DriverId)
+	require.Equal(t, pb.SagaStep_SAGA_STEP_CREATE_BOOKING, update.Saga.Step)
+
+	// A failed saga is final, so its update ends the stream.
+	failed := rideCreated
+	failed.Status, failed.Step, failed.Version = model.SagaFailed, model.StepDone, 3
+	addEvent(t, feed, sagaEvent(failed))
+
+	update = receive(t, updates)
+	require.Equal(t, pb.SagaStatus_SAGA_STATUS_FAILED, update.Saga.Status)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("watch did not end after the saga failed")
+	}
+}
+
+func TestBookingService_WatchBooking_BookingStatus(t *testing.T) {
+	logger := logrus.New()
+	completed := model.BookingSaga{ID: "saga-1", UserID: 1, Ride: model.Ride{ID: 101}, Status: model.SagaCompleted, Step: model.StepDone, BookingID: 1001, Version: 3}
+
+	mockStore := new(mocks.BookingStore)
+	mockStore.On("GetSaga", mock.Anything, "saga-1").Return(&completed, nil)
+	mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(
+		&model.Booking{ID: 1001, UserID: 1, RideID: 101, Status: model.BookingPending}, &model.User{}, &model.Ride{}, nil).Once()
+	mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(
+		&model.Booking{ID: 1001, UserID: 1, RideID: 101, Status: model.BookingConfirmed}, &model.User{}, &model.Ride{}, nil)
+	feed := outbox.NewMemStore()
+	service := NewBookingService(mockStore, feed, &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, _ := watch(ctx, service, &pb.WatchBookingRequest{SagaId: "saga-1"})
+
+	// A completed saga is followed until its booking finds a driver.
+	snapshot := receive(t, updates)
+	require.Equal(t, pb.BookingStatus_BOOKING_STATUS_PENDING, snapshot.BookingStatus)
+
+	addEvent(t, feed, &pb.BookingStatusChanged{BookingId: 1002, Status: pb.BookingStatus_BOOKING_STATUS_EXPIRED})
+	addEvent(t, feed, &pb.BookingStatusChanged{
+		BookingId:      1001,
+		PreviousStatus: pb.BookingStatus_BOOKING_STATUS_PENDING,
+		Status:         pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+		DriverId:       2,
+	})
+
+	update := receive(t, updates)
+	require.Equal(t, "2", update.ResumeToken)
+	require.Equal(t, pb.BookingStatus_BOOKING_STATUS_CONFIRMED, update.BookingStatus)
+	mockStore.AssertExpectations(t)
+}
+
+func TestBookingService_WatchBooking_OutOfOrderCommit(t *testing.T) {
+	logger := logrus.New()
+	completed := model.BookingSaga{ID: "saga-1", UserID: 1, Ride: model.Ride{ID: 101}, Status: model.SagaCompleted, Step: model.StepDone, BookingID: 1001, Version: 3}
+	pending := model.Booking{ID: 1001, UserID: 1, RideID: 101, Status: model.BookingPending}
+	confirmed := pending
+	confirmed.Status = model.BookingConfirmed
+
+	mockStore := new(mocks.BookingStore)
+	mockStore.On("GetSaga", mock.Anything, "saga-1").Return(&completed, nil)
+	// The confirmation is not visible until its transaction commits.
+	read := make(chan struct{}, 2)
+	mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(&pending, &model.User{}, &model.Ride{}, nil).
+		Run(func(mock.Arguments) { read <- struct{}{} }).Twice()
+	mockStore.On("GetBookingDetails", mock.Anything, int32(1001)).Return(&confirmed, &model.User{}, &model.Ride{}, nil)
+	feed := &uncommittedFeed{MemStore: outbox.NewMemStore(), hidden: map[int64]bool{1: true}}
+	service := NewBookingService(mockStore, feed, &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, _ := watch(ctx, service, &pb.WatchBookingRequest{SagaId: "saga-1"})
+
+	snapshot := receive(t, updates)
+	require.Equal(t, "0", snapshot.ResumeToken)
+	require.Equal(t, pb.BookingStatus_BOOKING_STATUS_PENDING, snapshot.BookingStatus)
+
+	// The confirmation takes seq 1, but another booking's change at seq 2
+	// commits and is read first, moving the watch past seq 1.
+	addEvent(t, feed.MemStore, &pb.BookingStatusChanged{
+		BookingId:      1001,
+		PreviousStatus: pb.BookingStatus_BOOKING_STATUS_PENDING,
+		Status:         pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+		DriverId:       2,
+	})
+	addEvent(t, feed.MemStore, &pb.BookingStatusChanged{BookingId: 1002, Status: pb.BookingStatus_BOOKING_STATUS_EXPIRED})
+	for range 2 {
+		select {
+		case <-read:
+		case <-time.After(2 * time.Second):
+			t.Fatal("watch did not re-read the booking")
+		}
+	}
+
+	// Once it commits, the next wake finds the confirmation in the store.
+	feed.commit(1)
+	addEvent(t, feed.MemStore, &pb.BookingStatusChanged{BookingId: 1003, Status: pb.BookingStatus_BOOKING_STATUS_EXPIRED})
+
+	update := receive(t, updates)
+	require.Equal(t, pb.BookingStatus_BOOKING_STATUS_CONFIRMED, update.BookingStatus)
+	mockStore.AssertExpectations(t)
+}
+
+func TestBookingService_WatchBooking_Resume(t *testing.T) {
+	logger := logrus.New()
+	running := model.BookingSaga{ID: "saga-1", UserID: 1, Status: model.SagaRunning, Step: model.StepCreateRide, Version: 1}
+	compensating := running
+	compensating.Ride.ID, compensating.Status, compensating.Step, compensating.Version = 101, model.SagaCompensating, model.StepDeleteRide, 2
+	failed := compensating
+	failed.Status, failed.Step, failed.Version = model.SagaFailed, model.StepDone, 3
+
+	tests := []struct {
+		name           string
+		resumeToken    string
+		expectedTokens []string
+	}{
+		{
+			name:           "Replays Missed Updates",
+			resumeToken:    "1",
+			expectedTokens: []string{"2", "3"},
+		},
+		{
+			name:        "Ends After Final Update",
+			resumeToken: "3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			mockStore.On("GetSaga", mock.Anything, "saga-1").Return(&failed, nil)
+			feed := outbox.NewMemStore()
+			for _, saga := range []model.BookingSaga{running, compensating, failed} {
+				addEvent(t, feed, sagaEvent(saga))
+			}
+			service := NewBookingService(mockStore, feed, &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logger)
+
+			var tokens []string
+			err := service.Watch(context.Background(), &pb.WatchBookingRequest{SagaId: "saga-1", ResumeToken: tt.resumeToken},
+				func(resp *pb.WatchBookingResponse) error {
+					tokens = append(tokens, resp.ResumeToken)
+					return nil
+				})
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedTokens, tokens)
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBookingService_WatchBooking_Errors(t *testing.T) {
+	logger := logrus.New()
+
+	tests := []struct {
+		name         string
+		req          *pb.WatchBookingRequest
+		setupMock    func(*mocks.BookingStore)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "Missing SagaID",
+			req:          &pb.WatchBookingRequest{},
+			setupMock:    func(*mocks.BookingStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Invalid Resume Token",
+			req:          &pb.WatchBookingRequest{SagaId: "saga-1", ResumeToken: "abc"},
+			setupMock:    func(*mocks.BookingStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Saga Not Found",
+			req:  &pb.WatchBookingRequest{SagaId: "saga-2"},
+			setupMock: func(m *mocks.BookingStore) {
+				m.On("GetSaga", mock.Anything, "saga-2").Return(nil, store.ErrSagaNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.BookingStore)
+			tt.setupMock(mockStore)
+			service := NewBookingService(mockStore, outbox.NewMemStore(), &fakeUsers{}, &fakeRides{}, &fakeDrivers{}, testTariff, noSurge, testPayments, testSchedule, logger)
+
+			err := service.Watch(context.Background(), tt.req, func(*pb.WatchBookingResponse) error {
+				t.Fatal("unexpected update")
+				return nil
+			})
+
+			require.Error(t, err)
+			grpcErr, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, tt.expectedCode, grpcErr.Code())
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
@@ -5,5 +5,79 @@ import "errors"
 // ErrBookingNotFound is returned when a booking is not found.
 var ErrBookingNotFound = errors.New("booking not found")
 
+// ErrSagaNotFound is returned when a booking saga is not found.
+var ErrSagaNotFound = errors.New("booking saga not found")
+
+// ErrSagaConflict is returned when a booking saga was updated by someone else
+// since it was read.
+var ErrSagaConflict = errors.New("booking saga was updated concurrently")
+
+// ErrAlreadyExists is returned when a write violates a unique constraint.
+var ErrAlreadyExists = errors.New("record already exists")
+
+// ErrForeignKeyViolation is returned when a write references a row that does not exist,
+// or a delete would orphan rows that still reference it.
+var ErrForeignKeyViolation = errors.New("foreign key violation")
+
+// ErrSerializationFailure is returned when a transaction conflicts with a concurrent one and can be retried.
+var ErrSerializationFailure = errors.New("serialization failure")
+
+// ErrTimeout is returned when a query exceeds its deadline or the statement timeout.
+var ErrTimeout = errors.New("database operation timed out")
+
+// ErrCanceled is returned when the caller cancels the query.
+var ErrCanceled = errors.New("database operation canceled")
+
 // ErrDatabaseOperation is returned for generic database operation errors.
 var ErrDatabaseOperation = errors.New("database operation failed")
+
+// ErrBookingNotPending is returned when dispatch acts on a booking that is no
+// longer pending.
+var ErrBookingNotPending = errors.New("booking is no longer pending")
+
+// ErrBookingNotScheduled is returned when the scheduler acts on a booking
+// that is no longer scheduled, or reminds its rider again.
+var ErrBookingNotScheduled = errors.New("booking is no longer scheduled")
+
+// ErrOfferNotFound is returned when an offer is not found, or was made to
+// another driver.
+var ErrOfferNotFound = errors.New("offer not found")
+
+// ErrOfferClosed is returned when responding to an offer that is no longer
+// pending or has expired.
+var ErrOfferClosed = errors.New("offer is no longer open")
+
+// ErrQuoteNotFound is returned when a fare quote is not found.
+var ErrQuoteNotFound = errors.New("fare quote not found")
+
+// ErrQuoteUsed is returned when starting a saga with a fare quote another
+// saga was started with.
+var ErrQuoteUsed = errors.New("fare quote was already used")
+
+// ErrPromoNotFound is returned when a promo code is not found.
+var ErrPromoNotFound = errors.New("promo code not found")
+
+// ErrPromoExhausted is returned when redeeming a promo code that has been
+// redeemed as often as it may be.
+var ErrPromoExhausted = errors.New("promo code was fully redeemed")
+
+// ErrPromoLimitReached is returned when redeeming a promo code that the user
+// has redeemed as often as each user may.
+var ErrPromoLimitReached = errors.New("promo code redemption limit reached for user")
+
+// ErrBookingNotConfirmed is returned when completing a booking that is not
+// confirmed with the driver completing it.
+var ErrBookingNotConfirmed = errors.New("booking is not confirmed with the driver")
+
+// ErrBookingNotCancellable is returned when cancelling a booking that is
+// neither pending nor confirmed, or that belongs to another user; or when
+// reporting a no-show for a booking not confirmed with the driver.
+var ErrBookingNotCancellable = errors.New("booking can no longer be cancelled")
+
+// ErrBookingNotRefundable is returned when refunding a booking that is
+// neither completed nor cancelled.
+var ErrBookingNotRefundable = errors.New("booking cannot be refunded")
+
+// ErrRefundConflict is returned when storing a refund of a booking that was
+// refunded by someone else since it was read.
+var ErrRefundConflict = errors.New("booking was refunded concurrently")
@@ -0,0 +1,318 @@
+package store
+
+import (
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+// bookingCreated encodes the BookingCreated event for a stored booking.
+func bookingCreated(booking model.Booking, ride model.Ride) (outbox.Message, error) {
+	return outbox.NewMessage(&pb.BookingCreated{
+		Booking: BookingToProto(&booking),
+		Ride:    RideToProto(&ride),
+	})
+}
+
+// bookingStatusChanged encodes the BookingStatusChanged event for a booking
+// that moved from previous to its current status.
+func bookingStatusChanged(booking *model.Booking, previous string) (outbox.Message, error) {
+	return outbox.NewMessage(&pb.BookingStatusChanged{
+		BookingId:      booking.ID,
+		PreviousStatus: bookingStatuses[previous],
+		Status:         bookingStatuses[booking.Status],
+		DriverId:       booking.DriverID,
+	})
+}
+
+// bookingReminder encodes the BookingReminder event for a scheduled booking.
+func bookingReminder(booking *model.Booking) (outbox.Message, error) {
+	return outbox.NewMessage(&pb.BookingReminder{
+		BookingId:  booking.ID,
+		UserId:     booking.UserID,
+		PickupTime: booking.PickupTime.Format(time.RFC3339),
+	})
+}
+
+// offerUpdated encodes the DriverOfferUpdated event for a saved offer.
+func offerUpdated(offer *model.Offer) (outbox.Message, error) {
+	return outbox.NewMessage(&pb.DriverOfferUpdated{Offer: OfferToProto(offer)})
+}
+
+// sagaUpdated encodes the BookingSagaUpdated event for a saved saga.
+func sagaUpdated(saga *model.BookingSaga) (outbox.Message, error) {
+	return outbox.NewMessage(&pb.BookingSagaUpdated{Saga: SagaToProto(saga)})
+}
+
+var bookingStatuses = map[string]pb.BookingStatus{
+	model.BookingScheduled: pb.BookingStatus_BOOKING_STATUS_SCHEDULED,
+	model.BookingPending:   pb.BookingStatus_BOOKING_STATUS_PENDING,
+	model.BookingConfirmed: pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+	model.BookingExpired:   pb.BookingStatus_BOOKING_STATUS_EXPIRED,
+	model.BookingCompleted: pb.BookingStatus_BOOKING_STATUS_COMPLETED,
+	model.BookingCancelled: pb.BookingStatus_BOOKING_STATUS_CANCELLED,
+}
+
+var accountTypes = map[string]pb.AccountType{
+	model.AccountRider:    pb.AccountType_ACCOUNT_TYPE_RIDER,
+	model.AccountDriver:   pb.AccountType_ACCOUNT_TYPE_DRIVER,
+	model.AccountPlatform: pb.AccountType_ACCOUNT_TYPE_PLATFORM,
+	model.AccountPromo:    pb.AccountType_ACCOUNT_TYPE_PROMO,
+}
+
+var entryKinds = map[string]pb.JournalEntryKind{
+	model.EntryRideCompleted:   pb.JournalEntryKind_JOURNAL_ENTRY_KIND_RIDE_COMPLETED,
+	model.EntryCancellationFee: pb.JournalEntryKind_JOURNAL_ENTRY_KIND_CANCELLATION_FEE,
+	model.EntryRefund:          pb.JournalEntryKind_JOURNAL_ENTRY_KIND_REFUND,
+}
+
+var offerStatuses = map[string]pb.OfferStatus{
+	model.OfferPending:  pb.OfferStatus_OFFER_STATUS_PENDING,
+	model.OfferAccepted: pb.OfferStatus_OFFER_STATUS_ACCEPTED,
+	model.OfferDeclined: pb.OfferStatus_OFFER_STATUS_DECLINED,
+	model.OfferExpired:  pb.OfferStatus_OFFER_STATUS_EXPIRED,
+}
+
+var sagaStatuses = map[string]pb.SagaStatus{
+	model.SagaRunning:      pb.SagaStatus_SAGA_STATUS_RUNNING,
+	model.SagaCompensating: pb.SagaStatus_SAGA_STATUS_COMPENSATING,
+	model.SagaCompleted:    pb.SagaStatus_SAGA_STATUS_COMPLETED,
+	model.SagaFailed:       pb.SagaStatus_SAGA_STATUS_FAILED,
+}
+
+var sagaSteps = map[string]pb.SagaStep{
+	model.StepValidateUser:  pb.SagaStep_SAGA_STEP_VALIDATE_USER,
+	model.StepHoldFunds:     pb.SagaStep_SAGA_STEP_HOLD_FUNDS,
+	model.StepCreateRide:    pb.SagaStep_SAGA_STEP_CREATE_RIDE,
+	model.StepCreateBooking: pb.SagaStep_SAGA_STEP_CREATE_BOOKING,
+	model.StepDeleteRide:    pb.SagaStep_SAGA_STEP_DELETE_RIDE,
+	model.StepReleaseHold:   pb.SagaStep_SAGA_STEP_RELEASE_HOLD,
+	model.StepDone:          pb.SagaStep_SAGA_STEP_DONE,
+}
+
+var paymentMethods = map[string]pb.PaymentMethod{
+	model.PaymentCard:   pb.PaymentMethod_PAYMENT_METHOD_CARD,
+	model.PaymentWallet: pb.PaymentMethod_PAYMENT_METHOD_WALLET,
+}
+
+var cancellationReasons = map[string]pb.CancellationReason{
+	model.CancelledByRider: pb.CancellationReason_CANCELLATION_REASON_RIDER,
+	model.CancelledNoShow:  pb.CancellationReason_CANCELLATION_REASON_NO_SHOW,
+}
+
+var refundReasons = map[string]pb.RefundReason{
+	model.RefundServiceIssue: pb.RefundReason_REFUND_REASON_SERVICE_ISSUE,
+	model.RefundFareDispute:  pb.RefundReason_REFUND_REASON_FARE_DISPUTE,
+	model.RefundFeeWaived:    pb.RefundReason_REFUND_REASON_FEE_WAIVED,
+	model.RefundOther:        pb.RefundReason_REFUND_REASON_OTHER,
+}
+
+// BookingStatusToProto converts a booking status to its API representation.
+func BookingStatusToProto(status string) pb.BookingStatus {
+	return bookingStatuses[status]
+}
+
+// PaymentMethodToProto converts a payment method to its API representation.
+func PaymentMethodToProto(method string) pb.PaymentMethod {
+	return paymentMethods[method]
+}
+
+// PaymentMethodFromProto converts an API payment method to the model;
+// unspecified means card.
+func PaymentMethodFromProto(method pb.PaymentMethod) string {
+	if method == pb.PaymentMethod_PAYMENT_METHOD_WALLET {
+		return model.PaymentWallet
+	}
+	return model.PaymentCard
+}
+
+// CancellationReasonToProto converts a cancellation reason to its API
+// representation.
+func CancellationReasonToProto(reason string) pb.CancellationReason {
+	return cancellationReasons[reason]
+}
+
+// RefundReasonToProto converts a refund reason to its API representation.
+func RefundReasonToProto(reason string) pb.RefundReason {
+	return refundReasons[reason]
+}
+
+// RefundReasonFromProto converts an API refund reason to the model, empty if
+// it is unknown.
+func RefundReasonFromProto(reason pb.RefundReason) string {
+	for name, r := range refundReasons {
+		if r == reason {
+			return name
+		}
+	}
+	return ""
+}
+
+// OptionalTimeToProto formats t as RFC 3339, empty if it is zero.
+func OptionalTimeToProto(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// OptionalMoneyToProto converts an amount to its API representation, nil if
+// it is zero.
+func OptionalMoneyToProto(m money.Money) *moneypb.Money {
+	if m.IsZero() {
+		return nil
+	}
+	return m.Proto()
+}
+
+// BookingToProto converts a booking to its API representation.
+func BookingToProto(booking *model.Booking) *pb.Booking {
+	return &pb.Booking{
+		BookingId: booking.ID,
+		UserId:    booking.UserID,
+		RideId:    booking.RideID,
+		Time:      booking.Timestamp.Format(time.RFC3339),
+		DriverId:  booking.DriverID,
+		Status:    bookingStatuses[booking.Status],
+
+		TariffVersion: booking.TariffVersion,
+		Discount:      DiscountToProto(booking.Discount),
+		PaymentMethod: paymentMethods[booking.PaymentMethod],
+		HoldId:        booking.HoldID,
+		PickupTime:    OptionalTimeToProto(booking.PickupTime),
+
+		CancellationReason: cancellationReasons[booking.CancellationReason],
+		CancellationFee:    OptionalMoneyToProto(booking.CancellationFee),
+		Refunded:           OptionalMoneyToProto(booking.Refunded),
+		RefundReason:       refundReasons[booking.RefundReason],
+	}
+}
+
+// DiscountToProto converts a discount to its API representation, nil if no
+// promo code was applied.
+func DiscountToProto(d model.Discount) *pb.Discount {
+	if !d.Applied() {
+		return nil
+	}
+	return &pb.Discount{
+		PromoCode: d.PromoCode,
+		Fare:      d.Fare.Proto(),
+		Amount:    d.Amount.Proto(),
+		Total:     d.Total().Proto(),
+	}
+}
+
+// RideToProto converts a ride to its API representation.
+func RideToProto(ride *model.Ride) *pb.Ride {
+	return &pb.Ride{
+		RideId:      ride.ID,
+		Source:      ride.Source,
+		Destination: ride.Destination,
+		Distance:    ride.Distance,
+		Cost:        ride.Cost.Proto(),
+		Pickup:      LatLngToProto(ride.Pickup),
+		Dropoff:     LatLngToProto(ride.Dropoff),
+	}
+}
+
+// OfferToProto converts an offer to its API representation.
+func OfferToProto(offer *model.Offer) *pb.DriverOffer {
+	return &pb.DriverOffer{
+		OfferId:    offer.ID,
+		BookingId:  offer.BookingID,
+		DriverId:   offer.DriverID,
+		Status:     offerStatuses[offer.Status],
+		DistanceKm: offer.DistanceKm,
+		CreatedAt:  offer.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:  offer.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+// LatLngToProto converts a point to its API representation, nil if unknown.
+func LatLngToProto(p *model.LatLng) *latlng.LatLng {
+	if p == nil {
+		return nil
+	}
+	return &latlng.LatLng{Latitude: p.Lat, Longitude: p.Lng}
+}
+
+// LatLngFromProto converts an API point to the model, nil if unset.
+func LatLngFromProto(p *latlng.LatLng) *model.LatLng {
+	if p == nil {
+		return nil
+	}
+	return &model.LatLng{Lat: p.Latitude, Lng: p.Longitude}
+}
+
+// SagaToProto converts a booking saga to its API representation, as used by
+// both GetBookingSaga and the BookingSagaUpdated event.
+func SagaToProto(saga *model.BookingSaga) *pb.BookingSaga {
+	return &pb.BookingSaga{
+		SagaId:    saga.ID,
+		UserId:    saga.UserID,
+		Ride:      RideToProto(&saga.Ride),
+		Status:    sagaStatuses[saga.Status],
+		Step:      sagaSteps[saga.Step],
+		BookingId: saga.BookingID,
+		Error:     saga.Error,
+		Version:   saga.Version,
+		CreatedAt: saga.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: saga.UpdatedAt.Format(time.RFC3339),
+
+		TariffVersion: saga.TariffVersion,
+		QuoteId:       saga.QuoteID,
+		Discount:      DiscountToProto(saga.Discount),
+		PaymentMethod: paymentMethods[saga.PaymentMethod],
+		HoldId:        saga.HoldID,
+		PickupTime:    OptionalTimeToProto(saga.PickupTime),
+	}
+}
+
+// AccountToProto converts a ledger account to its API representation.
+func AccountToProto(a model.Account) *pb.Account {
+	return &pb.Account{Type: accountTypes[a.Type], OwnerId: a.OwnerID}
+}
+
+// AccountFromProto converts an API ledger account to the model. The type of
+// an unknown account is empty.
+func AccountFromProto(a *pb.Account) model.Account {
+	account := model.Account{OwnerID: a.OwnerId}
+	for name, t := range accountTypes {
+		if t == a.Type {
+			account.Type = name
+		}
+	}
+	return account
+}
+
+// JournalEntryToProto converts a journal entry to its API representation.
+func JournalEntryToProto(entry *model.JournalEntry) *pb.JournalEntry {
+	res := &pb.JournalEntry{
+		EntryId:   entry.ID,
+		BookingId: entry.BookingID,
+		Kind:      entryKinds[entry.Kind],
+		Reference: entry.Reference,
+		CreatedAt: entry.CreatedAt.Format(time.RFC3339),
+	}
+	for _, p := range entry.Postings {
+		res.Postings = append(res.Postings, &pb.Posting{Account: AccountToProto(p.Account), Amount: p.Amount.Proto()})
+	}
+	return res
+}
+
+// StatementLineToProto converts a statement line to its API representation.
+func StatementLineToProto(line *model.StatementLine) *pb.StatementLine {
+	return &pb.StatementLine{
+		EntryId:   line.EntryID,
+		BookingId: line.BookingID,
+		Kind:      entryKinds[line.Kind],
+		Reference: line.Reference,
+		Amount:    line.Amount.Proto(),
+		CreatedAt: line.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,1040 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/ledger"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+)
+
+// MemBookingStore is a thread-safe in-memory BookingStore with the same
+// semantics as PGBookingStore: auto-increment ids, ErrBookingNotFound for
+// unknown bookings, foreign key checks on users, optimistic locking of sagas,
+// and events recorded in an outbox atomically with the writes. It is meant
+// for local runs and tests that should not need Postgres.
+//
+// Unlike Postgres, the store does not share a rides table with ride-service,
+// so it keeps a copy of each booked ride to serve GetBookingDetails.
+type MemBookingStore struct {
+	mu            sync.RWMutex
+	outbox        *outbox.MemStore
+	users         map[int32]model.User
+	rides         map[int32]model.Ride
+	bookings      map[int32]model.Booking
+	sagas         map[string]model.BookingSaga
+	offers        map[int32]model.Offer
+	quotes        map[string]model.Quote
+	promos        map[string]model.Promo
+	redemptions   map[string]redemption // By saga ID
+	entries       []model.JournalEntry  // Oldest first; never changed
+	lastUserID    int32
+	lastRideID    int32
+	lastBookingID int32
+	lastOfferID   int32
+}
+
+// NewMemBookingStore creates an empty MemBookingStore.
+func NewMemBookingStore() *MemBookingStore {
+	return &MemBookingStore{
+		users:       make(map[int32]model.User),
+		rides:       make(map[int32]model.Ride),
+		bookings:    make(map[int32]model.Booking),
+		sagas:       make(map[string]model.BookingSaga),
+		offers:      make(map[int32]model.Offer),
+		quotes:      make(map[string]model.Quote),
+		promos:      make(map[string]model.Promo),
+		redemptions: make(map[string]redemption),
+		outbox:      outbox.NewMemStore(),
+	}
+}
+
+// Outbox returns the outbox the store records events in.
+func (s *MemBookingStore) Outbox() *outbox.MemStore {
+	return s.outbox
+}
+
+// CreateUser adds a user that bookings can reference. Users are owned by
+// user-service, so this is only used to seed the store.
+func (s *MemBookingStore) CreateUser(ctx context.Context, name string) (int32, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastUserID++
+	s.users[s.lastUserID] = model.User{ID: s.lastUserID, Name: name}
+	return s.lastUserID, nil
+}
+
+// CreateRide adds a ride that bookings can reference. Rides are owned by
+// ride-service, so this is only used to seed the store.
+func (s *MemBookingStore) CreateRide(ctx context.Context, source, destination string, distance int32, cost money.Money) (int32, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastRideID++
+	s.rides[s.lastRideID] = model.Ride{
+		ID:          s.lastRideID,
+		Source:      source,
+		Destination: destination,
+		Distance:    distance,
+		Cost:        cost,
+	}
+	return s.lastRideID, nil
+}
+
+// createBooking stores a confirmed booking without recording an event. It is
+// only used to seed the store.
+func (s *MemBookingStore) createBooking(userID, rideID int32, bookingTime time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastBookingID++
+	s.bookings[s.lastBookingID] = model.Booking{
+		ID:         s.lastBookingID,
+		UserID:     userID,
+		RideID:     rideID,
+		Timestamp:  bookingTime,
+		Status:     model.BookingConfirmed,
+		PickupTime: bookingTime,
+	}
+}
+
+// GetBookingDetails returns a booking together with its user and ride.
+func (s *MemBookingStore) GetBookingDetails(ctx context.Context, bookingID int32) (*model.Booking, *model.User, *model.Ride, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	booking, ok := s.bookings[bookingID]
+	if !ok {
+		return nil, nil, nil, ErrBookingNotFound
+	}
+	user := s.users[booking.UserID]
+	ride := s.rides[booking.RideID]
+	return &booking, &user, &ride, nil
+}
+
+// ListBookings returns all bookings of a user ordered by booking ID.
+func (s *MemBookingStore) ListBookings(ctx context.Context, userID int32) ([]model.Booking, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	bookings := []model.Booking{}
+	for _, b := range s.bookings {
+		if b.UserID == userID {
+			bookings = append(bookings, b)
+		}
+	}
+	slices.SortFunc(bookings, func(a, b model.Booking) int { return cmp.Compare(a.ID, b.ID) })
+	return bookings, nil
+}
+
+// CreateQuote stores a new fare quote.
+func (s *MemBookingStore) CreateQuote(ctx context.Context, quote *model.Quote) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.quotes[quote.ID]; ok {
+		return fmt.Errorf("%w: quote %s", ErrAlreadyExists, quote.ID)
+	}
+	s.quotes[quote.ID] = *quote
+	return nil
+}
+
+// GetQuote retrieves a fare quote by ID.
+func (s *MemBookingStore) GetQuote(ctx context.Context, quoteID string) (*model.Quote, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrQuoteNotFound)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	quote, ok := s.quotes[quoteID]
+	if !ok {
+		return nil, ErrQuoteNotFound
+	}
+	return &quote, nil
+}
+
+// redemption is a promo code redeemed by a saga.
+type redemption struct {
+	code   string
+	userID int32
+}
+
+// CreatePromo stores a new promo code.
+func (s *MemBookingStore) CreatePromo(ctx context.Context, promo *model.Promo) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.promos[promo.Code]; ok {
+		return fmt.Errorf("%w: promo %s", ErrAlreadyExists, promo.Code)
+	}
+	created := *promo
+	created.VehicleClasses = slices.Clone(promo.VehicleClasses)
+	created.Redemptions, created.CreatedAt = 0, time.Now()
+	s.promos[promo.Code] = created
+	*promo = created
+	return nil
+}
+
+// GetPromo retrieves a promo code.
+func (s *MemBookingStore) GetPromo(ctx context.Context, code string) (*model.Promo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrPromoNotFound)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	promo, ok := s.promos[code]
+	if !ok {
+		return nil, ErrPromoNotFound
+	}
+	promo.VehicleClasses = slices.Clone(promo.VehicleClasses)
+	return &promo, nil
+}
+
+// redeem checks that saga's user may redeem its promo code, returning the
+// promo as counting the redemption leaves it. The caller must hold s.mu.
+func (s *MemBookingStore) redeem(saga *model.BookingSaga) (model.Promo, error) {
+	promo, ok := s.promos[saga.Discount.PromoCode]
+	if !ok {
+		return model.Promo{}, ErrPromoNotFound
+	}
+	if promo.MaxRedemptions > 0 && promo.Redemptions >= promo.MaxRedemptions {
+		return model.Promo{}, ErrPromoExhausted
+	}
+	if promo.MaxPerUser > 0 {
+		var redeemed int32
+		for _, r := range s.redemptions {
+			if r.code == promo.Code && r.userID == saga.UserID {
+				redeemed++
+			}
+		}
+		if redeemed >= promo.MaxPerUser {
+			return model.Promo{}, ErrPromoLimitReached
+		}
+	}
+	promo.Redemptions++
+	return promo, nil
+}
+
+// CreateSaga stores a new booking saga, records a BookingSagaUpdated event
+// and sets the saga's version and timestamps. A saga with a QuoteID uses the
+// quote up; it fails with ErrQuoteUsed if another saga already did. A saga
+// with a Discount redeems its promo code; it fails with ErrPromoExhausted or
+// ErrPromoLimitReached if the code's limits are reached.
+func (s *MemBookingStore) CreateSaga(ctx context.Context, saga *model.BookingSaga) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sagas[saga.ID]; ok {
+		return fmt.Errorf("%w: saga %s", ErrAlreadyExists, saga.ID)
+	}
+	quote, quoted := s.quotes[saga.QuoteID]
+	if saga.QuoteID != "" {
+		if !quoted {
+			return ErrQuoteNotFound
+		}
+		if quote.Used {
+			return ErrQuoteUsed
+		}
+	}
+	var promo model.Promo
+	if saga.Discount.Applied() {
+		var err error
+		if promo, err = s.redeem(saga); err != nil {
+			return err
+		}
+	}
+	created := *saga
+	created.Ride = cloneRide(saga.Ride)
+	now := time.Now()
+	created.Version, created.CreatedAt, created.UpdatedAt = 0, now, now
+	msg, err := sagaUpdated(&created)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+
+	s.outbox.Add(msg)
+	s.sagas[saga.ID] = created
+	if quoted {
+		quote.Used = true
+		s.quotes[quote.ID] = quote
+	}
+	if saga.Discount.Applied() {
+		s.promos[promo.Code] = promo
+		s.redemptions[saga.ID] = redemption{code: promo.Code, userID: saga.UserID}
+	}
+	*saga = created
+	return nil
+}
+
+// GetSaga retrieves a booking saga by ID.
+func (s *MemBookingStore) GetSaga(ctx context.Context, sagaID string) (*model.BookingSaga, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrSagaNotFound)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	saga, ok := s.sagas[sagaID]
+	if !ok {
+		return nil, ErrSagaNotFound
+	}
+	return &saga, nil
+}
+
+// UpdateSaga saves the progress of a booking saga and records a
+// BookingSagaUpdated event. It fails with ErrSagaConflict if the saga was
+// updated since it was read, and otherwise increments the saga's version.
+// A saga that failed gives back the promo code it redeemed and the quote it
+// used.
+func (s *MemBookingStore) UpdateSaga(ctx context.Context, saga *model.BookingSaga) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	updated, msg, err := s.updateSaga(saga)
+	if err != nil {
+		return err
+	}
+
+	s.outbox.Add(msg)
+	s.sagas[saga.ID] = updated
+	if updated.Status == model.SagaFailed {
+		s.releaseRedemption(saga.ID)
+		s.releaseQuote(updated)
+	}
+	*saga = updated
+	return nil
+}
+
+// releaseRedemption gives back the promo code redeemed by a saga, if any.
+// The caller must hold s.mu.
+func (s *MemBookingStore) releaseRedemption(sagaID string) {
+	r, ok := s.redemptions[sagaID]
+	if !ok {
+		return
+	}
+	delete(s.redemptions, sagaID)
+	promo := s.promos[r.code]
+	promo.Redemptions--
+	s.promos[r.code] = promo
+}
+
+// releaseQuote gives back the quote used by saga, if any, so another saga
+// can book with it. A quote another saga has used since is left alone. The
+// caller must hold s.mu.
+func (s *MemBookingStore) releaseQuote(saga model.BookingSaga) {
+	quote, ok := s.quotes[saga.QuoteID]
+	if !ok {
+		return
+	}
+	for _, other := range s.sagas {
+		if other.QuoteID == saga.QuoteID && other.ID != saga.ID && other.Status != model.SagaFailed {
+			return
+		}
+	}
+	quote.Used = false
+	s.quotes[quote.ID] = quote
+}
+
+// updateSaga returns saga as UpdateSaga would save it, and its
+// BookingSagaUpdated event. The caller must hold s.mu.
+func (s *MemBookingStore) updateSaga(saga *model.BookingSaga) (model.BookingSaga, outbox.Message, error) {
+	stored, ok := s.sagas[saga.ID]
+	if !ok || stored.Version != saga.Version {
+		return model.BookingSaga{}, outbox.Message{}, ErrSagaConflict
+	}
+
+	// Only the progress fields change, as in Postgres.
+	stored.Ride.ID, stored.Ride.Distance, stored.HoldID = saga.Ride.ID, saga.Ride.Distance, saga.HoldID
+	stored.Status, stored.Step, stored.BookingID, stored.Error = saga.Status, saga.Step, saga.BookingID, saga.Error
+	stored.Version++
+	stored.UpdatedAt = time.Now()
+	msg, err := sagaUpdated(&stored)
+	if err != nil {
+		return model.BookingSaga{}, outbox.Message{}, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+	return stored, msg, nil
+}
+
+// ListStaleSagas returns up to limit sagas that are still running or
+// compensating and were last updated before updatedBefore, oldest first.
+func (s *MemBookingStore) ListStaleSagas(ctx context.Context, updatedBefore time.Time, limit int) ([]model.BookingSaga, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sagas := []model.BookingSaga{}
+	for _, saga := range s.sagas {
+		if saga.Active() && saga.UpdatedAt.Before(updatedBefore) {
+			sagas = append(sagas, saga)
+		}
+	}
+	slices.SortFunc(sagas, func(a, b model.BookingSaga) int { return a.UpdatedAt.Compare(b.UpdatedAt) })
+	if len(sagas) > limit {
+		sagas = sagas[:limit]
+	}
+	return sagas, nil
+}
+
+// CompleteSaga stores the booking of a saga whose ride has been created,
+// records a BookingCreated event and marks the saga completed, recording a
+// BookingSagaUpdated event, atomically. It returns the booking ID. The user
+// must exist. The booking is pending until dispatch finds it a driver, or
+// scheduled if the saga has a pickup time.
+func (s *MemBookingStore) CompleteSaga(ctx context.Context, saga *model.BookingSaga, bookingTime time.Time) (int32, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[saga.UserID]; !ok {
+		return 0, fmt.Errorf("%w: user %d does not exist", ErrForeignKeyViolation, saga.UserID)
+	}
+
+	status, pickupTime := model.BookingPending, bookingTime
+	if !saga.PickupTime.IsZero() {
+		status, pickupTime = model.BookingScheduled, saga.PickupTime
+	}
+	booking := model.Booking{
+		ID:         s.lastBookingID + 1,
+		UserID:     saga.UserID,
+		RideID:     saga.Ride.ID,
+		Timestamp:  bookingTime,
+		Status:     status,
+		PickupTime: pickupTime,
+
+		TariffVersion: saga.TariffVersion,
+		QuoteID:       saga.QuoteID,
+		Discount:      saga.Discount,
+		PaymentMethod: saga.PaymentMethod,
+		HoldID:        saga.HoldID,
+		Cost:          saga.Ride.Cost,
+	}
+	msg, err := bookingCreated(booking, saga.Ride)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+
+	completed := *saga
+	completed.Status, completed.Step, completed.BookingID, completed.Error = model.SagaCompleted, model.StepDone, booking.ID, ""
+	completed, sagaMsg, err := s.updateSaga(&completed)
+	if err != nil {
+		return 0, err
+	}
+
+	s.outbox.Add(msg)
+	s.outbox.Add(sagaMsg)
+	s.sagas[saga.ID] = completed
+	s.rides[saga.Ride.ID] = cloneRide(saga.Ride)
+	s.lastBookingID = booking.ID
+	s.bookings[booking.ID] = booking
+	*saga = completed
+	return booking.ID, nil
+}
+
+// cloneRide copies a ride so the store does not share its pickup or
+// dropoff with the caller.
+func cloneRide(ride model.Ride) model.Ride {
+	if ride.Pickup != nil {
+		pickup := *ride.Pickup
+		ride.Pickup = &pickup
+	}
+	if ride.Dropoff != nil {
+		dropoff := *ride.Dropoff
+		ride.Dropoff = &dropoff
+	}
+	return ride
+}
+
+// ListScheduledBookings returns up to limit scheduled bookings after
+// afterID, in ID order, that are due either a reminder or a release: not yet
+// reminded with a pickup by remindBy, or with a pickup by releaseBy.
+func (s *MemBookingStore) ListScheduledBookings(ctx context.Context, remindBy, releaseBy time.Time, afterID int32, limit int) ([]model.Booking, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	scheduled := []model.Booking{}
+	for _, b := range s.bookings {
+		if b.Status != model.BookingScheduled || b.ID <= afterID {
+			continue
+		}
+		if (b.RemindedAt.IsZero() && !b.PickupTime.After(remindBy)) || !b.PickupTime.After(releaseBy) {
+			scheduled = append(scheduled, b)
+		}
+	}
+	slices.SortFunc(scheduled, func(a, b model.Booking) int { return cmp.Compare(a.ID, b.ID) })
+	if len(scheduled) > limit {
+		scheduled = scheduled[:limit]
+	}
+	return scheduled, nil
+}
+
+// RemindBooking marks the rider of a scheduled booking as reminded at now
+// and records a BookingReminder event. It fails with ErrBookingNotScheduled
+// if the booking is no longer scheduled or its rider was reminded already.
+func (s *MemBookingStore) RemindBooking(ctx context.Context, bookingID int32, now time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, ok := s.bookings[bookingID]
+	if !ok {
+		return ErrBookingNotFound
+	}
+	if booking.Status != model.BookingScheduled || !booking.RemindedAt.IsZero() {
+		return ErrBookingNotScheduled
+	}
+
+	booking.RemindedAt = now
+	msg, err := bookingReminder(&booking)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+	s.outbox.Add(msg)
+	s.bookings[bookingID] = booking
+	return nil
+}
+
+// ReleaseBooking moves a scheduled booking into dispatch, making it pending,
+// and records a BookingStatusChanged event. It fails with
+// ErrBookingNotScheduled if the booking is no longer scheduled.
+func (s *MemBookingStore) ReleaseBooking(ctx context.Context, bookingID int32) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, ok := s.bookings[bookingID]
+	if !ok {
+		return ErrBookingNotFound
+	}
+	if booking.Status != model.BookingScheduled {
+		return ErrBookingNotScheduled
+	}
+
+	booking.Status = model.BookingPending
+	msg, err := bookingStatusChanged(&booking, model.BookingScheduled)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+	s.outbox.Add(msg)
+	s.bookings[bookingID] = booking
+	return nil
+}
+
+// ListPendingBookings returns up to limit pending bookings with an ID after
+// afterID, oldest first, with their pickup and every offer made for them.
+func (s *MemBookingStore) ListPendingBookings(ctx context.Context, afterID int32, limit int) ([]model.PendingBooking, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pending := []model.PendingBooking{}
+	for _, b := range s.bookings {
+		if b.Status == model.BookingPending && b.ID > afterID {
+			pending = append(pending, model.PendingBooking{Booking: b, Pickup: cloneRide(s.rides[b.RideID]).Pickup})
+		}
+	}
+	slices.SortFunc(pending, func(a, b model.PendingBooking) int { return cmp.Compare(a.Booking.ID, b.Booking.ID) })
+	if len(pending) > limit {
+		pending = pending[:limit]
+	}
+	for i := range pending {
+		pending[i].Offers = s.listOffers(func(o model.Offer) bool { return o.BookingID == pending[i].Booking.ID })
+	}
+	return pending, nil
+}
+
+// listOffers returns the offers matching keep, oldest first. The caller must
+// hold s.mu.
+func (s *MemBookingStore) listOffers(keep func(model.Offer) bool) []model.Offer {
+	var offers []model.Offer
+	for _, o := range s.offers {
+		if keep(o) {
+			offers = append(offers, o)
+		}
+	}
+	slices.SortFunc(offers, func(a, b model.Offer) int { return cmp.Compare(a.ID, b.ID) })
+	return offers
+}
+
+// CreateOffer offers a pending booking to a driver and records a
+// DriverOfferUpdated event. The caller sets CreatedAt and ExpiresAt; the
+// offer's ID and status are set on success. It fails with
+// ErrBookingNotPending if the booking is no longer pending, and with
+// ErrAlreadyExists if the booking or the driver already has a pending offer
+// or the booking was offered to the driver before.
+func (s *MemBookingStore) CreateOffer(ctx context.Context, offer *model.Offer) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, ok := s.bookings[offer.BookingID]
+	if !ok {
+		return ErrBookingNotFound
+	}
+	if booking.Status != model.BookingPending {
+		return ErrBookingNotPending
+	}
+	for _, o := range s.offers {
+		switch {
+		case o.BookingID == offer.BookingID && o.DriverID == offer.DriverID:
+			return fmt.Errorf("%w: booking %d was offered to driver %d", ErrAlreadyExists, o.BookingID, o.DriverID)
+		case o.Status == model.OfferPending && o.BookingID == offer.BookingID:
+			return fmt.Errorf("%w: booking %d has a pending offer", ErrAlreadyExists, o.BookingID)
+		case o.Status == model.OfferPending && o.DriverID == offer.DriverID:
+			return fmt.Errorf("%w: driver %d has a pending offer", ErrAlreadyExists, o.DriverID)
+		}
+	}
+
+	created := *offer
+	created.ID, created.Status = s.lastOfferID+1, model.OfferPending
+	msg, err := offerUpdated(&created)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+
+	s.outbox.Add(msg)
+	s.lastOfferID = created.ID
+	s.offers[created.ID] = created
+	*offer = created
+	return nil
+}
+
+// ExpireOffers marks the pending offers that expired by now as expired,
+// recording a DriverOfferUpdated event for each, and returns them.
+func (s *MemBookingStore) ExpireOffers(ctx context.Context, now time.Time) ([]model.Offer, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expired := s.listOffers(func(o model.Offer) bool { return o.Status == model.OfferPending && !now.Before(o.ExpiresAt) })
+	msgs := make([]outbox.Message, 0, len(expired))
+	for i := range expired {
+		expired[i].Status = model.OfferExpired
+		msg, err := offerUpdated(&expired[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	for i, o := range expired {
+		s.outbox.Add(msgs[i])
+		s.offers[o.ID] = o
+	}
+	if expired == nil {
+		expired = []model.Offer{}
+	}
+	return expired, nil
+}
+
+// ExpireBooking marks a pending booking without a pending offer as expired
+// and records a BookingStatusChanged event. It fails with
+// ErrBookingNotPending if the booking is not pending or has a pending offer.
+func (s *MemBookingStore) ExpireBooking(ctx context.Context, bookingID int32) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, ok := s.bookings[bookingID]
+	if !ok {
+		return ErrBookingNotFound
+	}
+	offered := s.listOffers(func(o model.Offer) bool { return o.BookingID == bookingID && o.Status == model.OfferPending })
+	if booking.Status != model.BookingPending || len(offered) > 0 {
+		return ErrBookingNotPending
+	}
+
+	booking.Status = model.BookingExpired
+	msg, err := bookingStatusChanged(&booking, model.BookingPending)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+	s.outbox.Add(msg)
+	s.bookings[bookingID] = booking
+	return nil
+}
+
+// ListDriverOffers returns the offers pending for a driver that have not
+// expired by now, oldest first.
+func (s *MemBookingStore) ListDriverOffers(ctx context.Context, driverID int32, now time.Time) ([]model.Offer, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	offers := s.listOffers(func(o model.Offer) bool {
+		return o.DriverID == driverID && o.Status == model.OfferPending && now.Before(o.ExpiresAt)
+	})
+	if offers == nil {
+		offers = []model.Offer{}
+	}
+	return offers, nil
+}
+
+// RespondToOffer accepts or declines an offer made to driverID, recording a
+// DriverOfferUpdated event. Accepting also confirms the booking with the
+// driver and records a BookingStatusChanged event. It returns the offer and
+// its booking as saved. It fails with ErrOfferNotFound if the offer does not
+// exist or was made to another driver, and with ErrOfferClosed if it is no
+// longer pending or expired by now.
+func (s *MemBookingStore) RespondToOffer(ctx context.Context, offerID, driverID int32, accept bool, now time.Time) (*model.Offer, *model.Booking, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	offer, ok := s.offers[offerID]
+	if !ok || offer.DriverID != driverID {
+		return nil, nil, ErrOfferNotFound
+	}
+	booking := s.bookings[offer.BookingID]
+	if offer.Status != model.OfferPending || !now.Before(offer.ExpiresAt) || booking.Status != model.BookingPending {
+		return nil, nil, ErrOfferClosed
+	}
+
+	offer.Status = model.OfferDeclined
+	if accept {
+		offer.Status = model.OfferAccepted
+	}
+	msg, err := offerUpdated(&offer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+	msgs := []outbox.Message{msg}
+	if accept {
+		booking.Status, booking.DriverID, booking.AssignedAt = model.BookingConfirmed, driverID, now
+		msg, err := bookingStatusChanged(&booking, model.BookingPending)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	for _, m := range msgs {
+		s.outbox.Add(m)
+	}
+	s.offers[offerID] = offer
+	s.bookings[booking.ID] = booking
+	return &offer, &booking, nil
+}
+
+// CompleteBooking marks a booking confirmed with driverID completed and
+// stores entry, recording a BookingStatusChanged event, atomically. It
+// returns the booking as saved and sets the entry's ID and CreatedAt. It
+// fails with ErrBookingNotConfirmed if the booking is not confirmed with
+// the driver.
+func (s *MemBookingStore) CompleteBooking(ctx context.Context, bookingID, driverID int32, entry *model.JournalEntry, now time.Time) (*model.Booking, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	if err := ledger.Validate(entry); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, ok := s.bookings[bookingID]
+	if !ok {
+		return nil, ErrBookingNotFound
+	}
+	if booking.Status != model.BookingConfirmed || booking.DriverID != driverID {
+		return nil, ErrBookingNotConfirmed
+	}
+
+	booking.Status, booking.ChargeID = model.BookingCompleted, entry.Reference
+	msg, err := bookingStatusChanged(&booking, model.BookingConfirmed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+
+	if err := s.addEntry(entry, bookingID, now); err != nil {
+		return nil, err
+	}
+	s.outbox.Add(msg)
+	s.bookings[bookingID] = booking
+	return &booking, nil
+}
+
+// CancelBooking cancels a booking as c asks, expires its pending offer, if
+// any, and records BookingStatusChanged and DriverOfferUpdated events and
+// entry, the journal entry of the cancellation fee if one was charged,
+// atomically. It returns the booking as saved and the status it had. A
+// cancelled booking is returned unchanged. It fails with
+// ErrBookingNotCancellable unless c permits cancelling the booking.
+func (s *MemBookingStore) CancelBooking(ctx context.Context, c *model.Cancellation, entry *model.JournalEntry, now time.Time) (*model.Booking, string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, "", translateError(err, ErrDatabaseOperation)
+	}
+	if entry != nil {
+		if err := ledger.Validate(entry); err != nil {
+			return nil, "", fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, ok := s.bookings[c.BookingID]
+	if !ok {
+		return nil, "", ErrBookingNotFound
+	}
+	if !c.Permits(&booking) {
+		return nil, "", ErrBookingNotCancellable
+	}
+	previous := booking.Status
+	if previous == model.BookingCancelled {
+		return &booking, previous, nil
+	}
+
+	booking.Status, booking.CancellationReason = model.BookingCancelled, c.Reason
+	booking.CancellationFee, booking.ChargeID = c.Fee, c.ChargeID
+	msg, err := bookingStatusChanged(&booking, previous)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+	msgs := []outbox.Message{msg}
+	offers := s.listOffers(func(o model.Offer) bool { return o.BookingID == c.BookingID && o.Status == model.OfferPending })
+	for i := range offers {
+		offers[i].Status = model.OfferExpired
+		msg, err := offerUpdated(&offers[i])
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	if entry != nil {
+		if err := s.addEntry(entry, c.BookingID, now); err != nil {
+			return nil, "", err
+		}
+	}
+	for _, m := range msgs {
+		s.outbox.Add(m)
+	}
+	for _, o := range offers {
+		s.offers[o.ID] = o
+	}
+	s.bookings[c.BookingID] = booking
+	return &booking, previous, nil
+}
+
+// RefundBooking adds a refund to what was refunded of a completed or
+// cancelled booking, records its reason on the booking and stores entry,
+// the refund's journal entry, atomically. It fails with
+// ErrBookingNotRefundable if the booking is neither completed nor cancelled,
+// and with ErrRefundConflict if it was refunded since refund.Previous.
+func (s *MemBookingStore) RefundBooking(ctx context.Context, refund *model.Refund, entry *model.JournalEntry, now time.Time) (*model.Booking, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	if err := ledger.Validate(entry); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, ok := s.bookings[refund.BookingID]
+	if !ok {
+		return nil, ErrBookingNotFound
+	}
+	if booking.Status != model.BookingCompleted && booking.Status != model.BookingCancelled {
+		return nil, ErrBookingNotRefundable
+	}
+	if booking.Refunded.Minor != refund.Previous.Minor {
+		return nil, ErrRefundConflict
+	}
+
+	if err := s.addEntry(entry, refund.BookingID, now); err != nil {
+		return nil, err
+	}
+	booking.Refunded = money.Money{Currency: refund.Amount.Currency, Minor: booking.Refunded.Minor + refund.Amount.Minor}
+	booking.RefundReason = refund.Reason
+	s.bookings[refund.BookingID] = booking
+	return &booking, nil
+}
+
+// addEntry stores a journal entry of a booking, setting the fields the
+// store assigns on entry. Like the database, it fails with ErrAlreadyExists
+// if the booking has an entry of the same kind, unless both are refunds.
+// s.mu must be held.
+func (s *MemBookingStore) addEntry(entry *model.JournalEntry, bookingID int32, now time.Time) error {
+	if entry.Kind != model.EntryRefund {
+		for _, e := range s.entries {
+			if e.BookingID == bookingID && e.Kind == entry.Kind {
+				return fmt.Errorf("%w: booking %d has a %s entry", ErrAlreadyExists, bookingID, entry.Kind)
+			}
+		}
+	}
+
+	stored := *entry
+	stored.ID, stored.BookingID, stored.CreatedAt = int64(len(s.entries)+1), bookingID, now
+	stored.Postings = slices.Clone(entry.Postings)
+	s.entries = append(s.entries, stored)
+	entry.ID, entry.BookingID, entry.CreatedAt = stored.ID, stored.BookingID, stored.CreatedAt
+	return nil
+}
+
+// GetBalance returns the balance of an account in each currency it was
+// posted in, ordered by currency code.
+func (s *MemBookingStore) GetBalance(ctx context.Context, account model.Account) ([]money.Money, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sums := map[string]int64{}
+	for _, e := range s.entries {
+		for _, p := range e.Postings {
+			if p.Account == account {
+				sums[p.Amount.Currency] += p.Amount.Minor
+			}
+		}
+	}
+	balances := []money.Money{}
+	for currency, minor := range sums {
+		balances = append(balances, money.Money{Currency: currency, Minor: minor})
+	}
+	slices.SortFunc(balances, func(a, b money.Money) int { return cmp.Compare(a.Currency, b.Currency) })
+	return balances, nil
+}
+
+// ListStatement returns up to limit postings to an account, newest first,
+// from entries before beforeEntryID, or from the newest entry if it is 0.
+func (s *MemBookingStore) ListStatement(ctx context.Context, account model.Account, beforeEntryID int64, limit int) ([]model.StatementLine, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	lines := []model.StatementLine{}
+	for i := len(s.entries) - 1; i >= 0 && len(lines) < limit; i-- {
+		e := s.entries[i]
+		if beforeEntryID != 0 && e.ID >= beforeEntryID {
+			continue
+		}
+		for _, p := range e.Postings {
+			if p.Account == account {
+				lines = append(lines, model.StatementLine{
+					EntryID: e.ID, BookingID: e.BookingID, Kind: e.Kind, Reference: e.Reference, Amount: p.Amount, CreatedAt: e.CreatedAt,
+				})
+			}
+		}
+	}
+	return lines, nil
+}
+
+// SeedDemoData loads the same fixtures as docker/init.sql. Like the SQL
+// seed, it records no events.
+func (s *MemBookingStore) SeedDemoData(ctx context.Context) error {
+	for _, name := range []string{"Usman Attiq", "Adeel Ahmed", "Zaid Iqbal"} {
+		if _, err := s.CreateUser(ctx, name); err != nil {
+			return err
+		}
+	}
+	rides := []model.Ride{
+		{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: money.Money{Currency: "PKR", Minor: 15000},
+			Pickup: &model.LatLng{Lat: 31.5497, Lng: 74.2500}, Dropoff: &model.LatLng{Lat: 31.5216, Lng: 74.4036}},
+		{Source: "City Center", Destination: "Mall", Distance: 8, Cost: money.Money{Currency: "PKR", Minor: 8000},
+			Pickup: &model.LatLng{Lat: 31.5102, Lng: 74.3441}, Dropoff: &model.LatLng{Lat: 31.4660, Lng: 74.2770}},
+		{Source: "Train Station", Destination: "University", Distance: 12, Cost: money.Money{Currency: "PKR", Minor: 12000},
+			Pickup: &model.LatLng{Lat: 31.5770, Lng: 74.3361}, Dropoff: &model.LatLng{Lat: 31.4740, Lng: 74.3000}},
+	}
+	for i, r := range rides {
+		rideID, err := s.CreateRide(ctx, r.Source, r.Destination, r.Distance, r.Cost)
+		if err != nil {
+			return err
+		}
+		s.mu.Lock()
+		r.ID = rideID
+		s.rides[rideID] = r
+		s.mu.Unlock()
+		s.createBooking(int32(i+1), rideID, time.Now())
+	}
+	return s.CreatePromo(ctx, &model.Promo{
+		Code: "WELCOME20", Type: model.PromoPercent, Currency: "PKR", PercentOff: 20,
+		AmountOff: money.Zero("PKR"), MaxDiscount: money.Money{Currency: "PKR", Minor: 20000}, MinFare: money.Zero("PKR"),
+		StartsAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), EndsAt: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		MaxPerUser: 1,
+	})
+}
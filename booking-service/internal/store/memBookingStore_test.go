@@ -0,0 +1,15 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	"github.com/golang_falcon_task/booking-service/internal/store/storetest"
+)
+
+func TestMemBookingStore_Conformance(t *testing.T) {
+	storetest.RunBookingStoreTests(t, func(t *testing.T) storetest.Harness {
+		s := store.NewMemBookingStore()
+		return storetest.Harness{Store: s, CreateUser: s.CreateUser, CreateRide: s.CreateRide, Outbox: s.Outbox(), Feed: s.Outbox()}
+	})
+}
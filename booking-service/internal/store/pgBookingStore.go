@@ -2,10 +2,15 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/golang_falcon_task/booking-service/internal/ledger"
 	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -18,34 +23,6 @@ func NewPGBookingStore(db *pgxpool.Pool) *PGBookingStore {
 	return &PGBookingStore{db: db}
 }
 
-// CreateRide inserts a new ride into the database.
-func (s *PGBookingStore) CreateRide(ctx context.Context, source, destination string, distance, cost int32) (int32, error) {
-	var rideID int32
-	err := s.db.QueryRow(ctx, `
-        INSERT INTO rides (source, destination, distance, cost)
-        VALUES ($1, $2, $3, $4)
-        RETURNING ride_id
-    `, source, destination, distance, cost).Scan(&rideID)
-	if err != nil {
-		return 0, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
-	}
-	return rideID, nil
-}
-
-// CreateBooking inserts a new booking into the database.
-func (s *PGBookingStore) CreateBooking(ctx context.Context, userID, rideID int32, bookingTime time.Time) (int32, error) {
-	var bookingID int32
-	err := s.db.QueryRow(ctx, `
-        INSERT INTO bookings (user_id, ride_id, time)
-        VALUES ($1, $2, $3)
-        RETURNING booking_id
-    `, userID, rideID, bookingTime).Scan(&bookingID)
-	if err != nil {
-		return 0, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
-	}
-	return bookingID, nil
-}
-
 // GetBookingDetails retrieves booking details with joins on users and rides tables.
 func (s *PGBookingStore) GetBookingDetails(ctx context.Context, bookingID int32) (*model.Booking, *model.User, *model.Ride, error) {
 	var (
@@ -54,25 +31,1219 @@ func (s *PGBookingStore) GetBookingDetails(ctx context.Context, bookingID int32)
 		ride    model.Ride
 	)
 
+	var pickupLat, pickupLng, dropoffLat, dropoffLng *float64
+	var d discountColumns
+	var pc paymentColumns
+	var remindedAt, assignedAt *time.Time
+
 	err := s.db.QueryRow(ctx, `
-        SELECT b.booking_id, b.user_id, b.ride_id, b.time, 
+        SELECT b.booking_id, b.user_id, b.ride_id, COALESCE(b.driver_id, 0), b.time, b.status, b.tariff_version, b.quote_id,
+               b.promo_code, b.fare, b.discount, b.currency, b.payment_method, b.hold_id,
+               b.cancellation_reason, b.cancellation_fee, b.charge_id, b.refunded, b.refund_reason, b.payment_currency, b.cost,
+               b.pickup_time, b.reminded_at, b.assigned_at,
                u.user_id, u.name,
-               r.ride_id, r.source, r.destination, r.distance, r.cost
+               r.ride_id, r.source, r.destination, r.distance, r.cost, r.currency,
+               r.pickup_lat, r.pickup_lng, r.dropoff_lat, r.dropoff_lng
         FROM bookings b
         JOIN users u ON b.user_id = u.user_id
         JOIN rides r ON b.ride_id = r.ride_id
         WHERE b.booking_id = $1
     `, bookingID).Scan(
-		&booking.ID, &booking.UserID, &booking.RideID, &booking.Timestamp,
+		&booking.ID, &booking.UserID, &booking.RideID, &booking.DriverID, &booking.Timestamp, &booking.Status, &booking.TariffVersion, &booking.QuoteID,
+		&d.promoCode, &d.fare, &d.amount, &d.currency, &booking.PaymentMethod, &booking.HoldID,
+		&booking.CancellationReason, &pc.fee, &booking.ChargeID, &pc.refunded, &booking.RefundReason, &pc.currency, &pc.cost,
+		&booking.PickupTime, &remindedAt, &assignedAt,
 		&user.ID, &user.Name,
-		&ride.ID, &ride.Source, &ride.Destination, &ride.Distance, &ride.Cost,
+		&ride.ID, &ride.Source, &ride.Destination, &ride.Distance, &ride.Cost.Minor, &ride.Cost.Currency,
+		&pickupLat, &pickupLng, &dropoffLat, &dropoffLng,
 	)
 	if err != nil {
-		if err.Error() == "no rows in result set" {
-			return nil, nil, nil, ErrBookingNotFound
-		}
-		return nil, nil, nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+		return nil, nil, nil, translateError(err, ErrBookingNotFound)
 	}
+	ride.Pickup = latLngOf(pickupLat, pickupLng)
+	ride.Dropoff = latLngOf(dropoffLat, dropoffLng)
+	booking.Discount = d.discount()
+	booking.Cost, booking.CancellationFee, booking.Refunded = pc.amounts()
+	booking.RemindedAt = timeOf(remindedAt)
+	booking.AssignedAt = timeOf(assignedAt)
 
 	return &booking, &user, &ride, nil
 }
+
+// bookingColumns are the bookings columns scanned by scanBooking, in order.
+const bookingColumns = `booking_id, user_id, ride_id, COALESCE(driver_id, 0), time, status, tariff_version, quote_id,
+        promo_code, fare, discount, currency, payment_method, hold_id,
+        cancellation_reason, cancellation_fee, charge_id, refunded, refund_reason, payment_currency, cost, pickup_time, reminded_at,
+        assigned_at`
+
+// scanBooking reads a booking selected with bookingColumns, followed by
+// any columns scanned into extra.
+func scanBooking(row pgx.Row, extra ...any) (*model.Booking, error) {
+	var b model.Booking
+	var d discountColumns
+	var pc paymentColumns
+	var remindedAt, assignedAt *time.Time
+	dest := []any{&b.ID, &b.UserID, &b.RideID, &b.DriverID, &b.Timestamp, &b.Status, &b.TariffVersion, &b.QuoteID,
+		&d.promoCode, &d.fare, &d.amount, &d.currency, &b.PaymentMethod, &b.HoldID,
+		&b.CancellationReason, &pc.fee, &b.ChargeID, &pc.refunded, &b.RefundReason, &pc.currency, &pc.cost, &b.PickupTime, &remindedAt,
+		&assignedAt}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	b.Discount = d.discount()
+	b.Cost, b.CancellationFee, b.Refunded = pc.amounts()
+	b.RemindedAt = timeOf(remindedAt)
+	b.AssignedAt = timeOf(assignedAt)
+	return &b, nil
+}
+
+// paymentColumns holds the cost, cancellation_fee, refunded and
+// payment_currency columns a booking's cost, fee and refunds are stored in.
+type paymentColumns struct {
+	cost          *int64
+	fee, refunded int64
+	currency      string
+}
+
+// amounts builds the cost, cancellation fee and refunded amounts stored in
+// the columns, each zero Money if none.
+func (c paymentColumns) amounts() (cost, fee, refunded money.Money) {
+	if c.cost != nil {
+		cost = money.Money{Currency: c.currency, Minor: *c.cost}
+	}
+	if c.fee != 0 {
+		fee = money.Money{Currency: c.currency, Minor: c.fee}
+	}
+	if c.refunded != 0 {
+		refunded = money.Money{Currency: c.currency, Minor: c.refunded}
+	}
+	return cost, fee, refunded
+}
+
+// discountColumns holds the promo_code, fare, discount and currency columns
+// a discount is stored in.
+type discountColumns struct {
+	promoCode    string
+	fare, amount int64
+	currency     string
+}
+
+// discount builds the discount stored in the columns, the zero Discount if
+// no promo code was applied.
+func (d discountColumns) discount() model.Discount {
+	if d.promoCode == "" {
+		return model.Discount{}
+	}
+	return model.Discount{
+		PromoCode: d.promoCode,
+		Fare:      money.Money{Currency: d.currency, Minor: d.fare},
+		Amount:    money.Money{Currency: d.currency, Minor: d.amount},
+	}
+}
+
+// timeOf returns the time in a nullable column, zero if NULL.
+func timeOf(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+// timeArg returns the parameter for a nullable time column, NULL if t is
+// zero.
+func timeArg(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// latLngOf builds a point from its nullable columns.
+func latLngOf(lat, lng *float64) *model.LatLng {
+	if lat == nil || lng == nil {
+		return nil
+	}
+	return &model.LatLng{Lat: *lat, Lng: *lng}
+}
+
+// latLngArgs returns the parameters for the columns of a point, NULL if unknown.
+func latLngArgs(p *model.LatLng) (lat, lng *float64) {
+	if p == nil {
+		return nil, nil
+	}
+	return &p.Lat, &p.Lng
+}
+
+// ListBookings retrieves all bookings of a user ordered by booking ID.
+func (s *PGBookingStore) ListBookings(ctx context.Context, userID int32) ([]model.Booking, error) {
+	rows, err := s.db.Query(ctx, `
+        SELECT `+bookingColumns+`
+        FROM bookings
+        WHERE user_id = $1
+        ORDER BY booking_id
+    `, userID)
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	defer rows.Close()
+
+	bookings := []model.Booking{}
+	for rows.Next() {
+		b, err := scanBooking(rows)
+		if err != nil {
+			return nil, translateError(err, ErrDatabaseOperation)
+		}
+		bookings = append(bookings, *b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	return bookings, nil
+}
+
+// sagaColumns are the booking_sagas columns scanned by scanSaga, in order.
+const sagaColumns = `saga_id, user_id, source, destination, distance, cost, currency, pickup_lat, pickup_lng,
+        dropoff_lat, dropoff_lng, ride_id, status, step, booking_id, error, version, created_at, updated_at, tariff_version, quote_id,
+        promo_code, fare, discount, payment_method, hold_id, pickup_time`
+
+// scanSaga reads a booking saga selected with sagaColumns.
+func scanSaga(row pgx.Row) (*model.BookingSaga, error) {
+	var saga model.BookingSaga
+	var pickupLat, pickupLng, dropoffLat, dropoffLng *float64
+	var d discountColumns
+	var pickupTime *time.Time
+	err := row.Scan(
+		&saga.ID, &saga.UserID, &saga.Ride.Source, &saga.Ride.Destination, &saga.Ride.Distance, &saga.Ride.Cost.Minor, &saga.Ride.Cost.Currency,
+		&pickupLat, &pickupLng, &dropoffLat, &dropoffLng, &saga.Ride.ID,
+		&saga.Status, &saga.Step, &saga.BookingID, &saga.Error, &saga.Version, &saga.CreatedAt, &saga.UpdatedAt, &saga.TariffVersion,
+		&saga.QuoteID, &d.promoCode, &d.fare, &d.amount, &saga.PaymentMethod, &saga.HoldID, &pickupTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	saga.Ride.Pickup = latLngOf(pickupLat, pickupLng)
+	saga.Ride.Dropoff = latLngOf(dropoffLat, dropoffLng)
+	d.currency = saga.Ride.Cost.Currency
+	saga.Discount = d.discount()
+	saga.PickupTime = timeOf(pickupTime)
+	return &saga, nil
+}
+
+// quoteColumns are the fare_quotes columns scanned by scanQuote, in order.
+const quoteColumns = `quote_id, pickup_lat, pickup_lng, dropoff_lat, dropoff_lng, vehicle_class, distance, cost, currency,
+        surge, zone, tariff_version, created_at, expires_at, used_at IS NOT NULL,
+        base_fare, distance_fare, time_fare, duration_minutes, class_multiplier, minimum_applied`
+
+// scanQuote reads a fare quote selected with quoteColumns.
+func scanQuote(row pgx.Row) (*model.Quote, error) {
+	var q model.Quote
+	err := row.Scan(
+		&q.ID, &q.Pickup.Lat, &q.Pickup.Lng, &q.Dropoff.Lat, &q.Dropoff.Lng, &q.VehicleClass, &q.DistanceKm, &q.Cost.Minor, &q.Cost.Currency,
+		&q.Surge, &q.Zone, &q.TariffVersion, &q.CreatedAt, &q.ExpiresAt, &q.Used,
+		&q.BaseFare.Minor, &q.DistanceFare.Minor, &q.TimeFare.Minor, &q.DurationMinutes, &q.Multiplier, &q.MinimumApplied,
+	)
+	if err != nil {
+		return nil, err
+	}
+	q.BaseFare.Currency, q.DistanceFare.Currency, q.TimeFare.Currency = q.Cost.Currency, q.Cost.Currency, q.Cost.Currency
+	return &q, nil
+}
+
+// CreateQuote inserts a new fare quote.
+func (s *PGBookingStore) CreateQuote(ctx context.Context, quote *model.Quote) error {
+	_, err := s.db.Exec(ctx, `
+        INSERT INTO fare_quotes (quote_id, pickup_lat, pickup_lng, dropoff_lat, dropoff_lng, vehicle_class, distance, cost,
+                                 currency, surge, zone, tariff_version, created_at, expires_at,
+                                 base_fare, distance_fare, time_fare, duration_minutes, class_multiplier, minimum_applied)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+    `, quote.ID, quote.Pickup.Lat, quote.Pickup.Lng, quote.Dropoff.Lat, quote.Dropoff.Lng, quote.VehicleClass, quote.DistanceKm,
+		quote.Cost.Minor, quote.Cost.Currency, quote.Surge, quote.Zone, quote.TariffVersion, quote.CreatedAt, quote.ExpiresAt,
+		quote.BaseFare.Minor, quote.DistanceFare.Minor, quote.TimeFare.Minor, quote.DurationMinutes, quote.Multiplier, quote.MinimumApplied)
+	return translateError(err, ErrDatabaseOperation)
+}
+
+// GetQuote retrieves a fare quote by ID.
+func (s *PGBookingStore) GetQuote(ctx context.Context, quoteID string) (*model.Quote, error) {
+	quote, err := scanQuote(s.db.QueryRow(ctx, `SELECT `+quoteColumns+` FROM fare_quotes WHERE quote_id = $1`, quoteID))
+	if err != nil {
+		return nil, translateError(err, ErrQuoteNotFound)
+	}
+	return quote, nil
+}
+
+// CreateSaga inserts a new booking saga, records a BookingSagaUpdated event
+// and sets the saga's version and timestamps. A saga with a QuoteID uses the
+// quote up; it fails with ErrQuoteUsed if another saga already did. A saga
+// with a Discount redeems its promo code; it fails with ErrPromoExhausted or
+// ErrPromoLimitReached if the code's limits are reached.
+func (s *PGBookingStore) CreateSaga(ctx context.Context, saga *model.BookingSaga) error {
+	created := *saga
+	pickupLat, pickupLng := latLngArgs(saga.Ride.Pickup)
+	dropoffLat, dropoffLng := latLngArgs(saga.Ride.Dropoff)
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		if saga.QuoteID != "" {
+			var used bool
+			err := tx.QueryRow(ctx, `SELECT used_at IS NOT NULL FROM fare_quotes WHERE quote_id = $1 FOR UPDATE`, saga.QuoteID).Scan(&used)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrQuoteNotFound
+			}
+			if err != nil {
+				return err
+			}
+			if used {
+				return ErrQuoteUsed
+			}
+			if _, err := tx.Exec(ctx, `UPDATE fare_quotes SET used_at = CURRENT_TIMESTAMP WHERE quote_id = $1`, saga.QuoteID); err != nil {
+				return err
+			}
+		}
+
+		err := tx.QueryRow(ctx, `
+            INSERT INTO booking_sagas (saga_id, user_id, source, destination, distance, cost, currency, pickup_lat, pickup_lng,
+                                       dropoff_lat, dropoff_lng, ride_id, status, step, booking_id, error, tariff_version, quote_id,
+                                       promo_code, fare, discount, payment_method, hold_id, pickup_time)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
+            RETURNING version, created_at, updated_at
+        `, saga.ID, saga.UserID, saga.Ride.Source, saga.Ride.Destination, saga.Ride.Distance, saga.Ride.Cost.Minor, saga.Ride.Cost.Currency,
+			pickupLat, pickupLng, dropoffLat, dropoffLng, saga.Ride.ID,
+			saga.Status, saga.Step, saga.BookingID, saga.Error, saga.TariffVersion, saga.QuoteID,
+			saga.Discount.PromoCode, saga.Discount.Fare.Minor, saga.Discount.Amount.Minor, saga.PaymentMethod, saga.HoldID,
+			timeArg(saga.PickupTime)).Scan(&created.Version, &created.CreatedAt, &created.UpdatedAt)
+		if err != nil {
+			return err
+		}
+		if saga.Discount.Applied() {
+			if err := redeem(ctx, tx, saga); err != nil {
+				return err
+			}
+		}
+		return writeSagaUpdated(ctx, tx, &created)
+	})
+	switch {
+	case errors.Is(err, ErrQuoteNotFound), errors.Is(err, ErrQuoteUsed),
+		errors.Is(err, ErrPromoNotFound), errors.Is(err, ErrPromoExhausted), errors.Is(err, ErrPromoLimitReached):
+		return err
+	case err != nil:
+		return translateError(err, ErrDatabaseOperation)
+	}
+	*saga = created
+	return nil
+}
+
+// promoColumns are the promos columns scanned by scanPromo, in order.
+const promoColumns = `code, type, currency, percent_off, amount_off, max_discount, min_fare, vehicle_classes,
+        starts_at, ends_at, max_redemptions, max_per_user, redemptions, created_at`
+
+// scanPromo reads a promo selected with promoColumns.
+func scanPromo(row pgx.Row) (*model.Promo, error) {
+	var p model.Promo
+	err := row.Scan(
+		&p.Code, &p.Type, &p.Currency, &p.PercentOff, &p.AmountOff.Minor, &p.MaxDiscount.Minor, &p.MinFare.Minor, &p.VehicleClasses,
+		&p.StartsAt, &p.EndsAt, &p.MaxRedemptions, &p.MaxPerUser, &p.Redemptions, &p.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.AmountOff.Currency, p.MaxDiscount.Currency, p.MinFare.Currency = p.Currency, p.Currency, p.Currency
+	if len(p.VehicleClasses) == 0 {
+		p.VehicleClasses = nil
+	}
+	return &p, nil
+}
+
+// CreatePromo inserts a new promo code and sets its creation time.
+func (s *PGBookingStore) CreatePromo(ctx context.Context, promo *model.Promo) error {
+	created := *promo
+	classes := promo.VehicleClasses
+	if classes == nil {
+		classes = []string{}
+	}
+	err := s.db.QueryRow(ctx, `
+        INSERT INTO promos (code, type, currency, percent_off, amount_off, max_discount, min_fare, vehicle_classes,
+                            starts_at, ends_at, max_redemptions, max_per_user)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+        RETURNING redemptions, created_at
+    `, promo.Code, promo.Type, promo.Currency, promo.PercentOff, promo.AmountOff.Minor, promo.MaxDiscount.Minor, promo.MinFare.Minor,
+		classes, promo.StartsAt, promo.EndsAt, promo.MaxRedemptions, promo.MaxPerUser).Scan(&created.Redemptions, &created.CreatedAt)
+	if err != nil {
+		return translateError(err, ErrDatabaseOperation)
+	}
+	*promo = created
+	return nil
+}
+
+// GetPromo retrieves a promo code.
+func (s *PGBookingStore) GetPromo(ctx context.Context, code string) (*model.Promo, error) {
+	promo, err := scanPromo(s.db.QueryRow(ctx, `SELECT `+promoColumns+` FROM promos WHERE code = $1`, code))
+	if err != nil {
+		return nil, translateError(err, ErrPromoNotFound)
+	}
+	return promo, nil
+}
+
+// redeem counts the redemption of saga's promo code in tx, after checking
+// the code's limits. The promo row is locked, so concurrent redemptions of
+// one code are counted one at a time.
+func redeem(ctx context.Context, tx pgx.Tx, saga *model.BookingSaga) error {
+	var maxRedemptions, maxPerUser, redemptions int32
+	err := tx.QueryRow(ctx, `SELECT max_redemptions, max_per_user, redemptions FROM promos WHERE code = $1 FOR UPDATE`,
+		saga.Discount.PromoCode).Scan(&maxRedemptions, &maxPerUser, &redemptions)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrPromoNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if maxRedemptions > 0 && redemptions >= maxRedemptions {
+		return ErrPromoExhausted
+	}
+	if maxPerUser > 0 {
+		var redeemed int32
+		err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM promo_redemptions WHERE code = $1 AND user_id = $2`,
+			saga.Discount.PromoCode, saga.UserID).Scan(&redeemed)
+		if err != nil {
+			return err
+		}
+		if redeemed >= maxPerUser {
+			return ErrPromoLimitReached
+		}
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO promo_redemptions (saga_id, code, user_id) VALUES ($1, $2, $3)`,
+		saga.ID, saga.Discount.PromoCode, saga.UserID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `UPDATE promos SET redemptions = redemptions + 1 WHERE code = $1`, saga.Discount.PromoCode)
+	return err
+}
+
+// releaseRedemption gives back the promo code redeemed by a saga in tx, if
+// any.
+func releaseRedemption(ctx context.Context, tx pgx.Tx, sagaID string) error {
+	_, err := tx.Exec(ctx, `
+        WITH released AS (DELETE FROM promo_redemptions WHERE saga_id = $1 RETURNING code)
+        UPDATE promos SET redemptions = redemptions - 1 WHERE code IN (SELECT code FROM released)
+    `, sagaID)
+	return err
+}
+
+// releaseQuote gives back the quote used by a saga in tx, if any, so
+// another saga can book with it. A quote another saga has used since is
+// left alone.
+func releaseQuote(ctx context.Context, tx pgx.Tx, sagaID string) error {
+	_, err := tx.Exec(ctx, `
+        UPDATE fare_quotes q SET used_at = NULL
+        FROM booking_sagas s
+        WHERE s.saga_id = $1 AND q.quote_id::text = s.quote_id
+          AND NOT EXISTS (
+              SELECT 1 FROM booking_sagas o
+              WHERE o.quote_id = s.quote_id AND o.saga_id <> s.saga_id AND o.status <> $2
+          )
+    `, sagaID, model.SagaFailed)
+	return err
+}
+
+// GetSaga retrieves a booking saga by ID.
+func (s *PGBookingStore) GetSaga(ctx context.Context, sagaID string) (*model.BookingSaga, error) {
+	saga, err := scanSaga(s.db.QueryRow(ctx, `SELECT `+sagaColumns+` FROM booking_sagas WHERE saga_id = $1`, sagaID))
+	if err != nil {
+		return nil, translateError(err, ErrSagaNotFound)
+	}
+	return saga, nil
+}
+
+// UpdateSaga saves the progress of a booking saga and records a
+// BookingSagaUpdated event. It fails with ErrSagaConflict if the saga was
+// updated since it was read, and otherwise increments the saga's version.
+// A saga that failed gives back the promo code it redeemed and the quote it
+// used.
+func (s *PGBookingStore) UpdateSaga(ctx context.Context, saga *model.BookingSaga) error {
+	updated := *saga
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		if err := updateSaga(ctx, tx, &updated); err != nil {
+			return err
+		}
+		if updated.Status == model.SagaFailed {
+			if err := releaseRedemption(ctx, tx, updated.ID); err != nil {
+				return err
+			}
+			if err := releaseQuote(ctx, tx, updated.ID); err != nil {
+				return err
+			}
+		}
+		return writeSagaUpdated(ctx, tx, &updated)
+	})
+	if err != nil {
+		// Only the saga update can match no rows.
+		return translateError(err, ErrSagaConflict)
+	}
+	*saga = updated
+	return nil
+}
+
+// updateSaga runs the UpdateSaga query in tx. A saga that was updated
+// concurrently yields pgx.ErrNoRows.
+func updateSaga(ctx context.Context, tx pgx.Tx, saga *model.BookingSaga) error {
+	return tx.QueryRow(ctx, `
+        UPDATE booking_sagas
+        SET ride_id = $1, distance = $2, status = $3, step = $4, booking_id = $5, error = $6, hold_id = $7,
+            version = version + 1, updated_at = CURRENT_TIMESTAMP
+        WHERE saga_id = $8 AND version = $9
+        RETURNING version, updated_at
+    `, saga.Ride.ID, saga.Ride.Distance, saga.Status, saga.Step, saga.BookingID, saga.Error, saga.HoldID, saga.ID, saga.Version).Scan(&saga.Version, &saga.UpdatedAt)
+}
+
+// writeSagaUpdated records the BookingSagaUpdated event for saga in tx.
+func writeSagaUpdated(ctx context.Context, tx pgx.Tx, saga *model.BookingSaga) error {
+	msg, err := sagaUpdated(saga)
+	if err != nil {
+		return err
+	}
+	return outbox.Write(ctx, tx, msg)
+}
+
+// ListStaleSagas returns up to limit sagas that are still running or
+// compensating and were last updated before updatedBefore, oldest first.
+func (s *PGBookingStore) ListStaleSagas(ctx context.Context, updatedBefore time.Time, limit int) ([]model.BookingSaga, error) {
+	rows, err := s.db.Query(ctx, `
+        SELECT `+sagaColumns+`
+        FROM booking_sagas
+        WHERE status IN ($1, $2) AND updated_at < $3
+        ORDER BY updated_at
+        LIMIT $4
+    `, model.SagaRunning, model.SagaCompensating, updatedBefore, limit)
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	defer rows.Close()
+
+	sagas := []model.BookingSaga{}
+	for rows.Next() {
+		saga, err := scanSaga(rows)
+		if err != nil {
+			return nil, translateError(err, ErrDatabaseOperation)
+		}
+		sagas = append(sagas, *saga)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	return sagas, nil
+}
+
+// CompleteSaga stores the booking of a saga whose ride has been created,
+// records a BookingCreated event and marks the saga completed, recording a
+// BookingSagaUpdated event, all in one transaction. It returns the booking
+// ID. Like UpdateSaga, it fails with ErrSagaConflict if the saga was updated
+// since it was read. The booking is pending until dispatch finds it a
+// driver, or scheduled if the saga has a pickup time.
+func (s *PGBookingStore) CompleteSaga(ctx context.Context, saga *model.BookingSaga, bookingTime time.Time) (int32, error) {
+	// bookings.pickup_time has no time zone either, so keep it in UTC.
+	status, pickupTime := model.BookingPending, bookingTime
+	if !saga.PickupTime.IsZero() {
+		status, pickupTime = model.BookingScheduled, saga.PickupTime.UTC()
+	}
+	booking := model.Booking{
+		UserID:        saga.UserID,
+		RideID:        saga.Ride.ID,
+		Timestamp:     bookingTime,
+		Status:        status,
+		PickupTime:    pickupTime,
+		TariffVersion: saga.TariffVersion,
+		QuoteID:       saga.QuoteID,
+		Discount:      saga.Discount,
+		PaymentMethod: saga.PaymentMethod,
+		HoldID:        saga.HoldID,
+		Cost:          saga.Ride.Cost,
+	}
+	completed := *saga
+	lat, lng := latLngArgs(saga.Ride.Pickup)
+	d := booking.Discount
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, `
+            INSERT INTO bookings (user_id, ride_id, time, status, pickup_lat, pickup_lng, tariff_version,
+                                  promo_code, fare, discount, currency, payment_method, hold_id, pickup_time, cost, payment_currency,
+                                  quote_id)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+            RETURNING booking_id
+        `, booking.UserID, booking.RideID, booking.Timestamp, booking.Status, lat, lng, booking.TariffVersion,
+			d.PromoCode, d.Fare.Minor, d.Amount.Minor, d.Fare.Currency, booking.PaymentMethod, booking.HoldID, booking.PickupTime,
+			booking.Cost.Minor, booking.Cost.Currency, booking.QuoteID).Scan(&booking.ID)
+		if err != nil {
+			return err
+		}
+
+		msg, err := bookingCreated(booking, saga.Ride)
+		if err != nil {
+			return err
+		}
+		if err := outbox.Write(ctx, tx, msg); err != nil {
+			return err
+		}
+
+		completed.Status, completed.Step, completed.BookingID, completed.Error = model.SagaCompleted, model.StepDone, booking.ID, ""
+		if err := updateSaga(ctx, tx, &completed); err != nil {
+			return err
+		}
+		return writeSagaUpdated(ctx, tx, &completed)
+	})
+	if err != nil {
+		// Only the saga update can match no rows.
+		return 0, translateError(err, ErrSagaConflict)
+	}
+	*saga = completed
+	return booking.ID, nil
+}
+
+// offerColumns are the booking_offers columns scanned by scanOffer, in order.
+const offerColumns = `offer_id, booking_id, driver_id, status, distance_km, created_at, expires_at`
+
+// scanOffer reads an offer selected with offerColumns.
+func scanOffer(row pgx.Row) (*model.Offer, error) {
+	var o model.Offer
+	if err := row.Scan(&o.ID, &o.BookingID, &o.DriverID, &o.Status, &o.DistanceKm, &o.CreatedAt, &o.ExpiresAt); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// querier runs queries on the pool or in a transaction.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// queryOffers runs a query selecting offerColumns.
+func queryOffers(ctx context.Context, db querier, sql string, args ...any) ([]model.Offer, error) {
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	offers := []model.Offer{}
+	for rows.Next() {
+		o, err := scanOffer(rows)
+		if err != nil {
+			return nil, err
+		}
+		offers = append(offers, *o)
+	}
+	return offers, rows.Err()
+}
+
+// ListScheduledBookings returns up to limit scheduled bookings after
+// afterID, in ID order, that are due either a reminder or a release: not yet
+// reminded with a pickup by remindBy, or with a pickup by releaseBy.
+func (s *PGBookingStore) ListScheduledBookings(ctx context.Context, remindBy, releaseBy time.Time, afterID int32, limit int) ([]model.Booking, error) {
+	rows, err := s.db.Query(ctx, `
+        SELECT `+bookingColumns+`
+        FROM bookings
+        WHERE status = $1 AND booking_id > $2
+          AND ((reminded_at IS NULL AND pickup_time <= $3) OR pickup_time <= $4)
+        ORDER BY booking_id
+        LIMIT $5
+    `, model.BookingScheduled, afterID, remindBy.UTC(), releaseBy.UTC(), limit)
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	defer rows.Close()
+
+	scheduled := []model.Booking{}
+	for rows.Next() {
+		b, err := scanBooking(rows)
+		if err != nil {
+			return nil, translateError(err, ErrDatabaseOperation)
+		}
+		scheduled = append(scheduled, *b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	return scheduled, nil
+}
+
+// RemindBooking marks the rider of a scheduled booking as reminded at now
+// and records a BookingReminder event. It fails with ErrBookingNotScheduled
+// if the booking is no longer scheduled or its rider was reminded already.
+func (s *PGBookingStore) RemindBooking(ctx context.Context, bookingID int32, now time.Time) error {
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		booking, err := scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns+` FROM bookings WHERE booking_id = $1 FOR UPDATE`, bookingID))
+		if err != nil {
+			return err
+		}
+		if booking.Status != model.BookingScheduled || !booking.RemindedAt.IsZero() {
+			return ErrBookingNotScheduled
+		}
+
+		booking.RemindedAt = now.UTC()
+		if _, err := tx.Exec(ctx, `UPDATE bookings SET reminded_at = $1 WHERE booking_id = $2`, booking.RemindedAt, bookingID); err != nil {
+			return err
+		}
+		msg, err := bookingReminder(booking)
+		if err != nil {
+			return err
+		}
+		return outbox.Write(ctx, tx, msg)
+	})
+	if errors.Is(err, ErrBookingNotScheduled) {
+		return ErrBookingNotScheduled
+	}
+	return translateError(err, ErrBookingNotFound)
+}
+
+// ReleaseBooking moves a scheduled booking into dispatch, making it pending,
+// and records a BookingStatusChanged event. It fails with
+// ErrBookingNotScheduled if the booking is no longer scheduled.
+func (s *PGBookingStore) ReleaseBooking(ctx context.Context, bookingID int32) error {
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		booking, err := scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns+` FROM bookings WHERE booking_id = $1 FOR UPDATE`, bookingID))
+		if err != nil {
+			return err
+		}
+		if booking.Status != model.BookingScheduled {
+			return ErrBookingNotScheduled
+		}
+
+		if _, err := tx.Exec(ctx, `UPDATE bookings SET status = $1 WHERE booking_id = $2`, model.BookingPending, bookingID); err != nil {
+			return err
+		}
+		booking.Status = model.BookingPending
+		msg, err := bookingStatusChanged(booking, model.BookingScheduled)
+		if err != nil {
+			return err
+		}
+		return outbox.Write(ctx, tx, msg)
+	})
+	if errors.Is(err, ErrBookingNotScheduled) {
+		return ErrBookingNotScheduled
+	}
+	return translateError(err, ErrBookingNotFound)
+}
+
+// ListPendingBookings returns up to limit pending bookings with an ID after
+// afterID, oldest first, with their pickup and every offer made for them.
+func (s *PGBookingStore) ListPendingBookings(ctx context.Context, afterID int32, limit int) ([]model.PendingBooking, error) {
+	rows, err := s.db.Query(ctx, `
+        SELECT `+bookingColumns+`, pickup_lat, pickup_lng
+        FROM bookings
+        WHERE status = $1 AND booking_id > $2
+        ORDER BY booking_id
+        LIMIT $3
+    `, model.BookingPending, afterID, limit)
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	defer rows.Close()
+
+	pending := []model.PendingBooking{}
+	index := make(map[int32]int)
+	for rows.Next() {
+		var lat, lng *float64
+		b, err := scanBooking(rows, &lat, &lng)
+		if err != nil {
+			return nil, translateError(err, ErrDatabaseOperation)
+		}
+		index[b.ID] = len(pending)
+		pending = append(pending, model.PendingBooking{Booking: *b, Pickup: latLngOf(lat, lng)})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	if len(pending) == 0 {
+		return pending, nil
+	}
+
+	ids := make([]int32, 0, len(pending))
+	for _, p := range pending {
+		ids = append(ids, p.Booking.ID)
+	}
+	offers, err := queryOffers(ctx, s.db, `
+        SELECT `+offerColumns+`
+        FROM booking_offers
+        WHERE booking_id = ANY($1)
+        ORDER BY offer_id
+    `, ids)
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	for _, o := range offers {
+		p := &pending[index[o.BookingID]]
+		p.Offers = append(p.Offers, o)
+	}
+	return pending, nil
+}
+
+// CreateOffer offers a pending booking to a driver and records a
+// DriverOfferUpdated event. The caller sets CreatedAt and ExpiresAt; the
+// offer's ID and status are set on success. It fails with
+// ErrBookingNotPending if the booking is no longer pending, and with
+// ErrAlreadyExists if the booking or the driver already has a pending offer
+// or the booking was offered to the driver before.
+func (s *PGBookingStore) CreateOffer(ctx context.Context, offer *model.Offer) error {
+	created := *offer
+	created.Status = model.OfferPending
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		var status string
+		err := tx.QueryRow(ctx, `SELECT status FROM bookings WHERE booking_id = $1 FOR UPDATE`, offer.BookingID).Scan(&status)
+		if err != nil {
+			return err
+		}
+		if status != model.BookingPending {
+			return ErrBookingNotPending
+		}
+
+		err = tx.QueryRow(ctx, `
+            INSERT INTO booking_offers (booking_id, driver_id, status, distance_km, created_at, expires_at)
+            VALUES ($1, $2, $3, $4, $5, $6)
+            RETURNING offer_id
+        `, created.BookingID, created.DriverID, created.Status, created.DistanceKm, created.CreatedAt, created.ExpiresAt).Scan(&created.ID)
+		if err != nil {
+			return err
+		}
+		msg, err := offerUpdated(&created)
+		if err != nil {
+			return err
+		}
+		return outbox.Write(ctx, tx, msg)
+	})
+	if errors.Is(err, ErrBookingNotPending) {
+		return ErrBookingNotPending
+	}
+	if err != nil {
+		// Only the booking lookup can match no rows.
+		return translateError(err, ErrBookingNotFound)
+	}
+	*offer = created
+	return nil
+}
+
+// ExpireOffers marks the pending offers that expired by now as expired,
+// recording a DriverOfferUpdated event for each, and returns them.
+func (s *PGBookingStore) ExpireOffers(ctx context.Context, now time.Time) ([]model.Offer, error) {
+	var expired []model.Offer
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		var err error
+		expired, err = queryOffers(ctx, tx, `
+            UPDATE booking_offers
+            SET status = $1
+            WHERE status = $2 AND expires_at <= $3
+            RETURNING `+offerColumns,
+			model.OfferExpired, model.OfferPending, now)
+		if err != nil {
+			return err
+		}
+		for i := range expired {
+			msg, err := offerUpdated(&expired[i])
+			if err != nil {
+				return err
+			}
+			if err := outbox.Write(ctx, tx, msg); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	return expired, nil
+}
+
+// ExpireBooking marks a pending booking without a pending offer as expired
+// and records a BookingStatusChanged event. It fails with
+// ErrBookingNotPending if the booking is not pending or has a pending offer.
+func (s *PGBookingStore) ExpireBooking(ctx context.Context, bookingID int32) error {
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		// Lock the booking first, so CreateOffer cannot add an offer after
+		// the check below.
+		booking, err := scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns+` FROM bookings WHERE booking_id = $1 FOR UPDATE`, bookingID))
+		if err != nil {
+			return err
+		}
+		var offered bool
+		err = tx.QueryRow(ctx, `
+            SELECT EXISTS (SELECT 1 FROM booking_offers WHERE booking_id = $1 AND status = $2)
+        `, bookingID, model.OfferPending).Scan(&offered)
+		if err != nil {
+			return err
+		}
+		if booking.Status != model.BookingPending || offered {
+			return ErrBookingNotPending
+		}
+
+		if _, err := tx.Exec(ctx, `UPDATE bookings SET status = $1 WHERE booking_id = $2`, model.BookingExpired, bookingID); err != nil {
+			return err
+		}
+		booking.Status = model.BookingExpired
+		msg, err := bookingStatusChanged(booking, model.BookingPending)
+		if err != nil {
+			return err
+		}
+		return outbox.Write(ctx, tx, msg)
+	})
+	if errors.Is(err, ErrBookingNotPending) {
+		return ErrBookingNotPending
+	}
+	return translateError(err, ErrBookingNotFound)
+}
+
+// ListDriverOffers returns the offers pending for a driver that have not
+// expired by now, oldest first.
+func (s *PGBookingStore) ListDriverOffers(ctx context.Context, driverID int32, now time.Time) ([]model.Offer, error) {
+	offers, err := queryOffers(ctx, s.db, `
+        SELECT `+offerColumns+`
+        FROM booking_offers
+        WHERE driver_id = $1 AND status = $2 AND expires_at > $3
+        ORDER BY offer_id
+    `, driverID, model.OfferPending, now)
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	return offers, nil
+}
+
+// RespondToOffer accepts or declines an offer made to driverID, recording a
+// DriverOfferUpdated event. Accepting also confirms the booking with the
+// driver and records a BookingStatusChanged event. It returns the offer and
+// its booking as saved. It fails with ErrOfferNotFound if the offer does not
+// exist or was made to another driver, and with ErrOfferClosed if it is no
+// longer pending or expired by now.
+func (s *PGBookingStore) RespondToOffer(ctx context.Context, offerID, driverID int32, accept bool, now time.Time) (*model.Offer, *model.Booking, error) {
+	var (
+		offer   *model.Offer
+		booking *model.Booking
+	)
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		// Lock the booking before the offer, in the same order as CreateOffer.
+		var bookingID int32
+		if err := tx.QueryRow(ctx, `SELECT booking_id FROM booking_offers WHERE offer_id = $1`, offerID).Scan(&bookingID); err != nil {
+			return err
+		}
+		var err error
+		booking, err = scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns+` FROM bookings WHERE booking_id = $1 FOR UPDATE`, bookingID))
+		if err != nil {
+			return err
+		}
+		offer, err = scanOffer(tx.QueryRow(ctx, `SELECT `+offerColumns+` FROM booking_offers WHERE offer_id = $1 FOR UPDATE`, offerID))
+		if err != nil {
+			return err
+		}
+		if offer.DriverID != driverID {
+			return ErrOfferNotFound
+		}
+		if offer.Status != model.OfferPending || !now.Before(offer.ExpiresAt) || booking.Status != model.BookingPending {
+			return ErrOfferClosed
+		}
+
+		offer.Status = model.OfferDeclined
+		if accept {
+			offer.Status = model.OfferAccepted
+		}
+		if _, err := tx.Exec(ctx, `UPDATE booking_offers SET status = $1 WHERE offer_id = $2`, offer.Status, offerID); err != nil {
+			return err
+		}
+		msg, err := offerUpdated(offer)
+		if err != nil {
+			return err
+		}
+		if err := outbox.Write(ctx, tx, msg); err != nil {
+			return err
+		}
+		if !accept {
+			return nil
+		}
+
+		booking.Status, booking.DriverID, booking.AssignedAt = model.BookingConfirmed, driverID, now.UTC()
+		_, err = tx.Exec(ctx, `UPDATE bookings SET status = $1, driver_id = $2, assigned_at = $3 WHERE booking_id = $4`,
+			booking.Status, driverID, booking.AssignedAt, booking.ID)
+		if err != nil {
+			return err
+		}
+		msg, err = bookingStatusChanged(booking, model.BookingPending)
+		if err != nil {
+			return err
+		}
+		return outbox.Write(ctx, tx, msg)
+	})
+	switch {
+	case errors.Is(err, ErrOfferNotFound), errors.Is(err, ErrOfferClosed):
+		return nil, nil, err
+	case err != nil:
+		// Only the offer and booking lookups can match no rows.
+		return nil, nil, translateError(err, ErrOfferNotFound)
+	}
+	return offer, booking, nil
+}
+
+// CompleteBooking marks a booking confirmed with driverID completed and
+// stores entry, recording a BookingStatusChanged event, atomically. It
+// returns the booking as saved and sets the entry's ID and CreatedAt. It
+// fails with ErrBookingNotConfirmed if the booking is not confirmed with
+// the driver.
+func (s *PGBookingStore) CompleteBooking(ctx context.Context, bookingID, driverID int32, entry *model.JournalEntry, now time.Time) (*model.Booking, error) {
+	if err := ledger.Validate(entry); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+
+	var (
+		booking *model.Booking
+		stored  model.JournalEntry
+	)
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		var err error
+		booking, err = scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns+` FROM bookings WHERE booking_id = $1 FOR UPDATE`, bookingID))
+		if err != nil {
+			return err
+		}
+		if booking.Status != model.BookingConfirmed || booking.DriverID != driverID {
+			return ErrBookingNotConfirmed
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE bookings SET status = $1, charge_id = $2 WHERE booking_id = $3`,
+			model.BookingCompleted, entry.Reference, bookingID)
+		if err != nil {
+			return err
+		}
+		booking.Status, booking.ChargeID = model.BookingCompleted, entry.Reference
+		msg, err := bookingStatusChanged(booking, model.BookingConfirmed)
+		if err != nil {
+			return err
+		}
+		if err := outbox.Write(ctx, tx, msg); err != nil {
+			return err
+		}
+		stored, err = insertEntry(ctx, tx, entry, bookingID, now)
+		return err
+	})
+	switch {
+	case errors.Is(err, ErrBookingNotConfirmed):
+		return nil, err
+	case err != nil:
+		// Only the booking lookup can match no rows.
+		return nil, translateError(err, ErrBookingNotFound)
+	}
+	entry.ID, entry.BookingID, entry.CreatedAt = stored.ID, stored.BookingID, stored.CreatedAt
+	return booking, nil
+}
+
+// insertEntry stores a journal entry of a booking in tx, returning it with
+// the fields the database assigns.
+func insertEntry(ctx context.Context, tx pgx.Tx, entry *model.JournalEntry, bookingID int32, now time.Time) (model.JournalEntry, error) {
+	stored := *entry
+	stored.BookingID = bookingID
+	err := tx.QueryRow(ctx, `
+        INSERT INTO journal_entries (booking_id, kind, reference, created_at)
+        VALUES ($1, $2, $3, $4)
+        RETURNING entry_id, created_at
+    `, bookingID, entry.Kind, entry.Reference, now).Scan(&stored.ID, &stored.CreatedAt)
+	if err != nil {
+		return model.JournalEntry{}, err
+	}
+	for _, p := range entry.Postings {
+		_, err := tx.Exec(ctx, `
+            INSERT INTO postings (entry_id, account_type, owner_id, amount, currency)
+            VALUES ($1, $2, $3, $4, $5)
+        `, stored.ID, p.Account.Type, p.Account.OwnerID, p.Amount.Minor, p.Amount.Currency)
+		if err != nil {
+			return model.JournalEntry{}, err
+		}
+	}
+	return stored, nil
+}
+
+// CancelBooking cancels a booking as c asks, expires its pending offer, if
+// any, and records BookingStatusChanged and DriverOfferUpdated events and
+// entry, the journal entry of the cancellation fee if one was charged, all
+// in one transaction. It returns the booking as saved and the status it
+// had. A cancelled booking is returned unchanged. It fails with
+// ErrBookingNotCancellable unless c permits cancelling the booking.
+func (s *PGBookingStore) CancelBooking(ctx context.Context, c *model.Cancellation, entry *model.JournalEntry, now time.Time) (*model.Booking, string, error) {
+	if entry != nil {
+		if err := ledger.Validate(entry); err != nil {
+			return nil, "", fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+		}
+	}
+
+	var (
+		booking  *model.Booking
+		previous string
+		stored   model.JournalEntry
+	)
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		// Lock the booking first, in the same order as RespondToOffer.
+		var err error
+		booking, err = scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns+` FROM bookings WHERE booking_id = $1 FOR UPDATE`, c.BookingID))
+		if err != nil {
+			return err
+		}
+		if !c.Permits(booking) {
+			return ErrBookingNotCancellable
+		}
+		previous = booking.Status
+		if previous == model.BookingCancelled {
+			return nil
+		}
+
+		_, err = tx.Exec(ctx, `
+            UPDATE bookings
+            SET status = $2, cancellation_reason = $3, cancellation_fee = $4,
+                payment_currency = COALESCE(NULLIF($5, ''), payment_currency), charge_id = $6
+            WHERE booking_id = $1
+        `, c.BookingID, model.BookingCancelled, c.Reason, c.Fee.Minor, c.Fee.Currency, c.ChargeID)
+		if err != nil {
+			return err
+		}
+		booking.Status, booking.CancellationReason = model.BookingCancelled, c.Reason
+		booking.CancellationFee, booking.ChargeID = c.Fee, c.ChargeID
+		msg, err := bookingStatusChanged(booking, previous)
+		if err != nil {
+			return err
+		}
+		if err := outbox.Write(ctx, tx, msg); err != nil {
+			return err
+		}
+
+		offers, err := queryOffers(ctx, tx, `
+            UPDATE booking_offers SET status = $1
+            WHERE booking_id = $2 AND status = $3
+            RETURNING `+offerColumns, model.OfferExpired, c.BookingID, model.OfferPending)
+		if err != nil {
+			return err
+		}
+		for i := range offers {
+			msg, err := offerUpdated(&offers[i])
+			if err != nil {
+				return err
+			}
+			if err := outbox.Write(ctx, tx, msg); err != nil {
+				return err
+			}
+		}
+		if entry != nil {
+			stored, err = insertEntry(ctx, tx, entry, c.BookingID, now)
+		}
+		return err
+	})
+	switch {
+	case errors.Is(err, ErrBookingNotCancellable):
+		return nil, "", err
+	case err != nil:
+		// Only the booking lookup can match no rows.
+		return nil, "", translateError(err, ErrBookingNotFound)
+	}
+	if stored.ID != 0 {
+		entry.ID, entry.BookingID, entry.CreatedAt = stored.ID, stored.BookingID, stored.CreatedAt
+	}
+	return booking, previous, nil
+}
+
+// RefundBooking adds a refund to what was refunded of a completed or
+// cancelled booking, records its reason on the booking and stores entry,
+// the refund's journal entry, all in one transaction. It fails with
+// ErrBookingNotRefundable if the booking is neither completed nor cancelled,
+// and with ErrRefundConflict if it was refunded since refund.Previous.
+func (s *PGBookingStore) RefundBooking(ctx context.Context, refund *model.Refund, entry *model.JournalEntry, now time.Time) (*model.Booking, error) {
+	if err := ledger.Validate(entry); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+
+	var (
+		booking *model.Booking
+		stored  model.JournalEntry
+	)
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		var err error
+		booking, err = scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns+` FROM bookings WHERE booking_id = $1 FOR UPDATE`, refund.BookingID))
+		if err != nil {
+			return err
+		}
+		if booking.Status != model.BookingCompleted && booking.Status != model.BookingCancelled {
+			return ErrBookingNotRefundable
+		}
+		if booking.Refunded.Minor != refund.Previous.Minor {
+			return ErrRefundConflict
+		}
+
+		booking.Refunded = money.Money{Currency: refund.Amount.Currency, Minor: booking.Refunded.Minor + refund.Amount.Minor}
+		booking.RefundReason = refund.Reason
+		_, err = tx.Exec(ctx, `
+            UPDATE bookings SET refunded = $2, payment_currency = $3, refund_reason = $4
+            WHERE booking_id = $1
+        `, refund.BookingID, booking.Refunded.Minor, booking.Refunded.Currency, booking.RefundReason)
+		if err != nil {
+			return err
+		}
+		stored, err = insertEntry(ctx, tx, entry, refund.BookingID, now)
+		return err
+	})
+	switch {
+	case errors.Is(err, ErrBookingNotRefundable), errors.Is(err, ErrRefundConflict):
+		return nil, err
+	case err != nil:
+		// Only the booking lookup can match no rows.
+		return nil, translateError(err, ErrBookingNotFound)
+	}
+	entry.ID, entry.BookingID, entry.CreatedAt = stored.ID, stored.BookingID, stored.CreatedAt
+	return booking, nil
+}
+
+// GetBalance returns the balance of an account in each currency it was
+// posted in, ordered by currency code.
+func (s *PGBookingStore) GetBalance(ctx context.Context, account model.Account) ([]money.Money, error) {
+	rows, err := s.db.Query(ctx, `
+        SELECT currency, SUM(amount)::BIGINT
+        FROM postings
+        WHERE account_type = $1 AND owner_id = $2
+        GROUP BY currency
+        ORDER BY currency
+    `, account.Type, account.OwnerID)
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	defer rows.Close()
+
+	balances := []money.Money{}
+	for rows.Next() {
+		var m money.Money
+		if err := rows.Scan(&m.Currency, &m.Minor); err != nil {
+			return nil, translateError(err, ErrDatabaseOperation)
+		}
+		balances = append(balances, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	return balances, nil
+}
+
+// ListStatement returns up to limit postings to an account, newest first,
+// from entries before beforeEntryID, or from the newest entry if it is 0.
+func (s *PGBookingStore) ListStatement(ctx context.Context, account model.Account, beforeEntryID int64, limit int) ([]model.StatementLine, error) {
+	rows, err := s.db.Query(ctx, `
+        SELECT e.entry_id, e.booking_id, e.kind, e.reference, p.amount, p.currency, e.created_at
+        FROM postings p
+        JOIN journal_entries e ON e.entry_id = p.entry_id
+        WHERE p.account_type = $1 AND p.owner_id = $2 AND ($3::BIGINT = 0 OR p.entry_id < $3)
+        ORDER BY p.entry_id DESC
+        LIMIT $4
+    `, account.Type, account.OwnerID, beforeEntryID, limit)
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	defer rows.Close()
+
+	lines := []model.StatementLine{}
+	for rows.Next() {
+		var l model.StatementLine
+		err := rows.Scan(&l.EntryID, &l.BookingID, &l.Kind, &l.Reference, &l.Amount.Minor, &l.Amount.Currency, &l.CreatedAt)
+		if err != nil {
+			return nil, translateError(err, ErrDatabaseOperation)
+		}
+		lines = append(lines, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	return lines, nil
+}
@@ -0,0 +1,36 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	"github.com/golang_falcon_task/booking-service/internal/store/storetest"
+)
+
+func TestPGBookingStore_Conformance(t *testing.T) {
+	storetest.RunBookingStoreTests(t, func(t *testing.T) storetest.Harness {
+		pool := storetest.NewPGPool(t)
+		return storetest.Harness{
+			Store: store.NewPGBookingStore(pool),
+			CreateUser: func(ctx context.Context, name string) (int32, error) {
+				var userID int32
+				err := pool.QueryRow(ctx, `INSERT INTO users (name) VALUES ($1) RETURNING user_id`, name).Scan(&userID)
+				return userID, err
+			},
+			CreateRide: func(ctx context.Context, source, destination string, distance int32, cost money.Money) (int32, error) {
+				var rideID int32
+				err := pool.QueryRow(ctx, `
+                    INSERT INTO rides (source, destination, distance, cost, currency)
+                    VALUES ($1, $2, $3, $4, $5)
+                    RETURNING ride_id
+                `, source, destination, distance, cost.Minor, cost.Currency).Scan(&rideID)
+				return rideID, err
+			},
+			Outbox: outbox.NewPGStore(pool),
+			Feed:   outbox.NewPGFeed(pool),
+		}
+	})
+}
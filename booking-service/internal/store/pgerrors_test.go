@@ -0,0 +1,42 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateError(t *testing.T) {
+	notFound := errors.New("thing not found")
+
+	tests := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{"No Rows", pgx.ErrNoRows, notFound},
+		{"Wrapped No Rows", fmt.Errorf("scan: %w", pgx.ErrNoRows), notFound},
+		{"Unique Violation", &pgconn.PgError{Code: "23505"}, ErrAlreadyExists},
+		{"Foreign Key Violation", &pgconn.PgError{Code: "23503"}, ErrForeignKeyViolation},
+		{"Serialization Failure", &pgconn.PgError{Code: "40001"}, ErrSerializationFailure},
+		{"Deadlock", &pgconn.PgError{Code: "40P01"}, ErrSerializationFailure},
+		{"Statement Timeout", &pgconn.PgError{Code: "57014"}, ErrTimeout},
+		{"Context Deadline", context.DeadlineExceeded, ErrTimeout},
+		{"Context Canceled", context.Canceled, ErrCanceled},
+		{"Other PG Error", &pgconn.PgError{Code: "42P01"}, ErrDatabaseOperation},
+		{"Unknown Error", errors.New("connection reset"), ErrDatabaseOperation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, translateError(tt.err, notFound), tt.expected)
+		})
+	}
+
+	require.NoError(t, translateError(nil, notFound))
+}
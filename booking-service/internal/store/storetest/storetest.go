@@ -0,0 +1,1208 @@
+// Package storetest contains the conformance suite every BookingStore
+// implementation must pass, so the Postgres and in-memory stores stay
+// interchangeable.
+package storetest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/dispatch"
+	"github.com/golang_falcon_task/booking-service/internal/ledger"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/golang_falcon_task/booking-service/internal/money"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/schedule"
+	"github.com/golang_falcon_task/booking-service/internal/service"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// BookingStore is what the service, the dispatch engine and the scheduler
+// need of a store.
+type BookingStore interface {
+	service.BookingStore
+	dispatch.Store
+	schedule.Store
+}
+
+// Harness gives the suite a fresh store and the fixtures it needs. Drivers 1
+// and 2 exist in every store.
+type Harness struct {
+	Store BookingStore
+
+	// CreateUser inserts a user that bookings can reference.
+	CreateUser func(ctx context.Context, name string) (int32, error)
+
+	// CreateRide inserts a ride that bookings can reference.
+	CreateRide func(ctx context.Context, source, destination string, distance int32, cost money.Money) (int32, error)
+
+	// Outbox reads the events the store records.
+	Outbox outbox.Store
+
+	// Feed follows the events the store records.
+	Feed outbox.Feed
+}
+
+// RunBookingStoreTests runs the conformance suite. newHarness is called once
+// per subtest and must return an isolated store.
+func RunBookingStoreTests(t *testing.T, newHarness func(t *testing.T) Harness) {
+	ctx := context.Background()
+
+	t.Run("CompleteSaga And GetBookingDetails", func(t *testing.T) {
+		h := newHarness(t)
+
+		userID, err := h.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		rideID, err := h.CreateRide(ctx, "Downtown", "Airport", 15, pkr(15000))
+		require.NoError(t, err)
+
+		saga := newSaga(t, h, userID, rideID)
+		bookingTime := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+		bookingID, err := h.Store.CompleteSaga(ctx, saga, bookingTime)
+		require.NoError(t, err)
+		require.Positive(t, bookingID)
+
+		booking, user, ride, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, bookingID, booking.ID)
+		require.Equal(t, userID, booking.UserID)
+		require.Equal(t, rideID, booking.RideID)
+		require.True(t, bookingTime.Equal(booking.Timestamp), "expected %v, got %v", bookingTime, booking.Timestamp)
+		require.Equal(t, "2024-12-01", booking.TariffVersion)
+		require.Equal(t, pkr(15000), booking.Cost)
+		require.Equal(t, userID, user.ID)
+		require.Equal(t, "John Doe", user.Name)
+		require.Equal(t, rideID, ride.ID)
+		require.Equal(t, "Downtown", ride.Source)
+		require.Equal(t, "Airport", ride.Destination)
+		require.Equal(t, int32(15), ride.Distance)
+		require.Equal(t, pkr(15000), ride.Cost)
+
+		stored, err := h.Store.GetSaga(ctx, saga.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.SagaCompleted, stored.Status)
+		require.Equal(t, model.StepDone, stored.Step)
+		require.Equal(t, bookingID, stored.BookingID)
+		require.Equal(t, "2024-12-01", stored.TariffVersion)
+		require.Equal(t, saga.Version, stored.Version)
+	})
+
+	t.Run("CompleteSaga Records BookingCreated", func(t *testing.T) {
+		h := newHarness(t)
+
+		userID, err := h.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		rideID, err := h.CreateRide(ctx, "Downtown", "Airport", 15, pkr(15000))
+		require.NoError(t, err)
+
+		bookingTime := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+		bookingID, err := h.Store.CompleteSaga(ctx, newSaga(t, h, userID, rideID), bookingTime)
+		require.NoError(t, err)
+
+		msgs, err := h.Outbox.Pending(ctx, 10)
+		require.NoError(t, err)
+		var topics, ids []string
+		for _, m := range msgs {
+			require.NotEmpty(t, m.ID)
+			topics = append(topics, m.Topic)
+			ids = append(ids, m.ID)
+		}
+		require.Equal(t, []string{"booking.v1.BookingSagaUpdated", "booking.v1.BookingCreated", "booking.v1.BookingSagaUpdated"}, topics)
+
+		var event pb.BookingCreated
+		require.NoError(t, proto.Unmarshal(msgs[1].Payload, &event))
+		expected := &pb.BookingCreated{
+			Booking: &pb.Booking{BookingId: bookingID, UserId: userID, RideId: rideID, Time: "2024-12-01T10:30:00Z", Status: pb.BookingStatus_BOOKING_STATUS_PENDING,
+				TariffVersion: "2024-12-01", PaymentMethod: pb.PaymentMethod_PAYMENT_METHOD_CARD, PickupTime: "2024-12-01T10:30:00Z"},
+			Ride: &pb.Ride{RideId: rideID, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000).Proto()},
+		}
+		require.True(t, proto.Equal(expected, &event), "expected %v, got %v", expected, &event)
+
+		var updated pb.BookingSagaUpdated
+		require.NoError(t, proto.Unmarshal(msgs[2].Payload, &updated))
+		require.Equal(t, pb.SagaStatus_SAGA_STATUS_COMPLETED, updated.Saga.Status)
+		require.Equal(t, bookingID, updated.Saga.BookingId)
+
+		require.NoError(t, h.Outbox.MarkPublished(ctx, ids))
+		msgs, err = h.Outbox.Pending(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+	})
+
+	t.Run("CompleteSaga Unknown User", func(t *testing.T) {
+		h := newHarness(t)
+
+		rideID, err := h.CreateRide(ctx, "Downtown", "Airport", 15, pkr(15000))
+		require.NoError(t, err)
+
+		saga := newSaga(t, h, 1_000_000, rideID)
+		_, err = h.Store.CompleteSaga(ctx, saga, time.Now())
+		require.ErrorIs(t, err, store.ErrForeignKeyViolation)
+
+		// The events and saga update are rolled back with the booking, leaving
+		// only the event recorded when the saga started.
+		msgs, err := h.Outbox.Pending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.Equal(t, "booking.v1.BookingSagaUpdated", msgs[0].Topic)
+		stored, err := h.Store.GetSaga(ctx, saga.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.SagaRunning, stored.Status)
+		require.Equal(t, saga.Version, stored.Version)
+	})
+
+	t.Run("CompleteSaga Twice", func(t *testing.T) {
+		h := newHarness(t)
+
+		userID, err := h.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		rideID, err := h.CreateRide(ctx, "Downtown", "Airport", 15, pkr(15000))
+		require.NoError(t, err)
+
+		saga := newSaga(t, h, userID, rideID)
+		stale := *saga
+		_, err = h.Store.CompleteSaga(ctx, saga, time.Now())
+		require.NoError(t, err)
+
+		// A resumed copy of the saga must not book the ride again.
+		_, err = h.Store.CompleteSaga(ctx, &stale, time.Now())
+		require.ErrorIs(t, err, store.ErrSagaConflict)
+
+		bookings, err := h.Store.ListBookings(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, bookings, 1)
+	})
+
+	t.Run("GetBookingDetails Not Found", func(t *testing.T) {
+		h := newHarness(t)
+
+		_, _, _, err := h.Store.GetBookingDetails(ctx, 1_000_000)
+		require.ErrorIs(t, err, store.ErrBookingNotFound)
+	})
+
+	t.Run("ListBookings", func(t *testing.T) {
+		h := newHarness(t)
+
+		userID, err := h.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		otherID, err := h.CreateUser(ctx, "Jane Doe")
+		require.NoError(t, err)
+
+		var want []int32
+		for _, uid := range []int32{userID, otherID, userID} {
+			rideID, err := h.CreateRide(ctx, "Downtown", "Airport", 15, pkr(15000))
+			require.NoError(t, err)
+			bookingID, err := h.Store.CompleteSaga(ctx, newSaga(t, h, uid, rideID), time.Now())
+			require.NoError(t, err)
+			if uid == userID {
+				want = append(want, bookingID)
+			}
+		}
+
+		bookings, err := h.Store.ListBookings(ctx, userID)
+		require.NoError(t, err)
+		var got []int32
+		for _, b := range bookings {
+			require.Equal(t, userID, b.UserID)
+			got = append(got, b.ID)
+		}
+		require.Equal(t, want, got)
+
+		bookings, err = h.Store.ListBookings(ctx, 1_000_000)
+		require.NoError(t, err)
+		require.Empty(t, bookings)
+	})
+
+	t.Run("Saga Lifecycle", func(t *testing.T) {
+		h := newHarness(t)
+
+		saga := &model.BookingSaga{
+			ID:     uuid.NewString(),
+			UserID: 1,
+			Ride: model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000),
+				Pickup: &model.LatLng{Lat: 31.5497, Lng: 74.2500}, Dropoff: &model.LatLng{Lat: 31.5216, Lng: 74.4036}},
+			Status: model.SagaRunning,
+			Step:   model.StepValidateUser,
+		}
+		require.NoError(t, h.Store.CreateSaga(ctx, saga))
+		require.False(t, saga.CreatedAt.IsZero())
+
+		stored, err := h.Store.GetSaga(ctx, saga.ID)
+		require.NoError(t, err)
+		require.Equal(t, saga.Ride, stored.Ride)
+		require.Equal(t, model.StepValidateUser, stored.Step)
+
+		stale := *stored
+		stored.Step, stored.Ride.ID, stored.Ride.Distance, stored.Error = model.StepCreateBooking, 42, 16, "ride-service unavailable"
+		require.NoError(t, h.Store.UpdateSaga(ctx, stored))
+		require.Equal(t, stale.Version+1, stored.Version)
+
+		got, err := h.Store.GetSaga(ctx, saga.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.StepCreateBooking, got.Step)
+		require.Equal(t, int32(42), got.Ride.ID)
+		require.Equal(t, int32(16), got.Ride.Distance)
+		require.Equal(t, saga.Ride.Dropoff, got.Ride.Dropoff)
+		require.Equal(t, "ride-service unavailable", got.Error)
+		require.Equal(t, stored.Version, got.Version)
+
+		// Writing back the stale copy loses the race.
+		stale.Status = model.SagaFailed
+		require.ErrorIs(t, h.Store.UpdateSaga(ctx, &stale), store.ErrSagaConflict)
+	})
+
+	t.Run("Feed Follows Saga Changes", func(t *testing.T) {
+		h := newHarness(t)
+
+		head, err := h.Feed.Head(ctx)
+		require.NoError(t, err)
+
+		saga := newSaga(t, h, 1, 1)
+		saga.Error = "ride-service unavailable"
+		require.NoError(t, h.Store.UpdateSaga(ctx, saga))
+
+		msgs, err := h.Feed.Since(ctx, head, 10)
+		require.NoError(t, err)
+		require.Len(t, msgs, 2)
+		require.Greater(t, msgs[0].Seq, head)
+		require.Greater(t, msgs[1].Seq, msgs[0].Seq)
+		for i, m := range msgs {
+			require.Equal(t, "booking.v1.BookingSagaUpdated", m.Topic)
+			var event pb.BookingSagaUpdated
+			require.NoError(t, proto.Unmarshal(m.Payload, &event))
+			require.Equal(t, saga.ID, event.Saga.SagaId)
+			require.Equal(t, int32(i), event.Saga.Version)
+		}
+
+		latest, err := h.Feed.Head(ctx)
+		require.NoError(t, err)
+		require.Equal(t, msgs[1].Seq, latest)
+
+		// Publishing does not remove messages from the feed.
+		require.NoError(t, h.Outbox.MarkPublished(ctx, []string{msgs[0].ID, msgs[1].ID}))
+		resumed, err := h.Feed.Since(ctx, msgs[0].Seq, 10)
+		require.NoError(t, err)
+		require.Len(t, resumed, 1)
+		require.Equal(t, msgs[1].ID, resumed[0].ID)
+	})
+
+	t.Run("GetSaga Not Found", func(t *testing.T) {
+		h := newHarness(t)
+
+		_, err := h.Store.GetSaga(ctx, uuid.NewString())
+		require.ErrorIs(t, err, store.ErrSagaNotFound)
+	})
+
+	t.Run("Quote Books One Saga", func(t *testing.T) {
+		h := newHarness(t)
+
+		createdAt := time.Date(2024, 12, 1, 18, 0, 0, 0, time.UTC)
+		quote := &model.Quote{
+			ID:            uuid.NewString(),
+			Pickup:        model.LatLng{Lat: 31.5497, Lng: 74.2500},
+			Dropoff:       model.LatLng{Lat: 31.5216, Lng: 74.4036},
+			VehicleClass:  "comfort",
+			DistanceKm:    15,
+			Cost:          pkr(97550),
+			Surge:         1.2,
+			Zone:          "downtown",
+			TariffVersion: "2024-12-01",
+			CreatedAt:     createdAt,
+			ExpiresAt:     createdAt.Add(2 * time.Minute),
+
+			BaseFare:        pkr(10000),
+			DistanceFare:    pkr(45000),
+			TimeFare:        pkr(10000),
+			DurationMinutes: 20,
+			Multiplier:      1.25,
+		}
+		require.NoError(t, h.Store.CreateQuote(ctx, quote))
+		require.ErrorIs(t, h.Store.CreateQuote(ctx, quote), store.ErrAlreadyExists)
+
+		stored, err := h.Store.GetQuote(ctx, quote.ID)
+		require.NoError(t, err)
+		require.True(t, stored.CreatedAt.Equal(quote.CreatedAt) && stored.ExpiresAt.Equal(quote.ExpiresAt))
+		stored.CreatedAt, stored.ExpiresAt = quote.CreatedAt, quote.ExpiresAt
+		require.Equal(t, quote, stored)
+
+		userID, err := h.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		saga := &model.BookingSaga{ID: uuid.NewString(), UserID: userID, Status: model.SagaRunning, Step: model.StepValidateUser, QuoteID: quote.ID}
+		require.NoError(t, h.Store.CreateSaga(ctx, saga))
+		storedSaga, err := h.Store.GetSaga(ctx, saga.ID)
+		require.NoError(t, err)
+		require.Equal(t, quote.ID, storedSaga.QuoteID)
+
+		stored, err = h.Store.GetQuote(ctx, quote.ID)
+		require.NoError(t, err)
+		require.True(t, stored.Used)
+
+		// A second saga cannot use the quote, and is not created.
+		again := &model.BookingSaga{ID: uuid.NewString(), UserID: userID, Status: model.SagaRunning, Step: model.StepValidateUser, QuoteID: quote.ID}
+		require.ErrorIs(t, h.Store.CreateSaga(ctx, again), store.ErrQuoteUsed)
+		_, err = h.Store.GetSaga(ctx, again.ID)
+		require.ErrorIs(t, err, store.ErrSagaNotFound)
+
+		unknown := &model.BookingSaga{ID: uuid.NewString(), UserID: userID, Status: model.SagaRunning, Step: model.StepValidateUser, QuoteID: uuid.NewString()}
+		require.ErrorIs(t, h.Store.CreateSaga(ctx, unknown), store.ErrQuoteNotFound)
+
+		// A failed saga gives the quote back for another to book with.
+		saga.Status, saga.Step = model.SagaFailed, model.StepDone
+		require.NoError(t, h.Store.UpdateSaga(ctx, saga))
+		stored, err = h.Store.GetQuote(ctx, quote.ID)
+		require.NoError(t, err)
+		require.False(t, stored.Used)
+		require.NoError(t, h.Store.CreateSaga(ctx, again))
+
+		// Saving the failed saga again does not free the quote from the new one.
+		require.NoError(t, h.Store.UpdateSaga(ctx, saga))
+		stored, err = h.Store.GetQuote(ctx, quote.ID)
+		require.NoError(t, err)
+		require.True(t, stored.Used)
+		require.ErrorIs(t, h.Store.CreateSaga(ctx, &model.BookingSaga{ID: uuid.NewString(), UserID: userID, Status: model.SagaRunning,
+			Step: model.StepValidateUser, QuoteID: quote.ID}), store.ErrQuoteUsed)
+
+		// The booking keeps the quote it was priced with.
+		rideID, err := h.CreateRide(ctx, "Downtown", "Airport", 15, pkr(97550))
+		require.NoError(t, err)
+		again.Ride = model.Ride{ID: rideID, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(97550)}
+		bookingID, err := h.Store.CompleteSaga(ctx, again, time.Now().UTC().Truncate(time.Second))
+		require.NoError(t, err)
+		booking, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, quote.ID, booking.QuoteID)
+	})
+
+	t.Run("GetQuote Not Found", func(t *testing.T) {
+		h := newHarness(t)
+
+		_, err := h.Store.GetQuote(ctx, uuid.NewString())
+		require.ErrorIs(t, err, store.ErrQuoteNotFound)
+	})
+
+	t.Run("CreatePromo And GetPromo", func(t *testing.T) {
+		h := newHarness(t)
+
+		promo := &model.Promo{
+			Code: "FLAT200", Type: model.PromoFlat, Currency: "PKR",
+			AmountOff: pkr(20000), MaxDiscount: pkr(0), MinFare: pkr(30000), VehicleClasses: []string{"comfort", "xl"},
+			StartsAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), EndsAt: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			MaxRedemptions: 100, MaxPerUser: 2,
+		}
+		require.NoError(t, h.Store.CreatePromo(ctx, promo))
+		require.False(t, promo.CreatedAt.IsZero())
+		require.ErrorIs(t, h.Store.CreatePromo(ctx, promo), store.ErrAlreadyExists)
+
+		stored, err := h.Store.GetPromo(ctx, "FLAT200")
+		require.NoError(t, err)
+		require.True(t, stored.StartsAt.Equal(promo.StartsAt) && stored.EndsAt.Equal(promo.EndsAt) && stored.CreatedAt.Equal(promo.CreatedAt))
+		stored.StartsAt, stored.EndsAt, stored.CreatedAt = promo.StartsAt, promo.EndsAt, promo.CreatedAt
+		require.Equal(t, promo, stored)
+
+		_, err = h.Store.GetPromo(ctx, "UNKNOWN")
+		require.ErrorIs(t, err, store.ErrPromoNotFound)
+	})
+
+	t.Run("Promo Redemption Limits", func(t *testing.T) {
+		h := newHarness(t)
+
+		newPromo(t, h, "LIMITED", 2, 1)
+		first, err := h.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		second, err := h.CreateUser(ctx, "Jane Doe")
+		require.NoError(t, err)
+		third, err := h.CreateUser(ctx, "Jim Doe")
+		require.NoError(t, err)
+
+		require.NoError(t, h.Store.CreateSaga(ctx, promoSaga(first, "LIMITED")))
+		again := promoSaga(first, "LIMITED")
+		require.ErrorIs(t, h.Store.CreateSaga(ctx, again), store.ErrPromoLimitReached)
+		_, err = h.Store.GetSaga(ctx, again.ID)
+		require.ErrorIs(t, err, store.ErrSagaNotFound)
+
+		failing := promoSaga(second, "LIMITED")
+		require.NoError(t, h.Store.CreateSaga(ctx, failing))
+		require.ErrorIs(t, h.Store.CreateSaga(ctx, promoSaga(third, "LIMITED")), store.ErrPromoExhausted)
+		require.ErrorIs(t, h.Store.CreateSaga(ctx, promoSaga(third, "UNKNOWN")), store.ErrPromoNotFound)
+
+		promo, err := h.Store.GetPromo(ctx, "LIMITED")
+		require.NoError(t, err)
+		require.Equal(t, int32(2), promo.Redemptions)
+
+		// A failed saga gives its redemption back.
+		failing.Status, failing.Step = model.SagaFailed, model.StepDone
+		require.NoError(t, h.Store.UpdateSaga(ctx, failing))
+		promo, err = h.Store.GetPromo(ctx, "LIMITED")
+		require.NoError(t, err)
+		require.Equal(t, int32(1), promo.Redemptions)
+		require.NoError(t, h.Store.CreateSaga(ctx, promoSaga(third, "LIMITED")))
+	})
+
+	t.Run("Concurrent Promo Redemptions", func(t *testing.T) {
+		h := newHarness(t)
+
+		newPromo(t, h, "RUSH", 5, 0)
+		const n = 20
+		sagas := make([]*model.BookingSaga, n)
+		for i := range sagas {
+			userID, err := h.CreateUser(ctx, "John Doe")
+			require.NoError(t, err)
+			sagas[i] = promoSaga(userID, "RUSH")
+		}
+
+		errs := make([]error, n)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = h.Store.CreateSaga(ctx, sagas[i])
+			}(i)
+		}
+		wg.Wait()
+
+		redeemed := 0
+		for _, err := range errs {
+			if err == nil {
+				redeemed++
+			} else {
+				require.ErrorIs(t, err, store.ErrPromoExhausted)
+			}
+		}
+		require.Equal(t, 5, redeemed)
+		promo, err := h.Store.GetPromo(ctx, "RUSH")
+		require.NoError(t, err)
+		require.Equal(t, int32(5), promo.Redemptions)
+	})
+
+	t.Run("Discount Stored On Booking", func(t *testing.T) {
+		h := newHarness(t)
+
+		newPromo(t, h, "WELCOME", 0, 0)
+		userID, err := h.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		rideID, err := h.CreateRide(ctx, "Downtown", "Airport", 15, pkr(12500))
+		require.NoError(t, err)
+
+		saga := promoSaga(userID, "WELCOME")
+		require.NoError(t, h.Store.CreateSaga(ctx, saga))
+		storedSaga, err := h.Store.GetSaga(ctx, saga.ID)
+		require.NoError(t, err)
+		require.Equal(t, saga.Discount, storedSaga.Discount)
+
+		saga.Ride.ID, saga.Step = rideID, model.StepCreateBooking
+		require.NoError(t, h.Store.UpdateSaga(ctx, saga))
+		bookingID, err := h.Store.CompleteSaga(ctx, saga, time.Now().UTC())
+		require.NoError(t, err)
+
+		booking, _, ride, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, saga.Discount, booking.Discount)
+		require.Equal(t, booking.Discount.Total(), ride.Cost)
+
+		bookings, err := h.Store.ListBookings(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, bookings, 1)
+		require.Equal(t, saga.Discount, bookings[0].Discount)
+	})
+
+	t.Run("Wallet Hold Stored On Booking", func(t *testing.T) {
+		h := newHarness(t)
+
+		userID, err := h.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		rideID, err := h.CreateRide(ctx, "Downtown", "Airport", 15, pkr(15000))
+		require.NoError(t, err)
+
+		saga := &model.BookingSaga{
+			ID:     uuid.NewString(),
+			UserID: userID,
+			Ride:   model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)},
+			Status: model.SagaRunning,
+			Step:   model.StepHoldFunds,
+
+			PaymentMethod: model.PaymentWallet,
+		}
+		require.NoError(t, h.Store.CreateSaga(ctx, saga))
+		saga.HoldID, saga.Step = 7, model.StepCreateRide
+		require.NoError(t, h.Store.UpdateSaga(ctx, saga))
+		stored, err := h.Store.GetSaga(ctx, saga.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.PaymentWallet, stored.PaymentMethod)
+		require.Equal(t, int32(7), stored.HoldID)
+
+		saga.Ride.ID, saga.Step = rideID, model.StepCreateBooking
+		require.NoError(t, h.Store.UpdateSaga(ctx, saga))
+		bookingID, err := h.Store.CompleteSaga(ctx, saga, time.Now().UTC())
+		require.NoError(t, err)
+
+		booking, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, model.PaymentWallet, booking.PaymentMethod)
+		require.Equal(t, int32(7), booking.HoldID)
+		pending, err := h.Store.ListPendingBookings(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, *booking, pending[0].Booking)
+	})
+
+	t.Run("ListStaleSagas", func(t *testing.T) {
+		h := newHarness(t)
+
+		userID, err := h.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		rideID, err := h.CreateRide(ctx, "Downtown", "Airport", 15, pkr(15000))
+		require.NoError(t, err)
+
+		running := newSaga(t, h, userID, rideID)
+		compensating := newSaga(t, h, userID, rideID)
+		compensating.Status, compensating.Step = model.SagaCompensating, model.StepDeleteRide
+		require.NoError(t, h.Store.UpdateSaga(ctx, compensating))
+		completed := newSaga(t, h, userID, rideID)
+		_, err = h.Store.CompleteSaga(ctx, completed, time.Now())
+		require.NoError(t, err)
+
+		sagas, err := h.Store.ListStaleSagas(ctx, time.Now().Add(time.Minute), 10)
+		require.NoError(t, err)
+		var ids []string
+		for _, s := range sagas {
+			ids = append(ids, s.ID)
+		}
+		require.ElementsMatch(t, []string{running.ID, compensating.ID}, ids)
+
+		sagas, err = h.Store.ListStaleSagas(ctx, time.Now().Add(time.Minute), 1)
+		require.NoError(t, err)
+		require.Len(t, sagas, 1)
+
+		// Recently updated sagas are left to whoever is running them.
+		sagas, err = h.Store.ListStaleSagas(ctx, time.Now().Add(-time.Minute), 10)
+		require.NoError(t, err)
+		require.Empty(t, sagas)
+	})
+
+	t.Run("ListPendingBookings", func(t *testing.T) {
+		h := newHarness(t)
+
+		pickup := &model.LatLng{Lat: 31.5102, Lng: 74.3441}
+		first := newPendingBooking(t, h, pickup)
+		second := newPendingBooking(t, h, nil)
+
+		pending, err := h.Store.ListPendingBookings(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		require.Equal(t, first, pending[0].Booking.ID)
+		require.Equal(t, model.BookingPending, pending[0].Booking.Status)
+		require.Equal(t, pickup, pending[0].Pickup)
+		require.Empty(t, pending[0].Offers)
+		require.Equal(t, second, pending[1].Booking.ID)
+		require.Nil(t, pending[1].Pickup)
+
+		pending, err = h.Store.ListPendingBookings(ctx, 0, 1)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+
+		// Pages continue after the last booking listed.
+		pending, err = h.Store.ListPendingBookings(ctx, first, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, second, pending[0].Booking.ID)
+	})
+
+	t.Run("Scheduled Booking Lifecycle", func(t *testing.T) {
+		h := newHarness(t)
+
+		now := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+		pickupTime := now.Add(2 * time.Hour)
+		bookingID := newScheduledBooking(t, h, now, pickupTime)
+
+		booking, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		userID := booking.UserID
+		require.Equal(t, model.BookingScheduled, booking.Status)
+		require.True(t, pickupTime.Equal(booking.PickupTime), "expected pickup at %v, got %v", pickupTime, booking.PickupTime)
+		require.True(t, booking.RemindedAt.IsZero())
+
+		// A scheduled booking is not dispatched until it is released.
+		pending, err := h.Store.ListPendingBookings(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+
+		scheduled, err := h.Store.ListScheduledBookings(ctx, now.Add(time.Hour), now.Add(time.Hour), 0, 10)
+		require.NoError(t, err)
+		require.Empty(t, scheduled)
+		scheduled, err = h.Store.ListScheduledBookings(ctx, now.Add(3*time.Hour), now.Add(time.Hour), 0, 10)
+		require.NoError(t, err)
+		require.Len(t, scheduled, 1)
+		require.Equal(t, bookingID, scheduled[0].ID)
+		scheduled, err = h.Store.ListScheduledBookings(ctx, now.Add(3*time.Hour), now.Add(time.Hour), bookingID, 10)
+		require.NoError(t, err)
+		require.Empty(t, scheduled)
+
+		head, err := h.Feed.Head(ctx)
+		require.NoError(t, err)
+		require.NoError(t, h.Store.RemindBooking(ctx, bookingID, now.Add(time.Hour)))
+		require.ErrorIs(t, h.Store.RemindBooking(ctx, bookingID, now.Add(time.Hour)), store.ErrBookingNotScheduled)
+
+		// Once reminded, a booking is listed again only when due for release.
+		scheduled, err = h.Store.ListScheduledBookings(ctx, now.Add(3*time.Hour), now.Add(time.Hour), 0, 10)
+		require.NoError(t, err)
+		require.Empty(t, scheduled)
+		scheduled, err = h.Store.ListScheduledBookings(ctx, now.Add(3*time.Hour), now.Add(3*time.Hour), 0, 10)
+		require.NoError(t, err)
+		require.Len(t, scheduled, 1)
+		require.NoError(t, h.Store.ReleaseBooking(ctx, bookingID))
+		require.ErrorIs(t, h.Store.ReleaseBooking(ctx, bookingID), store.ErrBookingNotScheduled)
+
+		msgs, err := h.Feed.Since(ctx, head, 10)
+		require.NoError(t, err)
+		require.Len(t, msgs, 2)
+		require.Equal(t, "booking.v1.BookingReminder", msgs[0].Topic)
+		var reminder pb.BookingReminder
+		require.NoError(t, proto.Unmarshal(msgs[0].Payload, &reminder))
+		expectedReminder := &pb.BookingReminder{BookingId: bookingID, UserId: userID, PickupTime: "2024-12-01T12:30:00Z"}
+		require.True(t, proto.Equal(expectedReminder, &reminder), "expected %v, got %v", expectedReminder, &reminder)
+		require.Equal(t, "booking.v1.BookingStatusChanged", msgs[1].Topic)
+		var changed pb.BookingStatusChanged
+		require.NoError(t, proto.Unmarshal(msgs[1].Payload, &changed))
+		expectedChange := &pb.BookingStatusChanged{
+			BookingId:      bookingID,
+			PreviousStatus: pb.BookingStatus_BOOKING_STATUS_SCHEDULED,
+			Status:         pb.BookingStatus_BOOKING_STATUS_PENDING,
+		}
+		require.True(t, proto.Equal(expectedChange, &changed), "expected %v, got %v", expectedChange, &changed)
+
+		pending, err = h.Store.ListPendingBookings(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, bookingID, pending[0].Booking.ID)
+		require.False(t, pending[0].Booking.RemindedAt.IsZero())
+		scheduled, err = h.Store.ListScheduledBookings(ctx, now.Add(3*time.Hour), now.Add(3*time.Hour), 0, 10)
+		require.NoError(t, err)
+		require.Empty(t, scheduled)
+
+		require.ErrorIs(t, h.Store.RemindBooking(ctx, 999999, now), store.ErrBookingNotFound)
+		require.ErrorIs(t, h.Store.ReleaseBooking(ctx, 999999), store.ErrBookingNotFound)
+	})
+
+	t.Run("Cancel Scheduled Booking", func(t *testing.T) {
+		h := newHarness(t)
+
+		now := time.Now()
+		bookingID := newScheduledBooking(t, h, now, now.Add(24*time.Hour))
+		booking, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+
+		cancelled, _, err := h.Store.CancelBooking(ctx, &model.Cancellation{BookingID: bookingID, Reason: model.CancelledByRider, UserID: booking.UserID}, nil, now)
+		require.NoError(t, err)
+		require.Equal(t, model.BookingCancelled, cancelled.Status)
+		require.ErrorIs(t, h.Store.ReleaseBooking(ctx, bookingID), store.ErrBookingNotScheduled)
+	})
+
+	t.Run("Offer Lifecycle", func(t *testing.T) {
+		h := newHarness(t)
+
+		bookingID := newPendingBooking(t, h, &model.LatLng{Lat: 31.5102, Lng: 74.3441})
+		now := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+
+		declined := &model.Offer{BookingID: bookingID, DriverID: 1, DistanceKm: 0.2, CreatedAt: now, ExpiresAt: now.Add(time.Minute)}
+		require.NoError(t, h.Store.CreateOffer(ctx, declined))
+		require.Positive(t, declined.ID)
+		require.Equal(t, model.OfferPending, declined.Status)
+
+		// A booking and a driver have one pending offer at a time.
+		err := h.Store.CreateOffer(ctx, &model.Offer{BookingID: bookingID, DriverID: 2, CreatedAt: now, ExpiresAt: now.Add(time.Minute)})
+		require.ErrorIs(t, err, store.ErrAlreadyExists)
+
+		offers, err := h.Store.ListDriverOffers(ctx, 1, now)
+		require.NoError(t, err)
+		require.Len(t, offers, 1)
+		require.Equal(t, declined.ID, offers[0].ID)
+		require.InDelta(t, 0.2, offers[0].DistanceKm, 1e-9)
+		require.True(t, declined.ExpiresAt.Equal(offers[0].ExpiresAt))
+
+		_, _, err = h.Store.RespondToOffer(ctx, declined.ID, 2, true, now)
+		require.ErrorIs(t, err, store.ErrOfferNotFound)
+
+		offer, booking, err := h.Store.RespondToOffer(ctx, declined.ID, 1, false, now)
+		require.NoError(t, err)
+		require.Equal(t, model.OfferDeclined, offer.Status)
+		require.Equal(t, model.BookingPending, booking.Status)
+		require.True(t, booking.AssignedAt.IsZero())
+
+		// A declined offer is closed, and the booking is not offered to the
+		// driver again.
+		_, _, err = h.Store.RespondToOffer(ctx, declined.ID, 1, true, now)
+		require.ErrorIs(t, err, store.ErrOfferClosed)
+		err = h.Store.CreateOffer(ctx, &model.Offer{BookingID: bookingID, DriverID: 1, CreatedAt: now, ExpiresAt: now.Add(time.Minute)})
+		require.ErrorIs(t, err, store.ErrAlreadyExists)
+
+		accepted := &model.Offer{BookingID: bookingID, DriverID: 2, DistanceKm: 3.4, CreatedAt: now, ExpiresAt: now.Add(time.Minute)}
+		require.NoError(t, h.Store.CreateOffer(ctx, accepted))
+
+		head, err := h.Feed.Head(ctx)
+		require.NoError(t, err)
+		offer, booking, err = h.Store.RespondToOffer(ctx, accepted.ID, 2, true, now)
+		require.NoError(t, err)
+		require.Equal(t, model.OfferAccepted, offer.Status)
+		require.Equal(t, model.BookingConfirmed, booking.Status)
+		require.Equal(t, int32(2), booking.DriverID)
+		require.True(t, now.Equal(booking.AssignedAt), "expected assigned at %v, got %v", now, booking.AssignedAt)
+		stored, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.True(t, now.Equal(stored.AssignedAt), "expected assigned at %v, got %v", now, stored.AssignedAt)
+
+		msgs, err := h.Feed.Since(ctx, head, 10)
+		require.NoError(t, err)
+		require.Len(t, msgs, 2)
+		require.Equal(t, "booking.v1.DriverOfferUpdated", msgs[0].Topic)
+		require.Equal(t, "booking.v1.BookingStatusChanged", msgs[1].Topic)
+		var changed pb.BookingStatusChanged
+		require.NoError(t, proto.Unmarshal(msgs[1].Payload, &changed))
+		expected := &pb.BookingStatusChanged{
+			BookingId:      bookingID,
+			PreviousStatus: pb.BookingStatus_BOOKING_STATUS_PENDING,
+			Status:         pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+			DriverId:       2,
+		}
+		require.True(t, proto.Equal(expected, &changed), "expected %v, got %v", expected, &changed)
+
+		pending, err := h.Store.ListPendingBookings(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+		err = h.Store.CreateOffer(ctx, &model.Offer{BookingID: bookingID, DriverID: 3, CreatedAt: now, ExpiresAt: now.Add(time.Minute)})
+		require.ErrorIs(t, err, store.ErrBookingNotPending)
+	})
+
+	t.Run("Offer And Booking Expiry", func(t *testing.T) {
+		h := newHarness(t)
+
+		bookingID := newPendingBooking(t, h, &model.LatLng{Lat: 31.5102, Lng: 74.3441})
+		now := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+		offer := &model.Offer{BookingID: bookingID, DriverID: 1, CreatedAt: now, ExpiresAt: now.Add(time.Minute)}
+		require.NoError(t, h.Store.CreateOffer(ctx, offer))
+
+		// A booking with a pending offer does not expire.
+		require.ErrorIs(t, h.Store.ExpireBooking(ctx, bookingID), store.ErrBookingNotPending)
+
+		expired, err := h.Store.ExpireOffers(ctx, now.Add(30*time.Second))
+		require.NoError(t, err)
+		require.Empty(t, expired)
+
+		later := now.Add(time.Minute)
+		offers, err := h.Store.ListDriverOffers(ctx, 1, later)
+		require.NoError(t, err)
+		require.Empty(t, offers)
+		_, _, err = h.Store.RespondToOffer(ctx, offer.ID, 1, true, later)
+		require.ErrorIs(t, err, store.ErrOfferClosed)
+
+		expired, err = h.Store.ExpireOffers(ctx, later)
+		require.NoError(t, err)
+		require.Len(t, expired, 1)
+		require.Equal(t, offer.ID, expired[0].ID)
+		require.Equal(t, model.OfferExpired, expired[0].Status)
+
+		require.NoError(t, h.Store.ExpireBooking(ctx, bookingID))
+		booking, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, model.BookingExpired, booking.Status)
+		require.ErrorIs(t, h.Store.ExpireBooking(ctx, bookingID), store.ErrBookingNotPending)
+	})
+
+	t.Run("CompleteBooking Posts Journal Entry", func(t *testing.T) {
+		h := newHarness(t)
+
+		bookingID := newConfirmedBooking(t, h, 1)
+		booking, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		entry, err := ledger.RideCompleted(booking, pkr(15000), 0.2, "ch_1")
+		require.NoError(t, err)
+		now := time.Date(2024, 12, 1, 11, 0, 0, 0, time.UTC)
+
+		_, err = h.Store.CompleteBooking(ctx, bookingID, 2, entry, now)
+		require.ErrorIs(t, err, store.ErrBookingNotConfirmed)
+		_, err = h.Store.CompleteBooking(ctx, bookingID+1, 1, entry, now)
+		require.ErrorIs(t, err, store.ErrBookingNotFound)
+		unbalanced := &model.JournalEntry{BookingID: bookingID, Kind: model.EntryRideCompleted, Postings: entry.Postings[:2]}
+		_, err = h.Store.CompleteBooking(ctx, bookingID, 1, unbalanced, now)
+		require.Error(t, err)
+
+		head, err := h.Feed.Head(ctx)
+		require.NoError(t, err)
+		completed, err := h.Store.CompleteBooking(ctx, bookingID, 1, entry, now)
+		require.NoError(t, err)
+		require.Equal(t, model.BookingCompleted, completed.Status)
+		require.Positive(t, entry.ID)
+		require.True(t, now.Equal(entry.CreatedAt), "expected %v, got %v", now, entry.CreatedAt)
+
+		msgs, err := h.Feed.Since(ctx, head, 10)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		var changed pb.BookingStatusChanged
+		require.NoError(t, proto.Unmarshal(msgs[0].Payload, &changed))
+		require.Equal(t, pb.BookingStatus_BOOKING_STATUS_CONFIRMED, changed.PreviousStatus)
+		require.Equal(t, pb.BookingStatus_BOOKING_STATUS_COMPLETED, changed.Status)
+
+		// A booking is completed, and its rider charged, once.
+		_, err = h.Store.CompleteBooking(ctx, bookingID, 1, entry, now)
+		require.ErrorIs(t, err, store.ErrBookingNotConfirmed)
+
+		balances := map[model.Account][]money.Money{
+			ledger.Rider(booking.UserID): {pkr(-15000)},
+			ledger.Driver(1):             {pkr(12000)},
+			ledger.Platform:              {pkr(3000)},
+			ledger.Promo:                 {},
+		}
+		for account, expected := range balances {
+			balance, err := h.Store.GetBalance(ctx, account)
+			require.NoError(t, err)
+			require.Equal(t, expected, balance, "balance of %s", account)
+		}
+	})
+
+	t.Run("CancelBooking", func(t *testing.T) {
+		h := newHarness(t)
+
+		bookingID := newPendingBooking(t, h, &model.LatLng{Lat: 31.5102, Lng: 74.3441})
+		booking, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		now := time.Now()
+		offer := &model.Offer{BookingID: bookingID, DriverID: 1, CreatedAt: now, ExpiresAt: now.Add(time.Minute)}
+		require.NoError(t, h.Store.CreateOffer(ctx, offer))
+		byRider := func(bookingID, userID int32) *model.Cancellation {
+			return &model.Cancellation{BookingID: bookingID, Reason: model.CancelledByRider, UserID: userID}
+		}
+
+		_, _, err = h.Store.CancelBooking(ctx, byRider(bookingID, booking.UserID+1), nil, now)
+		require.ErrorIs(t, err, store.ErrBookingNotCancellable)
+		_, _, err = h.Store.CancelBooking(ctx, byRider(bookingID+1, booking.UserID), nil, now)
+		require.ErrorIs(t, err, store.ErrBookingNotFound)
+		// Only confirmed bookings can be reported as no-shows.
+		_, _, err = h.Store.CancelBooking(ctx, &model.Cancellation{BookingID: bookingID, Reason: model.CancelledNoShow, DriverID: 1}, nil, now)
+		require.ErrorIs(t, err, store.ErrBookingNotCancellable)
+
+		head, err := h.Feed.Head(ctx)
+		require.NoError(t, err)
+		cancelled, previous, err := h.Store.CancelBooking(ctx, byRider(bookingID, booking.UserID), nil, now)
+		require.NoError(t, err)
+		require.Equal(t, model.BookingCancelled, cancelled.Status)
+		require.Equal(t, model.CancelledByRider, cancelled.CancellationReason)
+		require.True(t, cancelled.CancellationFee.IsZero())
+		require.Equal(t, model.BookingPending, previous)
+		// Cancelling without a fee keeps what the booking cost.
+		stored, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, cancelled.Cost, stored.Cost)
+		require.False(t, stored.Cost.IsZero())
+
+		// The open offer is withdrawn along with the booking.
+		offers, err := h.Store.ListDriverOffers(ctx, 1, now)
+		require.NoError(t, err)
+		require.Empty(t, offers)
+		msgs, err := h.Feed.Since(ctx, head, 10)
+		require.NoError(t, err)
+		require.Len(t, msgs, 2)
+		var changed pb.BookingStatusChanged
+		require.NoError(t, proto.Unmarshal(msgs[0].Payload, &changed))
+		require.Equal(t, pb.BookingStatus_BOOKING_STATUS_PENDING, changed.PreviousStatus)
+		require.Equal(t, pb.BookingStatus_BOOKING_STATUS_CANCELLED, changed.Status)
+		var updated pb.DriverOfferUpdated
+		require.NoError(t, proto.Unmarshal(msgs[1].Payload, &updated))
+		require.Equal(t, pb.OfferStatus_OFFER_STATUS_EXPIRED, updated.Offer.Status)
+
+		// Cancelling again changes nothing.
+		again, previous, err := h.Store.CancelBooking(ctx, byRider(bookingID, booking.UserID), nil, now)
+		require.NoError(t, err)
+		require.Equal(t, cancelled, again)
+		require.Equal(t, model.BookingCancelled, previous)
+
+		// A fee is recorded on the booking and posted to the ledger.
+		confirmedID := newConfirmedBooking(t, h, 2)
+		confirmed, _, _, err := h.Store.GetBookingDetails(ctx, confirmedID)
+		require.NoError(t, err)
+		entry, err := ledger.CancellationFee(confirmed, pkr(10000), 0.2, "ch_fee")
+		require.NoError(t, err)
+		c := byRider(confirmedID, confirmed.UserID)
+		c.Fee, c.ChargeID = pkr(10000), "ch_fee"
+		cancelled, previous, err = h.Store.CancelBooking(ctx, c, entry, now)
+		require.NoError(t, err)
+		require.Equal(t, model.BookingConfirmed, previous)
+		require.Equal(t, int32(2), cancelled.DriverID)
+		require.Equal(t, pkr(10000), cancelled.CancellationFee)
+		require.NotZero(t, entry.ID)
+		fetched, _, _, err := h.Store.GetBookingDetails(ctx, confirmedID)
+		require.NoError(t, err)
+		require.Equal(t, cancelled, fetched)
+		balance, err := h.Store.GetBalance(ctx, ledger.Driver(2))
+		require.NoError(t, err)
+		require.Equal(t, []money.Money{pkr(8000)}, balance)
+
+		// The driver of a confirmed booking reports the rider did not show up.
+		noShowID := newConfirmedBooking(t, h, 3)
+		_, _, err = h.Store.CancelBooking(ctx, &model.Cancellation{BookingID: noShowID, Reason: model.CancelledNoShow, DriverID: 4}, nil, now)
+		require.ErrorIs(t, err, store.ErrBookingNotCancellable)
+		cancelled, previous, err = h.Store.CancelBooking(ctx, &model.Cancellation{BookingID: noShowID, Reason: model.CancelledNoShow, DriverID: 3}, nil, now)
+		require.NoError(t, err)
+		require.Equal(t, model.BookingConfirmed, previous)
+		require.Equal(t, model.CancelledNoShow, cancelled.CancellationReason)
+
+		completedID := newConfirmedBooking(t, h, 1)
+		completed, _, _, err := h.Store.GetBookingDetails(ctx, completedID)
+		require.NoError(t, err)
+		entry, err = ledger.RideCompleted(completed, pkr(15000), 0.2, "ch_1")
+		require.NoError(t, err)
+		_, err = h.Store.CompleteBooking(ctx, completedID, 1, entry, now)
+		require.NoError(t, err)
+		_, _, err = h.Store.CancelBooking(ctx, byRider(completedID, completed.UserID), nil, now)
+		require.ErrorIs(t, err, store.ErrBookingNotCancellable)
+	})
+
+	t.Run("RefundBooking", func(t *testing.T) {
+		h := newHarness(t)
+		now := time.Now()
+
+		bookingID := newConfirmedBooking(t, h, 1)
+		booking, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		refund := func(amount, previous money.Money) (*model.Booking, error) {
+			entry, err := ledger.Refund(booking, amount, "re_1")
+			require.NoError(t, err)
+			return h.Store.RefundBooking(ctx, &model.Refund{
+				BookingID: bookingID,
+				Amount:    amount,
+				Reason:    model.RefundServiceIssue,
+				Previous:  previous,
+			}, entry, now)
+		}
+
+		_, err = refund(pkr(5000), money.Money{})
+		require.ErrorIs(t, err, store.ErrBookingNotRefundable)
+
+		entry, err := ledger.RideCompleted(booking, pkr(15000), 0.2, "ch_1")
+		require.NoError(t, err)
+		completed, err := h.Store.CompleteBooking(ctx, bookingID, 1, entry, now)
+		require.NoError(t, err)
+		require.Equal(t, "ch_1", completed.ChargeID)
+
+		refunded, err := refund(pkr(5000), money.Money{})
+		require.NoError(t, err)
+		require.Equal(t, pkr(5000), refunded.Refunded)
+		require.Equal(t, model.RefundServiceIssue, refunded.RefundReason)
+		// A refund based on a stale total conflicts with the one stored.
+		_, err = refund(pkr(5000), money.Money{})
+		require.ErrorIs(t, err, store.ErrRefundConflict)
+		refunded, err = refund(pkr(10000), pkr(5000))
+		require.NoError(t, err)
+		require.Equal(t, pkr(15000), refunded.Refunded)
+
+		fetched, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+		require.NoError(t, err)
+		require.Equal(t, refunded, fetched)
+		balance, err := h.Store.GetBalance(ctx, ledger.Rider(booking.UserID))
+		require.NoError(t, err)
+		require.Equal(t, []money.Money{pkr(0)}, balance)
+		// Each refund is an entry of its own.
+		lines, err := h.Store.ListStatement(ctx, ledger.Rider(booking.UserID), 0, 10)
+		require.NoError(t, err)
+		require.Len(t, lines, 3)
+		require.Equal(t, model.EntryRefund, lines[0].Kind)
+		require.Equal(t, pkr(10000), lines[0].Amount)
+		require.Equal(t, model.EntryRefund, lines[1].Kind)
+		require.Equal(t, pkr(5000), lines[1].Amount)
+		require.Equal(t, model.EntryRideCompleted, lines[2].Kind)
+
+		_, err = h.Store.RefundBooking(ctx, &model.Refund{BookingID: bookingID + 100, Amount: pkr(1), Reason: model.RefundOther}, entry, now)
+		require.ErrorIs(t, err, store.ErrBookingNotFound)
+	})
+
+	t.Run("Account Statement", func(t *testing.T) {
+		h := newHarness(t)
+
+		var entryIDs []int64
+		for range 3 {
+			bookingID := newConfirmedBooking(t, h, 1)
+			booking, _, _, err := h.Store.GetBookingDetails(ctx, bookingID)
+			require.NoError(t, err)
+			entry, err := ledger.RideCompleted(booking, pkr(15000), 0.2, "ch_1")
+			require.NoError(t, err)
+			_, err = h.Store.CompleteBooking(ctx, bookingID, 1, entry, time.Now())
+			require.NoError(t, err)
+			entryIDs = append(entryIDs, entry.ID)
+		}
+
+		lines, err := h.Store.ListStatement(ctx, ledger.Driver(1), 0, 2)
+		require.NoError(t, err)
+		require.Len(t, lines, 2)
+		require.Equal(t, entryIDs[2], lines[0].EntryID)
+		require.Equal(t, entryIDs[1], lines[1].EntryID)
+		require.Equal(t, pkr(12000), lines[0].Amount)
+		require.Equal(t, model.EntryRideCompleted, lines[0].Kind)
+		require.Equal(t, "ch_1", lines[0].Reference)
+
+		lines, err = h.Store.ListStatement(ctx, ledger.Driver(1), entryIDs[1], 2)
+		require.NoError(t, err)
+		require.Len(t, lines, 1)
+		require.Equal(t, entryIDs[0], lines[0].EntryID)
+
+		lines, err = h.Store.ListStatement(ctx, ledger.Driver(2), 0, 2)
+		require.NoError(t, err)
+		require.Empty(t, lines)
+	})
+
+	t.Run("Canceled Context", func(t *testing.T) {
+		h := newHarness(t)
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := h.Store.ListBookings(canceled, 1)
+		require.ErrorIs(t, err, store.ErrCanceled)
+	})
+
+	t.Run("Concurrent UpdateSaga", func(t *testing.T) {
+		h := newHarness(t)
+
+		saga := newSaga(t, h, 1, 1)
+
+		const n = 20
+		errs := make([]error, n)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				update := *saga
+				update.Error = "attempt"
+				errs[i] = h.Store.UpdateSaga(ctx, &update)
+			}(i)
+		}
+		wg.Wait()
+
+		// Exactly one writer wins.
+		won := 0
+		for _, err := range errs {
+			if err == nil {
+				won++
+			} else {
+				require.ErrorIs(t, err, store.ErrSagaConflict)
+			}
+		}
+		require.Equal(t, 1, won)
+	})
+}
+
+// pkr returns minor units of Pakistani rupees.
+func pkr(minor int64) money.Money {
+	return money.Money{Currency: "PKR", Minor: minor}
+}
+
+// newSaga stores a running saga for userID whose ride rideID (a Downtown to
+// Airport ride of 15 km costing PKR 150) has been created, so it is ready to complete.
+func newSaga(t *testing.T, h Harness, userID, rideID int32) *model.BookingSaga {
+	t.Helper()
+
+	saga := &model.BookingSaga{
+		ID:     uuid.NewString(),
+		UserID: userID,
+		Ride:   model.Ride{ID: rideID, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)},
+		Status: model.SagaRunning,
+		Step:   model.StepCreateBooking,
+
+		TariffVersion: "2024-12-01",
+		PaymentMethod: model.PaymentCard,
+	}
+	require.NoError(t, h.Store.CreateSaga(context.Background(), saga))
+	return saga
+}
+
+// newPromo creates a promo code taking PKR 25 off fares, valid from now on
+// with the given limits.
+func newPromo(t *testing.T, h Harness, code string, maxRedemptions, maxPerUser int32) {
+	t.Helper()
+
+	now := time.Now()
+	require.NoError(t, h.Store.CreatePromo(context.Background(), &model.Promo{
+		Code: code, Type: model.PromoFlat, Currency: "PKR", AmountOff: pkr(2500), MaxDiscount: pkr(0), MinFare: pkr(0),
+		StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), MaxRedemptions: maxRedemptions, MaxPerUser: maxPerUser,
+	}))
+}
+
+// promoSaga returns a running saga for userID that redeems code, taking PKR
+// 25 off a PKR 150 fare.
+func promoSaga(userID int32, code string) *model.BookingSaga {
+	return &model.BookingSaga{
+		ID:       uuid.NewString(),
+		UserID:   userID,
+		Ride:     model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(12500)},
+		Status:   model.SagaRunning,
+		Step:     model.StepValidateUser,
+		Discount: model.Discount{PromoCode: code, Fare: pkr(15000), Amount: pkr(2500)},
+	}
+}
+
+// newConfirmedBooking returns the ID of a new booking confirmed with
+// driverID.
+func newConfirmedBooking(t *testing.T, h Harness, driverID int32) int32 {
+	t.Helper()
+	ctx := context.Background()
+
+	bookingID := newPendingBooking(t, h, &model.LatLng{Lat: 31.5102, Lng: 74.3441})
+	now := time.Now()
+	offer := &model.Offer{BookingID: bookingID, DriverID: driverID, CreatedAt: now, ExpiresAt: now.Add(time.Minute)}
+	require.NoError(t, h.Store.CreateOffer(ctx, offer))
+	_, _, err := h.Store.RespondToOffer(ctx, offer.ID, driverID, true, now)
+	require.NoError(t, err)
+	return bookingID
+}
+
+// newPendingBooking completes a booking saga for a new user and ride picked up
+// at pickup, returning the pending booking's ID.
+func newPendingBooking(t *testing.T, h Harness, pickup *model.LatLng) int32 {
+	t.Helper()
+	ctx := context.Background()
+
+	userID, err := h.CreateUser(ctx, "John Doe")
+	require.NoError(t, err)
+	rideID, err := h.CreateRide(ctx, "Downtown", "Airport", 15, pkr(15000))
+	require.NoError(t, err)
+	saga := newSaga(t, h, userID, rideID)
+	saga.Ride.Pickup = pickup
+	bookingID, err := h.Store.CompleteSaga(ctx, saga, time.Now())
+	require.NoError(t, err)
+	return bookingID
+}
+
+// newScheduledBooking completes a booking saga for a new user and ride,
+// booked at bookingTime for a pickup at pickupTime, returning the scheduled
+// booking's ID.
+func newScheduledBooking(t *testing.T, h Harness, bookingTime, pickupTime time.Time) int32 {
+	t.Helper()
+	ctx := context.Background()
+
+	userID, err := h.CreateUser(ctx, "John Doe")
+	require.NoError(t, err)
+	rideID, err := h.CreateRide(ctx, "Downtown", "Airport", 15, pkr(15000))
+	require.NoError(t, err)
+	saga := &model.BookingSaga{
+		ID:     uuid.NewString(),
+		UserID: userID,
+		Ride:   model.Ride{ID: rideID, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)},
+		Status: model.SagaRunning,
+		Step:   model.StepCreateBooking,
+
+		PaymentMethod: model.PaymentCard,
+		PickupTime:    pickupTime,
+	}
+	require.NoError(t, h.Store.CreateSaga(ctx, saga))
+	got, err := h.Store.GetSaga(ctx, saga.ID)
+	require.NoError(t, err)
+	require.True(t, pickupTime.Equal(got.PickupTime), "expected saga pickup at %v, got %v", pickupTime, got.PickupTime)
+	bookingID, err := h.Store.CompleteSaga(ctx, saga, bookingTime)
+	require.NoError(t, err)
+	return bookingID
+}
@@ -0,0 +1,137 @@
+// Package surge raises fares in zones where recent booking demand exceeds
+// what the zone normally sees.
+package surge
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/metrics"
+	"github.com/golang_falcon_task/booking-service/internal/model"
+)
+
+// Config tunes how demand becomes a multiplier.
+type Config struct {
+	// Window is how far back bookings count as demand.
+	Window time.Duration
+
+	// MaxMultiplier caps the multiplier, however high demand gets.
+	MaxMultiplier float64
+
+	// Smoothing is the time constant of the moving average the multiplier
+	// follows demand with, so it neither jumps nor collapses at once.
+	// Zero follows demand immediately.
+	Smoothing time.Duration
+}
+
+// DefaultConfig is used for zero Config fields.
+var DefaultConfig = Config{
+	Window:        10 * time.Minute,
+	MaxMultiplier: 2.5,
+	Smoothing:     5 * time.Minute,
+}
+
+// Tracker counts recent bookings per zone and turns them into surge
+// multipliers. It is safe for concurrent use. Demand is tracked in memory,
+// so each instance of the service surges on the bookings it made itself.
+type Tracker struct {
+	zones []*Zone
+	cfg   Config
+
+	mu    sync.Mutex
+	state map[string]*zoneState
+}
+
+// zoneState is the recent demand of one zone.
+type zoneState struct {
+	bookings   []time.Time // Oldest first
+	multiplier float64     // Smoothed, before rounding
+	updatedAt  time.Time
+}
+
+// New returns a tracker over zones. Zero cfg fields take the defaults of
+// DefaultConfig, except Smoothing.
+func New(zones []*Zone, cfg Config) *Tracker {
+	if cfg.Window <= 0 {
+		cfg.Window = DefaultConfig.Window
+	}
+	if cfg.MaxMultiplier <= 0 {
+		cfg.MaxMultiplier = DefaultConfig.MaxMultiplier
+	}
+	return &Tracker{zones: zones, cfg: cfg, state: make(map[string]*zoneState)}
+}
+
+// ZoneAt returns the first zone containing p, nil if none does.
+func (t *Tracker) ZoneAt(p model.LatLng) *Zone {
+	for _, z := range t.zones {
+		if z.Contains(p) {
+			return z
+		}
+	}
+	return nil
+}
+
+// Record counts a booking picked up at p at time now as demand.
+func (t *Tracker) Record(p model.LatLng, now time.Time) {
+	zone := t.ZoneAt(p)
+	if zone == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := t.zoneState(zone.ID, now)
+	s.bookings = append(s.bookings, now)
+}
+
+// Multiplier returns the surge multiplier for a pickup at p at time now,
+// rounded to two decimals, and the zone it comes from. Outside every zone
+// it is 1 and the zone is empty.
+func (t *Tracker) Multiplier(p model.LatLng, now time.Time) (float64, string) {
+	zone := t.ZoneAt(p)
+	if zone == nil {
+		return 1, ""
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := t.zoneState(zone.ID, now)
+
+	// Forget bookings that left the window.
+	cutoff := now.Add(-t.cfg.Window)
+	i := 0
+	for i < len(s.bookings) && !s.bookings[i].After(cutoff) {
+		i++
+	}
+	s.bookings = s.bookings[i:]
+
+	// Demand at or below the baseline does not surge.
+	target := min(max(float64(len(s.bookings))/zone.BaselineDemand, 1), t.cfg.MaxMultiplier)
+
+	// Move towards the target by the share of the smoothing time constant
+	// that passed since the last update.
+	alpha := 1.0
+	if t.cfg.Smoothing > 0 {
+		alpha = 1 - math.Exp(-float64(max(now.Sub(s.updatedAt), 0))/float64(t.cfg.Smoothing))
+	}
+	s.multiplier += alpha * (target - s.multiplier)
+	s.updatedAt = now
+
+	multiplier := math.Round(s.multiplier*100) / 100
+	metrics.SurgeMultiplier.WithLabelValues(zone.ID).Set(multiplier)
+	return multiplier, zone.ID
+}
+
+// zoneState returns the state of a zone, starting it without surge. The
+// caller must hold t.mu.
+func (t *Tracker) zoneState(zoneID string, now time.Time) *zoneState {
+	s, ok := t.state[zoneID]
+	if !ok {
+		s = &zoneState{multiplier: 1, updatedAt: now}
+		t.state[zoneID] = s
+	}
+	return s
+}
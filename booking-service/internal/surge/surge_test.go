@@ -0,0 +1,146 @@
+package surge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	liberty  = model.LatLng{Lat: 31.5102, Lng: 74.3441} // In gulberg
+	airport  = model.LatLng{Lat: 31.5216, Lng: 74.4036} // In airport
+	outskirt = model.LatLng{Lat: 31.3000, Lng: 74.1000} // In no zone
+)
+
+// square is a zone of one square around (lat, lng) with a square hole in its
+// middle.
+const square = `{"type": "FeatureCollection", "features": [{
+	"type": "Feature",
+	"properties": {"id": "square", "name": "Square", "baseline_demand": 2},
+	"geometry": {"type": "Polygon", "coordinates": [
+		[[0, 0], [4, 0], [4, 4], [0, 4], [0, 0]],
+		[[1, 1], [3, 1], [3, 3], [1, 3], [1, 1]]
+	]}
+}]}`
+
+func TestParseZones(t *testing.T) {
+	zones, err := ParseZones([]byte(square))
+	require.NoError(t, err)
+	require.Len(t, zones, 1)
+	require.Equal(t, "square", zones[0].ID)
+	require.Equal(t, 2.0, zones[0].BaselineDemand)
+
+	require.True(t, zones[0].Contains(model.LatLng{Lat: 0.5, Lng: 0.5}))
+	require.False(t, zones[0].Contains(model.LatLng{Lat: 2, Lng: 2}), "inside the hole")
+	require.False(t, zones[0].Contains(model.LatLng{Lat: 5, Lng: 2}))
+
+	tests := []struct {
+		name        string
+		data        string
+		expectedErr string
+	}{
+		{name: "Not A FeatureCollection", data: `{"type": "Feature"}`, expectedErr: "want a FeatureCollection"},
+		{
+			name:        "Missing ID",
+			data:        `{"type": "FeatureCollection", "features": [{"properties": {"baseline_demand": 1}}]}`,
+			expectedErr: "id is required",
+		},
+		{
+			name:        "No Baseline",
+			data:        `{"type": "FeatureCollection", "features": [{"properties": {"id": "a"}}]}`,
+			expectedErr: "baseline_demand must be positive",
+		},
+		{
+			name: "Point Geometry",
+			data: `{"type": "FeatureCollection", "features": [{"properties": {"id": "a", "baseline_demand": 1},
+				"geometry": {"type": "Point", "coordinates": [0, 0]}}]}`,
+			expectedErr: "must be a Polygon or MultiPolygon",
+		},
+		{
+			name: "Open Ring",
+			data: `{"type": "FeatureCollection", "features": [{"properties": {"id": "a", "baseline_demand": 1},
+				"geometry": {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 1]]]}}]}`,
+			expectedErr: "ring must be closed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseZones([]byte(tt.data))
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestDefaultZones(t *testing.T) {
+	tracker := New(DefaultZones, Config{})
+	require.Equal(t, "gulberg", tracker.ZoneAt(liberty).ID)
+	require.Equal(t, "airport", tracker.ZoneAt(airport).ID)
+	require.Nil(t, tracker.ZoneAt(outskirt))
+}
+
+func TestTracker_Multiplier(t *testing.T) {
+	start := time.Date(2024, 12, 1, 18, 0, 0, 0, time.UTC)
+
+	// record counts n bookings at liberty, one second apart from start.
+	record := func(tracker *Tracker, n int) {
+		for i := 0; i < n; i++ {
+			tracker.Record(liberty, start.Add(time.Duration(i)*time.Second))
+		}
+	}
+
+	t.Run("Outside Every Zone", func(t *testing.T) {
+		tracker := New(DefaultZones, Config{})
+		multiplier, zone := tracker.Multiplier(outskirt, start)
+		require.Equal(t, 1.0, multiplier)
+		require.Empty(t, zone)
+	})
+
+	t.Run("Demand At Baseline", func(t *testing.T) {
+		tracker := New(DefaultZones, Config{})
+		record(tracker, 20)
+		multiplier, zone := tracker.Multiplier(liberty, start.Add(time.Minute))
+		require.Equal(t, 1.0, multiplier)
+		require.Equal(t, "gulberg", zone)
+	})
+
+	t.Run("Follows Demand Without Smoothing", func(t *testing.T) {
+		tracker := New(DefaultZones, Config{Window: 10 * time.Minute, MaxMultiplier: 3})
+		record(tracker, 30)
+		multiplier, _ := tracker.Multiplier(liberty, start.Add(time.Minute))
+		require.Equal(t, 1.5, multiplier)
+	})
+
+	t.Run("Capped", func(t *testing.T) {
+		tracker := New(DefaultZones, Config{MaxMultiplier: 2})
+		record(tracker, 100)
+		multiplier, _ := tracker.Multiplier(liberty, start.Add(time.Minute))
+		require.Equal(t, 2.0, multiplier)
+	})
+
+	t.Run("Smoothed", func(t *testing.T) {
+		tracker := New(DefaultZones, Config{MaxMultiplier: 3, Smoothing: 5 * time.Minute})
+		record(tracker, 40)
+
+		// One time constant after the zone was first seen, the multiplier
+		// has covered 1-1/e of the way from 1 to the target of 2.
+		first, _ := tracker.Multiplier(liberty, start.Add(5*time.Minute))
+		require.Equal(t, 1.63, first)
+
+		// It keeps closing in on the target.
+		second, _ := tracker.Multiplier(liberty, start.Add(9*time.Minute))
+		require.Greater(t, second, first)
+		require.Less(t, second, 2.0)
+	})
+
+	t.Run("Demand Leaves The Window", func(t *testing.T) {
+		tracker := New(DefaultZones, Config{Window: 10 * time.Minute, MaxMultiplier: 3})
+		record(tracker, 40)
+		multiplier, _ := tracker.Multiplier(liberty, start.Add(time.Minute))
+		require.Equal(t, 2.0, multiplier)
+
+		multiplier, _ = tracker.Multiplier(liberty, start.Add(11*time.Minute))
+		require.Equal(t, 1.0, multiplier)
+	})
+}
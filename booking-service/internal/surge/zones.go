@@ -0,0 +1,180 @@
+package surge
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/golang_falcon_task/booking-service/internal/model"
+)
+
+//go:embed zones.geojson
+var defaultZones []byte
+
+// DefaultZones are the zones shipped with the service, from zones.geojson.
+var DefaultZones = mustParseZones(defaultZones)
+
+// Zone is an area whose fares surge together.
+type Zone struct {
+	ID   string
+	Name string
+
+	// BaselineDemand is how many bookings the zone normally sees in a
+	// demand window. Demand above it raises the zone's multiplier.
+	BaselineDemand float64
+
+	// polygons are the zone's areas, each an outer ring followed by any
+	// holes. Rings are closed: the last point repeats the first.
+	polygons [][][]model.LatLng
+}
+
+// Contains reports whether p lies inside the zone.
+func (z *Zone) Contains(p model.LatLng) bool {
+	for _, rings := range z.polygons {
+		if !inRing(p, rings[0]) {
+			continue
+		}
+		inHole := false
+		for _, hole := range rings[1:] {
+			if inRing(p, hole) {
+				inHole = true
+				break
+			}
+		}
+		if !inHole {
+			return true
+		}
+	}
+	return false
+}
+
+// inRing reports whether p lies inside a closed ring, by casting a ray east
+// from p and counting the edges it crosses.
+func inRing(p model.LatLng, ring []model.LatLng) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// geoJSON is the subset of a GeoJSON FeatureCollection zones are read from.
+type geoJSON struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Properties struct {
+			ID             string  `json:"id"`
+			Name           string  `json:"name"`
+			BaselineDemand float64 `json:"baseline_demand"`
+		} `json:"properties"`
+		Geometry struct {
+			Type        string          `json:"type"`
+			Coordinates json.RawMessage `json:"coordinates"`
+		} `json:"geometry"`
+	} `json:"features"`
+}
+
+// LoadZones reads zones from a GeoJSON file.
+func LoadZones(path string) ([]*Zone, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zones: %w", err)
+	}
+	return ParseZones(data)
+}
+
+// ParseZones reads zones from a GeoJSON FeatureCollection. Each feature is a
+// Polygon or MultiPolygon whose properties give the zone's id, name and
+// baseline_demand. Where zones overlap, the first one wins.
+func ParseZones(data []byte) ([]*Zone, error) {
+	var fc geoJSON
+	if err := json.Unmarshal(data, &fc); err != nil {
+		return nil, fmt.Errorf("invalid zones: %w", err)
+	}
+	if fc.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("invalid zones: want a FeatureCollection, got %q", fc.Type)
+	}
+
+	zones := make([]*Zone, 0, len(fc.Features))
+	seen := make(map[string]bool)
+	for i, f := range fc.Features {
+		zone := &Zone{ID: f.Properties.ID, Name: f.Properties.Name, BaselineDemand: f.Properties.BaselineDemand}
+		if zone.ID == "" {
+			return nil, fmt.Errorf("invalid zone %d: id is required", i)
+		}
+		if seen[zone.ID] {
+			return nil, fmt.Errorf("invalid zone %q: duplicate id", zone.ID)
+		}
+		seen[zone.ID] = true
+		if zone.BaselineDemand <= 0 {
+			return nil, fmt.Errorf("invalid zone %q: baseline_demand must be positive", zone.ID)
+		}
+
+		var err error
+		if zone.polygons, err = parseGeometry(f.Geometry.Type, f.Geometry.Coordinates); err != nil {
+			return nil, fmt.Errorf("invalid zone %q: %w", zone.ID, err)
+		}
+		zones = append(zones, zone)
+	}
+	return zones, nil
+}
+
+func mustParseZones(data []byte) []*Zone {
+	zones, err := ParseZones(data)
+	if err != nil {
+		panic(err)
+	}
+	return zones
+}
+
+// parseGeometry converts GeoJSON Polygon or MultiPolygon coordinates, which
+// are [longitude, latitude] pairs, into rings of points.
+func parseGeometry(kind string, coordinates json.RawMessage) ([][][]model.LatLng, error) {
+	var polygons [][][][]float64
+	switch kind {
+	case "Polygon":
+		var polygon [][][]float64
+		if err := json.Unmarshal(coordinates, &polygon); err != nil {
+			return nil, err
+		}
+		polygons = [][][][]float64{polygon}
+	case "MultiPolygon":
+		if err := json.Unmarshal(coordinates, &polygons); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("geometry must be a Polygon or MultiPolygon, got %q", kind)
+	}
+
+	out := make([][][]model.LatLng, 0, len(polygons))
+	for _, polygon := range polygons {
+		if len(polygon) == 0 {
+			return nil, errors.New("polygon has no rings")
+		}
+		rings := make([][]model.LatLng, 0, len(polygon))
+		for _, ring := range polygon {
+			if len(ring) < 4 {
+				return nil, errors.New("ring needs at least 4 positions")
+			}
+			points := make([]model.LatLng, 0, len(ring))
+			for _, pos := range ring {
+				if len(pos) < 2 {
+					return nil, errors.New("position needs a longitude and a latitude")
+				}
+				points = append(points, model.LatLng{Lat: pos[1], Lng: pos[0]})
+			}
+			if points[0] != points[len(points)-1] {
+				return nil, errors.New("ring must be closed")
+			}
+			rings = append(rings, points)
+		}
+		out = append(out, rings)
+	}
+	return out, nil
+}
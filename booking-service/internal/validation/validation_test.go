@@ -0,0 +1,198 @@
+package validation
+
+import (
+	"testing"
+
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+var (
+	downtown = &latlng.LatLng{Latitude: 31.5497, Longitude: 74.2500}
+	airport  = &latlng.LatLng{Latitude: 31.5216, Longitude: 74.4036}
+	rs250    = &money.Money{CurrencyCode: "PKR", Units: 250}
+)
+
+const quoteID = "5f0c6a52-2d4b-4c8e-9a37-6f1d2e3b4c5d"
+
+func TestValidator_Validate(t *testing.T) {
+	validator := New()
+
+	tests := []struct {
+		name     string
+		req      *pb.CreateBookingRequest
+		expected []Violation
+	}{
+		{
+			name: "Valid",
+			req: &pb.CreateBookingRequest{
+				UserId:  1,
+				QuoteId: quoteID,
+				Ride:    &pb.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: rs250, Pickup: downtown, Dropoff: airport},
+			},
+			expected: nil,
+		},
+		{
+			name: "Labels Optional",
+			req: &pb.CreateBookingRequest{
+				UserId:  1,
+				QuoteId: quoteID,
+				Ride:    &pb.Ride{Cost: rs250, Pickup: downtown, Dropoff: airport},
+			},
+			expected: nil,
+		},
+		{
+			name: "Unknown Vehicle Class",
+			req: &pb.CreateBookingRequest{
+				UserId:       1,
+				Ride:         &pb.Ride{Cost: rs250, Pickup: downtown, Dropoff: airport},
+				VehicleClass: 42,
+				QuoteId:      quoteID,
+			},
+			expected: []Violation{
+				{Field: "vehicle_class", RuleID: "enum.defined_only", Message: "value must be one of the defined enum values"},
+			},
+		},
+		{
+			name: "Missing Ride",
+			req:  &pb.CreateBookingRequest{UserId: 1, QuoteId: quoteID},
+			expected: []Violation{
+				{Field: "ride", RuleID: "required", Message: "value is required"},
+			},
+		},
+		{
+			name: "Invalid Fields",
+			req: &pb.CreateBookingRequest{
+				UserId: 0,
+				Ride:   &pb.Ride{Source: "Downtown", Destination: "Airport", Distance: -1, Cost: &money.Money{CurrencyCode: "PKR", Units: -5}},
+			},
+			expected: []Violation{
+				{Field: "user_id", RuleID: "int32.gt", Message: "value must be greater than 0"},
+				{Field: "ride.distance", RuleID: "int32.gte", Message: "value must be greater than or equal to 0"},
+				{Field: "ride.pickup", RuleID: "required", Message: "value is required"},
+				{Field: "ride.dropoff", RuleID: "required", Message: "value is required"},
+				{Field: "ride.cost", RuleID: "ride.cost_non_negative", Message: "cost must not be negative"},
+				{Field: "quote_id", RuleID: "required", Message: "value is required"},
+			},
+		},
+		{
+			name: "Malformed Quote ID",
+			req: &pb.CreateBookingRequest{
+				UserId:  1,
+				QuoteId: "not-a-quote",
+				Ride:    &pb.Ride{Pickup: downtown, Dropoff: airport},
+			},
+			expected: []Violation{
+				{Field: "quote_id", RuleID: "string.uuid", Message: "value must be a valid UUID"},
+			},
+		},
+		{
+			name: "Same Source And Destination",
+			req: &pb.CreateBookingRequest{
+				UserId:  1,
+				QuoteId: quoteID,
+				Ride:    &pb.Ride{Source: "Airport", Destination: "Airport", Cost: rs250, Pickup: downtown, Dropoff: airport},
+			},
+			expected: []Violation{
+				{Field: "ride", RuleID: "ride.distinct_labels", Message: "source and destination must differ"},
+			},
+		},
+		{
+			name: "Same Pickup And Dropoff",
+			req: &pb.CreateBookingRequest{
+				UserId:  1,
+				QuoteId: quoteID,
+				Ride:    &pb.Ride{Cost: rs250, Pickup: airport, Dropoff: airport},
+			},
+			expected: []Violation{
+				{Field: "ride", RuleID: "ride.distinct_endpoints", Message: "pickup and dropoff must differ"},
+			},
+		},
+		{
+			name: "Pickup Out Of Range",
+			req: &pb.CreateBookingRequest{
+				UserId:  1,
+				QuoteId: quoteID,
+				Ride: &pb.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: rs250,
+					Pickup: &latlng.LatLng{Latitude: 91, Longitude: 74.3441}, Dropoff: airport},
+			},
+			expected: []Violation{
+				{Field: "ride.pickup", RuleID: "ride.pickup_range", Message: "latitude must be between -90 and 90 and longitude between -180 and 180"},
+			},
+		},
+		{
+			name: "Long Promo Code",
+			req: &pb.CreateBookingRequest{
+				UserId:    1,
+				QuoteId:   quoteID,
+				Ride:      &pb.Ride{Cost: rs250, Pickup: downtown, Dropoff: airport},
+				PromoCode: "THIS-PROMO-CODE-IS-FAR-TOO-LONG-TO-BE-REAL",
+			},
+			expected: []Violation{
+				{Field: "promo_code", RuleID: "string.max_len", Message: "value length must be at most 32 characters"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := validator.Validate(tt.req)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, violations)
+		})
+	}
+}
+
+func TestValidator_ValidatePromo(t *testing.T) {
+	validator := New()
+
+	tests := []struct {
+		name     string
+		promo    *pb.Promo
+		expected []Violation
+	}{
+		{
+			name:  "Percent",
+			promo: &pb.Promo{Code: "welcome20", Type: pb.DiscountType_DISCOUNT_TYPE_PERCENT, PercentOff: 20, StartsAt: "2025-01-01T00:00:00Z", EndsAt: "2030-01-01T00:00:00Z"},
+		},
+		{
+			name:  "Flat",
+			promo: &pb.Promo{Code: "FLAT200", Type: pb.DiscountType_DISCOUNT_TYPE_FLAT, AmountOff: rs250, StartsAt: "2025-01-01T00:00:00Z", EndsAt: "2030-01-01T00:00:00Z"},
+		},
+		{
+			name:  "Percent Without Percent Off",
+			promo: &pb.Promo{Code: "WELCOME20", Type: pb.DiscountType_DISCOUNT_TYPE_PERCENT, StartsAt: "2025-01-01T00:00:00Z", EndsAt: "2030-01-01T00:00:00Z"},
+			expected: []Violation{
+				{Field: "promo", RuleID: "promo.percent_off", Message: "percent promos need a percent_off between 1 and 100"},
+			},
+		},
+		{
+			name:  "Flat Without Amount Off",
+			promo: &pb.Promo{Code: "FLAT200", Type: pb.DiscountType_DISCOUNT_TYPE_FLAT, StartsAt: "2025-01-01T00:00:00Z", EndsAt: "2030-01-01T00:00:00Z"},
+			expected: []Violation{
+				{Field: "promo", RuleID: "promo.amount_off", Message: "flat promos need a positive amount_off"},
+			},
+		},
+		{
+			name:  "Invalid Fields",
+			promo: &pb.Promo{Code: "a b", PercentOff: 101, EndsAt: "2030-01-01T00:00:00Z", MaxRedemptions: -1},
+			expected: []Violation{
+				{Field: "promo.code", RuleID: "string.pattern", Message: "value does not match regex pattern `^[A-Za-z0-9_-]{3,32}$`"},
+				{Field: "promo.type", RuleID: "enum.not_in", Message: "value must not be in list [0]"},
+				{Field: "promo.percent_off", RuleID: "int32.lte", Message: "value must be less than or equal to 100"},
+				{Field: "promo.starts_at", RuleID: "string.min_len", Message: "value length must be at least 1 characters"},
+				{Field: "promo.max_redemptions", RuleID: "int32.gte", Message: "value must be greater than or equal to 0"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := validator.Validate(&pb.CreatePromoRequest{Promo: tt.promo})
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, violations)
+		})
+	}
+}
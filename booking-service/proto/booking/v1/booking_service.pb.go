@@ -7,6 +7,11 @@
 package v1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	latlng "google.golang.org/genproto/googleapis/type/latlng"
+	money "google.golang.org/genproto/googleapis/type/money"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,33 +25,3258 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// CancellationReason is who cancelled a booking, and why.
+type CancellationReason int32
+
+const (
+	CancellationReason_CANCELLATION_REASON_UNSPECIFIED CancellationReason = 0
+	CancellationReason_CANCELLATION_REASON_RIDER       CancellationReason = 1 // The rider cancelled it
+	CancellationReason_CANCELLATION_REASON_NO_SHOW     CancellationReason = 2 // The driver reported that the rider did not show up
+)
+
+// Enum value maps for CancellationReason.
+var (
+	CancellationReason_name = map[int32]string{
+		0: "CANCELLATION_REASON_UNSPECIFIED",
+		1: "CANCELLATION_REASON_RIDER",
+		2: "CANCELLATION_REASON_NO_SHOW",
+	}
+	CancellationReason_value = map[string]int32{
+		"CANCELLATION_REASON_UNSPECIFIED": 0,
+		"CANCELLATION_REASON_RIDER":       1,
+		"CANCELLATION_REASON_NO_SHOW":     2,
+	}
+)
+
+func (x CancellationReason) Enum() *CancellationReason {
+	p := new(CancellationReason)
+	*p = x
+	return p
+}
+
+func (x CancellationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancellationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[0].Descriptor()
+}
+
+func (CancellationReason) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[0]
+}
+
+func (x CancellationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancellationReason.Descriptor instead.
+func (CancellationReason) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{0}
+}
+
+// RefundReason is why a rider was refunded.
+type RefundReason int32
+
+const (
+	RefundReason_REFUND_REASON_UNSPECIFIED   RefundReason = 0
+	RefundReason_REFUND_REASON_SERVICE_ISSUE RefundReason = 1 // Something went wrong with the ride
+	RefundReason_REFUND_REASON_FARE_DISPUTE  RefundReason = 2 // The rider disputed the fare
+	RefundReason_REFUND_REASON_FEE_WAIVED    RefundReason = 3 // A cancellation or no-show fee was waived
+	RefundReason_REFUND_REASON_OTHER         RefundReason = 4
+)
+
+// Enum value maps for RefundReason.
+var (
+	RefundReason_name = map[int32]string{
+		0: "REFUND_REASON_UNSPECIFIED",
+		1: "REFUND_REASON_SERVICE_ISSUE",
+		2: "REFUND_REASON_FARE_DISPUTE",
+		3: "REFUND_REASON_FEE_WAIVED",
+		4: "REFUND_REASON_OTHER",
+	}
+	RefundReason_value = map[string]int32{
+		"REFUND_REASON_UNSPECIFIED":   0,
+		"REFUND_REASON_SERVICE_ISSUE": 1,
+		"REFUND_REASON_FARE_DISPUTE":  2,
+		"REFUND_REASON_FEE_WAIVED":    3,
+		"REFUND_REASON_OTHER":         4,
+	}
+)
+
+func (x RefundReason) Enum() *RefundReason {
+	p := new(RefundReason)
+	*p = x
+	return p
+}
+
+func (x RefundReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[1].Descriptor()
+}
+
+func (RefundReason) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[1]
+}
+
+func (x RefundReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundReason.Descriptor instead.
+func (RefundReason) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{1}
+}
+
+// PaymentMethod is how the rider pays for a booking.
+type PaymentMethod int32
+
+const (
+	PaymentMethod_PAYMENT_METHOD_UNSPECIFIED PaymentMethod = 0 // Treated as CARD
+	PaymentMethod_PAYMENT_METHOD_CARD        PaymentMethod = 1 // Charged through the payment provider on completion
+	// Held in the rider's wallet when booking, captured on completion and
+	// released if the booking is cancelled or expires
+	PaymentMethod_PAYMENT_METHOD_WALLET PaymentMethod = 2
+)
+
+// Enum value maps for PaymentMethod.
+var (
+	PaymentMethod_name = map[int32]string{
+		0: "PAYMENT_METHOD_UNSPECIFIED",
+		1: "PAYMENT_METHOD_CARD",
+		2: "PAYMENT_METHOD_WALLET",
+	}
+	PaymentMethod_value = map[string]int32{
+		"PAYMENT_METHOD_UNSPECIFIED": 0,
+		"PAYMENT_METHOD_CARD":        1,
+		"PAYMENT_METHOD_WALLET":      2,
+	}
+)
+
+func (x PaymentMethod) Enum() *PaymentMethod {
+	p := new(PaymentMethod)
+	*p = x
+	return p
+}
+
+func (x PaymentMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[2].Descriptor()
+}
+
+func (PaymentMethod) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[2]
+}
+
+func (x PaymentMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentMethod.Descriptor instead.
+func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{2}
+}
+
+// BookingStatus tells whether a booking has a driver yet, and whether the
+// ride is over.
+type BookingStatus int32
+
+const (
+	BookingStatus_BOOKING_STATUS_UNSPECIFIED BookingStatus = 0
+	BookingStatus_BOOKING_STATUS_PENDING     BookingStatus = 1 // Being offered to nearby drivers
+	BookingStatus_BOOKING_STATUS_CONFIRMED   BookingStatus = 2 // A driver accepted it
+	BookingStatus_BOOKING_STATUS_EXPIRED     BookingStatus = 3 // No driver accepted it in time
+	BookingStatus_BOOKING_STATUS_COMPLETED   BookingStatus = 4 // The driver finished the ride and the rider paid
+	BookingStatus_BOOKING_STATUS_CANCELLED   BookingStatus = 5 // The rider cancelled it, or did not show up, before it was completed
+	BookingStatus_BOOKING_STATUS_SCHEDULED   BookingStatus = 6 // Booked for a later pickup; becomes PENDING shortly before it
+)
+
+// Enum value maps for BookingStatus.
+var (
+	BookingStatus_name = map[int32]string{
+		0: "BOOKING_STATUS_UNSPECIFIED",
+		1: "BOOKING_STATUS_PENDING",
+		2: "BOOKING_STATUS_CONFIRMED",
+		3: "BOOKING_STATUS_EXPIRED",
+		4: "BOOKING_STATUS_COMPLETED",
+		5: "BOOKING_STATUS_CANCELLED",
+		6: "BOOKING_STATUS_SCHEDULED",
+	}
+	BookingStatus_value = map[string]int32{
+		"BOOKING_STATUS_UNSPECIFIED": 0,
+		"BOOKING_STATUS_PENDING":     1,
+		"BOOKING_STATUS_CONFIRMED":   2,
+		"BOOKING_STATUS_EXPIRED":     3,
+		"BOOKING_STATUS_COMPLETED":   4,
+		"BOOKING_STATUS_CANCELLED":   5,
+		"BOOKING_STATUS_SCHEDULED":   6,
+	}
+)
+
+func (x BookingStatus) Enum() *BookingStatus {
+	p := new(BookingStatus)
+	*p = x
+	return p
+}
+
+func (x BookingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[3].Descriptor()
+}
+
+func (BookingStatus) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[3]
+}
+
+func (x BookingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingStatus.Descriptor instead.
+func (BookingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{3}
+}
+
+// VehicleClass is the kind of vehicle a ride is booked in; each has its own
+// fare multiplier in the tariff.
+type VehicleClass int32
+
+const (
+	VehicleClass_VEHICLE_CLASS_UNSPECIFIED VehicleClass = 0 // Treated as ECONOMY
+	VehicleClass_VEHICLE_CLASS_ECONOMY     VehicleClass = 1
+	VehicleClass_VEHICLE_CLASS_COMFORT     VehicleClass = 2
+	VehicleClass_VEHICLE_CLASS_XL          VehicleClass = 3
+)
+
+// Enum value maps for VehicleClass.
+var (
+	VehicleClass_name = map[int32]string{
+		0: "VEHICLE_CLASS_UNSPECIFIED",
+		1: "VEHICLE_CLASS_ECONOMY",
+		2: "VEHICLE_CLASS_COMFORT",
+		3: "VEHICLE_CLASS_XL",
+	}
+	VehicleClass_value = map[string]int32{
+		"VEHICLE_CLASS_UNSPECIFIED": 0,
+		"VEHICLE_CLASS_ECONOMY":     1,
+		"VEHICLE_CLASS_COMFORT":     2,
+		"VEHICLE_CLASS_XL":          3,
+	}
+)
+
+func (x VehicleClass) Enum() *VehicleClass {
+	p := new(VehicleClass)
+	*p = x
+	return p
+}
+
+func (x VehicleClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VehicleClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[4].Descriptor()
+}
+
+func (VehicleClass) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[4]
+}
+
+func (x VehicleClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VehicleClass.Descriptor instead.
+func (VehicleClass) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{4}
+}
+
+// SagaStatus is the overall state of a booking saga.
+type SagaStatus int32
+
+const (
+	SagaStatus_SAGA_STATUS_UNSPECIFIED  SagaStatus = 0
+	SagaStatus_SAGA_STATUS_RUNNING      SagaStatus = 1 // Executing or waiting to resume forward steps
+	SagaStatus_SAGA_STATUS_COMPENSATING SagaStatus = 2 // Undoing completed steps after a failure
+	SagaStatus_SAGA_STATUS_COMPLETED    SagaStatus = 3 // The booking was created
+	SagaStatus_SAGA_STATUS_FAILED       SagaStatus = 4 // The booking was not created and completed steps were undone
+)
+
+// Enum value maps for SagaStatus.
+var (
+	SagaStatus_name = map[int32]string{
+		0: "SAGA_STATUS_UNSPECIFIED",
+		1: "SAGA_STATUS_RUNNING",
+		2: "SAGA_STATUS_COMPENSATING",
+		3: "SAGA_STATUS_COMPLETED",
+		4: "SAGA_STATUS_FAILED",
+	}
+	SagaStatus_value = map[string]int32{
+		"SAGA_STATUS_UNSPECIFIED":  0,
+		"SAGA_STATUS_RUNNING":      1,
+		"SAGA_STATUS_COMPENSATING": 2,
+		"SAGA_STATUS_COMPLETED":    3,
+		"SAGA_STATUS_FAILED":       4,
+	}
+)
+
+func (x SagaStatus) Enum() *SagaStatus {
+	p := new(SagaStatus)
+	*p = x
+	return p
+}
+
+func (x SagaStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SagaStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[5].Descriptor()
+}
+
+func (SagaStatus) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[5]
+}
+
+func (x SagaStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SagaStatus.Descriptor instead.
+func (SagaStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{5}
+}
+
+// SagaStep is the step a booking saga executes next.
+type SagaStep int32
+
+const (
+	SagaStep_SAGA_STEP_UNSPECIFIED    SagaStep = 0
+	SagaStep_SAGA_STEP_VALIDATE_USER  SagaStep = 1
+	SagaStep_SAGA_STEP_CREATE_RIDE    SagaStep = 2
+	SagaStep_SAGA_STEP_CREATE_BOOKING SagaStep = 3
+	SagaStep_SAGA_STEP_DELETE_RIDE    SagaStep = 4 // Compensates SAGA_STEP_CREATE_RIDE
+	SagaStep_SAGA_STEP_DONE           SagaStep = 5
+	SagaStep_SAGA_STEP_HOLD_FUNDS     SagaStep = 6 // Holds the fare in the rider's wallet
+	SagaStep_SAGA_STEP_RELEASE_HOLD   SagaStep = 7 // Compensates SAGA_STEP_HOLD_FUNDS
+)
+
+// Enum value maps for SagaStep.
+var (
+	SagaStep_name = map[int32]string{
+		0: "SAGA_STEP_UNSPECIFIED",
+		1: "SAGA_STEP_VALIDATE_USER",
+		2: "SAGA_STEP_CREATE_RIDE",
+		3: "SAGA_STEP_CREATE_BOOKING",
+		4: "SAGA_STEP_DELETE_RIDE",
+		5: "SAGA_STEP_DONE",
+		6: "SAGA_STEP_HOLD_FUNDS",
+		7: "SAGA_STEP_RELEASE_HOLD",
+	}
+	SagaStep_value = map[string]int32{
+		"SAGA_STEP_UNSPECIFIED":    0,
+		"SAGA_STEP_VALIDATE_USER":  1,
+		"SAGA_STEP_CREATE_RIDE":    2,
+		"SAGA_STEP_CREATE_BOOKING": 3,
+		"SAGA_STEP_DELETE_RIDE":    4,
+		"SAGA_STEP_DONE":           5,
+		"SAGA_STEP_HOLD_FUNDS":     6,
+		"SAGA_STEP_RELEASE_HOLD":   7,
+	}
+)
+
+func (x SagaStep) Enum() *SagaStep {
+	p := new(SagaStep)
+	*p = x
+	return p
+}
+
+func (x SagaStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SagaStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[6].Descriptor()
+}
+
+func (SagaStep) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[6]
+}
+
+func (x SagaStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SagaStep.Descriptor instead.
+func (SagaStep) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{6}
+}
+
+// OfferStatus is the state of an offer of a booking to a driver.
+type OfferStatus int32
+
+const (
+	OfferStatus_OFFER_STATUS_UNSPECIFIED OfferStatus = 0
+	OfferStatus_OFFER_STATUS_PENDING     OfferStatus = 1 // Waiting for the driver to respond
+	OfferStatus_OFFER_STATUS_ACCEPTED    OfferStatus = 2
+	OfferStatus_OFFER_STATUS_DECLINED    OfferStatus = 3
+	OfferStatus_OFFER_STATUS_EXPIRED     OfferStatus = 4 // The driver did not respond in time
+)
+
+// Enum value maps for OfferStatus.
+var (
+	OfferStatus_name = map[int32]string{
+		0: "OFFER_STATUS_UNSPECIFIED",
+		1: "OFFER_STATUS_PENDING",
+		2: "OFFER_STATUS_ACCEPTED",
+		3: "OFFER_STATUS_DECLINED",
+		4: "OFFER_STATUS_EXPIRED",
+	}
+	OfferStatus_value = map[string]int32{
+		"OFFER_STATUS_UNSPECIFIED": 0,
+		"OFFER_STATUS_PENDING":     1,
+		"OFFER_STATUS_ACCEPTED":    2,
+		"OFFER_STATUS_DECLINED":    3,
+		"OFFER_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x OfferStatus) Enum() *OfferStatus {
+	p := new(OfferStatus)
+	*p = x
+	return p
+}
+
+func (x OfferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OfferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[7].Descriptor()
+}
+
+func (OfferStatus) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[7]
+}
+
+func (x OfferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OfferStatus.Descriptor instead.
+func (OfferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{7}
+}
+
+// DiscountType is how a promo code discounts a fare.
+type DiscountType int32
+
+const (
+	DiscountType_DISCOUNT_TYPE_UNSPECIFIED DiscountType = 0
+	DiscountType_DISCOUNT_TYPE_PERCENT     DiscountType = 1 // percent_off of the fare, up to max_discount
+	DiscountType_DISCOUNT_TYPE_FLAT        DiscountType = 2 // amount_off, up to the whole fare
+)
+
+// Enum value maps for DiscountType.
+var (
+	DiscountType_name = map[int32]string{
+		0: "DISCOUNT_TYPE_UNSPECIFIED",
+		1: "DISCOUNT_TYPE_PERCENT",
+		2: "DISCOUNT_TYPE_FLAT",
+	}
+	DiscountType_value = map[string]int32{
+		"DISCOUNT_TYPE_UNSPECIFIED": 0,
+		"DISCOUNT_TYPE_PERCENT":     1,
+		"DISCOUNT_TYPE_FLAT":        2,
+	}
+)
+
+func (x DiscountType) Enum() *DiscountType {
+	p := new(DiscountType)
+	*p = x
+	return p
+}
+
+func (x DiscountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[8].Descriptor()
+}
+
+func (DiscountType) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[8]
+}
+
+func (x DiscountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscountType.Descriptor instead.
+func (DiscountType) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{8}
+}
+
+// ReceiptFormat is the document format of a receipt.
+type ReceiptFormat int32
+
+const (
+	ReceiptFormat_RECEIPT_FORMAT_UNSPECIFIED ReceiptFormat = 0
+	ReceiptFormat_RECEIPT_FORMAT_HTML        ReceiptFormat = 1
+	ReceiptFormat_RECEIPT_FORMAT_PDF         ReceiptFormat = 2
+)
+
+// Enum value maps for ReceiptFormat.
+var (
+	ReceiptFormat_name = map[int32]string{
+		0: "RECEIPT_FORMAT_UNSPECIFIED",
+		1: "RECEIPT_FORMAT_HTML",
+		2: "RECEIPT_FORMAT_PDF",
+	}
+	ReceiptFormat_value = map[string]int32{
+		"RECEIPT_FORMAT_UNSPECIFIED": 0,
+		"RECEIPT_FORMAT_HTML":        1,
+		"RECEIPT_FORMAT_PDF":         2,
+	}
+)
+
+func (x ReceiptFormat) Enum() *ReceiptFormat {
+	p := new(ReceiptFormat)
+	*p = x
+	return p
+}
+
+func (x ReceiptFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReceiptFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[9].Descriptor()
+}
+
+func (ReceiptFormat) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[9]
+}
+
+func (x ReceiptFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReceiptFormat.Descriptor instead.
+func (ReceiptFormat) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{9}
+}
+
+// AccountType is whose money a ledger account holds.
+type AccountType int32
+
+const (
+	AccountType_ACCOUNT_TYPE_UNSPECIFIED AccountType = 0
+	AccountType_ACCOUNT_TYPE_RIDER       AccountType = 1 // What a rider paid, as a negative balance
+	AccountType_ACCOUNT_TYPE_DRIVER      AccountType = 2 // What a driver earned
+	AccountType_ACCOUNT_TYPE_PLATFORM    AccountType = 3 // Commission the platform earned
+	AccountType_ACCOUNT_TYPE_PROMO       AccountType = 4 // What promo codes gave away, as a negative balance
+)
+
+// Enum value maps for AccountType.
+var (
+	AccountType_name = map[int32]string{
+		0: "ACCOUNT_TYPE_UNSPECIFIED",
+		1: "ACCOUNT_TYPE_RIDER",
+		2: "ACCOUNT_TYPE_DRIVER",
+		3: "ACCOUNT_TYPE_PLATFORM",
+		4: "ACCOUNT_TYPE_PROMO",
+	}
+	AccountType_value = map[string]int32{
+		"ACCOUNT_TYPE_UNSPECIFIED": 0,
+		"ACCOUNT_TYPE_RIDER":       1,
+		"ACCOUNT_TYPE_DRIVER":      2,
+		"ACCOUNT_TYPE_PLATFORM":    3,
+		"ACCOUNT_TYPE_PROMO":       4,
+	}
+)
+
+func (x AccountType) Enum() *AccountType {
+	p := new(AccountType)
+	*p = x
+	return p
+}
+
+func (x AccountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[10].Descriptor()
+}
+
+func (AccountType) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[10]
+}
+
+func (x AccountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountType.Descriptor instead.
+func (AccountType) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{10}
+}
+
+// JournalEntryKind is why money moved.
+type JournalEntryKind int32
+
+const (
+	JournalEntryKind_JOURNAL_ENTRY_KIND_UNSPECIFIED      JournalEntryKind = 0
+	JournalEntryKind_JOURNAL_ENTRY_KIND_RIDE_COMPLETED   JournalEntryKind = 1 // The rider paid for a completed ride
+	JournalEntryKind_JOURNAL_ENTRY_KIND_CANCELLATION_FEE JournalEntryKind = 2 // The rider paid for cancelling or not showing up
+	JournalEntryKind_JOURNAL_ENTRY_KIND_REFUND           JournalEntryKind = 3 // The rider was refunded
+)
+
+// Enum value maps for JournalEntryKind.
+var (
+	JournalEntryKind_name = map[int32]string{
+		0: "JOURNAL_ENTRY_KIND_UNSPECIFIED",
+		1: "JOURNAL_ENTRY_KIND_RIDE_COMPLETED",
+		2: "JOURNAL_ENTRY_KIND_CANCELLATION_FEE",
+		3: "JOURNAL_ENTRY_KIND_REFUND",
+	}
+	JournalEntryKind_value = map[string]int32{
+		"JOURNAL_ENTRY_KIND_UNSPECIFIED":      0,
+		"JOURNAL_ENTRY_KIND_RIDE_COMPLETED":   1,
+		"JOURNAL_ENTRY_KIND_CANCELLATION_FEE": 2,
+		"JOURNAL_ENTRY_KIND_REFUND":           3,
+	}
+)
+
+func (x JournalEntryKind) Enum() *JournalEntryKind {
+	p := new(JournalEntryKind)
+	*p = x
+	return p
+}
+
+func (x JournalEntryKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JournalEntryKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_service_proto_enumTypes[11].Descriptor()
+}
+
+func (JournalEntryKind) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_service_proto_enumTypes[11]
+}
+
+func (x JournalEntryKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JournalEntryKind.Descriptor instead.
+func (JournalEntryKind) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{11}
+}
+
 // Booking definition, specific to BookingService
 type Booking struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BookingId int32  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	UserId    int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RideId    int32  `protobuf:"varint,3,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
-	Time      string `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"` // Timestamp of the booking
+	BookingId          int32              `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId             int32              `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RideId             int32              `protobuf:"varint,3,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	Time               string             `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`                          // Timestamp of the booking
+	DriverId           int32              `protobuf:"varint,5,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"` // Assigned driver, 0 if none
+	Status             BookingStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	TariffVersion      string             `protobuf:"bytes,7,opt,name=tariff_version,json=tariffVersion,proto3" json:"tariff_version,omitempty"` // Tariff the cost was computed with
+	Discount           *Discount          `protobuf:"bytes,8,opt,name=discount,proto3" json:"discount,omitempty"`                                // Set if the booking was made with a promo code
+	PaymentMethod      PaymentMethod      `protobuf:"varint,9,opt,name=payment_method,json=paymentMethod,proto3,enum=booking.v1.PaymentMethod" json:"payment_method,omitempty"`
+	HoldId             int32              `protobuf:"varint,10,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`                                                                        // Wallet hold paying for the booking, 0 if none
+	CancellationReason CancellationReason `protobuf:"varint,11,opt,name=cancellation_reason,json=cancellationReason,proto3,enum=booking.v1.CancellationReason" json:"cancellation_reason,omitempty"` // Set once cancelled
+	CancellationFee    *money.Money       `protobuf:"bytes,12,opt,name=cancellation_fee,json=cancellationFee,proto3" json:"cancellation_fee,omitempty"`                                              // Charged for cancelling, unset if nothing was
+	Refunded           *money.Money       `protobuf:"bytes,13,opt,name=refunded,proto3" json:"refunded,omitempty"`                                                                                   // Given back to the rider in total, unset if nothing was
+	RefundReason       RefundReason       `protobuf:"varint,14,opt,name=refund_reason,json=refundReason,proto3,enum=booking.v1.RefundReason" json:"refund_reason,omitempty"`                         // Of the latest refund
+	PickupTime         string             `protobuf:"bytes,15,opt,name=pickup_time,json=pickupTime,proto3" json:"pickup_time,omitempty"`                                                             // When the rider is picked up: the booking time, or later if scheduled
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Booking) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *Booking) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Booking) GetRideId() int32 {
+	if x != nil {
+		return x.RideId
+	}
+	return 0
+}
+
+func (x *Booking) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *Booking) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *Booking) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *Booking) GetTariffVersion() string {
+	if x != nil {
+		return x.TariffVersion
+	}
+	return ""
+}
+
+func (x *Booking) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *Booking) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *Booking) GetHoldId() int32 {
+	if x != nil {
+		return x.HoldId
+	}
+	return 0
+}
+
+func (x *Booking) GetCancellationReason() CancellationReason {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return CancellationReason_CANCELLATION_REASON_UNSPECIFIED
+}
+
+func (x *Booking) GetCancellationFee() *money.Money {
+	if x != nil {
+		return x.CancellationFee
+	}
+	return nil
+}
+
+func (x *Booking) GetRefunded() *money.Money {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+func (x *Booking) GetRefundReason() RefundReason {
+	if x != nil {
+		return x.RefundReason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
+func (x *Booking) GetPickupTime() string {
+	if x != nil {
+		return x.PickupTime
+	}
+	return ""
+}
+
+// Discount is what a promo code took off a fare; the rider is charged
+// total, which is also the ride's cost.
+type Discount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromoCode string       `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	Fare      *money.Money `protobuf:"bytes,2,opt,name=fare,proto3" json:"fare,omitempty"`     // Quoted fare before the discount
+	Amount    *money.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // Taken off the fare
+	Total     *money.Money `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`   // fare minus amount
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Discount) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Discount) GetFare() *money.Money {
+	if x != nil {
+		return x.Fare
+	}
+	return nil
+}
+
+func (x *Discount) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Discount) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+// Ride definition, embedded for convenience. A ride goes from pickup to
+// dropoff; source and destination are optional labels for them.
+type Ride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RideId      int32  `protobuf:"varint,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`           // Label of the pickup, e.g. "Downtown"
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"` // Label of the dropoff
+	// Great-circle distance from pickup to dropoff in kilometers, computed by
+	// RideService. A distance sent by the client must agree with it.
+	Distance int32 `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	DriverId int32 `protobuf:"varint,6,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"` // Assigned driver, 0 if none; ignored by CreateBooking
+	// Where the rider is picked up, and where dispatch looks for drivers
+	Pickup *latlng.LatLng `protobuf:"bytes,7,opt,name=pickup,proto3" json:"pickup,omitempty"`
+	// Where the rider is dropped off
+	Dropoff *latlng.LatLng `protobuf:"bytes,8,opt,name=dropoff,proto3" json:"dropoff,omitempty"`
+	// What the ride costs; computed from the tariff by CreateBooking
+	Cost *money.Money `protobuf:"bytes,9,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *Ride) Reset() {
+	*x = Ride{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ride) ProtoMessage() {}
+
+func (x *Ride) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ride.ProtoReflect.Descriptor instead.
+func (*Ride) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Ride) GetRideId() int32 {
+	if x != nil {
+		return x.RideId
+	}
+	return 0
+}
+
+func (x *Ride) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Ride) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Ride) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *Ride) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *Ride) GetPickup() *latlng.LatLng {
+	if x != nil {
+		return x.Pickup
+	}
+	return nil
+}
+
+func (x *Ride) GetDropoff() *latlng.LatLng {
+	if x != nil {
+		return x.Dropoff
+	}
+	return nil
+}
+
+func (x *Ride) GetCost() *money.Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+type CreateBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int32        `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ride         *Ride        `protobuf:"bytes,2,opt,name=ride,proto3" json:"ride,omitempty"` // Ride is defined within BookingService
+	VehicleClass VehicleClass `protobuf:"varint,3,opt,name=vehicle_class,json=vehicleClass,proto3,enum=booking.v1.VehicleClass" json:"vehicle_class,omitempty"`
+	// Returned by EstimateFare for the same pickup, dropoff and vehicle class;
+	// the booking is charged the quoted cost. A quote books at most one ride
+	// and must be used before it expires.
+	QuoteId string `protobuf:"bytes,4,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	// Optional promo code to discount the quoted fare with; see CreatePromo
+	PromoCode string `protobuf:"bytes,5,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	// How the rider pays; WALLET holds the fare in the rider's wallet, which
+	// must have enough available
+	PaymentMethod PaymentMethod `protobuf:"varint,6,opt,name=payment_method,json=paymentMethod,proto3,enum=booking.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Optional RFC 3339 time to be picked up at, to book the ride in advance;
+	// unset books it for now. The booking is SCHEDULED until shortly before
+	// the pickup, when dispatch starts looking for a driver.
+	PickupTime string `protobuf:"bytes,7,opt,name=pickup_time,json=pickupTime,proto3" json:"pickup_time,omitempty"`
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateBookingRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetRide() *Ride {
+	if x != nil {
+		return x.Ride
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetVehicleClass() VehicleClass {
+	if x != nil {
+		return x.VehicleClass
+	}
+	return VehicleClass_VEHICLE_CLASS_UNSPECIFIED
+}
+
+func (x *CreateBookingRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *CreateBookingRequest) GetPickupTime() string {
+	if x != nil {
+		return x.PickupTime
+	}
+	return ""
+}
+
+type CreateBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`             // Reference Booking within BookingService
+	SagaId  string   `protobuf:"bytes,2,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"` // Saga that created the booking
+}
+
+func (x *CreateBookingResponse) Reset() {
+	*x = CreateBookingResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingResponse) ProtoMessage() {}
+
+func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CreateBookingResponse) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+type GetBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId int32 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type GetBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name               string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source             string             `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Destination        string             `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Distance           int32              `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	Time               string             `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	DriverId           int32              `protobuf:"varint,7,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"` // Assigned driver, 0 if none
+	Status             BookingStatus      `protobuf:"varint,8,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	Pickup             *latlng.LatLng     `protobuf:"bytes,9,opt,name=pickup,proto3" json:"pickup,omitempty"`                                     // Where the rider is picked up
+	Dropoff            *latlng.LatLng     `protobuf:"bytes,10,opt,name=dropoff,proto3" json:"dropoff,omitempty"`                                  // Where the rider is dropped off
+	TariffVersion      string             `protobuf:"bytes,11,opt,name=tariff_version,json=tariffVersion,proto3" json:"tariff_version,omitempty"` // Tariff the cost was computed with
+	Cost               *money.Money       `protobuf:"bytes,12,opt,name=cost,proto3" json:"cost,omitempty"`                                        // What the rider is charged, after any discount
+	Discount           *Discount          `protobuf:"bytes,13,opt,name=discount,proto3" json:"discount,omitempty"`                                // Set if the booking was made with a promo code
+	PaymentMethod      PaymentMethod      `protobuf:"varint,14,opt,name=payment_method,json=paymentMethod,proto3,enum=booking.v1.PaymentMethod" json:"payment_method,omitempty"`
+	CancellationReason CancellationReason `protobuf:"varint,15,opt,name=cancellation_reason,json=cancellationReason,proto3,enum=booking.v1.CancellationReason" json:"cancellation_reason,omitempty"` // Set once cancelled
+	CancellationFee    *money.Money       `protobuf:"bytes,16,opt,name=cancellation_fee,json=cancellationFee,proto3" json:"cancellation_fee,omitempty"`                                              // Charged for cancelling, unset if nothing was
+	Refunded           *money.Money       `protobuf:"bytes,17,opt,name=refunded,proto3" json:"refunded,omitempty"`                                                                                   // Given back to the rider in total, unset if nothing was
+	RefundReason       RefundReason       `protobuf:"varint,18,opt,name=refund_reason,json=refundReason,proto3,enum=booking.v1.RefundReason" json:"refund_reason,omitempty"`                         // Of the latest refund
+	PickupTime         string             `protobuf:"bytes,19,opt,name=pickup_time,json=pickupTime,proto3" json:"pickup_time,omitempty"`                                                             // When the rider is picked up: the booking time, or later if scheduled
+}
+
+func (x *GetBookingResponse) Reset() {
+	*x = GetBookingResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingResponse) ProtoMessage() {}
+
+func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBookingResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *GetBookingResponse) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *GetBookingResponse) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *GetBookingResponse) GetPickup() *latlng.LatLng {
+	if x != nil {
+		return x.Pickup
+	}
+	return nil
+}
+
+func (x *GetBookingResponse) GetDropoff() *latlng.LatLng {
+	if x != nil {
+		return x.Dropoff
+	}
+	return nil
+}
+
+func (x *GetBookingResponse) GetTariffVersion() string {
+	if x != nil {
+		return x.TariffVersion
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetCost() *money.Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *GetBookingResponse) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *GetBookingResponse) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *GetBookingResponse) GetCancellationReason() CancellationReason {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return CancellationReason_CANCELLATION_REASON_UNSPECIFIED
+}
+
+func (x *GetBookingResponse) GetCancellationFee() *money.Money {
+	if x != nil {
+		return x.CancellationFee
+	}
+	return nil
+}
+
+func (x *GetBookingResponse) GetRefunded() *money.Money {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+func (x *GetBookingResponse) GetRefundReason() RefundReason {
+	if x != nil {
+		return x.RefundReason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
+func (x *GetBookingResponse) GetPickupTime() string {
+	if x != nil {
+		return x.PickupTime
+	}
+	return ""
+}
+
+type ListBookingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ListBookingsRequest) Reset() {
+	*x = ListBookingsRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsRequest) ProtoMessage() {}
+
+func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListBookingsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListBookingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bookings []*Booking `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"` // Oldest first
+}
+
+func (x *ListBookingsResponse) Reset() {
+	*x = ListBookingsResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsResponse) ProtoMessage() {}
+
+func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+type BookingSaga struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SagaId        string        `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	UserId        int32         `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ride          *Ride         `protobuf:"bytes,3,opt,name=ride,proto3" json:"ride,omitempty"` // ride_id is set once the ride is created
+	Status        SagaStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=booking.v1.SagaStatus" json:"status,omitempty"`
+	Step          SagaStep      `protobuf:"varint,5,opt,name=step,proto3,enum=booking.v1.SagaStep" json:"step,omitempty"`
+	BookingId     int32         `protobuf:"varint,6,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Error         string        `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"` // Why the saga failed, or the last error it is retrying after
+	CreatedAt     string        `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string        `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version       int32         `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`                                 // Incremented on every change
+	TariffVersion string        `protobuf:"bytes,11,opt,name=tariff_version,json=tariffVersion,proto3" json:"tariff_version,omitempty"` // Tariff ride.cost was computed with
+	QuoteId       string        `protobuf:"bytes,12,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`                   // Fare quote the booking was made with
+	Discount      *Discount     `protobuf:"bytes,13,opt,name=discount,proto3" json:"discount,omitempty"`                                // Set if the booking is made with a promo code; ride.cost is its total
+	PaymentMethod PaymentMethod `protobuf:"varint,14,opt,name=payment_method,json=paymentMethod,proto3,enum=booking.v1.PaymentMethod" json:"payment_method,omitempty"`
+	HoldId        int32         `protobuf:"varint,15,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`            // Wallet hold of the fare, set once held
+	PickupTime    string        `protobuf:"bytes,16,opt,name=pickup_time,json=pickupTime,proto3" json:"pickup_time,omitempty"` // Set if the booking is scheduled for a later pickup
+}
+
+func (x *BookingSaga) Reset() {
+	*x = BookingSaga{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingSaga) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingSaga) ProtoMessage() {}
+
+func (x *BookingSaga) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingSaga.ProtoReflect.Descriptor instead.
+func (*BookingSaga) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BookingSaga) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *BookingSaga) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingSaga) GetRide() *Ride {
+	if x != nil {
+		return x.Ride
+	}
+	return nil
+}
+
+func (x *BookingSaga) GetStatus() SagaStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SagaStatus_SAGA_STATUS_UNSPECIFIED
+}
+
+func (x *BookingSaga) GetStep() SagaStep {
+	if x != nil {
+		return x.Step
+	}
+	return SagaStep_SAGA_STEP_UNSPECIFIED
+}
+
+func (x *BookingSaga) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *BookingSaga) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BookingSaga) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *BookingSaga) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *BookingSaga) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BookingSaga) GetTariffVersion() string {
+	if x != nil {
+		return x.TariffVersion
+	}
+	return ""
+}
+
+func (x *BookingSaga) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *BookingSaga) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *BookingSaga) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *BookingSaga) GetHoldId() int32 {
+	if x != nil {
+		return x.HoldId
+	}
+	return 0
+}
+
+func (x *BookingSaga) GetPickupTime() string {
+	if x != nil {
+		return x.PickupTime
+	}
+	return ""
+}
+
+type GetBookingSagaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SagaId string `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+}
+
+func (x *GetBookingSagaRequest) Reset() {
+	*x = GetBookingSagaRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingSagaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingSagaRequest) ProtoMessage() {}
+
+func (x *GetBookingSagaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingSagaRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingSagaRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBookingSagaRequest) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+type GetBookingSagaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Saga *BookingSaga `protobuf:"bytes,1,opt,name=saga,proto3" json:"saga,omitempty"`
+}
+
+func (x *GetBookingSagaResponse) Reset() {
+	*x = GetBookingSagaResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingSagaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingSagaResponse) ProtoMessage() {}
+
+func (x *GetBookingSagaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingSagaResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingSagaResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetBookingSagaResponse) GetSaga() *BookingSaga {
+	if x != nil {
+		return x.Saga
+	}
+	return nil
+}
+
+type WatchBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SagaId      string `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`                // Returned by CreateBooking, or with its SAGA_PENDING error
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // Resume after the update carrying this token
+}
+
+func (x *WatchBookingRequest) Reset() {
+	*x = WatchBookingRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBookingRequest) ProtoMessage() {}
+
+func (x *WatchBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBookingRequest.ProtoReflect.Descriptor instead.
+func (*WatchBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchBookingRequest) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *WatchBookingRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type WatchBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResumeToken   string        `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`                                      // Opaque; pass it back to resume after this update
+	Saga          *BookingSaga  `protobuf:"bytes,2,opt,name=saga,proto3" json:"saga,omitempty"`                                                                       // Status COMPLETED means the booking was created
+	Ride          *Ride         `protobuf:"bytes,3,opt,name=ride,proto3" json:"ride,omitempty"`                                                                       // Current details of the booked ride
+	BookingStatus BookingStatus `protobuf:"varint,4,opt,name=booking_status,json=bookingStatus,proto3,enum=booking.v1.BookingStatus" json:"booking_status,omitempty"` // Set once the booking is created; CONFIRMED once a driver accepted it
+}
+
+func (x *WatchBookingResponse) Reset() {
+	*x = WatchBookingResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBookingResponse) ProtoMessage() {}
+
+func (x *WatchBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBookingResponse.ProtoReflect.Descriptor instead.
+func (*WatchBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchBookingResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchBookingResponse) GetSaga() *BookingSaga {
+	if x != nil {
+		return x.Saga
+	}
+	return nil
+}
+
+func (x *WatchBookingResponse) GetRide() *Ride {
+	if x != nil {
+		return x.Ride
+	}
+	return nil
+}
+
+func (x *WatchBookingResponse) GetBookingStatus() BookingStatus {
+	if x != nil {
+		return x.BookingStatus
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+type DriverOffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OfferId    int32       `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	BookingId  int32       `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	DriverId   int32       `protobuf:"varint,3,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Status     OfferStatus `protobuf:"varint,4,opt,name=status,proto3,enum=booking.v1.OfferStatus" json:"status,omitempty"`
+	DistanceKm float64     `protobuf:"fixed64,5,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"` // From the driver to the pickup when offered
+	CreatedAt  string      `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt  string      `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *DriverOffer) Reset() {
+	*x = DriverOffer{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverOffer) ProtoMessage() {}
+
+func (x *DriverOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverOffer.ProtoReflect.Descriptor instead.
+func (*DriverOffer) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DriverOffer) GetOfferId() int32 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *DriverOffer) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *DriverOffer) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *DriverOffer) GetStatus() OfferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OfferStatus_OFFER_STATUS_UNSPECIFIED
+}
+
+func (x *DriverOffer) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *DriverOffer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DriverOffer) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ListDriverOffersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DriverId int32 `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+}
+
+func (x *ListDriverOffersRequest) Reset() {
+	*x = ListDriverOffersRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriverOffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriverOffersRequest) ProtoMessage() {}
+
+func (x *ListDriverOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriverOffersRequest.ProtoReflect.Descriptor instead.
+func (*ListDriverOffersRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListDriverOffersRequest) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+type ListDriverOffersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offers []*DriverOffer `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"` // Open offers, oldest first
+}
+
+func (x *ListDriverOffersResponse) Reset() {
+	*x = ListDriverOffersResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriverOffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriverOffersResponse) ProtoMessage() {}
+
+func (x *ListDriverOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriverOffersResponse.ProtoReflect.Descriptor instead.
+func (*ListDriverOffersResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListDriverOffersResponse) GetOffers() []*DriverOffer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type RespondToOfferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OfferId  int32 `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	DriverId int32 `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"` // Driver the offer was made to
+	Accept   bool  `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *RespondToOfferRequest) Reset() {
+	*x = RespondToOfferRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToOfferRequest) ProtoMessage() {}
+
+func (x *RespondToOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToOfferRequest.ProtoReflect.Descriptor instead.
+func (*RespondToOfferRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RespondToOfferRequest) GetOfferId() int32 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *RespondToOfferRequest) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *RespondToOfferRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondToOfferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offer   *DriverOffer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	Booking *Booking     `protobuf:"bytes,2,opt,name=booking,proto3" json:"booking,omitempty"`
+}
+
+func (x *RespondToOfferResponse) Reset() {
+	*x = RespondToOfferResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToOfferResponse) ProtoMessage() {}
+
+func (x *RespondToOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToOfferResponse.ProtoReflect.Descriptor instead.
+func (*RespondToOfferResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RespondToOfferResponse) GetOffer() *DriverOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *RespondToOfferResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type EstimateFareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pickup       *latlng.LatLng `protobuf:"bytes,1,opt,name=pickup,proto3" json:"pickup,omitempty"`
+	Dropoff      *latlng.LatLng `protobuf:"bytes,2,opt,name=dropoff,proto3" json:"dropoff,omitempty"`
+	VehicleClass VehicleClass   `protobuf:"varint,3,opt,name=vehicle_class,json=vehicleClass,proto3,enum=booking.v1.VehicleClass" json:"vehicle_class,omitempty"`
+}
+
+func (x *EstimateFareRequest) Reset() {
+	*x = EstimateFareRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateFareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateFareRequest) ProtoMessage() {}
+
+func (x *EstimateFareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateFareRequest.ProtoReflect.Descriptor instead.
+func (*EstimateFareRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EstimateFareRequest) GetPickup() *latlng.LatLng {
+	if x != nil {
+		return x.Pickup
+	}
+	return nil
+}
+
+func (x *EstimateFareRequest) GetDropoff() *latlng.LatLng {
+	if x != nil {
+		return x.Dropoff
+	}
+	return nil
+}
+
+func (x *EstimateFareRequest) GetVehicleClass() VehicleClass {
+	if x != nil {
+		return x.VehicleClass
+	}
+	return VehicleClass_VEHICLE_CLASS_UNSPECIFIED
+}
+
+// Fare is a quote broken down into its parts. The parts are summed, scaled by
+// the vehicle class and surge multipliers and raised to the minimum fare, then
+// rounded to the currency's minor unit to give cost.
+type Fare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cost            *money.Money `protobuf:"bytes,13,opt,name=cost,proto3" json:"cost,omitempty"`                                              // What the ride costs
+	Distance        int32        `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`                                      // Kilometers, as RideService computes them
+	DurationMinutes int32        `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"` // Estimated from the distance at the tariff's average speed
+	BaseFare        *money.Money `protobuf:"bytes,14,opt,name=base_fare,json=baseFare,proto3" json:"base_fare,omitempty"`
+	DistanceFare    *money.Money `protobuf:"bytes,15,opt,name=distance_fare,json=distanceFare,proto3" json:"distance_fare,omitempty"`
+	TimeFare        *money.Money `protobuf:"bytes,16,opt,name=time_fare,json=timeFare,proto3" json:"time_fare,omitempty"`
+	Multiplier      float64      `protobuf:"fixed64,7,opt,name=multiplier,proto3" json:"multiplier,omitempty"`                              // Of the vehicle class
+	MinimumApplied  bool         `protobuf:"varint,8,opt,name=minimum_applied,json=minimumApplied,proto3" json:"minimum_applied,omitempty"` // Whether cost was raised to the minimum fare
+	VehicleClass    VehicleClass `protobuf:"varint,9,opt,name=vehicle_class,json=vehicleClass,proto3,enum=booking.v1.VehicleClass" json:"vehicle_class,omitempty"`
+	TariffVersion   string       `protobuf:"bytes,10,opt,name=tariff_version,json=tariffVersion,proto3" json:"tariff_version,omitempty"`
+	SurgeMultiplier float64      `protobuf:"fixed64,11,opt,name=surge_multiplier,json=surgeMultiplier,proto3" json:"surge_multiplier,omitempty"` // Raised by recent demand in zone, 1 without surge
+	Zone            string       `protobuf:"bytes,12,opt,name=zone,proto3" json:"zone,omitempty"`                                                // Surge zone of the pickup, empty if none
+}
+
+func (x *Fare) Reset() {
+	*x = Fare{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fare) ProtoMessage() {}
+
+func (x *Fare) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fare.ProtoReflect.Descriptor instead.
+func (*Fare) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Fare) GetCost() *money.Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *Fare) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *Fare) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *Fare) GetBaseFare() *money.Money {
+	if x != nil {
+		return x.BaseFare
+	}
+	return nil
+}
+
+func (x *Fare) GetDistanceFare() *money.Money {
+	if x != nil {
+		return x.DistanceFare
+	}
+	return nil
+}
+
+func (x *Fare) GetTimeFare() *money.Money {
+	if x != nil {
+		return x.TimeFare
+	}
+	return nil
+}
+
+func (x *Fare) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *Fare) GetMinimumApplied() bool {
+	if x != nil {
+		return x.MinimumApplied
+	}
+	return false
+}
+
+func (x *Fare) GetVehicleClass() VehicleClass {
+	if x != nil {
+		return x.VehicleClass
+	}
+	return VehicleClass_VEHICLE_CLASS_UNSPECIFIED
+}
+
+func (x *Fare) GetTariffVersion() string {
+	if x != nil {
+		return x.TariffVersion
+	}
+	return ""
+}
+
+func (x *Fare) GetSurgeMultiplier() float64 {
+	if x != nil {
+		return x.SurgeMultiplier
+	}
+	return 0
+}
+
+func (x *Fare) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+type EstimateFareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fare      *Fare  `protobuf:"bytes,1,opt,name=fare,proto3" json:"fare,omitempty"`
+	QuoteId   string `protobuf:"bytes,2,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`       // Pass to CreateBooking to book the ride at this fare
+	ExpiresAt string `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // The quote cannot be booked from this time on
+}
+
+func (x *EstimateFareResponse) Reset() {
+	*x = EstimateFareResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateFareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateFareResponse) ProtoMessage() {}
+
+func (x *EstimateFareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateFareResponse.ProtoReflect.Descriptor instead.
+func (*EstimateFareResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EstimateFareResponse) GetFare() *Fare {
+	if x != nil {
+		return x.Fare
+	}
+	return nil
+}
+
+func (x *EstimateFareResponse) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *EstimateFareResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// Promo is a promo code campaign. A code discounts fares booked between
+// starts_at and ends_at, for at most max_redemptions bookings in total and
+// max_redemptions_per_user per rider; 0 means no limit. A fare is eligible if
+// it is at least min_fare and booked in one of vehicle_classes, if any.
+type Promo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code                  string         `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Type                  DiscountType   `protobuf:"varint,2,opt,name=type,proto3,enum=booking.v1.DiscountType" json:"type,omitempty"`
+	PercentOff            int32          `protobuf:"varint,3,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`
+	AmountOff             *money.Money   `protobuf:"bytes,4,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`
+	MaxDiscount           *money.Money   `protobuf:"bytes,5,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`                                               // Caps a percent discount; unset for no cap
+	MinFare               *money.Money   `protobuf:"bytes,6,opt,name=min_fare,json=minFare,proto3" json:"min_fare,omitempty"`                                                           // Smaller fares are not eligible; unset for none
+	VehicleClasses        []VehicleClass `protobuf:"varint,7,rep,packed,name=vehicle_classes,json=vehicleClasses,proto3,enum=booking.v1.VehicleClass" json:"vehicle_classes,omitempty"` // Eligible classes; empty for all
+	StartsAt              string         `protobuf:"bytes,8,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`                                                        // RFC 3339
+	EndsAt                string         `protobuf:"bytes,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`                                                              // RFC 3339; codes cannot be redeemed from this time on
+	MaxRedemptions        int32          `protobuf:"varint,10,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	MaxRedemptionsPerUser int32          `protobuf:"varint,11,opt,name=max_redemptions_per_user,json=maxRedemptionsPerUser,proto3" json:"max_redemptions_per_user,omitempty"`
+	Redemptions           int32          `protobuf:"varint,12,opt,name=redemptions,proto3" json:"redemptions,omitempty"` // Bookings the code was redeemed for; ignored by CreatePromo
+}
+
+func (x *Promo) Reset() {
+	*x = Promo{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promo) ProtoMessage() {}
+
+func (x *Promo) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promo.ProtoReflect.Descriptor instead.
+func (*Promo) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Promo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promo) GetType() DiscountType {
+	if x != nil {
+		return x.Type
+	}
+	return DiscountType_DISCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *Promo) GetPercentOff() int32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *Promo) GetAmountOff() *money.Money {
+	if x != nil {
+		return x.AmountOff
+	}
+	return nil
+}
+
+func (x *Promo) GetMaxDiscount() *money.Money {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return nil
+}
+
+func (x *Promo) GetMinFare() *money.Money {
+	if x != nil {
+		return x.MinFare
+	}
+	return nil
+}
+
+func (x *Promo) GetVehicleClasses() []VehicleClass {
+	if x != nil {
+		return x.VehicleClasses
+	}
+	return nil
+}
+
+func (x *Promo) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *Promo) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *Promo) GetMaxRedemptions() int32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *Promo) GetMaxRedemptionsPerUser() int32 {
+	if x != nil {
+		return x.MaxRedemptionsPerUser
+	}
+	return 0
+}
+
+func (x *Promo) GetRedemptions() int32 {
+	if x != nil {
+		return x.Redemptions
+	}
+	return 0
+}
+
+type CreatePromoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Promo *Promo `protobuf:"bytes,1,opt,name=promo,proto3" json:"promo,omitempty"`
+}
+
+func (x *CreatePromoRequest) Reset() {
+	*x = CreatePromoRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoRequest) ProtoMessage() {}
+
+func (x *CreatePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreatePromoRequest) GetPromo() *Promo {
+	if x != nil {
+		return x.Promo
+	}
+	return nil
+}
+
+type CreatePromoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Promo *Promo `protobuf:"bytes,1,opt,name=promo,proto3" json:"promo,omitempty"`
+}
+
+func (x *CreatePromoResponse) Reset() {
+	*x = CreatePromoResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoResponse) ProtoMessage() {}
+
+func (x *CreatePromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromoResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreatePromoResponse) GetPromo() *Promo {
+	if x != nil {
+		return x.Promo
+	}
+	return nil
+}
+
+type GetPromoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *GetPromoRequest) Reset() {
+	*x = GetPromoRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromoRequest) ProtoMessage() {}
+
+func (x *GetPromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromoRequest.ProtoReflect.Descriptor instead.
+func (*GetPromoRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPromoRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GetPromoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Promo *Promo `protobuf:"bytes,1,opt,name=promo,proto3" json:"promo,omitempty"`
+}
+
+func (x *GetPromoResponse) Reset() {
+	*x = GetPromoResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromoResponse) ProtoMessage() {}
+
+func (x *GetPromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromoResponse.ProtoReflect.Descriptor instead.
+func (*GetPromoResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetPromoResponse) GetPromo() *Promo {
+	if x != nil {
+		return x.Promo
+	}
+	return nil
+}
+
+type CompleteBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId int32 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	DriverId  int32 `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"` // Must be the booking's driver
+}
+
+func (x *CompleteBookingRequest) Reset() {
+	*x = CompleteBookingRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteBookingRequest) ProtoMessage() {}
+
+func (x *CompleteBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteBookingRequest.ProtoReflect.Descriptor instead.
+func (*CompleteBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CompleteBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *CompleteBookingRequest) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+type CompleteBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking      `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Entry   *JournalEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"` // Records what the rider paid and who earned it
+}
+
+func (x *CompleteBookingResponse) Reset() {
+	*x = CompleteBookingResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteBookingResponse) ProtoMessage() {}
+
+func (x *CompleteBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteBookingResponse.ProtoReflect.Descriptor instead.
+func (*CompleteBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CompleteBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CompleteBookingResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId int32 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId    int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must be the booking's rider
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CancelBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *CancelBookingRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking      `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Entry   *JournalEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"` // Records the cancellation fee, unset if none was charged
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CancelBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CancelBookingResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ReportNoShowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId int32 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	DriverId  int32 `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"` // Must be the booking's driver
+}
+
+func (x *ReportNoShowRequest) Reset() {
+	*x = ReportNoShowRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportNoShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportNoShowRequest) ProtoMessage() {}
+
+func (x *ReportNoShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportNoShowRequest.ProtoReflect.Descriptor instead.
+func (*ReportNoShowRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReportNoShowRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *ReportNoShowRequest) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+type ReportNoShowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking      `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Entry   *JournalEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"` // Records the no-show fee, unset if none was charged
+}
+
+func (x *ReportNoShowResponse) Reset() {
+	*x = ReportNoShowResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportNoShowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportNoShowResponse) ProtoMessage() {}
+
+func (x *ReportNoShowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportNoShowResponse.ProtoReflect.Descriptor instead.
+func (*ReportNoShowResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReportNoShowResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *ReportNoShowResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type RefundBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId int32 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// In the currency of the charge, at most what is left of it to refund.
+	// Defaults to all that is left.
+	Amount *money.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason RefundReason `protobuf:"varint,3,opt,name=reason,proto3,enum=booking.v1.RefundReason" json:"reason,omitempty"`
+}
+
+func (x *RefundBookingRequest) Reset() {
+	*x = RefundBookingRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundBookingRequest) ProtoMessage() {}
+
+func (x *RefundBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundBookingRequest.ProtoReflect.Descriptor instead.
+func (*RefundBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RefundBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *RefundBookingRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RefundBookingRequest) GetReason() RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
+type RefundBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking      `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Entry   *JournalEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"` // Records the refund
+}
+
+func (x *RefundBookingResponse) Reset() {
+	*x = RefundBookingResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundBookingResponse) ProtoMessage() {}
+
+func (x *RefundBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundBookingResponse.ProtoReflect.Descriptor instead.
+func (*RefundBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RefundBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *RefundBookingResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId int32         `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Format    ReceiptFormat `protobuf:"varint,2,opt,name=format,proto3,enum=booking.v1.ReceiptFormat" json:"format,omitempty"` // Defaults to HTML
+	// BCP 47 language tag money and times are formatted for, e.g. "en-PK".
+	// Defaults to "en"; unsupported languages fall back to the closest one.
+	Locale string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	// IANA time zone times are shown in, e.g. "Asia/Karachi". Defaults to UTC.
+	TimeZone string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetReceiptRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *GetReceiptRequest) GetFormat() ReceiptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReceiptFormat_RECEIPT_FORMAT_UNSPECIFIED
+}
+
+func (x *GetReceiptRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetReceiptRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// Receipt describes a receipt rendered by GetReceipt.
+type Receipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Stable per booking: the date it was made and its ID, e.g.
+	// "R-20250101-000042".
+	ReceiptNumber string       `protobuf:"bytes,1,opt,name=receipt_number,json=receiptNumber,proto3" json:"receipt_number,omitempty"`
+	BookingId     int32        `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Total         *money.Money `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"` // What the rider paid, less refunds
 }
 
-func (x *Booking) Reset() {
-	*x = Booking{}
-	mi := &file_booking_v1_booking_service_proto_msgTypes[0]
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Booking) String() string {
+func (x *Receipt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Booking) ProtoMessage() {}
+func (*Receipt) ProtoMessage() {}
 
-func (x *Booking) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_v1_booking_service_proto_msgTypes[0]
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,67 +3287,171 @@ func (x *Booking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
-func (*Booking) Descriptor() ([]byte, []int) {
-	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Booking) GetBookingId() int32 {
+func (x *Receipt) GetReceiptNumber() string {
+	if x != nil {
+		return x.ReceiptNumber
+	}
+	return ""
+}
+
+func (x *Receipt) GetBookingId() int32 {
 	if x != nil {
 		return x.BookingId
 	}
 	return 0
 }
 
-func (x *Booking) GetUserId() int32 {
+func (x *Receipt) GetTotal() *money.Money {
 	if x != nil {
-		return x.UserId
+		return x.Total
 	}
-	return 0
+	return nil
 }
 
-func (x *Booking) GetRideId() int32 {
+// Account is a ledger account. Riders and drivers each have one, owned by
+// their user or driver ID; the platform and promo accounts have no owner.
+type Account struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    AccountType `protobuf:"varint,1,opt,name=type,proto3,enum=booking.v1.AccountType" json:"type,omitempty"`
+	OwnerId int32       `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[37]
 	if x != nil {
-		return x.RideId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Account) GetType() AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *Account) GetOwnerId() int32 {
+	if x != nil {
+		return x.OwnerId
 	}
 	return 0
 }
 
-func (x *Booking) GetTime() string {
+// Posting credits amount to an account, or debits it if amount is negative.
+type Posting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *Account     `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Amount  *money.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Posting) Reset() {
+	*x = Posting{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Posting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Posting) ProtoMessage() {}
+
+func (x *Posting) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[38]
 	if x != nil {
-		return x.Time
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// Ride definition, embedded for convenience
-type Ride struct {
+// Deprecated: Use Posting.ProtoReflect.Descriptor instead.
+func (*Posting) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Posting) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *Posting) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// JournalEntry records money moving between ledger accounts. Its postings
+// sum to zero in each currency, and it never changes once posted.
+type JournalEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RideId      int32  `protobuf:"varint,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
-	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
-	Distance    int32  `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"` // Distance in kilometers
-	Cost        int32  `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`         // Cost in currency units
+	EntryId   int64            `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	BookingId int32            `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Kind      JournalEntryKind `protobuf:"varint,3,opt,name=kind,proto3,enum=booking.v1.JournalEntryKind" json:"kind,omitempty"`
+	Reference string           `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"` // Payment provider charge or refund ID, empty if no money moved through it
+	Postings  []*Posting       `protobuf:"bytes,5,rep,name=postings,proto3" json:"postings,omitempty"`
+	CreatedAt string           `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC 3339
 }
 
-func (x *Ride) Reset() {
-	*x = Ride{}
-	mi := &file_booking_v1_booking_service_proto_msgTypes[1]
+func (x *JournalEntry) Reset() {
+	*x = JournalEntry{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Ride) String() string {
+func (x *JournalEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ride) ProtoMessage() {}
+func (*JournalEntry) ProtoMessage() {}
 
-func (x *Ride) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_v1_booking_service_proto_msgTypes[1]
+func (x *JournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -128,70 +3462,76 @@ func (x *Ride) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ride.ProtoReflect.Descriptor instead.
-func (*Ride) Descriptor() ([]byte, []int) {
-	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use JournalEntry.ProtoReflect.Descriptor instead.
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Ride) GetRideId() int32 {
+func (x *JournalEntry) GetEntryId() int64 {
 	if x != nil {
-		return x.RideId
+		return x.EntryId
 	}
 	return 0
 }
 
-func (x *Ride) GetSource() string {
+func (x *JournalEntry) GetBookingId() int32 {
 	if x != nil {
-		return x.Source
+		return x.BookingId
 	}
-	return ""
+	return 0
 }
 
-func (x *Ride) GetDestination() string {
+func (x *JournalEntry) GetKind() JournalEntryKind {
 	if x != nil {
-		return x.Destination
+		return x.Kind
+	}
+	return JournalEntryKind_JOURNAL_ENTRY_KIND_UNSPECIFIED
+}
+
+func (x *JournalEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
 	}
 	return ""
 }
 
-func (x *Ride) GetDistance() int32 {
+func (x *JournalEntry) GetPostings() []*Posting {
 	if x != nil {
-		return x.Distance
+		return x.Postings
 	}
-	return 0
+	return nil
 }
 
-func (x *Ride) GetCost() int32 {
+func (x *JournalEntry) GetCreatedAt() string {
 	if x != nil {
-		return x.Cost
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-type CreateBookingRequest struct {
+type GetAccountBalanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Ride   *Ride `protobuf:"bytes,2,opt,name=ride,proto3" json:"ride,omitempty"` // Ride is defined within BookingService
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *CreateBookingRequest) Reset() {
-	*x = CreateBookingRequest{}
-	mi := &file_booking_v1_booking_service_proto_msgTypes[2]
+func (x *GetAccountBalanceRequest) Reset() {
+	*x = GetAccountBalanceRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBookingRequest) String() string {
+func (x *GetAccountBalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBookingRequest) ProtoMessage() {}
+func (*GetAccountBalanceRequest) ProtoMessage() {}
 
-func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_v1_booking_service_proto_msgTypes[2]
+func (x *GetAccountBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -202,48 +3542,42 @@ func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
-func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *CreateBookingRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use GetAccountBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CreateBookingRequest) GetRide() *Ride {
+func (x *GetAccountBalanceRequest) GetAccount() *Account {
 	if x != nil {
-		return x.Ride
+		return x.Account
 	}
 	return nil
 }
 
-type CreateBookingResponse struct {
+type GetAccountBalanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"` // Reference Booking within BookingService
+	Account  *Account       `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Balances []*money.Money `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"` // One per currency, ordered by currency code; empty if never posted to
 }
 
-func (x *CreateBookingResponse) Reset() {
-	*x = CreateBookingResponse{}
-	mi := &file_booking_v1_booking_service_proto_msgTypes[3]
+func (x *GetAccountBalanceResponse) Reset() {
+	*x = GetAccountBalanceResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBookingResponse) String() string {
+func (x *GetAccountBalanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBookingResponse) ProtoMessage() {}
+func (*GetAccountBalanceResponse) ProtoMessage() {}
 
-func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_v1_booking_service_proto_msgTypes[3]
+func (x *GetAccountBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -254,41 +3588,50 @@ func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
-func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetAccountBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *CreateBookingResponse) GetBooking() *Booking {
+func (x *GetAccountBalanceResponse) GetAccount() *Account {
 	if x != nil {
-		return x.Booking
+		return x.Account
 	}
 	return nil
 }
 
-type GetBookingRequest struct {
+func (x *GetAccountBalanceResponse) GetBalances() []*money.Money {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type GetAccountStatementRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BookingId int32 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Account   *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	PageSize  int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Defaults to 20
+	PageToken string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
 }
 
-func (x *GetBookingRequest) Reset() {
-	*x = GetBookingRequest{}
-	mi := &file_booking_v1_booking_service_proto_msgTypes[4]
+func (x *GetAccountStatementRequest) Reset() {
+	*x = GetAccountStatementRequest{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookingRequest) String() string {
+func (x *GetAccountStatementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookingRequest) ProtoMessage() {}
+func (*GetAccountStatementRequest) ProtoMessage() {}
 
-func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_v1_booking_service_proto_msgTypes[4]
+func (x *GetAccountStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,46 +3642,61 @@ func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
-func (*GetBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetAccountStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountStatementRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetBookingRequest) GetBookingId() int32 {
+func (x *GetAccountStatementRequest) GetAccount() *Account {
 	if x != nil {
-		return x.BookingId
+		return x.Account
+	}
+	return nil
+}
+
+func (x *GetAccountStatementRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-type GetBookingResponse struct {
+func (x *GetAccountStatementRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// StatementLine is one posting to an account, with the entry it belongs to.
+type StatementLine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
-	Distance    int32  `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"`
-	Cost        int32  `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`
-	Time        string `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	EntryId   int64            `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	BookingId int32            `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Kind      JournalEntryKind `protobuf:"varint,3,opt,name=kind,proto3,enum=booking.v1.JournalEntryKind" json:"kind,omitempty"`
+	Reference string           `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	Amount    *money.Money     `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                        // Credit if positive, debit if negative
+	CreatedAt string           `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC 3339
 }
 
-func (x *GetBookingResponse) Reset() {
-	*x = GetBookingResponse{}
-	mi := &file_booking_v1_booking_service_proto_msgTypes[5]
+func (x *StatementLine) Reset() {
+	*x = StatementLine{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookingResponse) String() string {
+func (x *StatementLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookingResponse) ProtoMessage() {}
+func (*StatementLine) ProtoMessage() {}
 
-func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_v1_booking_service_proto_msgTypes[5]
+func (x *StatementLine) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,49 +3707,102 @@ func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
-func (*GetBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use StatementLine.ProtoReflect.Descriptor instead.
+func (*StatementLine) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetBookingResponse) GetName() string {
+func (x *StatementLine) GetEntryId() int64 {
 	if x != nil {
-		return x.Name
+		return x.EntryId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetBookingResponse) GetSource() string {
+func (x *StatementLine) GetBookingId() int32 {
 	if x != nil {
-		return x.Source
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *StatementLine) GetKind() JournalEntryKind {
+	if x != nil {
+		return x.Kind
+	}
+	return JournalEntryKind_JOURNAL_ENTRY_KIND_UNSPECIFIED
+}
+
+func (x *StatementLine) GetReference() string {
+	if x != nil {
+		return x.Reference
 	}
 	return ""
 }
 
-func (x *GetBookingResponse) GetDestination() string {
+func (x *StatementLine) GetAmount() *money.Money {
 	if x != nil {
-		return x.Destination
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *StatementLine) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *GetBookingResponse) GetDistance() int32 {
+type GetAccountStatementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lines         []*StatementLine `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	NextPageToken string           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+}
+
+func (x *GetAccountStatementResponse) Reset() {
+	*x = GetAccountStatementResponse{}
+	mi := &file_booking_v1_booking_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountStatementResponse) ProtoMessage() {}
+
+func (x *GetAccountStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_service_proto_msgTypes[44]
 	if x != nil {
-		return x.Distance
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountStatementResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetBookingResponse) GetCost() int32 {
+func (x *GetAccountStatementResponse) GetLines() []*StatementLine {
 	if x != nil {
-		return x.Cost
+		return x.Lines
 	}
-	return 0
+	return nil
 }
 
-func (x *GetBookingResponse) GetTime() string {
+func (x *GetAccountStatementResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Time
+		return x.NextPageToken
 	}
 	return ""
 }
@@ -401,62 +3812,895 @@ var File_booking_v1_booking_service_proto protoreflect.FileDescriptor
 var file_booking_v1_booking_service_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f,
 	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x22, 0x6e,
-	0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x72, 0x69, 0x64, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x89,
-	0x01, 0x0a, 0x04, 0x52, 0x69, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x64, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x69, 0x64, 0x65, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x72,
-	0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
-	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69, 0x64, 0x65, 0x52, 0x04, 0x72, 0x69, 0x64,
-	0x65, 0x22, 0x46, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x62, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x32, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xa6, 0x01,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x32, 0xb3, 0x01, 0x0a, 0x0e, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
-	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x74, 0x6f, 0x12, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1b,
+	0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x62, 0x6f, 0x64, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x2f, 0x6c, 0x61, 0x74, 0x6c, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x05, 0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x69,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x69,
+	0x66, 0x66, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x30, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x13,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3d, 0x0a,
+	0x10, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0f, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x2e, 0x0a, 0x08,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0d,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa7, 0x01, 0x0a,
+	0x08, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x66, 0x61, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x66, 0x61, 0x72, 0x65,
+	0x12, 0x2a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xbb, 0x08, 0x0a, 0x04, 0x52, 0x69, 0x64, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x72, 0x69, 0x64, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18,
+	0xff, 0x01, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28,
+	0x00, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0xfc, 0x01, 0x0a, 0x06, 0x70, 0x69, 0x63,
+	0x6b, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x61, 0x74, 0x4c, 0x6e, 0x67, 0x42, 0xce,
+	0x01, 0xba, 0x48, 0xca, 0x01, 0xba, 0x01, 0xc3, 0x01, 0x0a, 0x11, 0x72, 0x69, 0x64, 0x65, 0x2e,
+	0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x46, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x62,
+	0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x2d, 0x39, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x39,
+	0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20,
+	0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x2d, 0x31, 0x38, 0x30, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x31, 0x38, 0x30, 0x1a, 0x66, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x20, 0x3e, 0x3d, 0x20, 0x2d, 0x39, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3c, 0x3d,
+	0x20, 0x39, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3e, 0x3d, 0x20, 0x2d, 0x31, 0x38, 0x30, 0x2e,
+	0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x20, 0x3c, 0x3d, 0x20, 0x31, 0x38, 0x30, 0x2e, 0x30, 0xc8, 0x01, 0x01, 0x52,
+	0x06, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x12, 0xff, 0x01, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70,
+	0x6f, 0x66, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x61, 0x74, 0x4c, 0x6e, 0x67, 0x42, 0xcf,
+	0x01, 0xba, 0x48, 0xcb, 0x01, 0xba, 0x01, 0xc4, 0x01, 0x0a, 0x12, 0x72, 0x69, 0x64, 0x65, 0x2e,
+	0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x46, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20,
+	0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x2d, 0x39, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x39, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x2d, 0x31, 0x38, 0x30, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x31, 0x38, 0x30, 0x1a, 0x66, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x20, 0x3e, 0x3d, 0x20, 0x2d, 0x39, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3c,
+	0x3d, 0x20, 0x39, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3e, 0x3d, 0x20, 0x2d, 0x31, 0x38, 0x30,
+	0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x20, 0x3c, 0x3d, 0x20, 0x31, 0x38, 0x30, 0x2e, 0x30, 0xc8, 0x01, 0x01,
+	0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x12, 0x85, 0x01, 0x0a, 0x04, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x42, 0x5d, 0xba, 0x48,
+	0x5a, 0xba, 0x01, 0x57, 0x0a, 0x16, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x63, 0x6f, 0x73, 0x74, 0x5f,
+	0x6e, 0x6f, 0x6e, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x19, 0x63, 0x6f,
+	0x73, 0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6e,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x22, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x75, 0x6e,
+	0x69, 0x74, 0x73, 0x20, 0x3e, 0x3d, 0x20, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x20, 0x3e, 0x3d, 0x20, 0x30, 0x52, 0x04, 0x63, 0x6f, 0x73,
+	0x74, 0x3a, 0xfa, 0x01, 0xba, 0x48, 0xf6, 0x01, 0x1a, 0x81, 0x01, 0x0a, 0x17, 0x72, 0x69, 0x64,
+	0x65, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x64, 0x69,
+	0x66, 0x66, 0x65, 0x72, 0x1a, 0x46, 0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x21, 0x68, 0x61, 0x73, 0x28,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x29, 0x20, 0x7c, 0x7c,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x20, 0x21, 0x3d, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x1a, 0x70, 0x0a, 0x14,
+	0x72, 0x69, 0x64, 0x65, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x22, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6d, 0x75, 0x73,
+	0x74, 0x20, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x1a, 0x34, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74,
+	0x68, 0x69, 0x73, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04,
+	0x08, 0x05, 0x10, 0x06, 0x22, 0xf5, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x04, 0x72, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69, 0x64, 0x65, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x72, 0x69, 0x64, 0x65, 0x12, 0x47, 0x0a,
+	0x0d, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x08, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x26,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x28, 0x0a, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40,
+	0x52, 0x0a, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x67, 0x61, 0x49, 0x64, 0x22, 0x3b, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52,
+	0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xa7, 0x06, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2b, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x61,
+	0x74, 0x4c, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x2d, 0x0a, 0x07,
+	0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x61, 0x74, 0x4c,
+	0x6e, 0x67, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x61, 0x72, 0x69, 0x66, 0x66, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+	0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x4f,
+	0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x12, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x3d, 0x0a, 0x10, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0f, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x2e,
+	0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x3d,
+	0x0a, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x4a, 0x04,
+	0x08, 0x05, 0x10, 0x06, 0x22, 0x37, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x47, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xbc, 0x04, 0x0a, 0x0b, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x61, 0x67, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x67, 0x61, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x69, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69, 0x64, 0x65, 0x52, 0x04, 0x72, 0x69, 0x64, 0x65, 0x12, 0x2e,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x67, 0x61,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28,
+	0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x67, 0x61, 0x53, 0x74,
+	0x65, 0x70, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x61, 0x72, 0x69, 0x66, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0d, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68,
+	0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x6f,
+	0x6c, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x69, 0x63, 0x6b, 0x75,
+	0x70, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x61, 0x67, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x73, 0x61, 0x67, 0x61, 0x49,
+	0x64, 0x22, 0x45, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x61, 0x67, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x73,
+	0x61, 0x67, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x61,
+	0x67, 0x61, 0x52, 0x04, 0x73, 0x61, 0x67, 0x61, 0x22, 0x64, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x73, 0x61, 0x67, 0x61,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18,
+	0x20, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xce,
+	0x01, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x61,
+	0x67, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x67,
+	0x61, 0x52, 0x04, 0x73, 0x61, 0x67, 0x61, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x69, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x69, 0x64, 0x65, 0x52, 0x04, 0x72, 0x69, 0x64, 0x65, 0x12, 0x40, 0x0a,
+	0x0e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0d, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0xf4, 0x01, 0x0a, 0x0b, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x3f, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x22, 0x79, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54,
+	0x6f, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x08, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22,
+	0x76, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0xdf, 0x04, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x46, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0xfc, 0x01, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c,
+	0x61, 0x74, 0x4c, 0x6e, 0x67, 0x42, 0xce, 0x01, 0xba, 0x48, 0xca, 0x01, 0xba, 0x01, 0xc3, 0x01,
+	0x0a, 0x11, 0x66, 0x61, 0x72, 0x65, 0x2e, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x46, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x6d, 0x75,
+	0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x2d, 0x39,
+	0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x39, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x2d,
+	0x31, 0x38, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x38, 0x30, 0x1a, 0x66, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3e, 0x3d, 0x20, 0x2d, 0x39,
+	0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x20, 0x3c, 0x3d, 0x20, 0x39, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3e,
+	0x3d, 0x20, 0x2d, 0x31, 0x38, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3c, 0x3d, 0x20, 0x31, 0x38,
+	0x30, 0x2e, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x12, 0xff,
+	0x01, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c,
+	0x61, 0x74, 0x4c, 0x6e, 0x67, 0x42, 0xcf, 0x01, 0xba, 0x48, 0xcb, 0x01, 0xba, 0x01, 0xc4, 0x01,
+	0x0a, 0x12, 0x66, 0x61, 0x72, 0x65, 0x2e, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x5f, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x46, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x6d,
+	0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x2d,
+	0x39, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x39, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20,
+	0x2d, 0x31, 0x38, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x38, 0x30, 0x1a, 0x66, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3e, 0x3d, 0x20, 0x2d,
+	0x39, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3c, 0x3d, 0x20, 0x39, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20,
+	0x3e, 0x3d, 0x20, 0x2d, 0x31, 0x38, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3c, 0x3d, 0x20, 0x31,
+	0x38, 0x30, 0x2e, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66,
+	0x12, 0x47, 0x0a, 0x0d, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x96, 0x04, 0x0a, 0x04, 0x46, 0x61,
+	0x72, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x2f, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x61, 0x72, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x46, 0x61,
+	0x72, 0x65, 0x12, 0x37, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66,
+	0x61, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x61, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x66, 0x61, 0x72, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x61, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0c, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61,
+	0x72, 0x69, 0x66, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x73,
+	0x75, 0x72, 0x67, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x75, 0x72, 0x67, 0x65, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06,
+	0x10, 0x07, 0x22, 0x76, 0x0a, 0x14, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x61,
+	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x72, 0x65, 0x52, 0x04, 0x66, 0x61, 0x72, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x94, 0x07, 0x0a, 0x05, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x30, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x32, 0x15, 0x5e, 0x5b, 0x41, 0x2d, 0x5a,
+	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x7b, 0x33, 0x2c, 0x33, 0x32, 0x7d, 0x24,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x2a, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x12, 0x31, 0x0a, 0x0a,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x12,
+	0x35, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x61,
+	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6d, 0x69,
+	0x6e, 0x46, 0x61, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0e, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x20,
+	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74,
+	0x12, 0x30, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02,
+	0x28, 0x00, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x40, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x15, 0x6d,
+	0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0xb8, 0x02, 0xba, 0x48, 0xb4, 0x02, 0x1a, 0x70, 0x0a,
+	0x11, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x2e, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6f,
+	0x66, 0x66, 0x12, 0x33, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x73, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x61, 0x20, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x5f, 0x6f, 0x66, 0x66, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x31, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x31, 0x30, 0x30, 0x1a, 0x26, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x31, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x20, 0x3e, 0x20, 0x30, 0x1a,
+	0xbf, 0x01, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x2e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6f, 0x66, 0x66, 0x12, 0x26, 0x66, 0x6c, 0x61, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x73, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x61, 0x20, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x20, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x1a, 0x82, 0x01, 0x74,
+	0x68, 0x69, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x32, 0x20, 0x7c, 0x7c,
+	0x20, 0x28, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6f, 0x66, 0x66, 0x29, 0x20, 0x26, 0x26, 0x20, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x73,
+	0x20, 0x3e, 0x20, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x20, 0x3d, 0x3d,
+	0x20, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6f, 0x66, 0x66, 0x2e, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x20, 0x3e, 0x20, 0x30, 0x29,
+	0x29, 0x22, 0x45, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x22, 0x3e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x27, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x22, 0x43, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17,
+	0x32, 0x15, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x7b, 0x33, 0x2c, 0x33, 0x32, 0x7d, 0x24, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3b, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x22, 0x66, 0x0a, 0x16, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20,
+	0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x09,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x78, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x60, 0x0a, 0x14,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20,
+	0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x76,
+	0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x63, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20,
+	0x00, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x14, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x22, 0xa8, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x42, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x3c, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01,
+	0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x76, 0x0a,
+	0x15, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x1f, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x23, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x12, 0x24, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x79, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0x98, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20,
+	0x00, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02,
+	0x28, 0x00, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x3a, 0xaf, 0x01, 0xba, 0x48,
+	0xab, 0x01, 0x1a, 0xa8, 0x01, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x59, 0x72, 0x69, 0x64, 0x65, 0x72, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x20, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x2c, 0x20, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x20, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x6f,
+	0x6e, 0x65, 0x1a, 0x39, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x20, 0x3d,
+	0x3d, 0x20, 0x31, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x20, 0x3d, 0x3d, 0x20, 0x32, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x20, 0x3e, 0x20, 0x30, 0x29, 0x22, 0x64, 0x0a,
+	0x07, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x0c, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2f,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x51,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x7a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xa3, 0x01,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28,
+	0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xe4, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
+	0x30, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x2a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x76, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2a, 0x79, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a,
+	0x19, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x49, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0xa5, 0x01,
+	0x0a, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x19, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a,
+	0x1b, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53,
+	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x10, 0x01, 0x12, 0x1e,
+	0x0a, 0x1a, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x46, 0x41, 0x52, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x55, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x46, 0x45, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13,
+	0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x54,
+	0x48, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x63, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12,
+	0x19, 0x0a, 0x15, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f,
+	0x44, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x10, 0x02, 0x2a, 0xdf, 0x01, 0x0a, 0x0d, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a,
+	0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
+	0x52, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1c,
+	0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x79, 0x0a, 0x0c,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x19,
+	0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x56,
+	0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x45, 0x43, 0x4f,
+	0x4e, 0x4f, 0x4d, 0x59, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c,
+	0x45, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x46, 0x4f, 0x52, 0x54, 0x10,
+	0x02, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x43, 0x4c, 0x41,
+	0x53, 0x53, 0x5f, 0x58, 0x4c, 0x10, 0x03, 0x2a, 0x93, 0x01, 0x0a, 0x0a, 0x53, 0x61, 0x67, 0x61,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x41, 0x47, 0x41, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x41, 0x47, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x53, 0x41, 0x47, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
+	0x45, 0x4e, 0x53, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x41,
+	0x47, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x41, 0x47, 0x41, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xe0, 0x01,
+	0x0a, 0x08, 0x53, 0x61, 0x67, 0x61, 0x53, 0x74, 0x65, 0x70, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x41,
+	0x47, 0x41, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x41, 0x47, 0x41, 0x5f, 0x53, 0x54,
+	0x45, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x41, 0x47, 0x41, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x49, 0x44, 0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a,
+	0x18, 0x53, 0x41, 0x47, 0x41, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x53,
+	0x41, 0x47, 0x41, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f,
+	0x52, 0x49, 0x44, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41, 0x47, 0x41, 0x5f, 0x53,
+	0x54, 0x45, 0x50, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x41,
+	0x47, 0x41, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x46, 0x55, 0x4e,
+	0x44, 0x53, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x41, 0x47, 0x41, 0x5f, 0x53, 0x54, 0x45,
+	0x50, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x07,
+	0x2a, 0x95, 0x01, 0x0a, 0x0b, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x46, 0x46, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x18,
+	0x0a, 0x14, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x60, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x49, 0x53, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x49, 0x53, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x46, 0x4c, 0x41, 0x54, 0x10, 0x02, 0x2a, 0x60, 0x0a, 0x0d, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x52,
+	0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52,
+	0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x48, 0x54,
+	0x4d, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x44, 0x46, 0x10, 0x02, 0x2a, 0x8f, 0x01, 0x0a,
+	0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18,
+	0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43,
+	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x49, 0x44, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x54,
+	0x46, 0x4f, 0x52, 0x4d, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x10, 0x04, 0x2a, 0xa5,
+	0x01, 0x0a, 0x10, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x1e, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45,
+	0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x4a, 0x4f, 0x55, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x49,
+	0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x27,
+	0x0a, 0x23, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4a, 0x4f, 0x55, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45,
+	0x46, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x32, 0xaf, 0x10, 0x0a, 0x0e, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6d, 0x0a, 0x0d, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6f,
 	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f,
 	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62,
 	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e,
-	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a, 0x36,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e,
-	0x67, 0x5f, 0x66, 0x61, 0x6c, 0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x6e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x67, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x7c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x61, 0x67, 0x61, 0x12, 0x21, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x67, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x61,
+	0x67, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2d,
+	0x73, 0x61, 0x67, 0x61, 0x73, 0x2f, 0x7b, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x7e, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12,
+	0x1f, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x61, 0x67, 0x61, 0x73, 0x2f, 0x7b, 0x73,
+	0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12,
+	0x85, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54,
+	0x6f, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x54, 0x6f, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x76,
+	0x31, 0x2f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x12, 0x70, 0x0a, 0x0c, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x61, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x46, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x46, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x61, 0x72, 0x65, 0x73, 0x3a, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x65, 0x0a,
+	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x1e, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x6d, 0x6f, 0x73, 0x12, 0x60, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x73, 0x2f,
+	0x7b, 0x63, 0x6f, 0x64, 0x65, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x3a,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x53, 0x68, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x53, 0x68,
+	0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d,
+	0x3a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x81, 0x01,
+	0x0a, 0x0d, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12,
+	0x20, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22,
+	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x12, 0x6c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
+	0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x42, 0x6f, 0x64, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
+	0x7e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x86, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x5f, 0x66, 0x61,
+	0x6c, 0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -471,27 +4715,192 @@ func file_booking_v1_booking_service_proto_rawDescGZIP() []byte {
 	return file_booking_v1_booking_service_proto_rawDescData
 }
 
-var file_booking_v1_booking_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_booking_v1_booking_service_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_booking_v1_booking_service_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_booking_v1_booking_service_proto_goTypes = []any{
-	(*Booking)(nil),               // 0: booking.v1.Booking
-	(*Ride)(nil),                  // 1: booking.v1.Ride
-	(*CreateBookingRequest)(nil),  // 2: booking.v1.CreateBookingRequest
-	(*CreateBookingResponse)(nil), // 3: booking.v1.CreateBookingResponse
-	(*GetBookingRequest)(nil),     // 4: booking.v1.GetBookingRequest
-	(*GetBookingResponse)(nil),    // 5: booking.v1.GetBookingResponse
+	(CancellationReason)(0),             // 0: booking.v1.CancellationReason
+	(RefundReason)(0),                   // 1: booking.v1.RefundReason
+	(PaymentMethod)(0),                  // 2: booking.v1.PaymentMethod
+	(BookingStatus)(0),                  // 3: booking.v1.BookingStatus
+	(VehicleClass)(0),                   // 4: booking.v1.VehicleClass
+	(SagaStatus)(0),                     // 5: booking.v1.SagaStatus
+	(SagaStep)(0),                       // 6: booking.v1.SagaStep
+	(OfferStatus)(0),                    // 7: booking.v1.OfferStatus
+	(DiscountType)(0),                   // 8: booking.v1.DiscountType
+	(ReceiptFormat)(0),                  // 9: booking.v1.ReceiptFormat
+	(AccountType)(0),                    // 10: booking.v1.AccountType
+	(JournalEntryKind)(0),               // 11: booking.v1.JournalEntryKind
+	(*Booking)(nil),                     // 12: booking.v1.Booking
+	(*Discount)(nil),                    // 13: booking.v1.Discount
+	(*Ride)(nil),                        // 14: booking.v1.Ride
+	(*CreateBookingRequest)(nil),        // 15: booking.v1.CreateBookingRequest
+	(*CreateBookingResponse)(nil),       // 16: booking.v1.CreateBookingResponse
+	(*GetBookingRequest)(nil),           // 17: booking.v1.GetBookingRequest
+	(*GetBookingResponse)(nil),          // 18: booking.v1.GetBookingResponse
+	(*ListBookingsRequest)(nil),         // 19: booking.v1.ListBookingsRequest
+	(*ListBookingsResponse)(nil),        // 20: booking.v1.ListBookingsResponse
+	(*BookingSaga)(nil),                 // 21: booking.v1.BookingSaga
+	(*GetBookingSagaRequest)(nil),       // 22: booking.v1.GetBookingSagaRequest
+	(*GetBookingSagaResponse)(nil),      // 23: booking.v1.GetBookingSagaResponse
+	(*WatchBookingRequest)(nil),         // 24: booking.v1.WatchBookingRequest
+	(*WatchBookingResponse)(nil),        // 25: booking.v1.WatchBookingResponse
+	(*DriverOffer)(nil),                 // 26: booking.v1.DriverOffer
+	(*ListDriverOffersRequest)(nil),     // 27: booking.v1.ListDriverOffersRequest
+	(*ListDriverOffersResponse)(nil),    // 28: booking.v1.ListDriverOffersResponse
+	(*RespondToOfferRequest)(nil),       // 29: booking.v1.RespondToOfferRequest
+	(*RespondToOfferResponse)(nil),      // 30: booking.v1.RespondToOfferResponse
+	(*EstimateFareRequest)(nil),         // 31: booking.v1.EstimateFareRequest
+	(*Fare)(nil),                        // 32: booking.v1.Fare
+	(*EstimateFareResponse)(nil),        // 33: booking.v1.EstimateFareResponse
+	(*Promo)(nil),                       // 34: booking.v1.Promo
+	(*CreatePromoRequest)(nil),          // 35: booking.v1.CreatePromoRequest
+	(*CreatePromoResponse)(nil),         // 36: booking.v1.CreatePromoResponse
+	(*GetPromoRequest)(nil),             // 37: booking.v1.GetPromoRequest
+	(*GetPromoResponse)(nil),            // 38: booking.v1.GetPromoResponse
+	(*CompleteBookingRequest)(nil),      // 39: booking.v1.CompleteBookingRequest
+	(*CompleteBookingResponse)(nil),     // 40: booking.v1.CompleteBookingResponse
+	(*CancelBookingRequest)(nil),        // 41: booking.v1.CancelBookingRequest
+	(*CancelBookingResponse)(nil),       // 42: booking.v1.CancelBookingResponse
+	(*ReportNoShowRequest)(nil),         // 43: booking.v1.ReportNoShowRequest
+	(*ReportNoShowResponse)(nil),        // 44: booking.v1.ReportNoShowResponse
+	(*RefundBookingRequest)(nil),        // 45: booking.v1.RefundBookingRequest
+	(*RefundBookingResponse)(nil),       // 46: booking.v1.RefundBookingResponse
+	(*GetReceiptRequest)(nil),           // 47: booking.v1.GetReceiptRequest
+	(*Receipt)(nil),                     // 48: booking.v1.Receipt
+	(*Account)(nil),                     // 49: booking.v1.Account
+	(*Posting)(nil),                     // 50: booking.v1.Posting
+	(*JournalEntry)(nil),                // 51: booking.v1.JournalEntry
+	(*GetAccountBalanceRequest)(nil),    // 52: booking.v1.GetAccountBalanceRequest
+	(*GetAccountBalanceResponse)(nil),   // 53: booking.v1.GetAccountBalanceResponse
+	(*GetAccountStatementRequest)(nil),  // 54: booking.v1.GetAccountStatementRequest
+	(*StatementLine)(nil),               // 55: booking.v1.StatementLine
+	(*GetAccountStatementResponse)(nil), // 56: booking.v1.GetAccountStatementResponse
+	(*money.Money)(nil),                 // 57: google.type.Money
+	(*latlng.LatLng)(nil),               // 58: google.type.LatLng
+	(*httpbody.HttpBody)(nil),           // 59: google.api.HttpBody
 }
 var file_booking_v1_booking_service_proto_depIdxs = []int32{
-	1, // 0: booking.v1.CreateBookingRequest.ride:type_name -> booking.v1.Ride
-	0, // 1: booking.v1.CreateBookingResponse.booking:type_name -> booking.v1.Booking
-	2, // 2: booking.v1.BookingService.CreateBooking:input_type -> booking.v1.CreateBookingRequest
-	4, // 3: booking.v1.BookingService.GetBooking:input_type -> booking.v1.GetBookingRequest
-	3, // 4: booking.v1.BookingService.CreateBooking:output_type -> booking.v1.CreateBookingResponse
-	5, // 5: booking.v1.BookingService.GetBooking:output_type -> booking.v1.GetBookingResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3,  // 0: booking.v1.Booking.status:type_name -> booking.v1.BookingStatus
+	13, // 1: booking.v1.Booking.discount:type_name -> booking.v1.Discount
+	2,  // 2: booking.v1.Booking.payment_method:type_name -> booking.v1.PaymentMethod
+	0,  // 3: booking.v1.Booking.cancellation_reason:type_name -> booking.v1.CancellationReason
+	57, // 4: booking.v1.Booking.cancellation_fee:type_name -> google.type.Money
+	57, // 5: booking.v1.Booking.refunded:type_name -> google.type.Money
+	1,  // 6: booking.v1.Booking.refund_reason:type_name -> booking.v1.RefundReason
+	57, // 7: booking.v1.Discount.fare:type_name -> google.type.Money
+	57, // 8: booking.v1.Discount.amount:type_name -> google.type.Money
+	57, // 9: booking.v1.Discount.total:type_name -> google.type.Money
+	58, // 10: booking.v1.Ride.pickup:type_name -> google.type.LatLng
+	58, // 11: booking.v1.Ride.dropoff:type_name -> google.type.LatLng
+	57, // 12: booking.v1.Ride.cost:type_name -> google.type.Money
+	14, // 13: booking.v1.CreateBookingRequest.ride:type_name -> booking.v1.Ride
+	4,  // 14: booking.v1.CreateBookingRequest.vehicle_class:type_name -> booking.v1.VehicleClass
+	2,  // 15: booking.v1.CreateBookingRequest.payment_method:type_name -> booking.v1.PaymentMethod
+	12, // 16: booking.v1.CreateBookingResponse.booking:type_name -> booking.v1.Booking
+	3,  // 17: booking.v1.GetBookingResponse.status:type_name -> booking.v1.BookingStatus
+	58, // 18: booking.v1.GetBookingResponse.pickup:type_name -> google.type.LatLng
+	58, // 19: booking.v1.GetBookingResponse.dropoff:type_name -> google.type.LatLng
+	57, // 20: booking.v1.GetBookingResponse.cost:type_name -> google.type.Money
+	13, // 21: booking.v1.GetBookingResponse.discount:type_name -> booking.v1.Discount
+	2,  // 22: booking.v1.GetBookingResponse.payment_method:type_name -> booking.v1.PaymentMethod
+	0,  // 23: booking.v1.GetBookingResponse.cancellation_reason:type_name -> booking.v1.CancellationReason
+	57, // 24: booking.v1.GetBookingResponse.cancellation_fee:type_name -> google.type.Money
+	57, // 25: booking.v1.GetBookingResponse.refunded:type_name -> google.type.Money
+	1,  // 26: booking.v1.GetBookingResponse.refund_reason:type_name -> booking.v1.RefundReason
+	12, // 27: booking.v1.ListBookingsResponse.bookings:type_name -> booking.v1.Booking
+	14, // 28: booking.v1.BookingSaga.ride:type_name -> booking.v1.Ride
+	5,  // 29: booking.v1.BookingSaga.status:type_name -> booking.v1.SagaStatus
+	6,  // 30: booking.v1.BookingSaga.step:type_name -> booking.v1.SagaStep
+	13, // 31: booking.v1.BookingSaga.discount:type_name -> booking.v1.Discount
+	2,  // 32: booking.v1.BookingSaga.payment_method:type_name -> booking.v1.PaymentMethod
+	21, // 33: booking.v1.GetBookingSagaResponse.saga:type_name -> booking.v1.BookingSaga
+	21, // 34: booking.v1.WatchBookingResponse.saga:type_name -> booking.v1.BookingSaga
+	14, // 35: booking.v1.WatchBookingResponse.ride:type_name -> booking.v1.Ride
+	3,  // 36: booking.v1.WatchBookingResponse.booking_status:type_name -> booking.v1.BookingStatus
+	7,  // 37: booking.v1.DriverOffer.status:type_name -> booking.v1.OfferStatus
+	26, // 38: booking.v1.ListDriverOffersResponse.offers:type_name -> booking.v1.DriverOffer
+	26, // 39: booking.v1.RespondToOfferResponse.offer:type_name -> booking.v1.DriverOffer
+	12, // 40: booking.v1.RespondToOfferResponse.booking:type_name -> booking.v1.Booking
+	58, // 41: booking.v1.EstimateFareRequest.pickup:type_name -> google.type.LatLng
+	58, // 42: booking.v1.EstimateFareRequest.dropoff:type_name -> google.type.LatLng
+	4,  // 43: booking.v1.EstimateFareRequest.vehicle_class:type_name -> booking.v1.VehicleClass
+	57, // 44: booking.v1.Fare.cost:type_name -> google.type.Money
+	57, // 45: booking.v1.Fare.base_fare:type_name -> google.type.Money
+	57, // 46: booking.v1.Fare.distance_fare:type_name -> google.type.Money
+	57, // 47: booking.v1.Fare.time_fare:type_name -> google.type.Money
+	4,  // 48: booking.v1.Fare.vehicle_class:type_name -> booking.v1.VehicleClass
+	32, // 49: booking.v1.EstimateFareResponse.fare:type_name -> booking.v1.Fare
+	8,  // 50: booking.v1.Promo.type:type_name -> booking.v1.DiscountType
+	57, // 51: booking.v1.Promo.amount_off:type_name -> google.type.Money
+	57, // 52: booking.v1.Promo.max_discount:type_name -> google.type.Money
+	57, // 53: booking.v1.Promo.min_fare:type_name -> google.type.Money
+	4,  // 54: booking.v1.Promo.vehicle_classes:type_name -> booking.v1.VehicleClass
+	34, // 55: booking.v1.CreatePromoRequest.promo:type_name -> booking.v1.Promo
+	34, // 56: booking.v1.CreatePromoResponse.promo:type_name -> booking.v1.Promo
+	34, // 57: booking.v1.GetPromoResponse.promo:type_name -> booking.v1.Promo
+	12, // 58: booking.v1.CompleteBookingResponse.booking:type_name -> booking.v1.Booking
+	51, // 59: booking.v1.CompleteBookingResponse.entry:type_name -> booking.v1.JournalEntry
+	12, // 60: booking.v1.CancelBookingResponse.booking:type_name -> booking.v1.Booking
+	51, // 61: booking.v1.CancelBookingResponse.entry:type_name -> booking.v1.JournalEntry
+	12, // 62: booking.v1.ReportNoShowResponse.booking:type_name -> booking.v1.Booking
+	51, // 63: booking.v1.ReportNoShowResponse.entry:type_name -> booking.v1.JournalEntry
+	57, // 64: booking.v1.RefundBookingRequest.amount:type_name -> google.type.Money
+	1,  // 65: booking.v1.RefundBookingRequest.reason:type_name -> booking.v1.RefundReason
+	12, // 66: booking.v1.RefundBookingResponse.booking:type_name -> booking.v1.Booking
+	51, // 67: booking.v1.RefundBookingResponse.entry:type_name -> booking.v1.JournalEntry
+	9,  // 68: booking.v1.GetReceiptRequest.format:type_name -> booking.v1.ReceiptFormat
+	57, // 69: booking.v1.Receipt.total:type_name -> google.type.Money
+	10, // 70: booking.v1.Account.type:type_name -> booking.v1.AccountType
+	49, // 71: booking.v1.Posting.account:type_name -> booking.v1.Account
+	57, // 72: booking.v1.Posting.amount:type_name -> google.type.Money
+	11, // 73: booking.v1.JournalEntry.kind:type_name -> booking.v1.JournalEntryKind
+	50, // 74: booking.v1.JournalEntry.postings:type_name -> booking.v1.Posting
+	49, // 75: booking.v1.GetAccountBalanceRequest.account:type_name -> booking.v1.Account
+	49, // 76: booking.v1.GetAccountBalanceResponse.account:type_name -> booking.v1.Account
+	57, // 77: booking.v1.GetAccountBalanceResponse.balances:type_name -> google.type.Money
+	49, // 78: booking.v1.GetAccountStatementRequest.account:type_name -> booking.v1.Account
+	11, // 79: booking.v1.StatementLine.kind:type_name -> booking.v1.JournalEntryKind
+	57, // 80: booking.v1.StatementLine.amount:type_name -> google.type.Money
+	55, // 81: booking.v1.GetAccountStatementResponse.lines:type_name -> booking.v1.StatementLine
+	15, // 82: booking.v1.BookingService.CreateBooking:input_type -> booking.v1.CreateBookingRequest
+	17, // 83: booking.v1.BookingService.GetBooking:input_type -> booking.v1.GetBookingRequest
+	19, // 84: booking.v1.BookingService.ListBookings:input_type -> booking.v1.ListBookingsRequest
+	22, // 85: booking.v1.BookingService.GetBookingSaga:input_type -> booking.v1.GetBookingSagaRequest
+	24, // 86: booking.v1.BookingService.WatchBooking:input_type -> booking.v1.WatchBookingRequest
+	27, // 87: booking.v1.BookingService.ListDriverOffers:input_type -> booking.v1.ListDriverOffersRequest
+	29, // 88: booking.v1.BookingService.RespondToOffer:input_type -> booking.v1.RespondToOfferRequest
+	31, // 89: booking.v1.BookingService.EstimateFare:input_type -> booking.v1.EstimateFareRequest
+	35, // 90: booking.v1.BookingService.CreatePromo:input_type -> booking.v1.CreatePromoRequest
+	37, // 91: booking.v1.BookingService.GetPromo:input_type -> booking.v1.GetPromoRequest
+	39, // 92: booking.v1.BookingService.CompleteBooking:input_type -> booking.v1.CompleteBookingRequest
+	41, // 93: booking.v1.BookingService.CancelBooking:input_type -> booking.v1.CancelBookingRequest
+	43, // 94: booking.v1.BookingService.ReportNoShow:input_type -> booking.v1.ReportNoShowRequest
+	45, // 95: booking.v1.BookingService.RefundBooking:input_type -> booking.v1.RefundBookingRequest
+	47, // 96: booking.v1.BookingService.GetReceipt:input_type -> booking.v1.GetReceiptRequest
+	52, // 97: booking.v1.BookingService.GetAccountBalance:input_type -> booking.v1.GetAccountBalanceRequest
+	54, // 98: booking.v1.BookingService.GetAccountStatement:input_type -> booking.v1.GetAccountStatementRequest
+	16, // 99: booking.v1.BookingService.CreateBooking:output_type -> booking.v1.CreateBookingResponse
+	18, // 100: booking.v1.BookingService.GetBooking:output_type -> booking.v1.GetBookingResponse
+	20, // 101: booking.v1.BookingService.ListBookings:output_type -> booking.v1.ListBookingsResponse
+	23, // 102: booking.v1.BookingService.GetBookingSaga:output_type -> booking.v1.GetBookingSagaResponse
+	25, // 103: booking.v1.BookingService.WatchBooking:output_type -> booking.v1.WatchBookingResponse
+	28, // 104: booking.v1.BookingService.ListDriverOffers:output_type -> booking.v1.ListDriverOffersResponse
+	30, // 105: booking.v1.BookingService.RespondToOffer:output_type -> booking.v1.RespondToOfferResponse
+	33, // 106: booking.v1.BookingService.EstimateFare:output_type -> booking.v1.EstimateFareResponse
+	36, // 107: booking.v1.BookingService.CreatePromo:output_type -> booking.v1.CreatePromoResponse
+	38, // 108: booking.v1.BookingService.GetPromo:output_type -> booking.v1.GetPromoResponse
+	40, // 109: booking.v1.BookingService.CompleteBooking:output_type -> booking.v1.CompleteBookingResponse
+	42, // 110: booking.v1.BookingService.CancelBooking:output_type -> booking.v1.CancelBookingResponse
+	44, // 111: booking.v1.BookingService.ReportNoShow:output_type -> booking.v1.ReportNoShowResponse
+	46, // 112: booking.v1.BookingService.RefundBooking:output_type -> booking.v1.RefundBookingResponse
+	59, // 113: booking.v1.BookingService.GetReceipt:output_type -> google.api.HttpBody
+	53, // 114: booking.v1.BookingService.GetAccountBalance:output_type -> booking.v1.GetAccountBalanceResponse
+	56, // 115: booking.v1.BookingService.GetAccountStatement:output_type -> booking.v1.GetAccountStatementResponse
+	99, // [99:116] is the sub-list for method output_type
+	82, // [82:99] is the sub-list for method input_type
+	82, // [82:82] is the sub-list for extension type_name
+	82, // [82:82] is the sub-list for extension extendee
+	0,  // [0:82] is the sub-list for field type_name
 }
 
 func init() { file_booking_v1_booking_service_proto_init() }
@@ -504,13 +4913,14 @@ func file_booking_v1_booking_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_booking_v1_booking_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      12,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_booking_v1_booking_service_proto_goTypes,
 		DependencyIndexes: file_booking_v1_booking_service_proto_depIdxs,
+		EnumInfos:         file_booking_v1_booking_service_proto_enumTypes,
 		MessageInfos:      file_booking_v1_booking_service_proto_msgTypes,
 	}.Build()
 	File_booking_v1_booking_service_proto = out.File
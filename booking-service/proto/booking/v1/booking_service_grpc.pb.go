@@ -8,6 +8,7 @@ package v1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,16 +20,91 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookingService_CreateBooking_FullMethodName = "/booking.v1.BookingService/CreateBooking"
-	BookingService_GetBooking_FullMethodName    = "/booking.v1.BookingService/GetBooking"
+	BookingService_CreateBooking_FullMethodName       = "/booking.v1.BookingService/CreateBooking"
+	BookingService_GetBooking_FullMethodName          = "/booking.v1.BookingService/GetBooking"
+	BookingService_ListBookings_FullMethodName        = "/booking.v1.BookingService/ListBookings"
+	BookingService_GetBookingSaga_FullMethodName      = "/booking.v1.BookingService/GetBookingSaga"
+	BookingService_WatchBooking_FullMethodName        = "/booking.v1.BookingService/WatchBooking"
+	BookingService_ListDriverOffers_FullMethodName    = "/booking.v1.BookingService/ListDriverOffers"
+	BookingService_RespondToOffer_FullMethodName      = "/booking.v1.BookingService/RespondToOffer"
+	BookingService_EstimateFare_FullMethodName        = "/booking.v1.BookingService/EstimateFare"
+	BookingService_CreatePromo_FullMethodName         = "/booking.v1.BookingService/CreatePromo"
+	BookingService_GetPromo_FullMethodName            = "/booking.v1.BookingService/GetPromo"
+	BookingService_CompleteBooking_FullMethodName     = "/booking.v1.BookingService/CompleteBooking"
+	BookingService_CancelBooking_FullMethodName       = "/booking.v1.BookingService/CancelBooking"
+	BookingService_ReportNoShow_FullMethodName        = "/booking.v1.BookingService/ReportNoShow"
+	BookingService_RefundBooking_FullMethodName       = "/booking.v1.BookingService/RefundBooking"
+	BookingService_GetReceipt_FullMethodName          = "/booking.v1.BookingService/GetReceipt"
+	BookingService_GetAccountBalance_FullMethodName   = "/booking.v1.BookingService/GetAccountBalance"
+	BookingService_GetAccountStatement_FullMethodName = "/booking.v1.BookingService/GetAccountStatement"
 )
 
 // BookingServiceClient is the client API for BookingService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BookingServiceClient interface {
+	// CreateBooking books a ride for a user. It runs a saga that validates the
+	// user with UserService, holds the fare in the user's wallet when paying by
+	// wallet, creates the ride with RideService and then stores the booking,
+	// deleting the ride and releasing the hold again if the booking cannot be
+	// stored. A booking with a pickup_time is scheduled for later.
 	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error)
 	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error)
+	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	// GetBookingSaga reports the progress of the saga started by CreateBooking.
+	GetBookingSaga(ctx context.Context, in *GetBookingSagaRequest, opts ...grpc.CallOption) (*GetBookingSagaResponse, error)
+	// WatchBooking streams the state of a booking as it changes: saga status
+	// and step transitions, then dispatch confirming the booking and changes
+	// to the booked ride. The first update
+	// is the current state, unless the watch resumes after resume_token. The
+	// stream ends after the saga fails; otherwise it runs until the client
+	// cancels it.
+	WatchBooking(ctx context.Context, in *WatchBookingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchBookingResponse], error)
+	// ListDriverOffers returns the bookings currently offered to a driver by
+	// dispatch. An offer is open until it expires or the driver responds.
+	ListDriverOffers(ctx context.Context, in *ListDriverOffersRequest, opts ...grpc.CallOption) (*ListDriverOffersResponse, error)
+	// RespondToOffer accepts or declines an open offer. Accepting confirms the
+	// booking with the driver; declining lets dispatch offer it to the next
+	// nearest driver.
+	RespondToOffer(ctx context.Context, in *RespondToOfferRequest, opts ...grpc.CallOption) (*RespondToOfferResponse, error)
+	// EstimateFare quotes the fare CreateBooking would charge for a ride from
+	// pickup to dropoff under the current tariff.
+	EstimateFare(ctx context.Context, in *EstimateFareRequest, opts ...grpc.CallOption) (*EstimateFareResponse, error)
+	// CreatePromo starts a promo code campaign. Codes are case-insensitive
+	// and stored upper-cased.
+	CreatePromo(ctx context.Context, in *CreatePromoRequest, opts ...grpc.CallOption) (*CreatePromoResponse, error)
+	// GetPromo returns a promo code and how often it was redeemed.
+	GetPromo(ctx context.Context, in *GetPromoRequest, opts ...grpc.CallOption) (*GetPromoResponse, error)
+	// CompleteBooking is called by the driver of a confirmed booking when the
+	// ride is over. It charges the rider the ride's cost and posts a journal
+	// entry splitting the fare between the driver, the platform and any promo
+	// code.
+	CompleteBooking(ctx context.Context, in *CompleteBookingRequest, opts ...grpc.CallOption) (*CompleteBookingResponse, error)
+	// CancelBooking is called by the rider of a pending or confirmed booking.
+	// Cancelling is free within the cancellation policy's free window and
+	// until a driver accepts the booking; after that the rider is charged the
+	// policy's fee, from the booking's wallet hold if any. The rest of the
+	// hold is released and the driver freed. Cancelling a cancelled booking
+	// again succeeds and charges nothing more.
+	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	// ReportNoShow is called by the driver of a confirmed booking whose rider
+	// did not show up. It cancels the booking and charges the rider the
+	// cancellation policy's no-show fee.
+	ReportNoShow(ctx context.Context, in *ReportNoShowRequest, opts ...grpc.CallOption) (*ReportNoShowResponse, error)
+	// RefundBooking gives the rider of a completed or cancelled booking back
+	// part or all of what they were charged for it, the fare or the
+	// cancellation fee, into their wallet or through the payment provider. The
+	// platform funds refunds, so what the driver earned is kept.
+	RefundBooking(ctx context.Context, in *RefundBookingRequest, opts ...grpc.CallOption) (*RefundBookingResponse, error)
+	// GetReceipt renders the receipt of a completed booking, or of a cancelled
+	// one the rider paid a fee for, as HTML or PDF. Its body is the document,
+	// served as is over REST, and its extensions hold a Receipt.
+	GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// GetAccountBalance returns the balance of a ledger account in each
+	// currency it was posted in.
+	GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error)
+	// GetAccountStatement lists the postings to a ledger account, newest first.
+	GetAccountStatement(ctx context.Context, in *GetAccountStatementRequest, opts ...grpc.CallOption) (*GetAccountStatementResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -59,12 +135,231 @@ func (c *bookingServiceClient) GetBooking(ctx context.Context, in *GetBookingReq
 	return out, nil
 }
 
+func (c *bookingServiceClient) ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBookingSaga(ctx context.Context, in *GetBookingSagaRequest, opts ...grpc.CallOption) (*GetBookingSagaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingSagaResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetBookingSaga_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) WatchBooking(ctx context.Context, in *WatchBookingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchBookingResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookingService_ServiceDesc.Streams[0], BookingService_WatchBooking_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchBookingRequest, WatchBookingResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_WatchBookingClient = grpc.ServerStreamingClient[WatchBookingResponse]
+
+func (c *bookingServiceClient) ListDriverOffers(ctx context.Context, in *ListDriverOffersRequest, opts ...grpc.CallOption) (*ListDriverOffersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDriverOffersResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListDriverOffers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RespondToOffer(ctx context.Context, in *RespondToOfferRequest, opts ...grpc.CallOption) (*RespondToOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondToOfferResponse)
+	err := c.cc.Invoke(ctx, BookingService_RespondToOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) EstimateFare(ctx context.Context, in *EstimateFareRequest, opts ...grpc.CallOption) (*EstimateFareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EstimateFareResponse)
+	err := c.cc.Invoke(ctx, BookingService_EstimateFare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreatePromo(ctx context.Context, in *CreatePromoRequest, opts ...grpc.CallOption) (*CreatePromoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromoResponse)
+	err := c.cc.Invoke(ctx, BookingService_CreatePromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetPromo(ctx context.Context, in *GetPromoRequest, opts ...grpc.CallOption) (*GetPromoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPromoResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetPromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CompleteBooking(ctx context.Context, in *CompleteBookingRequest, opts ...grpc.CallOption) (*CompleteBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_CompleteBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_CancelBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ReportNoShow(ctx context.Context, in *ReportNoShowRequest, opts ...grpc.CallOption) (*ReportNoShowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportNoShowResponse)
+	err := c.cc.Invoke(ctx, BookingService_ReportNoShow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RefundBooking(ctx context.Context, in *RefundBookingRequest, opts ...grpc.CallOption) (*RefundBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_RefundBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, BookingService_GetReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetAccountBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetAccountStatement(ctx context.Context, in *GetAccountStatementRequest, opts ...grpc.CallOption) (*GetAccountStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountStatementResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetAccountStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility.
 type BookingServiceServer interface {
+	// CreateBooking books a ride for a user. It runs a saga that validates the
+	// user with UserService, holds the fare in the user's wallet when paying by
+	// wallet, creates the ride with RideService and then stores the booking,
+	// deleting the ride and releasing the hold again if the booking cannot be
+	// stored. A booking with a pickup_time is scheduled for later.
 	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
 	GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error)
+	ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error)
+	// GetBookingSaga reports the progress of the saga started by CreateBooking.
+	GetBookingSaga(context.Context, *GetBookingSagaRequest) (*GetBookingSagaResponse, error)
+	// WatchBooking streams the state of a booking as it changes: saga status
+	// and step transitions, then dispatch confirming the booking and changes
+	// to the booked ride. The first update
+	// is the current state, unless the watch resumes after resume_token. The
+	// stream ends after the saga fails; otherwise it runs until the client
+	// cancels it.
+	WatchBooking(*WatchBookingRequest, grpc.ServerStreamingServer[WatchBookingResponse]) error
+	// ListDriverOffers returns the bookings currently offered to a driver by
+	// dispatch. An offer is open until it expires or the driver responds.
+	ListDriverOffers(context.Context, *ListDriverOffersRequest) (*ListDriverOffersResponse, error)
+	// RespondToOffer accepts or declines an open offer. Accepting confirms the
+	// booking with the driver; declining lets dispatch offer it to the next
+	// nearest driver.
+	RespondToOffer(context.Context, *RespondToOfferRequest) (*RespondToOfferResponse, error)
+	// EstimateFare quotes the fare CreateBooking would charge for a ride from
+	// pickup to dropoff under the current tariff.
+	EstimateFare(context.Context, *EstimateFareRequest) (*EstimateFareResponse, error)
+	// CreatePromo starts a promo code campaign. Codes are case-insensitive
+	// and stored upper-cased.
+	CreatePromo(context.Context, *CreatePromoRequest) (*CreatePromoResponse, error)
+	// GetPromo returns a promo code and how often it was redeemed.
+	GetPromo(context.Context, *GetPromoRequest) (*GetPromoResponse, error)
+	// CompleteBooking is called by the driver of a confirmed booking when the
+	// ride is over. It charges the rider the ride's cost and posts a journal
+	// entry splitting the fare between the driver, the platform and any promo
+	// code.
+	CompleteBooking(context.Context, *CompleteBookingRequest) (*CompleteBookingResponse, error)
+	// CancelBooking is called by the rider of a pending or confirmed booking.
+	// Cancelling is free within the cancellation policy's free window and
+	// until a driver accepts the booking; after that the rider is charged the
+	// policy's fee, from the booking's wallet hold if any. The rest of the
+	// hold is released and the driver freed. Cancelling a cancelled booking
+	// again succeeds and charges nothing more.
+	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	// ReportNoShow is called by the driver of a confirmed booking whose rider
+	// did not show up. It cancels the booking and charges the rider the
+	// cancellation policy's no-show fee.
+	ReportNoShow(context.Context, *ReportNoShowRequest) (*ReportNoShowResponse, error)
+	// RefundBooking gives the rider of a completed or cancelled booking back
+	// part or all of what they were charged for it, the fare or the
+	// cancellation fee, into their wallet or through the payment provider. The
+	// platform funds refunds, so what the driver earned is kept.
+	RefundBooking(context.Context, *RefundBookingRequest) (*RefundBookingResponse, error)
+	// GetReceipt renders the receipt of a completed booking, or of a cancelled
+	// one the rider paid a fee for, as HTML or PDF. Its body is the document,
+	// served as is over REST, and its extensions hold a Receipt.
+	GetReceipt(context.Context, *GetReceiptRequest) (*httpbody.HttpBody, error)
+	// GetAccountBalance returns the balance of a ledger account in each
+	// currency it was posted in.
+	GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error)
+	// GetAccountStatement lists the postings to a ledger account, newest first.
+	GetAccountStatement(context.Context, *GetAccountStatementRequest) (*GetAccountStatementResponse, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -81,6 +376,51 @@ func (UnimplementedBookingServiceServer) CreateBooking(context.Context, *CreateB
 func (UnimplementedBookingServiceServer) GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBookingSaga(context.Context, *GetBookingSagaRequest) (*GetBookingSagaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingSaga not implemented")
+}
+func (UnimplementedBookingServiceServer) WatchBooking(*WatchBookingRequest, grpc.ServerStreamingServer[WatchBookingResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ListDriverOffers(context.Context, *ListDriverOffersRequest) (*ListDriverOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDriverOffers not implemented")
+}
+func (UnimplementedBookingServiceServer) RespondToOffer(context.Context, *RespondToOfferRequest) (*RespondToOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToOffer not implemented")
+}
+func (UnimplementedBookingServiceServer) EstimateFare(context.Context, *EstimateFareRequest) (*EstimateFareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFare not implemented")
+}
+func (UnimplementedBookingServiceServer) CreatePromo(context.Context, *CreatePromoRequest) (*CreatePromoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromo not implemented")
+}
+func (UnimplementedBookingServiceServer) GetPromo(context.Context, *GetPromoRequest) (*GetPromoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromo not implemented")
+}
+func (UnimplementedBookingServiceServer) CompleteBooking(context.Context, *CompleteBookingRequest) (*CompleteBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ReportNoShow(context.Context, *ReportNoShowRequest) (*ReportNoShowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportNoShow not implemented")
+}
+func (UnimplementedBookingServiceServer) RefundBooking(context.Context, *RefundBookingRequest) (*RefundBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) GetReceipt(context.Context, *GetReceiptRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipt not implemented")
+}
+func (UnimplementedBookingServiceServer) GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBalance not implemented")
+}
+func (UnimplementedBookingServiceServer) GetAccountStatement(context.Context, *GetAccountStatementRequest) (*GetAccountStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountStatement not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
 
@@ -138,6 +478,269 @@ func _BookingService_GetBooking_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_ListBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListBookings(ctx, req.(*ListBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBookingSaga_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingSagaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookingSaga(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookingSaga_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookingSaga(ctx, req.(*GetBookingSagaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_WatchBooking_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBookingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookingServiceServer).WatchBooking(m, &grpc.GenericServerStream[WatchBookingRequest, WatchBookingResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_WatchBookingServer = grpc.ServerStreamingServer[WatchBookingResponse]
+
+func _BookingService_ListDriverOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDriverOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListDriverOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListDriverOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListDriverOffers(ctx, req.(*ListDriverOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RespondToOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RespondToOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RespondToOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RespondToOffer(ctx, req.(*RespondToOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_EstimateFare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).EstimateFare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_EstimateFare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).EstimateFare(ctx, req.(*EstimateFareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreatePromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreatePromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreatePromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreatePromo(ctx, req.(*CreatePromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetPromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetPromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetPromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetPromo(ctx, req.(*GetPromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CompleteBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CompleteBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CompleteBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CompleteBooking(ctx, req.(*CompleteBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ReportNoShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportNoShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ReportNoShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ReportNoShow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ReportNoShow(ctx, req.(*ReportNoShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RefundBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RefundBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RefundBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RefundBooking(ctx, req.(*RefundBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetReceipt(ctx, req.(*GetReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetAccountBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetAccountBalance(ctx, req.(*GetAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetAccountStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetAccountStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetAccountStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetAccountStatement(ctx, req.(*GetAccountStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +756,69 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBooking",
 			Handler:    _BookingService_GetBooking_Handler,
 		},
+		{
+			MethodName: "ListBookings",
+			Handler:    _BookingService_ListBookings_Handler,
+		},
+		{
+			MethodName: "GetBookingSaga",
+			Handler:    _BookingService_GetBookingSaga_Handler,
+		},
+		{
+			MethodName: "ListDriverOffers",
+			Handler:    _BookingService_ListDriverOffers_Handler,
+		},
+		{
+			MethodName: "RespondToOffer",
+			Handler:    _BookingService_RespondToOffer_Handler,
+		},
+		{
+			MethodName: "EstimateFare",
+			Handler:    _BookingService_EstimateFare_Handler,
+		},
+		{
+			MethodName: "CreatePromo",
+			Handler:    _BookingService_CreatePromo_Handler,
+		},
+		{
+			MethodName: "GetPromo",
+			Handler:    _BookingService_GetPromo_Handler,
+		},
+		{
+			MethodName: "CompleteBooking",
+			Handler:    _BookingService_CompleteBooking_Handler,
+		},
+		{
+			MethodName: "CancelBooking",
+			Handler:    _BookingService_CancelBooking_Handler,
+		},
+		{
+			MethodName: "ReportNoShow",
+			Handler:    _BookingService_ReportNoShow_Handler,
+		},
+		{
+			MethodName: "RefundBooking",
+			Handler:    _BookingService_RefundBooking_Handler,
+		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _BookingService_GetReceipt_Handler,
+		},
+		{
+			MethodName: "GetAccountBalance",
+			Handler:    _BookingService_GetAccountBalance_Handler,
+		},
+		{
+			MethodName: "GetAccountStatement",
+			Handler:    _BookingService_GetAccountStatement_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBooking",
+			Handler:       _BookingService_WatchBooking_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking/v1/booking_service.proto",
 }
@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        (unknown)
+// source: booking/v1/events.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BookingCreated is published when a booking and its ride are stored.
+type BookingCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Ride    *Ride    `protobuf:"bytes,2,opt,name=ride,proto3" json:"ride,omitempty"`
+}
+
+func (x *BookingCreated) Reset() {
+	*x = BookingCreated{}
+	mi := &file_booking_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingCreated) ProtoMessage() {}
+
+func (x *BookingCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingCreated.ProtoReflect.Descriptor instead.
+func (*BookingCreated) Descriptor() ([]byte, []int) {
+	return file_booking_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BookingCreated) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *BookingCreated) GetRide() *Ride {
+	if x != nil {
+		return x.Ride
+	}
+	return nil
+}
+
+// BookingSagaUpdated is published whenever a booking saga is started or
+// makes progress.
+type BookingSagaUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Saga *BookingSaga `protobuf:"bytes,1,opt,name=saga,proto3" json:"saga,omitempty"`
+}
+
+func (x *BookingSagaUpdated) Reset() {
+	*x = BookingSagaUpdated{}
+	mi := &file_booking_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingSagaUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingSagaUpdated) ProtoMessage() {}
+
+func (x *BookingSagaUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingSagaUpdated.ProtoReflect.Descriptor instead.
+func (*BookingSagaUpdated) Descriptor() ([]byte, []int) {
+	return file_booking_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BookingSagaUpdated) GetSaga() *BookingSaga {
+	if x != nil {
+		return x.Saga
+	}
+	return nil
+}
+
+// BookingStatusChanged is published when a scheduled booking is released to
+// dispatch, when dispatch confirms or expires a booking, when its driver
+// completes it and when its rider cancels it.
+type BookingStatusChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId      int32         `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	PreviousStatus BookingStatus `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3,enum=booking.v1.BookingStatus" json:"previous_status,omitempty"`
+	Status         BookingStatus `protobuf:"varint,3,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	DriverId       int32         `protobuf:"varint,4,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"` // Set when the booking is confirmed
+}
+
+func (x *BookingStatusChanged) Reset() {
+	*x = BookingStatusChanged{}
+	mi := &file_booking_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingStatusChanged) ProtoMessage() {}
+
+func (x *BookingStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingStatusChanged.ProtoReflect.Descriptor instead.
+func (*BookingStatusChanged) Descriptor() ([]byte, []int) {
+	return file_booking_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BookingStatusChanged) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *BookingStatusChanged) GetPreviousStatus() BookingStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusChanged) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusChanged) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+// DriverOfferUpdated is published when dispatch offers a booking to a driver
+// and when the offer is accepted, declined or expires.
+type DriverOfferUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offer *DriverOffer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+}
+
+func (x *DriverOfferUpdated) Reset() {
+	*x = DriverOfferUpdated{}
+	mi := &file_booking_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverOfferUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverOfferUpdated) ProtoMessage() {}
+
+func (x *DriverOfferUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverOfferUpdated.ProtoReflect.Descriptor instead.
+func (*DriverOfferUpdated) Descriptor() ([]byte, []int) {
+	return file_booking_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DriverOfferUpdated) GetOffer() *DriverOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+// BookingReminder is published once for a scheduled booking, ahead of its
+// pickup, so its rider can be reminded of it.
+type BookingReminder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId  int32  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId     int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PickupTime string `protobuf:"bytes,3,opt,name=pickup_time,json=pickupTime,proto3" json:"pickup_time,omitempty"` // RFC 3339
+}
+
+func (x *BookingReminder) Reset() {
+	*x = BookingReminder{}
+	mi := &file_booking_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingReminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingReminder) ProtoMessage() {}
+
+func (x *BookingReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingReminder.ProtoReflect.Descriptor instead.
+func (*BookingReminder) Descriptor() ([]byte, []int) {
+	return file_booking_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BookingReminder) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *BookingReminder) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingReminder) GetPickupTime() string {
+	if x != nil {
+		return x.PickupTime
+	}
+	return ""
+}
+
+var File_booking_v1_events_proto protoreflect.FileDescriptor
+
+var file_booking_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x65, 0x0a, 0x0e, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x69, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69, 0x64, 0x65, 0x52, 0x04, 0x72, 0x69, 0x64, 0x65, 0x22, 0x41,
+	0x0a, 0x12, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x67, 0x61, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x61, 0x67, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x67, 0x61, 0x52, 0x04, 0x73, 0x61, 0x67,
+	0x61, 0x22, 0xc9, 0x01, 0x0a, 0x14, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x43, 0x0a,
+	0x12, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x22, 0x6a, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6d,
+	0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x40,
+	0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6c,
+	0x61, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x6c, 0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x2f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_booking_v1_events_proto_rawDescOnce sync.Once
+	file_booking_v1_events_proto_rawDescData = file_booking_v1_events_proto_rawDesc
+)
+
+func file_booking_v1_events_proto_rawDescGZIP() []byte {
+	file_booking_v1_events_proto_rawDescOnce.Do(func() {
+		file_booking_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_booking_v1_events_proto_rawDescData)
+	})
+	return file_booking_v1_events_proto_rawDescData
+}
+
+var file_booking_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_booking_v1_events_proto_goTypes = []any{
+	(*BookingCreated)(nil),       // 0: booking.v1.BookingCreated
+	(*BookingSagaUpdated)(nil),   // 1: booking.v1.BookingSagaUpdated
+	(*BookingStatusChanged)(nil), // 2: booking.v1.BookingStatusChanged
+	(*DriverOfferUpdated)(nil),   // 3: booking.v1.DriverOfferUpdated
+	(*BookingReminder)(nil),      // 4: booking.v1.BookingReminder
+	(*Booking)(nil),              // 5: booking.v1.Booking
+	(*Ride)(nil),                 // 6: booking.v1.Ride
+	(*BookingSaga)(nil),          // 7: booking.v1.BookingSaga
+	(BookingStatus)(0),           // 8: booking.v1.BookingStatus
+	(*DriverOffer)(nil),          // 9: booking.v1.DriverOffer
+}
+var file_booking_v1_events_proto_depIdxs = []int32{
+	5, // 0: booking.v1.BookingCreated.booking:type_name -> booking.v1.Booking
+	6, // 1: booking.v1.BookingCreated.ride:type_name -> booking.v1.Ride
+	7, // 2: booking.v1.BookingSagaUpdated.saga:type_name -> booking.v1.BookingSaga
+	8, // 3: booking.v1.BookingStatusChanged.previous_status:type_name -> booking.v1.BookingStatus
+	8, // 4: booking.v1.BookingStatusChanged.status:type_name -> booking.v1.BookingStatus
+	9, // 5: booking.v1.DriverOfferUpdated.offer:type_name -> booking.v1.DriverOffer
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_events_proto_init() }
+func file_booking_v1_events_proto_init() {
+	if File_booking_v1_events_proto != nil {
+		return
+	}
+	file_booking_v1_booking_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_booking_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_events_proto_goTypes,
+		DependencyIndexes: file_booking_v1_events_proto_depIdxs,
+		MessageInfos:      file_booking_v1_events_proto_msgTypes,
+	}.Build()
+	File_booking_v1_events_proto = out.File
+	file_booking_v1_events_proto_rawDesc = nil
+	file_booking_v1_events_proto_goTypes = nil
+	file_booking_v1_events_proto_depIdxs = nil
+}
@@ -0,0 +1,663 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: booking/v1/booking_service.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BookingServiceName is the fully-qualified name of the BookingService service.
+	BookingServiceName = "booking.v1.BookingService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BookingServiceCreateBookingProcedure is the fully-qualified name of the BookingService's
+	// CreateBooking RPC.
+	BookingServiceCreateBookingProcedure = "/booking.v1.BookingService/CreateBooking"
+	// BookingServiceGetBookingProcedure is the fully-qualified name of the BookingService's GetBooking
+	// RPC.
+	BookingServiceGetBookingProcedure = "/booking.v1.BookingService/GetBooking"
+	// BookingServiceListBookingsProcedure is the fully-qualified name of the BookingService's
+	// ListBookings RPC.
+	BookingServiceListBookingsProcedure = "/booking.v1.BookingService/ListBookings"
+	// BookingServiceGetBookingSagaProcedure is the fully-qualified name of the BookingService's
+	// GetBookingSaga RPC.
+	BookingServiceGetBookingSagaProcedure = "/booking.v1.BookingService/GetBookingSaga"
+	// BookingServiceWatchBookingProcedure is the fully-qualified name of the BookingService's
+	// WatchBooking RPC.
+	BookingServiceWatchBookingProcedure = "/booking.v1.BookingService/WatchBooking"
+	// BookingServiceListDriverOffersProcedure is the fully-qualified name of the BookingService's
+	// ListDriverOffers RPC.
+	BookingServiceListDriverOffersProcedure = "/booking.v1.BookingService/ListDriverOffers"
+	// BookingServiceRespondToOfferProcedure is the fully-qualified name of the BookingService's
+	// RespondToOffer RPC.
+	BookingServiceRespondToOfferProcedure = "/booking.v1.BookingService/RespondToOffer"
+	// BookingServiceEstimateFareProcedure is the fully-qualified name of the BookingService's
+	// EstimateFare RPC.
+	BookingServiceEstimateFareProcedure = "/booking.v1.BookingService/EstimateFare"
+	// BookingServiceCreatePromoProcedure is the fully-qualified name of the BookingService's
+	// CreatePromo RPC.
+	BookingServiceCreatePromoProcedure = "/booking.v1.BookingService/CreatePromo"
+	// BookingServiceGetPromoProcedure is the fully-qualified name of the BookingService's GetPromo RPC.
+	BookingServiceGetPromoProcedure = "/booking.v1.BookingService/GetPromo"
+	// BookingServiceCompleteBookingProcedure is the fully-qualified name of the BookingService's
+	// CompleteBooking RPC.
+	BookingServiceCompleteBookingProcedure = "/booking.v1.BookingService/CompleteBooking"
+	// BookingServiceCancelBookingProcedure is the fully-qualified name of the BookingService's
+	// CancelBooking RPC.
+	BookingServiceCancelBookingProcedure = "/booking.v1.BookingService/CancelBooking"
+	// BookingServiceReportNoShowProcedure is the fully-qualified name of the BookingService's
+	// ReportNoShow RPC.
+	BookingServiceReportNoShowProcedure = "/booking.v1.BookingService/ReportNoShow"
+	// BookingServiceRefundBookingProcedure is the fully-qualified name of the BookingService's
+	// RefundBooking RPC.
+	BookingServiceRefundBookingProcedure = "/booking.v1.BookingService/RefundBooking"
+	// BookingServiceGetReceiptProcedure is the fully-qualified name of the BookingService's GetReceipt
+	// RPC.
+	BookingServiceGetReceiptProcedure = "/booking.v1.BookingService/GetReceipt"
+	// BookingServiceGetAccountBalanceProcedure is the fully-qualified name of the BookingService's
+	// GetAccountBalance RPC.
+	BookingServiceGetAccountBalanceProcedure = "/booking.v1.BookingService/GetAccountBalance"
+	// BookingServiceGetAccountStatementProcedure is the fully-qualified name of the BookingService's
+	// GetAccountStatement RPC.
+	BookingServiceGetAccountStatementProcedure = "/booking.v1.BookingService/GetAccountStatement"
+)
+
+// BookingServiceClient is a client for the booking.v1.BookingService service.
+type BookingServiceClient interface {
+	// CreateBooking books a ride for a user. It runs a saga that validates the
+	// user with UserService, holds the fare in the user's wallet when paying by
+	// wallet, creates the ride with RideService and then stores the booking,
+	// deleting the ride and releasing the hold again if the booking cannot be
+	// stored. A booking with a pickup_time is scheduled for later.
+	CreateBooking(context.Context, *connect.Request[v1.CreateBookingRequest]) (*connect.Response[v1.CreateBookingResponse], error)
+	GetBooking(context.Context, *connect.Request[v1.GetBookingRequest]) (*connect.Response[v1.GetBookingResponse], error)
+	ListBookings(context.Context, *connect.Request[v1.ListBookingsRequest]) (*connect.Response[v1.ListBookingsResponse], error)
+	// GetBookingSaga reports the progress of the saga started by CreateBooking.
+	GetBookingSaga(context.Context, *connect.Request[v1.GetBookingSagaRequest]) (*connect.Response[v1.GetBookingSagaResponse], error)
+	// WatchBooking streams the state of a booking as it changes: saga status
+	// and step transitions, then dispatch confirming the booking and changes
+	// to the booked ride. The first update
+	// is the current state, unless the watch resumes after resume_token. The
+	// stream ends after the saga fails; otherwise it runs until the client
+	// cancels it.
+	WatchBooking(context.Context, *connect.Request[v1.WatchBookingRequest]) (*connect.ServerStreamForClient[v1.WatchBookingResponse], error)
+	// ListDriverOffers returns the bookings currently offered to a driver by
+	// dispatch. An offer is open until it expires or the driver responds.
+	ListDriverOffers(context.Context, *connect.Request[v1.ListDriverOffersRequest]) (*connect.Response[v1.ListDriverOffersResponse], error)
+	// RespondToOffer accepts or declines an open offer. Accepting confirms the
+	// booking with the driver; declining lets dispatch offer it to the next
+	// nearest driver.
+	RespondToOffer(context.Context, *connect.Request[v1.RespondToOfferRequest]) (*connect.Response[v1.RespondToOfferResponse], error)
+	// EstimateFare quotes the fare CreateBooking would charge for a ride from
+	// pickup to dropoff under the current tariff.
+	EstimateFare(context.Context, *connect.Request[v1.EstimateFareRequest]) (*connect.Response[v1.EstimateFareResponse], error)
+	// CreatePromo starts a promo code campaign. Codes are case-insensitive
+	// and stored upper-cased.
+	CreatePromo(context.Context, *connect.Request[v1.CreatePromoRequest]) (*connect.Response[v1.CreatePromoResponse], error)
+	// GetPromo returns a promo code and how often it was redeemed.
+	GetPromo(context.Context, *connect.Request[v1.GetPromoRequest]) (*connect.Response[v1.GetPromoResponse], error)
+	// CompleteBooking is called by the driver of a confirmed booking when the
+	// ride is over. It charges the rider the ride's cost and posts a journal
+	// entry splitting the fare between the driver, the platform and any promo
+	// code.
+	CompleteBooking(context.Context, *connect.Request[v1.CompleteBookingRequest]) (*connect.Response[v1.CompleteBookingResponse], error)
+	// CancelBooking is called by the rider of a pending or confirmed booking.
+	// Cancelling is free within the cancellation policy's free window and
+	// until a driver accepts the booking; after that the rider is charged the
+	// policy's fee, from the booking's wallet hold if any. The rest of the
+	// hold is released and the driver freed. Cancelling a cancelled booking
+	// again succeeds and charges nothing more.
+	CancelBooking(context.Context, *connect.Request[v1.CancelBookingRequest]) (*connect.Response[v1.CancelBookingResponse], error)
+	// ReportNoShow is called by the driver of a confirmed booking whose rider
+	// did not show up. It cancels the booking and charges the rider the
+	// cancellation policy's no-show fee.
+	ReportNoShow(context.Context, *connect.Request[v1.ReportNoShowRequest]) (*connect.Response[v1.ReportNoShowResponse], error)
+	// RefundBooking gives the rider of a completed or cancelled booking back
+	// part or all of what they were charged for it, the fare or the
+	// cancellation fee, into their wallet or through the payment provider. The
+	// platform funds refunds, so what the driver earned is kept.
+	RefundBooking(context.Context, *connect.Request[v1.RefundBookingRequest]) (*connect.Response[v1.RefundBookingResponse], error)
+	// GetReceipt renders the receipt of a completed booking, or of a cancelled
+	// one the rider paid a fee for, as HTML or PDF. Its body is the document,
+	// served as is over REST, and its extensions hold a Receipt.
+	GetReceipt(context.Context, *connect.Request[v1.GetReceiptRequest]) (*connect.Response[httpbody.HttpBody], error)
+	// GetAccountBalance returns the balance of a ledger account in each
+	// currency it was posted in.
+	GetAccountBalance(context.Context, *connect.Request[v1.GetAccountBalanceRequest]) (*connect.Response[v1.GetAccountBalanceResponse], error)
+	// GetAccountStatement lists the postings to a ledger account, newest first.
+	GetAccountStatement(context.Context, *connect.Request[v1.GetAccountStatementRequest]) (*connect.Response[v1.GetAccountStatementResponse], error)
+}
+
+// NewBookingServiceClient constructs a client for the booking.v1.BookingService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBookingServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BookingServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	bookingServiceMethods := v1.File_booking_v1_booking_service_proto.Services().ByName("BookingService").Methods()
+	return &bookingServiceClient{
+		createBooking: connect.NewClient[v1.CreateBookingRequest, v1.CreateBookingResponse](
+			httpClient,
+			baseURL+BookingServiceCreateBookingProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("CreateBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		getBooking: connect.NewClient[v1.GetBookingRequest, v1.GetBookingResponse](
+			httpClient,
+			baseURL+BookingServiceGetBookingProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("GetBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		listBookings: connect.NewClient[v1.ListBookingsRequest, v1.ListBookingsResponse](
+			httpClient,
+			baseURL+BookingServiceListBookingsProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("ListBookings")),
+			connect.WithClientOptions(opts...),
+		),
+		getBookingSaga: connect.NewClient[v1.GetBookingSagaRequest, v1.GetBookingSagaResponse](
+			httpClient,
+			baseURL+BookingServiceGetBookingSagaProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("GetBookingSaga")),
+			connect.WithClientOptions(opts...),
+		),
+		watchBooking: connect.NewClient[v1.WatchBookingRequest, v1.WatchBookingResponse](
+			httpClient,
+			baseURL+BookingServiceWatchBookingProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("WatchBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		listDriverOffers: connect.NewClient[v1.ListDriverOffersRequest, v1.ListDriverOffersResponse](
+			httpClient,
+			baseURL+BookingServiceListDriverOffersProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("ListDriverOffers")),
+			connect.WithClientOptions(opts...),
+		),
+		respondToOffer: connect.NewClient[v1.RespondToOfferRequest, v1.RespondToOfferResponse](
+			httpClient,
+			baseURL+BookingServiceRespondToOfferProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("RespondToOffer")),
+			connect.WithClientOptions(opts...),
+		),
+		estimateFare: connect.NewClient[v1.EstimateFareRequest, v1.EstimateFareResponse](
+			httpClient,
+			baseURL+BookingServiceEstimateFareProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("EstimateFare")),
+			connect.WithClientOptions(opts...),
+		),
+		createPromo: connect.NewClient[v1.CreatePromoRequest, v1.CreatePromoResponse](
+			httpClient,
+			baseURL+BookingServiceCreatePromoProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("CreatePromo")),
+			connect.WithClientOptions(opts...),
+		),
+		getPromo: connect.NewClient[v1.GetPromoRequest, v1.GetPromoResponse](
+			httpClient,
+			baseURL+BookingServiceGetPromoProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("GetPromo")),
+			connect.WithClientOptions(opts...),
+		),
+		completeBooking: connect.NewClient[v1.CompleteBookingRequest, v1.CompleteBookingResponse](
+			httpClient,
+			baseURL+BookingServiceCompleteBookingProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("CompleteBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelBooking: connect.NewClient[v1.CancelBookingRequest, v1.CancelBookingResponse](
+			httpClient,
+			baseURL+BookingServiceCancelBookingProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("CancelBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		reportNoShow: connect.NewClient[v1.ReportNoShowRequest, v1.ReportNoShowResponse](
+			httpClient,
+			baseURL+BookingServiceReportNoShowProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("ReportNoShow")),
+			connect.WithClientOptions(opts...),
+		),
+		refundBooking: connect.NewClient[v1.RefundBookingRequest, v1.RefundBookingResponse](
+			httpClient,
+			baseURL+BookingServiceRefundBookingProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("RefundBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		getReceipt: connect.NewClient[v1.GetReceiptRequest, httpbody.HttpBody](
+			httpClient,
+			baseURL+BookingServiceGetReceiptProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("GetReceipt")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountBalance: connect.NewClient[v1.GetAccountBalanceRequest, v1.GetAccountBalanceResponse](
+			httpClient,
+			baseURL+BookingServiceGetAccountBalanceProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("GetAccountBalance")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountStatement: connect.NewClient[v1.GetAccountStatementRequest, v1.GetAccountStatementResponse](
+			httpClient,
+			baseURL+BookingServiceGetAccountStatementProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("GetAccountStatement")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// bookingServiceClient implements BookingServiceClient.
+type bookingServiceClient struct {
+	createBooking       *connect.Client[v1.CreateBookingRequest, v1.CreateBookingResponse]
+	getBooking          *connect.Client[v1.GetBookingRequest, v1.GetBookingResponse]
+	listBookings        *connect.Client[v1.ListBookingsRequest, v1.ListBookingsResponse]
+	getBookingSaga      *connect.Client[v1.GetBookingSagaRequest, v1.GetBookingSagaResponse]
+	watchBooking        *connect.Client[v1.WatchBookingRequest, v1.WatchBookingResponse]
+	listDriverOffers    *connect.Client[v1.ListDriverOffersRequest, v1.ListDriverOffersResponse]
+	respondToOffer      *connect.Client[v1.RespondToOfferRequest, v1.RespondToOfferResponse]
+	estimateFare        *connect.Client[v1.EstimateFareRequest, v1.EstimateFareResponse]
+	createPromo         *connect.Client[v1.CreatePromoRequest, v1.CreatePromoResponse]
+	getPromo            *connect.Client[v1.GetPromoRequest, v1.GetPromoResponse]
+	completeBooking     *connect.Client[v1.CompleteBookingRequest, v1.CompleteBookingResponse]
+	cancelBooking       *connect.Client[v1.CancelBookingRequest, v1.CancelBookingResponse]
+	reportNoShow        *connect.Client[v1.ReportNoShowRequest, v1.ReportNoShowResponse]
+	refundBooking       *connect.Client[v1.RefundBookingRequest, v1.RefundBookingResponse]
+	getReceipt          *connect.Client[v1.GetReceiptRequest, httpbody.HttpBody]
+	getAccountBalance   *connect.Client[v1.GetAccountBalanceRequest, v1.GetAccountBalanceResponse]
+	getAccountStatement *connect.Client[v1.GetAccountStatementRequest, v1.GetAccountStatementResponse]
+}
+
+// CreateBooking calls booking.v1.BookingService.CreateBooking.
+func (c *bookingServiceClient) CreateBooking(ctx context.Context, req *connect.Request[v1.CreateBookingRequest]) (*connect.Response[v1.CreateBookingResponse], error) {
+	return c.createBooking.CallUnary(ctx, req)
+}
+
+// GetBooking calls booking.v1.BookingService.GetBooking.
+func (c *bookingServiceClient) GetBooking(ctx context.Context, req *connect.Request[v1.GetBookingRequest]) (*connect.Response[v1.GetBookingResponse], error) {
+	return c.getBooking.CallUnary(ctx, req)
+}
+
+// ListBookings calls booking.v1.BookingService.ListBookings.
+func (c *bookingServiceClient) ListBookings(ctx context.Context, req *connect.Request[v1.ListBookingsRequest]) (*connect.Response[v1.ListBookingsResponse], error) {
+	return c.listBookings.CallUnary(ctx, req)
+}
+
+// GetBookingSaga calls booking.v1.BookingService.GetBookingSaga.
+func (c *bookingServiceClient) GetBookingSaga(ctx context.Context, req *connect.Request[v1.GetBookingSagaRequest]) (*connect.Response[v1.GetBookingSagaResponse], error) {
+	return c.getBookingSaga.CallUnary(ctx, req)
+}
+
+// WatchBooking calls booking.v1.BookingService.WatchBooking.
+func (c *bookingServiceClient) WatchBooking(ctx context.Context, req *connect.Request[v1.WatchBookingRequest]) (*connect.ServerStreamForClient[v1.WatchBookingResponse], error) {
+	return c.watchBooking.CallServerStream(ctx, req)
+}
+
+// ListDriverOffers calls booking.v1.BookingService.ListDriverOffers.
+func (c *bookingServiceClient) ListDriverOffers(ctx context.Context, req *connect.Request[v1.ListDriverOffersRequest]) (*connect.Response[v1.ListDriverOffersResponse], error) {
+	return c.listDriverOffers.CallUnary(ctx, req)
+}
+
+// RespondToOffer calls booking.v1.BookingService.RespondToOffer.
+func (c *bookingServiceClient) RespondToOffer(ctx context.Context, req *connect.Request[v1.RespondToOfferRequest]) (*connect.Response[v1.RespondToOfferResponse], error) {
+	return c.respondToOffer.CallUnary(ctx, req)
+}
+
+// EstimateFare calls booking.v1.BookingService.EstimateFare.
+func (c *bookingServiceClient) EstimateFare(ctx context.Context, req *connect.Request[v1.EstimateFareRequest]) (*connect.Response[v1.EstimateFareResponse], error) {
+	return c.estimateFare.CallUnary(ctx, req)
+}
+
+// CreatePromo calls booking.v1.BookingService.CreatePromo.
+func (c *bookingServiceClient) CreatePromo(ctx context.Context, req *connect.Request[v1.CreatePromoRequest]) (*connect.Response[v1.CreatePromoResponse], error) {
+	return c.createPromo.CallUnary(ctx, req)
+}
+
+// GetPromo calls booking.v1.BookingService.GetPromo.
+func (c *bookingServiceClient) GetPromo(ctx context.Context, req *connect.Request[v1.GetPromoRequest]) (*connect.Response[v1.GetPromoResponse], error) {
+	return c.getPromo.CallUnary(ctx, req)
+}
+
+// CompleteBooking calls booking.v1.BookingService.CompleteBooking.
+func (c *bookingServiceClient) CompleteBooking(ctx context.Context, req *connect.Request[v1.CompleteBookingRequest]) (*connect.Response[v1.CompleteBookingResponse], error) {
+	return c.completeBooking.CallUnary(ctx, req)
+}
+
+// CancelBooking calls booking.v1.BookingService.CancelBooking.
+func (c *bookingServiceClient) CancelBooking(ctx context.Context, req *connect.Request[v1.CancelBookingRequest]) (*connect.Response[v1.CancelBookingResponse], error) {
+	return c.cancelBooking.CallUnary(ctx, req)
+}
+
+// ReportNoShow calls booking.v1.BookingService.ReportNoShow.
+func (c *bookingServiceClient) ReportNoShow(ctx context.Context, req *connect.Request[v1.ReportNoShowRequest]) (*connect.Response[v1.ReportNoShowResponse], error) {
+	return c.reportNoShow.CallUnary(ctx, req)
+}
+
+// RefundBooking calls booking.v1.BookingService.RefundBooking.
+func (c *bookingServiceClient) RefundBooking(ctx context.Context, req *connect.Request[v1.RefundBookingRequest]) (*connect.Response[v1.RefundBookingResponse], error) {
+	return c.refundBooking.CallUnary(ctx, req)
+}
+
+// GetReceipt calls booking.v1.BookingService.GetReceipt.
+func (c *bookingServiceClient) GetReceipt(ctx context.Context, req *connect.Request[v1.GetReceiptRequest]) (*connect.Response[httpbody.HttpBody], error) {
+	return c.getReceipt.CallUnary(ctx, req)
+}
+
+// GetAccountBalance calls booking.v1.BookingService.GetAccountBalance.
+func (c *bookingServiceClient) GetAccountBalance(ctx context.Context, req *connect.Request[v1.GetAccountBalanceRequest]) (*connect.Response[v1.GetAccountBalanceResponse], error) {
+	return c.getAccountBalance.CallUnary(ctx, req)
+}
+
+// GetAccountStatement calls booking.v1.BookingService.GetAccountStatement.
+func (c *bookingServiceClient) GetAccountStatement(ctx context.Context, req *connect.Request[v1.GetAccountStatementRequest]) (*connect.Response[v1.GetAccountStatementResponse], error) {
+	return c.getAccountStatement.CallUnary(ctx, req)
+}
+
+// BookingServiceHandler is an implementation of the booking.v1.BookingService service.
+type BookingServiceHandler interface {
+	// CreateBooking books a ride for a user. It runs a saga that validates the
+	// user with UserService, holds the fare in the user's wallet when paying by
+	// wallet, creates the ride with RideService and then stores the booking,
+	// deleting the ride and releasing the hold again if the booking cannot be
+	// stored. A booking with a pickup_time is scheduled for later.
+	CreateBooking(context.Context, *connect.Request[v1.CreateBookingRequest]) (*connect.Response[v1.CreateBookingResponse], error)
+	GetBooking(context.Context, *connect.Request[v1.GetBookingRequest]) (*connect.Response[v1.GetBookingResponse], error)
+	ListBookings(context.Context, *connect.Request[v1.ListBookingsRequest]) (*connect.Response[v1.ListBookingsResponse], error)
+	// GetBookingSaga reports the progress of the saga started by CreateBooking.
+	GetBookingSaga(context.Context, *connect.Request[v1.GetBookingSagaRequest]) (*connect.Response[v1.GetBookingSagaResponse], error)
+	// WatchBooking streams the state of a booking as it changes: saga status
+	// and step transitions, then dispatch confirming the booking and changes
+	// to the booked ride. The first update
+	// is the current state, unless the watch resumes after resume_token. The
+	// stream ends after the saga fails; otherwise it runs until the client
+	// cancels it.
+	WatchBooking(context.Context, *connect.Request[v1.WatchBookingRequest], *connect.ServerStream[v1.WatchBookingResponse]) error
+	// ListDriverOffers returns the bookings currently offered to a driver by
+	// dispatch. An offer is open until it expires or the driver responds.
+	ListDriverOffers(context.Context, *connect.Request[v1.ListDriverOffersRequest]) (*connect.Response[v1.ListDriverOffersResponse], error)
+	// RespondToOffer accepts or declines an open offer. Accepting confirms the
+	// booking with the driver; declining lets dispatch offer it to the next
+	// nearest driver.
+	RespondToOffer(context.Context, *connect.Request[v1.RespondToOfferRequest]) (*connect.Response[v1.RespondToOfferResponse], error)
+	// EstimateFare quotes the fare CreateBooking would charge for a ride from
+	// pickup to dropoff under the current tariff.
+	EstimateFare(context.Context, *connect.Request[v1.EstimateFareRequest]) (*connect.Response[v1.EstimateFareResponse], error)
+	// CreatePromo starts a promo code campaign. Codes are case-insensitive
+	// and stored upper-cased.
+	CreatePromo(context.Context, *connect.Request[v1.CreatePromoRequest]) (*connect.Response[v1.CreatePromoResponse], error)
+	// GetPromo returns a promo code and how often it was redeemed.
+	GetPromo(context.Context, *connect.Request[v1.GetPromoRequest]) (*connect.Response[v1.GetPromoResponse], error)
+	// CompleteBooking is called by the driver of a confirmed booking when the
+	// ride is over. It charges the rider the ride's cost and posts a journal
+	// entry splitting the fare between the driver, the platform and any promo
+	// code.
+	CompleteBooking(context.Context, *connect.Request[v1.CompleteBookingRequest]) (*connect.Response[v1.CompleteBookingResponse], error)
+	// CancelBooking is called by the rider of a pending or confirmed booking.
+	// Cancelling is free within the cancellation policy's free window and
+	// until a driver accepts the booking; after that the rider is charged the
+	// policy's fee, from the booking's wallet hold if any. The rest of the
+	// hold is released and the driver freed. Cancelling a cancelled booking
+	// again succeeds and charges nothing more.
+	CancelBooking(context.Context, *connect.Request[v1.CancelBookingRequest]) (*connect.Response[v1.CancelBookingResponse], error)
+	// ReportNoShow is called by the driver of a confirmed booking whose rider
+	// did not show up. It cancels the booking and charges the rider the
+	// cancellation policy's no-show fee.
+	ReportNoShow(context.Context, *connect.Request[v1.ReportNoShowRequest]) (*connect.Response[v1.ReportNoShowResponse], error)
+	// RefundBooking gives the rider of a completed or cancelled booking back
+	// part or all of what they were charged for it, the fare or the
+	// cancellation fee, into their wallet or through the payment provider. The
+	// platform funds refunds, so what the driver earned is kept.
+	RefundBooking(context.Context, *connect.Request[v1.RefundBookingRequest]) (*connect.Response[v1.RefundBookingResponse], error)
+	// GetReceipt renders the receipt of a completed booking, or of a cancelled
+	// one the rider paid a fee for, as HTML or PDF. Its body is the document,
+	// served as is over REST, and its extensions hold a Receipt.
+	GetReceipt(context.Context, *connect.Request[v1.GetReceiptRequest]) (*connect.Response[httpbody.HttpBody], error)
+	// GetAccountBalance returns the balance of a ledger account in each
+	// currency it was posted in.
+	GetAccountBalance(context.Context, *connect.Request[v1.GetAccountBalanceRequest]) (*connect.Response[v1.GetAccountBalanceResponse], error)
+	// GetAccountStatement lists the postings to a ledger account, newest first.
+	GetAccountStatement(context.Context, *connect.Request[v1.GetAccountStatementRequest]) (*connect.Response[v1.GetAccountStatementResponse], error)
+}
+
+// NewBookingServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBookingServiceHandler(svc BookingServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	bookingServiceMethods := v1.File_booking_v1_booking_service_proto.Services().ByName("BookingService").Methods()
+	bookingServiceCreateBookingHandler := connect.NewUnaryHandler(
+		BookingServiceCreateBookingProcedure,
+		svc.CreateBooking,
+		connect.WithSchema(bookingServiceMethods.ByName("CreateBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceGetBookingHandler := connect.NewUnaryHandler(
+		BookingServiceGetBookingProcedure,
+		svc.GetBooking,
+		connect.WithSchema(bookingServiceMethods.ByName("GetBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceListBookingsHandler := connect.NewUnaryHandler(
+		BookingServiceListBookingsProcedure,
+		svc.ListBookings,
+		connect.WithSchema(bookingServiceMethods.ByName("ListBookings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceGetBookingSagaHandler := connect.NewUnaryHandler(
+		BookingServiceGetBookingSagaProcedure,
+		svc.GetBookingSaga,
+		connect.WithSchema(bookingServiceMethods.ByName("GetBookingSaga")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceWatchBookingHandler := connect.NewServerStreamHandler(
+		BookingServiceWatchBookingProcedure,
+		svc.WatchBooking,
+		connect.WithSchema(bookingServiceMethods.ByName("WatchBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceListDriverOffersHandler := connect.NewUnaryHandler(
+		BookingServiceListDriverOffersProcedure,
+		svc.ListDriverOffers,
+		connect.WithSchema(bookingServiceMethods.ByName("ListDriverOffers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceRespondToOfferHandler := connect.NewUnaryHandler(
+		BookingServiceRespondToOfferProcedure,
+		svc.RespondToOffer,
+		connect.WithSchema(bookingServiceMethods.ByName("RespondToOffer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceEstimateFareHandler := connect.NewUnaryHandler(
+		BookingServiceEstimateFareProcedure,
+		svc.EstimateFare,
+		connect.WithSchema(bookingServiceMethods.ByName("EstimateFare")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceCreatePromoHandler := connect.NewUnaryHandler(
+		BookingServiceCreatePromoProcedure,
+		svc.CreatePromo,
+		connect.WithSchema(bookingServiceMethods.ByName("CreatePromo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceGetPromoHandler := connect.NewUnaryHandler(
+		BookingServiceGetPromoProcedure,
+		svc.GetPromo,
+		connect.WithSchema(bookingServiceMethods.ByName("GetPromo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceCompleteBookingHandler := connect.NewUnaryHandler(
+		BookingServiceCompleteBookingProcedure,
+		svc.CompleteBooking,
+		connect.WithSchema(bookingServiceMethods.ByName("CompleteBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceCancelBookingHandler := connect.NewUnaryHandler(
+		BookingServiceCancelBookingProcedure,
+		svc.CancelBooking,
+		connect.WithSchema(bookingServiceMethods.ByName("CancelBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceReportNoShowHandler := connect.NewUnaryHandler(
+		BookingServiceReportNoShowProcedure,
+		svc.ReportNoShow,
+		connect.WithSchema(bookingServiceMethods.ByName("ReportNoShow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceRefundBookingHandler := connect.NewUnaryHandler(
+		BookingServiceRefundBookingProcedure,
+		svc.RefundBooking,
+		connect.WithSchema(bookingServiceMethods.ByName("RefundBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceGetReceiptHandler := connect.NewUnaryHandler(
+		BookingServiceGetReceiptProcedure,
+		svc.GetReceipt,
+		connect.WithSchema(bookingServiceMethods.ByName("GetReceipt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceGetAccountBalanceHandler := connect.NewUnaryHandler(
+		BookingServiceGetAccountBalanceProcedure,
+		svc.GetAccountBalance,
+		connect.WithSchema(bookingServiceMethods.ByName("GetAccountBalance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceGetAccountStatementHandler := connect.NewUnaryHandler(
+		BookingServiceGetAccountStatementProcedure,
+		svc.GetAccountStatement,
+		connect.WithSchema(bookingServiceMethods.ByName("GetAccountStatement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/booking.v1.BookingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BookingServiceCreateBookingProcedure:
+			bookingServiceCreateBookingHandler.ServeHTTP(w, r)
+		case BookingServiceGetBookingProcedure:
+			bookingServiceGetBookingHandler.ServeHTTP(w, r)
+		case BookingServiceListBookingsProcedure:
+			bookingServiceListBookingsHandler.ServeHTTP(w, r)
+		case BookingServiceGetBookingSagaProcedure:
+			bookingServiceGetBookingSagaHandler.ServeHTTP(w, r)
+		case BookingServiceWatchBookingProcedure:
+			bookingServiceWatchBookingHandler.ServeHTTP(w, r)
+		case BookingServiceListDriverOffersProcedure:
+			bookingServiceListDriverOffersHandler.ServeHTTP(w, r)
+		case BookingServiceRespondToOfferProcedure:
+			bookingServiceRespondToOfferHandler.ServeHTTP(w, r)
+		case BookingServiceEstimateFareProcedure:
+			bookingServiceEstimateFareHandler.ServeHTTP(w, r)
+		case BookingServiceCreatePromoProcedure:
+			bookingServiceCreatePromoHandler.ServeHTTP(w, r)
+		case BookingServiceGetPromoProcedure:
+			bookingServiceGetPromoHandler.ServeHTTP(w, r)
+		case BookingServiceCompleteBookingProcedure:
+			bookingServiceCompleteBookingHandler.ServeHTTP(w, r)
+		case BookingServiceCancelBookingProcedure:
+			bookingServiceCancelBookingHandler.ServeHTTP(w, r)
+		case BookingServiceReportNoShowProcedure:
+			bookingServiceReportNoShowHandler.ServeHTTP(w, r)
+		case BookingServiceRefundBookingProcedure:
+			bookingServiceRefundBookingHandler.ServeHTTP(w, r)
+		case BookingServiceGetReceiptProcedure:
+			bookingServiceGetReceiptHandler.ServeHTTP(w, r)
+		case BookingServiceGetAccountBalanceProcedure:
+			bookingServiceGetAccountBalanceHandler.ServeHTTP(w, r)
+		case BookingServiceGetAccountStatementProcedure:
+			bookingServiceGetAccountStatementHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBookingServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBookingServiceHandler struct{}
+
+func (UnimplementedBookingServiceHandler) CreateBooking(context.Context, *connect.Request[v1.CreateBookingRequest]) (*connect.Response[v1.CreateBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.CreateBooking is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) GetBooking(context.Context, *connect.Request[v1.GetBookingRequest]) (*connect.Response[v1.GetBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.GetBooking is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) ListBookings(context.Context, *connect.Request[v1.ListBookingsRequest]) (*connect.Response[v1.ListBookingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.ListBookings is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) GetBookingSaga(context.Context, *connect.Request[v1.GetBookingSagaRequest]) (*connect.Response[v1.GetBookingSagaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.GetBookingSaga is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) WatchBooking(context.Context, *connect.Request[v1.WatchBookingRequest], *connect.ServerStream[v1.WatchBookingResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.WatchBooking is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) ListDriverOffers(context.Context, *connect.Request[v1.ListDriverOffersRequest]) (*connect.Response[v1.ListDriverOffersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.ListDriverOffers is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) RespondToOffer(context.Context, *connect.Request[v1.RespondToOfferRequest]) (*connect.Response[v1.RespondToOfferResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.RespondToOffer is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) EstimateFare(context.Context, *connect.Request[v1.EstimateFareRequest]) (*connect.Response[v1.EstimateFareResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.EstimateFare is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) CreatePromo(context.Context, *connect.Request[v1.CreatePromoRequest]) (*connect.Response[v1.CreatePromoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.CreatePromo is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) GetPromo(context.Context, *connect.Request[v1.GetPromoRequest]) (*connect.Response[v1.GetPromoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.GetPromo is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) CompleteBooking(context.Context, *connect.Request[v1.CompleteBookingRequest]) (*connect.Response[v1.CompleteBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.CompleteBooking is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) CancelBooking(context.Context, *connect.Request[v1.CancelBookingRequest]) (*connect.Response[v1.CancelBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.CancelBooking is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) ReportNoShow(context.Context, *connect.Request[v1.ReportNoShowRequest]) (*connect.Response[v1.ReportNoShowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.ReportNoShow is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) RefundBooking(context.Context, *connect.Request[v1.RefundBookingRequest]) (*connect.Response[v1.RefundBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.RefundBooking is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) GetReceipt(context.Context, *connect.Request[v1.GetReceiptRequest]) (*connect.Response[httpbody.HttpBody], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.GetReceipt is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) GetAccountBalance(context.Context, *connect.Request[v1.GetAccountBalanceRequest]) (*connect.Response[v1.GetAccountBalanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.GetAccountBalance is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) GetAccountStatement(context.Context, *connect.Request[v1.GetAccountStatementRequest]) (*connect.Response[v1.GetAccountStatementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("booking.v1.BookingService.GetAccountStatement is not implemented"))
+}
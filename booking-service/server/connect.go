@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/golang_falcon_task/booking-service/internal/service"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"github.com/golang_falcon_task/booking-service/proto/booking/v1/v1connect"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+)
+
+// connectService exposes BookingService to the generated Connect handler,
+// which serves both the Connect and gRPC-Web protocols.
+type connectService struct {
+	svc *service.BookingService
+}
+
+var _ v1connect.BookingServiceHandler = (*connectService)(nil)
+
+func (s *connectService) CreateBooking(ctx context.Context, req *connect.Request[pb.CreateBookingRequest]) (*connect.Response[pb.CreateBookingResponse], error) {
+	res, err := s.svc.CreateBooking(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) GetBooking(ctx context.Context, req *connect.Request[pb.GetBookingRequest]) (*connect.Response[pb.GetBookingResponse], error) {
+	res, err := s.svc.GetBooking(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) ListBookings(ctx context.Context, req *connect.Request[pb.ListBookingsRequest]) (*connect.Response[pb.ListBookingsResponse], error) {
+	res, err := s.svc.ListBookings(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) GetBookingSaga(ctx context.Context, req *connect.Request[pb.GetBookingSagaRequest]) (*connect.Response[pb.GetBookingSagaResponse], error) {
+	res, err := s.svc.GetBookingSaga(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) WatchBooking(ctx context.Context, req *connect.Request[pb.WatchBookingRequest], stream *connect.ServerStream[pb.WatchBookingResponse]) error {
+	return s.svc.Watch(ctx, req.Msg, stream.Send)
+}
+
+func (s *connectService) ListDriverOffers(ctx context.Context, req *connect.Request[pb.ListDriverOffersRequest]) (*connect.Response[pb.ListDriverOffersResponse], error) {
+	res, err := s.svc.ListDriverOffers(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) RespondToOffer(ctx context.Context, req *connect.Request[pb.RespondToOfferRequest]) (*connect.Response[pb.RespondToOfferResponse], error) {
+	res, err := s.svc.RespondToOffer(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) EstimateFare(ctx context.Context, req *connect.Request[pb.EstimateFareRequest]) (*connect.Response[pb.EstimateFareResponse], error) {
+	res, err := s.svc.EstimateFare(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) CreatePromo(ctx context.Context, req *connect.Request[pb.CreatePromoRequest]) (*connect.Response[pb.CreatePromoResponse], error) {
+	res, err := s.svc.CreatePromo(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) GetPromo(ctx context.Context, req *connect.Request[pb.GetPromoRequest]) (*connect.Response[pb.GetPromoResponse], error) {
+	res, err := s.svc.GetPromo(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) CompleteBooking(ctx context.Context, req *connect.Request[pb.CompleteBookingRequest]) (*connect.Response[pb.CompleteBookingResponse], error) {
+	res, err := s.svc.CompleteBooking(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) CancelBooking(ctx context.Context, req *connect.Request[pb.CancelBookingRequest]) (*connect.Response[pb.CancelBookingResponse], error) {
+	res, err := s.svc.CancelBooking(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) ReportNoShow(ctx context.Context, req *connect.Request[pb.ReportNoShowRequest]) (*connect.Response[pb.ReportNoShowResponse], error) {
+	res, err := s.svc.ReportNoShow(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) RefundBooking(ctx context.Context, req *connect.Request[pb.RefundBookingRequest]) (*connect.Response[pb.RefundBookingResponse], error) {
+	res, err := s.svc.RefundBooking(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) GetReceipt(ctx context.Context, req *connect.Request[pb.GetReceiptRequest]) (*connect.Response[httpbody.HttpBody], error) {
+	res, err := s.svc.GetReceipt(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) GetAccountBalance(ctx context.Context, req *connect.Request[pb.GetAccountBalanceRequest]) (*connect.Response[pb.GetAccountBalanceResponse], error) {
+	res, err := s.svc.GetAccountBalance(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) GetAccountStatement(ctx context.Context, req *connect.Request[pb.GetAccountStatementRequest]) (*connect.Response[pb.GetAccountStatementResponse], error) {
+	res, err := s.svc.GetAccountStatement(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,270 @@
+// Package server wires BookingService into a gRPC server with the production
+// interceptor chain, and serves it over Connect and gRPC-Web on the same port.
+// It is used by cmd/main.go and by the end-to-end harness.
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/golang_falcon_task/booking-service/internal/dispatch"
+	"github.com/golang_falcon_task/booking-service/internal/middleware"
+	"github.com/golang_falcon_task/booking-service/internal/outbox"
+	"github.com/golang_falcon_task/booking-service/internal/payments"
+	"github.com/golang_falcon_task/booking-service/internal/pricing"
+	"github.com/golang_falcon_task/booking-service/internal/schedule"
+	"github.com/golang_falcon_task/booking-service/internal/service"
+	"github.com/golang_falcon_task/booking-service/internal/store"
+	"github.com/golang_falcon_task/booking-service/internal/surge"
+	pb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"github.com/golang_falcon_task/booking-service/proto/booking/v1/v1connect"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// Options configures the server.
+type Options struct {
+	Logger *logrus.Logger
+
+	// DB backs the Postgres store. When nil, an in-memory store seeded with
+	// the docker/init.sql fixtures is used instead.
+	DB *pgxpool.Pool
+
+	// Broker receives the domain events relayed from the outbox. When nil,
+	// an in-process broker without subscribers is used.
+	Broker outbox.Broker
+
+	// OutboxPollInterval is how often the relay publishes pending events.
+	// Defaults to one second.
+	OutboxPollInterval time.Duration
+
+	// Users and Rides connect to UserService and RideService, which the
+	// booking saga calls, and Drivers to DriverService, which dispatch
+	// calls. All are required.
+	Users   grpc.ClientConnInterface
+	Rides   grpc.ClientConnInterface
+	Drivers grpc.ClientConnInterface
+
+	// Tariff prices bookings. Defaults to pricing.Default, the tariff
+	// shipped with the service.
+	Tariff *pricing.Tariff
+
+	// SurgeZones are the zones fares surge in. Defaults to
+	// surge.DefaultZones, the zones shipped with the service.
+	SurgeZones []*surge.Zone
+
+	// Surge tunes how demand becomes a surge multiplier. Zero fields take
+	// the defaults of surge.DefaultConfig, except Smoothing.
+	Surge surge.Config
+
+	// Dispatch tunes the engine that offers bookings to drivers. Zero
+	// fields take the defaults of dispatch.DefaultConfig.
+	Dispatch dispatch.Config
+
+	// Schedule bounds how far ahead bookings can be scheduled and tunes the
+	// scheduler that reminds riders of them and releases them to dispatch.
+	// Zero fields take the defaults of schedule.DefaultConfig.
+	Schedule schedule.Config
+
+	// Payments charges riders for completed rides and cancellations.
+	// Defaults to a payments.FakeProvider keeping
+	// payments.DefaultCommissionRate; the zero Cancellation policy charges
+	// no cancellation fees.
+	Payments payments.Config
+
+	// SagaRecoveryInterval is how often RunSagaRecovery looks for stale
+	// sagas. Defaults to five seconds.
+	SagaRecoveryInterval time.Duration
+
+	// SagaStaleAfter is how long an active saga must go without progress
+	// before it is resumed. Defaults to thirty seconds.
+	SagaStaleAfter time.Duration
+}
+
+// Server is BookingService behind the logging, metrics and validation
+// interceptors. The embedded gRPC server serves native gRPC clients; Handler
+// adds the Connect and gRPC-Web protocols for browsers.
+type Server struct {
+	*grpc.Server
+
+	// Relay publishes the events recorded by the store; run it with Relay.Run.
+	Relay *outbox.Relay
+
+	// Dispatcher offers pending bookings to drivers; run it with
+	// Dispatcher.Run.
+	Dispatcher *dispatch.Engine
+
+	// Scheduler reminds riders of scheduled bookings and releases them to
+	// dispatch; run it with Scheduler.Run.
+	Scheduler *schedule.Scheduler
+
+	bookings             *service.BookingService
+	feed                 *outbox.PGFeed
+	log                  *logrus.Logger
+	sagaRecoveryInterval time.Duration
+	sagaStaleAfter       time.Duration
+	connect              *http.ServeMux
+}
+
+// New creates a Server with BookingService registered.
+func New(opts Options) (*Server, error) {
+	var (
+		bookingStore interface {
+			service.BookingStore
+			dispatch.Store
+			schedule.Store
+		}
+		events outbox.Store
+		feed   outbox.Feed
+		pgFeed *outbox.PGFeed
+	)
+	if opts.DB != nil {
+		bookingStore = store.NewPGBookingStore(opts.DB)
+		events = outbox.NewPGStore(opts.DB)
+		pgFeed = outbox.NewPGFeed(opts.DB)
+		feed = pgFeed
+	} else {
+		memStore := store.NewMemBookingStore()
+		if err := memStore.SeedDemoData(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to seed in-memory store: %v", err)
+		}
+		bookingStore = memStore
+		events = memStore.Outbox()
+		feed = memStore.Outbox()
+	}
+	if opts.Users == nil || opts.Rides == nil || opts.Drivers == nil {
+		return nil, fmt.Errorf("user, ride and driver service connections are required")
+	}
+	drivers := driverpb.NewDriverServiceClient(opts.Drivers)
+	users := userpb.NewUserServiceClient(opts.Users)
+	tariff := opts.Tariff
+	if tariff == nil {
+		tariff = pricing.Default
+	}
+	zones := opts.SurgeZones
+	if zones == nil {
+		zones = surge.DefaultZones
+	}
+	pay := opts.Payments
+	if pay.Provider == nil {
+		pay.Provider = payments.NewFakeProvider()
+	}
+	if pay.CommissionRate == 0 {
+		pay.CommissionRate = payments.DefaultCommissionRate
+	}
+	if pay.CommissionRate < 0 || pay.CommissionRate > 1 {
+		return nil, fmt.Errorf("commission rate must be between 0 and 1, got %g", pay.CommissionRate)
+	}
+	bookingService := service.NewBookingService(bookingStore, feed, users,
+		ridepb.NewRideServiceClient(opts.Rides), drivers, tariff, surge.New(zones, opts.Surge), pay, opts.Schedule, opts.Logger)
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		middleware.LoggingInterceptor(opts.Logger), // Logs all requests and responses
+		middleware.MetricsInterceptor(),            // Captures Prometheus metrics
+		middleware.ValidationInterceptor(),         // Enforces buf.validate rules from the .proto files
+	}
+
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		middleware.StreamLoggingInterceptor(opts.Logger), // Logs the start and end of streams
+		middleware.StreamMetricsInterceptor(),            // Captures Prometheus metrics
+		middleware.StreamValidationInterceptor(),         // Enforces buf.validate rules on received messages
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	pb.RegisterBookingServiceServer(grpcServer, bookingService)
+
+	// Enable reflection for testing
+	reflection.Register(grpcServer)
+
+	connectMux := http.NewServeMux()
+	connectMux.Handle(v1connect.NewBookingServiceHandler(
+		&connectService{svc: bookingService},
+		connect.WithInterceptors(
+			middleware.ConnectInterceptor(interceptors...),
+			middleware.ConnectStreamInterceptor(streamInterceptors...),
+		),
+	))
+
+	broker := opts.Broker
+	if broker == nil {
+		broker = outbox.NewInProcessBroker()
+	}
+	interval := opts.OutboxPollInterval
+	if interval == 0 {
+		interval = time.Second
+	}
+	relay := outbox.NewRelay(events, broker, interval, opts.Logger)
+
+	recoveryInterval := opts.SagaRecoveryInterval
+	if recoveryInterval == 0 {
+		recoveryInterval = 5 * time.Second
+	}
+	staleAfter := opts.SagaStaleAfter
+	if staleAfter == 0 {
+		staleAfter = 30 * time.Second
+	}
+
+	return &Server{
+		Server:               grpcServer,
+		Relay:                relay,
+		Dispatcher:           dispatch.New(bookingStore, feed, drivers, users, opts.Dispatch, opts.Logger),
+		Scheduler:            schedule.New(bookingStore, opts.Schedule, opts.Logger),
+		bookings:             bookingService,
+		feed:                 pgFeed,
+		log:                  opts.Logger,
+		sagaRecoveryInterval: recoveryInterval,
+		sagaStaleAfter:       staleAfter,
+		connect:              connectMux,
+	}, nil
+}
+
+// ListenForChanges wakes WatchBooking streams as soon as events are recorded,
+// until ctx is canceled. With the in-memory store it returns immediately, as
+// that store wakes them itself; without it, Postgres-backed streams only
+// notice events by polling.
+func (s *Server) ListenForChanges(ctx context.Context) {
+	if s.feed != nil {
+		s.feed.Listen(ctx, s.log)
+	}
+}
+
+// RunSagaRecovery resumes stale booking sagas until ctx is canceled.
+func (s *Server) RunSagaRecovery(ctx context.Context) {
+	s.bookings.RunSagaRecovery(ctx, s.sagaRecoveryInterval, s.sagaStaleAfter)
+}
+
+// ResumeSagas runs one recovery pass, resuming active sagas that have not
+// progressed for staleAfter, and returns how many it resumed.
+func (s *Server) ResumeSagas(ctx context.Context, staleAfter time.Duration) (int, error) {
+	return s.bookings.ResumeSagas(ctx, staleAfter)
+}
+
+// Handler serves native gRPC, Connect and gRPC-Web on one port, over HTTP/1.1
+// and cleartext HTTP/2 (h2c). Native gRPC requests go to the embedded gRPC
+// server; everything else is handled by the Connect handler.
+func (s *Server) Handler() http.Handler {
+	return h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType := r.Header.Get("Content-Type")
+		if r.ProtoMajor == 2 && strings.HasPrefix(contentType, "application/grpc") &&
+			!strings.HasPrefix(contentType, "application/grpc-web") {
+			s.Server.ServeHTTP(w, r)
+			return
+		}
+		s.connect.ServeHTTP(w, r)
+	}), &http2.Server{})
+}
@@ -10,31 +10,319 @@ INSERT INTO users (name) VALUES
 ('Adeel Ahmed'),
 ('Zaid Iqbal');
 
+-- Create Wallets table. Riders top up a wallet per currency and pay for
+-- bookings from it: a booking holds money, set aside until the ride is
+-- completed and it is captured, or the booking is cancelled and it is
+-- released. Captured money can be refunded. Amounts are in minor units of
+-- currency.
+CREATE TABLE wallets (
+user_id INT NOT NULL REFERENCES users(user_id),
+currency TEXT NOT NULL,
+balance BIGINT NOT NULL DEFAULT 0 CHECK (balance >= 0),
+held BIGINT NOT NULL DEFAULT 0 CHECK (held >= 0 AND held <= balance), -- Set aside for held holds
+PRIMARY KEY (user_id, currency)
+);
+
+CREATE TABLE wallet_holds (
+hold_id SERIAL PRIMARY KEY,
+user_id INT NOT NULL,
+currency TEXT NOT NULL,
+amount BIGINT NOT NULL CHECK (amount > 0),
+captured BIGINT NOT NULL DEFAULT 0 CHECK (captured BETWEEN 0 AND amount),
+refunded BIGINT NOT NULL DEFAULT 0 CHECK (refunded BETWEEN 0 AND captured), -- Given back of captured
+status TEXT NOT NULL, -- HELD, CAPTURED or RELEASED
+reference TEXT NOT NULL, -- What the hold pays for, e.g. a booking
+created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+UNIQUE (user_id, reference),
+FOREIGN KEY (user_id, currency) REFERENCES wallets(user_id, currency)
+);
+
+-- Every change to a wallet, newest last. Top-ups, captures and refunds
+-- change its balance, holds and releases the money available.
+CREATE TABLE wallet_transactions (
+transaction_id BIGSERIAL PRIMARY KEY,
+user_id INT NOT NULL,
+kind TEXT NOT NULL, -- TOP_UP, HOLD, CAPTURE, RELEASE or REFUND
+amount BIGINT NOT NULL, -- Negative when money is taken out
+currency TEXT NOT NULL,
+hold_id INT REFERENCES wallet_holds(hold_id), -- NULL for top-ups
+created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+FOREIGN KEY (user_id, currency) REFERENCES wallets(user_id, currency)
+);
+
+CREATE INDEX wallet_transactions_user ON wallet_transactions (user_id, transaction_id);
+
+-- Create Drivers table
+CREATE TABLE drivers (
+driver_id SERIAL PRIMARY KEY,
+name TEXT NOT NULL,
+phone TEXT NOT NULL,
+license_number TEXT NOT NULL UNIQUE,
+vehicle_make TEXT NOT NULL,
+vehicle_model TEXT NOT NULL,
+vehicle_color TEXT NOT NULL DEFAULT '',
+plate_number TEXT NOT NULL UNIQUE,
+seats INT NOT NULL,
+status TEXT NOT NULL DEFAULT 'OFFLINE' -- OFFLINE, AVAILABLE or ON_TRIP
+);
+
+-- Seed Drivers table
+INSERT INTO drivers (name, phone, license_number, vehicle_make, vehicle_model, vehicle_color, plate_number, seats, status) VALUES
+('Ali Raza', '+923001234567', 'LHR-10001', 'Toyota', 'Corolla', 'White', 'LEA-1234', 4, 'AVAILABLE'),
+('Bilal Khan', '+923007654321', 'LHR-10002', 'Suzuki', 'Alto', 'Silver', 'LEB-5678', 3, 'AVAILABLE'),
+('Sana Malik', '+923211112222', 'ISB-20001', 'Honda', 'City', 'Black', 'ICT-9012', 4, 'OFFLINE');
+
 -- Create Rides table
 CREATE TABLE rides (
 ride_id SERIAL PRIMARY KEY,
-source TEXT NOT NULL,
-destination TEXT NOT NULL,
-distance INT NOT NULL,
-cost INT NOT NULL
+source TEXT NOT NULL DEFAULT '', -- Label of the pickup, may be empty
+destination TEXT NOT NULL DEFAULT '', -- Label of the dropoff, may be empty
+distance INT NOT NULL, -- Great-circle kilometers from pickup to dropoff, computed by RideService
+cost BIGINT NOT NULL CHECK (cost >= 0), -- In minor units of currency, e.g. paisa
+currency TEXT NOT NULL DEFAULT 'PKR', -- ISO 4217 code; UpdateRide cannot change it
+request_id TEXT UNIQUE, -- Idempotency key of the CreateRide call that created the ride
+driver_id INT REFERENCES drivers(driver_id), -- Driver assigned to the ride, if any
+pickup_lat DOUBLE PRECISION, -- Where the rider is picked up; RideService requires it
+pickup_lng DOUBLE PRECISION,
+dropoff_lat DOUBLE PRECISION, -- Where the rider is dropped off; RideService requires it
+dropoff_lng DOUBLE PRECISION,
+CHECK ((pickup_lat IS NULL) = (pickup_lng IS NULL)),
+CHECK ((dropoff_lat IS NULL) = (dropoff_lng IS NULL))
 );
 
 -- Seed Rides table
-INSERT INTO rides (source, destination, distance, cost) VALUES
-('Downtown', 'Airport', 15, 150),
-('City Center', 'Mall', 8, 80),
-('Train Station', 'University', 12, 120);
+INSERT INTO rides (source, destination, distance, cost, pickup_lat, pickup_lng, dropoff_lat, dropoff_lng) VALUES
+('Downtown', 'Airport', 15, 15000, 31.5497, 74.2500, 31.5216, 74.4036),
+('City Center', 'Mall', 8, 8000, 31.5102, 74.3441, 31.4660, 74.2770),
+('Train Station', 'University', 12, 12000, 31.5770, 74.3361, 31.4740, 74.3000);
 
 -- Create Bookings table
 CREATE TABLE bookings (
 booking_id SERIAL PRIMARY KEY,
 user_id INT REFERENCES users(user_id),
 ride_id INT REFERENCES rides(ride_id),
-time TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+driver_id INT REFERENCES drivers(driver_id), -- Driver assigned to the booking, if any
+time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+status TEXT NOT NULL DEFAULT 'PENDING', -- SCHEDULED until shortly before a later pickup, PENDING until a driver accepts it, then CONFIRMED until they complete it (COMPLETED); EXPIRED if none did, CANCELLED by the rider or for a no-show
+pickup_lat DOUBLE PRECISION, -- Where dispatch looks for drivers; bookings without one are never offered
+pickup_lng DOUBLE PRECISION,
+tariff_version TEXT NOT NULL DEFAULT '', -- Tariff the ride's cost was computed with; empty for bookings that predate pricing
+quote_id TEXT NOT NULL DEFAULT '', -- Fare quote the booking was made with; empty for bookings that predate quotes
+promo_code TEXT NOT NULL DEFAULT '', -- Promo code the booking was made with, if any
+fare BIGINT NOT NULL DEFAULT 0, -- With a promo code, the fare before the discount, in minor units of currency
+discount BIGINT NOT NULL DEFAULT 0, -- With a promo code, what it took off the fare; the ride's cost is fare - discount
+currency TEXT NOT NULL DEFAULT '',
+payment_method TEXT NOT NULL DEFAULT 'CARD', -- CARD or WALLET
+hold_id INT NOT NULL DEFAULT 0, -- Wallet hold of the ride's cost, 0 if none
+cancellation_reason TEXT NOT NULL DEFAULT '', -- RIDER or NO_SHOW once CANCELLED
+cancellation_fee BIGINT NOT NULL DEFAULT 0, -- Charged for cancelling, in minor units of payment_currency
+charge_id TEXT NOT NULL DEFAULT '', -- Reference of the fare or fee the rider was charged, if any
+refunded BIGINT NOT NULL DEFAULT 0, -- Given back of the charge, in minor units of payment_currency
+refund_reason TEXT NOT NULL DEFAULT '', -- Of the latest refund
+payment_currency TEXT NOT NULL DEFAULT '',
+cost BIGINT, -- What the rider pays for the ride, fixed when booking, in minor units of payment_currency; NULL for bookings that predate it
+pickup_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- When the rider is picked up: the booking time, or later if SCHEDULED
+reminded_at TIMESTAMP, -- When the rider of a SCHEDULED booking was reminded of it
+assigned_at TIMESTAMP -- When a driver accepted the booking; NULL until one did, and for bookings that predate it
+);
+
+-- Seed Bookings table. They predate dispatch, so they are already confirmed.
+INSERT INTO bookings (user_id, ride_id, status) VALUES
+(1, 1, 'CONFIRMED'),
+(2, 2, 'CONFIRMED'),
+(3, 3, 'CONFIRMED');
+
+CREATE INDEX bookings_pending ON bookings (booking_id) WHERE status = 'PENDING';
+CREATE INDEX bookings_scheduled ON bookings (pickup_time) WHERE status = 'SCHEDULED';
+
+-- Create Booking Offers table. Dispatch offers each pending booking to one
+-- driver at a time, and a driver has at most one pending offer.
+CREATE TABLE booking_offers (
+offer_id SERIAL PRIMARY KEY,
+booking_id INT NOT NULL REFERENCES bookings(booking_id) ON DELETE CASCADE,
+driver_id INT NOT NULL REFERENCES drivers(driver_id) ON DELETE CASCADE,
+status TEXT NOT NULL DEFAULT 'PENDING', -- PENDING, ACCEPTED, DECLINED or EXPIRED
+distance_km DOUBLE PRECISION NOT NULL, -- From the driver to the pickup when offered
+created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+expires_at TIMESTAMPTZ NOT NULL,
+UNIQUE (booking_id, driver_id) -- A booking is never offered to the same driver twice
+);
+
+CREATE UNIQUE INDEX booking_offers_pending_booking ON booking_offers (booking_id) WHERE status = 'PENDING';
+CREATE UNIQUE INDEX booking_offers_pending_driver ON booking_offers (driver_id) WHERE status = 'PENDING';
+CREATE INDEX booking_offers_pending_expiry ON booking_offers (expires_at) WHERE status = 'PENDING';
+
+-- Create Outbox table. Services record domain events here in the same
+-- transaction as the write that caused them; each service's relay publishes
+-- its own rows (by source) and stamps published_at.
+CREATE TABLE outbox (
+id BIGSERIAL PRIMARY KEY,
+event_id UUID NOT NULL UNIQUE,
+source TEXT NOT NULL,
+topic TEXT NOT NULL,
+payload BYTEA NOT NULL,
+created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+published_at TIMESTAMPTZ
+);
+
+CREATE INDEX outbox_pending ON outbox (source, id) WHERE published_at IS NULL;
+
+-- Notify listeners on the "outbox" channel of every recorded event, so
+-- WatchBooking streams wake without polling.
+CREATE FUNCTION notify_outbox() RETURNS trigger AS $$
+BEGIN
+PERFORM pg_notify('outbox', NEW.id::text);
+RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER outbox_notify AFTER INSERT ON outbox FOR EACH ROW EXECUTE FUNCTION notify_outbox();
+
+-- Create Fare Quotes table. EstimateFare records every fare it quotes here,
+-- and CreateBooking charges it if the quote is booked before it expires.
+CREATE TABLE fare_quotes (
+quote_id UUID PRIMARY KEY,
+pickup_lat DOUBLE PRECISION NOT NULL,
+pickup_lng DOUBLE PRECISION NOT NULL,
+dropoff_lat DOUBLE PRECISION NOT NULL,
+dropoff_lng DOUBLE PRECISION NOT NULL,
+vehicle_class TEXT NOT NULL,
+distance INT NOT NULL,
+cost BIGINT NOT NULL, -- In minor units of currency, surge included
+currency TEXT NOT NULL,
+surge DOUBLE PRECISION NOT NULL DEFAULT 1, -- Surge multiplier of the pickup's zone when quoted
+zone TEXT NOT NULL DEFAULT '', -- Surge zone of the pickup, empty if none
+tariff_version TEXT NOT NULL,
+created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+expires_at TIMESTAMPTZ NOT NULL,
+used_at TIMESTAMPTZ, -- Set when a booking saga is started with the quote
+base_fare BIGINT NOT NULL DEFAULT 0, -- The parts of cost before the multipliers, in minor units of currency
+distance_fare BIGINT NOT NULL DEFAULT 0,
+time_fare BIGINT NOT NULL DEFAULT 0,
+duration_minutes INT NOT NULL DEFAULT 0, -- Estimated duration time_fare is charged for
+class_multiplier DOUBLE PRECISION NOT NULL DEFAULT 0, -- Of vehicle_class; 0 for quotes that predate the breakdown
+minimum_applied BOOLEAN NOT NULL DEFAULT false -- Whether cost was raised to the tariff's minimum fare
+);
+
+-- Create Booking Sagas table. BookingService records the progress of every
+-- CreateBooking here, so sagas interrupted by a crash can be resumed.
+CREATE TABLE booking_sagas (
+saga_id UUID PRIMARY KEY,
+user_id INT NOT NULL,
+source TEXT NOT NULL,
+destination TEXT NOT NULL,
+distance INT NOT NULL,
+cost BIGINT NOT NULL, -- In minor units of currency
+currency TEXT NOT NULL,
+pickup_lat DOUBLE PRECISION, -- Where the rider is picked up, if given
+pickup_lng DOUBLE PRECISION,
+dropoff_lat DOUBLE PRECISION, -- Where the rider is dropped off, if given
+dropoff_lng DOUBLE PRECISION,
+ride_id INT NOT NULL DEFAULT 0, -- Set once RideService created the ride
+status TEXT NOT NULL,
+step TEXT NOT NULL,
+booking_id INT NOT NULL DEFAULT 0,
+error TEXT NOT NULL DEFAULT '',
+version INT NOT NULL DEFAULT 0,
+created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+tariff_version TEXT NOT NULL DEFAULT '', -- Tariff cost was computed with
+quote_id TEXT NOT NULL DEFAULT '', -- Fare quote the saga was started with
+promo_code TEXT NOT NULL DEFAULT '', -- Promo code the saga redeemed, if any
+fare BIGINT NOT NULL DEFAULT 0, -- With a promo code, the quoted fare before the discount; cost is fare - discount
+discount BIGINT NOT NULL DEFAULT 0,
+payment_method TEXT NOT NULL DEFAULT 'CARD', -- CARD or WALLET
+hold_id INT NOT NULL DEFAULT 0, -- Wallet hold of cost, set once held
+pickup_time TIMESTAMPTZ -- Set if the booking is scheduled for a later pickup
+);
+
+CREATE INDEX booking_sagas_active ON booking_sagas (updated_at) WHERE status IN ('RUNNING', 'COMPENSATING');
+
+-- Create Promos table. CreatePromo starts promo code campaigns here, and
+-- CreateBooking counts every redemption of a code. Amounts are in minor
+-- units of currency, and limits of 0 mean no limit.
+CREATE TABLE promos (
+code TEXT PRIMARY KEY, -- Upper-cased
+type TEXT NOT NULL, -- PERCENT or FLAT
+currency TEXT NOT NULL DEFAULT '', -- Of the amounts; empty if the promo has none
+percent_off INT NOT NULL DEFAULT 0 CHECK (percent_off BETWEEN 0 AND 100),
+amount_off BIGINT NOT NULL DEFAULT 0 CHECK (amount_off >= 0), -- Of a FLAT promo
+max_discount BIGINT NOT NULL DEFAULT 0 CHECK (max_discount >= 0), -- Caps a PERCENT discount; 0 for no cap
+min_fare BIGINT NOT NULL DEFAULT 0 CHECK (min_fare >= 0), -- Smaller fares are not eligible
+vehicle_classes TEXT[] NOT NULL DEFAULT '{}', -- Eligible vehicle classes; empty for all
+starts_at TIMESTAMPTZ NOT NULL,
+ends_at TIMESTAMPTZ NOT NULL, -- The code cannot be redeemed from this time on
+max_redemptions INT NOT NULL DEFAULT 0, -- Across all riders
+max_per_user INT NOT NULL DEFAULT 0, -- Per rider
+redemptions INT NOT NULL DEFAULT 0, -- Rows in promo_redemptions for the code
+created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+CHECK (ends_at > starts_at),
+CHECK (max_redemptions = 0 OR redemptions <= max_redemptions)
+);
+
+-- Seed Promos table
+INSERT INTO promos (code, type, currency, percent_off, max_discount, starts_at, ends_at, max_per_user) VALUES
+('WELCOME20', 'PERCENT', 'PKR', 20, 20000, '2025-01-01T00:00:00Z', '2030-01-01T00:00:00Z', 1);
+
+-- Create Promo Redemptions table. A booking saga redeems its promo code when
+-- it starts, and gives the redemption back if it fails.
+CREATE TABLE promo_redemptions (
+saga_id UUID PRIMARY KEY REFERENCES booking_sagas(saga_id) ON DELETE CASCADE,
+code TEXT NOT NULL REFERENCES promos(code),
+user_id INT NOT NULL,
+created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX promo_redemptions_user ON promo_redemptions (code, user_id);
+
+-- Create Journal Entries and Postings tables, the double-entry ledger.
+-- CompleteBooking posts an entry for every completed booking, CancelBooking
+-- one for every cancellation fee charged and RefundBooking one for every
+-- refund. Each posting credits an account, or debits it if its amount is
+-- negative, and the postings of an entry sum to zero in each currency.
+-- Entries never change.
+CREATE TABLE journal_entries (
+entry_id BIGSERIAL PRIMARY KEY,
+booking_id INT NOT NULL REFERENCES bookings(booking_id),
+kind TEXT NOT NULL, -- RIDE_COMPLETED, CANCELLATION_FEE or REFUND
+reference TEXT NOT NULL DEFAULT '', -- Payment provider charge or refund ID, empty if nothing was charged
+created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A booking is charged once, but may be refunded several times.
+CREATE UNIQUE INDEX journal_entries_booking_kind ON journal_entries (booking_id, kind) WHERE kind <> 'REFUND';
+
+CREATE TABLE postings (
+entry_id BIGINT NOT NULL REFERENCES journal_entries(entry_id),
+account_type TEXT NOT NULL, -- RIDER, DRIVER, PLATFORM or PROMO
+owner_id INT NOT NULL DEFAULT 0, -- User or driver ID; 0 for the platform and promo accounts
+amount BIGINT NOT NULL CHECK (amount <> 0), -- In minor units of currency
+currency TEXT NOT NULL,
+PRIMARY KEY (entry_id, account_type, owner_id)
 );
 
--- Seed Bookings table
-INSERT INTO bookings (user_id, ride_id) VALUES
-(1, 1),
-(2, 2),
-(3, 3);
+CREATE INDEX postings_account ON postings (account_type, owner_id, entry_id);
+
+-- Reject an entry whose postings do not balance, once the transaction that
+-- posted it commits.
+CREATE FUNCTION check_entry_balanced() RETURNS trigger AS $$
+BEGIN
+IF EXISTS (SELECT 1 FROM postings WHERE entry_id = NEW.entry_id GROUP BY currency HAVING SUM(amount) <> 0) THEN
+RAISE EXCEPTION 'journal entry % does not balance', NEW.entry_id;
+END IF;
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER postings_balanced AFTER INSERT ON postings
+DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION check_entry_balanced();
+
+CREATE FUNCTION forbid_ledger_changes() RETURNS trigger AS $$
+BEGIN
+RAISE EXCEPTION '% rows cannot be changed', TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER journal_entries_immutable BEFORE UPDATE OR DELETE ON journal_entries FOR EACH ROW EXECUTE FUNCTION forbid_ledger_changes();
+CREATE TRIGGER postings_immutable BEFORE UPDATE OR DELETE ON postings FOR EACH ROW EXECUTE FUNCTION forbid_ledger_changes();
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"github.com/golang_falcon_task/driver-service/internal/config"
+	"github.com/golang_falcon_task/driver-service/internal/db"
+	"github.com/golang_falcon_task/driver-service/internal/logging"
+	"github.com/golang_falcon_task/driver-service/internal/metrics"
+	"github.com/golang_falcon_task/driver-service/internal/outbox"
+	"github.com/golang_falcon_task/driver-service/server"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"net"
+	"net/http"
+)
+
+func main() {
+	// Initialize logger
+	logging.InitLogger()
+	log := logging.Logger
+
+	// Load configuration
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	// Initialize metrics
+	metrics.InitMetrics()
+	metrics.StartMetricsServer(":9008")
+
+	// Initialize database, unless running on the in-memory store
+	var database *pgxpool.Pool
+	if cfg.StoreBackend == config.StoreMemory {
+		log.Println("Using in-memory store")
+	} else {
+		database, err = db.InitDB(cfg, log)
+		if err != nil {
+			log.Fatalf("failed to connect to database: %v", err)
+		}
+		defer database.Close()
+	}
+
+	// Pick the broker outbox events are relayed to
+	var broker outbox.Broker
+	if cfg.EventBroker == config.BrokerFile {
+		fileBroker, err := outbox.NewFileBroker(cfg.EventFile)
+		if err != nil {
+			log.Fatalf("failed to open event file: %v", err)
+		}
+		defer fileBroker.Close()
+		log.Printf("Publishing events to %s", cfg.EventFile)
+		broker = fileBroker
+	} else {
+		broker = outbox.NewInProcessBroker()
+	}
+
+	srv, err := server.New(server.Options{
+		Logger:             log,
+		DB:                 database,
+		Broker:             broker,
+		OutboxPollInterval: cfg.OutboxPollInterval,
+		LocationMaxAge:     cfg.LocationMaxAge,
+	})
+	if err != nil {
+		log.Fatalf("failed to create server: %v", err)
+	}
+
+	// Relay outbox events in the background
+	go srv.Relay.Run(context.Background())
+
+	// Drop driver locations once they go stale
+	go srv.Locations.RunEviction(context.Background(), cfg.LocationMaxAge)
+
+	// Listen for gRPC, Connect and gRPC-Web on one port
+	lis, err := net.Listen("tcp", ":50054")
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	// Start the REST/JSON gateway, which forwards to the gRPC server
+	conn, err := grpc.NewClient("localhost:50054", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to create gateway client: %v", err)
+	}
+	defer conn.Close()
+
+	gateway, err := server.NewGateway(context.Background(), conn)
+	if err != nil {
+		log.Fatalf("failed to create gateway: %v", err)
+	}
+	go func() {
+		log.Println("DriverService REST gateway is running on port 8054")
+		if err := http.ListenAndServe(":8054", gateway); err != nil {
+			log.Fatalf("failed to serve gateway: %v", err)
+		}
+	}()
+
+	log.Println("DriverService is running on port 50054 (gRPC, Connect and gRPC-Web)")
+	if err := http.Serve(lis, srv.Handler()); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"fmt"
+	"github.com/joho/godotenv"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Store backends selectable with STORE_BACKEND.
+const (
+	StorePostgres = "postgres"
+	StoreMemory   = "memory"
+)
+
+// Event brokers selectable with EVENT_BROKER.
+const (
+	BrokerInProcess = "inprocess"
+	BrokerFile      = "file"
+)
+
+type Config struct {
+	// StoreBackend selects the store implementation, StorePostgres or StoreMemory.
+	StoreBackend string
+
+	DBHost     string
+	DBPort     string
+	DBUser     string
+	DBPassword string
+	DBName     string
+	DBSSLMode  string
+
+	// Connection pool tuning
+	DBMaxConns          int32
+	DBMinConns          int32
+	DBMaxConnLifetime   time.Duration
+	DBMaxConnIdleTime   time.Duration
+	DBHealthCheckPeriod time.Duration
+
+	// DBStatementTimeout bounds how long Postgres runs any single query.
+	DBStatementTimeout time.Duration
+
+	// DBConnectTimeout is the total time to wait for Postgres at startup.
+	DBConnectTimeout time.Duration
+
+	// EventBroker selects where outbox events are published, BrokerInProcess or BrokerFile.
+	EventBroker string
+
+	// EventFile is the file BrokerFile appends events to.
+	EventFile string
+
+	// OutboxPollInterval is how often the relay publishes pending events.
+	OutboxPollInterval time.Duration
+
+	// LocationMaxAge is how long a driver's reported location stays usable.
+	LocationMaxAge time.Duration
+}
+
+func LoadConfig() (*Config, error) {
+	err := godotenv.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{
+		StoreBackend: getEnv("STORE_BACKEND", StorePostgres),
+		DBHost:       os.Getenv("DB_HOST"),
+		DBPort:       os.Getenv("DB_PORT"),
+		DBUser:       os.Getenv("DB_USER"),
+		DBPassword:   os.Getenv("DB_PASSWORD"),
+		DBName:       os.Getenv("DB_NAME"),
+		DBSSLMode:    getEnv("DB_SSLMODE", "disable"),
+		EventBroker:  getEnv("EVENT_BROKER", BrokerInProcess),
+		EventFile:    getEnv("EVENT_FILE", "events/driver-service.jsonl"),
+	}
+
+	if cfg.DBMaxConns, err = getEnvInt32("DB_MAX_CONNS", 10); err != nil {
+		return nil, err
+	}
+	if cfg.DBMinConns, err = getEnvInt32("DB_MIN_CONNS", 0); err != nil {
+		return nil, err
+	}
+	if cfg.DBMaxConnLifetime, err = getEnvDuration("DB_MAX_CONN_LIFETIME", time.Hour); err != nil {
+		return nil, err
+	}
+	if cfg.DBMaxConnIdleTime, err = getEnvDuration("DB_MAX_CONN_IDLE_TIME", 30*time.Minute); err != nil {
+		return nil, err
+	}
+	if cfg.DBHealthCheckPeriod, err = getEnvDuration("DB_HEALTH_CHECK_PERIOD", time.Minute); err != nil {
+		return nil, err
+	}
+	if cfg.DBStatementTimeout, err = getEnvDuration("DB_STATEMENT_TIMEOUT", 5*time.Second); err != nil {
+		return nil, err
+	}
+	if cfg.DBConnectTimeout, err = getEnvDuration("DB_CONNECT_TIMEOUT", 30*time.Second); err != nil {
+		return nil, err
+	}
+	if cfg.OutboxPollInterval, err = getEnvDuration("OUTBOX_POLL_INTERVAL", time.Second); err != nil {
+		return nil, err
+	}
+
+	if cfg.LocationMaxAge, err = getEnvDuration("LOCATION_MAX_AGE", 2*time.Minute); err != nil {
+		return nil, err
+	}
+
+	if cfg.StoreBackend != StorePostgres && cfg.StoreBackend != StoreMemory {
+		return nil, fmt.Errorf("STORE_BACKEND must be %q or %q, got %q", StorePostgres, StoreMemory, cfg.StoreBackend)
+	}
+	if cfg.EventBroker != BrokerInProcess && cfg.EventBroker != BrokerFile {
+		return nil, fmt.Errorf("EVENT_BROKER must be %q or %q, got %q", BrokerInProcess, BrokerFile, cfg.EventBroker)
+	}
+	if cfg.OutboxPollInterval <= 0 {
+		return nil, fmt.Errorf("OUTBOX_POLL_INTERVAL must be positive, got %s", cfg.OutboxPollInterval)
+	}
+	if cfg.LocationMaxAge <= 0 {
+		return nil, fmt.Errorf("LOCATION_MAX_AGE must be positive, got %s", cfg.LocationMaxAge)
+	}
+	if cfg.DBMaxConns <= 0 {
+		return nil, fmt.Errorf("DB_MAX_CONNS must be positive, got %d", cfg.DBMaxConns)
+	}
+	if cfg.DBMinConns < 0 || cfg.DBMinConns > cfg.DBMaxConns {
+		return nil, fmt.Errorf("DB_MIN_CONNS must be between 0 and DB_MAX_CONNS, got %d", cfg.DBMinConns)
+	}
+
+	return cfg, nil
+}
+
+// getEnv returns the value of an environment variable or a fallback if it is unset.
+func getEnv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
+
+// getEnvInt32 parses an integer environment variable, returning fallback if it is unset.
+func getEnvInt32(key string, fallback int32) (int32, error) {
+	v := getEnv(key, "")
+	if v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", key, v, err)
+	}
+	return int32(n), nil
+}
+
+// getEnvDuration parses a duration environment variable (e.g. "30s"), returning fallback if it is unset.
+func getEnvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	v := getEnv(key, "")
+	if v == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", key, v, err)
+	}
+	return d, nil
+}
@@ -0,0 +1,29 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/golang_falcon_task/driver-service/internal/config"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDSN(t *testing.T) {
+	cfg := &config.Config{
+		DBHost:     "localhost",
+		DBPort:     "5432",
+		DBUser:     "usman",
+		DBPassword: "p@ss:w/rd?#",
+		DBName:     "careemDb",
+		DBSSLMode:  "require",
+	}
+
+	poolCfg, err := pgxpool.ParseConfig(BuildDSN(cfg))
+	require.NoError(t, err)
+	require.Equal(t, "usman", poolCfg.ConnConfig.User)
+	require.Equal(t, "p@ss:w/rd?#", poolCfg.ConnConfig.Password)
+	require.Equal(t, "localhost", poolCfg.ConnConfig.Host)
+	require.Equal(t, uint16(5432), poolCfg.ConnConfig.Port)
+	require.Equal(t, "careemDb", poolCfg.ConnConfig.Database)
+	require.NotNil(t, poolCfg.ConnConfig.TLSConfig)
+}
@@ -0,0 +1,32 @@
+// Package geo keeps the latest known position of each driver in memory and
+// finds the drivers nearest to a point.
+package geo
+
+import (
+	"math"
+)
+
+// EarthRadiusKm is the mean radius of the Earth.
+const EarthRadiusKm = 6371.0
+
+// kmPerDegree is the length of one degree of latitude.
+const kmPerDegree = math.Pi * EarthRadiusKm / 180
+
+// Point is a position in degrees.
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Distance returns the great-circle distance between a and b in kilometers.
+func Distance(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat, dLng := lat2-lat1, radians(b.Lng-a.Lng)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusKm * math.Asin(math.Sqrt(math.Min(h, 1)))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,236 @@
+package geo
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// CellSize is the side of an index grid cell in degrees, about 5.5 km of
+// latitude.
+const CellSize = 0.05
+
+const (
+	gridRows = int(180 / CellSize)
+	gridCols = int(360 / CellSize)
+)
+
+// cell is a grid cell, counted from the south pole and the antimeridian.
+type cell struct {
+	row, col int
+}
+
+func cellOf(p Point) cell {
+	row := int(math.Floor((p.Lat + 90) / CellSize))
+	col := int(math.Floor((p.Lng + 180) / CellSize))
+	return cell{row: min(max(row, 0), gridRows-1), col: ((col % gridCols) + gridCols) % gridCols}
+}
+
+// Location is the last position reported by a driver.
+type Location struct {
+	DriverID  int32
+	Point     Point
+	UpdatedAt time.Time
+}
+
+// Neighbor is a location found by Nearby, with its distance from the search
+// center.
+type Neighbor struct {
+	Location
+	DistanceKm float64
+}
+
+// Index holds the latest location of each driver in a grid of CellSize
+// cells, so a search only looks at the cells its radius covers. Locations
+// not updated for maxAge are stale: searches skip them and EvictStale drops
+// them. It is safe for concurrent use.
+type Index struct {
+	maxAge time.Duration
+	now    func() time.Time
+
+	mu        sync.RWMutex
+	locations map[int32]Location
+	cells     map[cell]map[int32]struct{}
+}
+
+// NewIndex creates an empty Index whose locations go stale after maxAge. A
+// zero maxAge keeps locations until they are removed.
+func NewIndex(maxAge time.Duration) *Index {
+	return &Index{
+		maxAge:    maxAge,
+		now:       time.Now,
+		locations: make(map[int32]Location),
+		cells:     make(map[cell]map[int32]struct{}),
+	}
+}
+
+// Update records p as the current location of a driver and returns it.
+func (x *Index) Update(driverID int32, p Point) Location {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	loc := Location{DriverID: driverID, Point: p, UpdatedAt: x.now()}
+	if prev, ok := x.locations[driverID]; ok {
+		x.unlink(prev)
+	}
+	x.locations[driverID] = loc
+	c := cellOf(p)
+	if x.cells[c] == nil {
+		x.cells[c] = make(map[int32]struct{})
+	}
+	x.cells[c][driverID] = struct{}{}
+	return loc
+}
+
+// Remove forgets the location of a driver.
+func (x *Index) Remove(driverID int32) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if loc, ok := x.locations[driverID]; ok {
+		x.unlink(loc)
+		delete(x.locations, driverID)
+	}
+}
+
+// unlink removes loc from its cell. The caller holds x.mu.
+func (x *Index) unlink(loc Location) {
+	c := cellOf(loc.Point)
+	delete(x.cells[c], loc.DriverID)
+	if len(x.cells[c]) == 0 {
+		delete(x.cells, c)
+	}
+}
+
+// Get returns the location of a driver, unless it is unknown or stale.
+func (x *Index) Get(driverID int32) (Location, bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	loc, ok := x.locations[driverID]
+	if !ok || x.stale(loc, x.now()) {
+		return Location{}, false
+	}
+	return loc, true
+}
+
+// Len returns the number of locations held, stale or not.
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.locations)
+}
+
+// Nearby returns up to k fresh locations within radiusKm of center, nearest
+// first, keeping only drivers for which keep returns true. A nil keep keeps
+// every driver and k <= 0 returns all matches.
+func (x *Index) Nearby(center Point, radiusKm float64, k int, keep func(driverID int32) bool) []Neighbor {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	now := x.now()
+	var found []Neighbor
+	consider := func(loc Location) {
+		if x.stale(loc, now) || (keep != nil && !keep(loc.DriverID)) {
+			return
+		}
+		if d := Distance(center, loc.Point); d <= radiusKm {
+			found = append(found, Neighbor{Location: loc, DistanceKm: d})
+		}
+	}
+
+	cells, ok := coveringCells(center, radiusKm, len(x.locations))
+	if ok {
+		for _, c := range cells {
+			for id := range x.cells[c] {
+				consider(x.locations[id])
+			}
+		}
+	} else {
+		// Scanning the locations is cheaper than visiting every cell.
+		for _, loc := range x.locations {
+			consider(loc)
+		}
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].DistanceKm != found[j].DistanceKm {
+			return found[i].DistanceKm < found[j].DistanceKm
+		}
+		return found[i].DriverID < found[j].DriverID
+	})
+	if k > 0 && len(found) > k {
+		found = found[:k]
+	}
+	return found
+}
+
+// coveringCells returns the cells of the bounding box of the circle around
+// center. It returns false instead when the box has more than limit cells.
+func coveringCells(center Point, radiusKm float64, limit int) ([]cell, bool) {
+	latSpan := radiusKm / kmPerDegree
+	south, north := math.Max(center.Lat-latSpan, -90), math.Min(center.Lat+latSpan, 90)
+	minRow, maxRow := cellOf(Point{Lat: south}).row, cellOf(Point{Lat: north}).row
+
+	// Longitude degrees shrink towards the poles, so the box is widest at
+	// the latitude nearest to one.
+	cols := gridCols
+	if cos := math.Cos(radians(math.Max(math.Abs(south), math.Abs(north)))); cos > 0 {
+		if lngSpan := latSpan / cos; lngSpan < 180 {
+			cols = int(math.Ceil(2*lngSpan/CellSize)) + 1
+		}
+	}
+	cols = min(cols, gridCols)
+
+	if (maxRow-minRow+1)*cols > limit {
+		return nil, false
+	}
+	firstCol := cellOf(Point{Lng: center.Lng - float64(cols-1)/2*CellSize}).col
+	if cols == gridCols {
+		firstCol = 0
+	}
+	cells := make([]cell, 0, (maxRow-minRow+1)*cols)
+	for row := minRow; row <= maxRow; row++ {
+		for i := 0; i < cols; i++ {
+			cells = append(cells, cell{row: row, col: (firstCol + i) % gridCols})
+		}
+	}
+	return cells, true
+}
+
+// EvictStale drops stale locations and returns how many it dropped.
+func (x *Index) EvictStale() int {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	now, evicted := x.now(), 0
+	for id, loc := range x.locations {
+		if x.stale(loc, now) {
+			x.unlink(loc)
+			delete(x.locations, id)
+			evicted++
+		}
+	}
+	return evicted
+}
+
+// RunEviction evicts stale locations every interval until ctx is canceled.
+func (x *Index) RunEviction(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			x.EvictStale()
+		}
+	}
+}
+
+func (x *Index) stale(loc Location, now time.Time) bool {
+	return x.maxAge > 0 && now.Sub(loc.UpdatedAt) > x.maxAge
+}
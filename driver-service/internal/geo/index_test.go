@@ -0,0 +1,170 @@
+package geo
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	lahore     = Point{Lat: 31.5204, Lng: 74.3587}
+	islamabad  = Point{Lat: 33.6844, Lng: 73.0479}
+	gulberg    = Point{Lat: 31.5120, Lng: 74.3450} // About 1.6 km from lahore
+	modelTown  = Point{Lat: 31.4840, Lng: 74.3250} // About 5.2 km from lahore
+	raiwind    = Point{Lat: 31.2500, Lng: 74.2167} // About 33 km from lahore
+	eastOfDate = Point{Lat: 0, Lng: 179.99}
+	westOfDate = Point{Lat: 0, Lng: -179.99}
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Point
+		expected float64
+	}{
+		{name: "Same Point", a: lahore, b: lahore, expected: 0},
+		{name: "Lahore To Islamabad", a: lahore, b: islamabad, expected: 270.1},
+		{name: "Across Antimeridian", a: eastOfDate, b: westOfDate, expected: 2.2},
+		{name: "Pole To Pole", a: Point{Lat: 90}, b: Point{Lat: -90}, expected: 20015.1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.InDelta(t, tt.expected, Distance(tt.a, tt.b), 0.1)
+			require.InDelta(t, tt.expected, Distance(tt.b, tt.a), 0.1)
+		})
+	}
+}
+
+// newTestIndex returns an index with a clock the test moves with advance.
+func newTestIndex(maxAge time.Duration) (*Index, func(time.Duration)) {
+	now := time.Date(2024, 12, 1, 10, 0, 0, 0, time.UTC)
+	x := NewIndex(maxAge)
+	x.now = func() time.Time { return now }
+	return x, func(d time.Duration) { now = now.Add(d) }
+}
+
+func ids(neighbors []Neighbor) []int32 {
+	out := make([]int32, 0, len(neighbors))
+	for _, n := range neighbors {
+		out = append(out, n.DriverID)
+	}
+	return out
+}
+
+func TestIndex_Nearby(t *testing.T) {
+	x, _ := newTestIndex(time.Minute)
+	x.Update(1, gulberg)
+	x.Update(2, modelTown)
+	x.Update(3, raiwind)
+	x.Update(4, islamabad)
+
+	tests := []struct {
+		name     string
+		center   Point
+		radiusKm float64
+		k        int
+		keep     func(int32) bool
+		expected []int32
+	}{
+		{name: "Nearest First", center: lahore, radiusKm: 50, expected: []int32{1, 2, 3}},
+		{name: "Within Radius", center: lahore, radiusKm: 10, expected: []int32{1, 2}},
+		{name: "Limited To K", center: lahore, radiusKm: 50, k: 1, expected: []int32{1}},
+		{name: "Filtered", center: lahore, radiusKm: 50, keep: func(id int32) bool { return id != 1 }, expected: []int32{2, 3}},
+		{name: "None In Range", center: Point{Lat: 24.8607, Lng: 67.0011}, radiusKm: 50, expected: []int32{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found := x.Nearby(tt.center, tt.radiusKm, tt.k, tt.keep)
+			require.Equal(t, tt.expected, ids(found))
+			for _, n := range found {
+				require.LessOrEqual(t, n.DistanceKm, tt.radiusKm)
+				require.InDelta(t, Distance(tt.center, n.Point), n.DistanceKm, 1e-9)
+			}
+		})
+	}
+}
+
+func TestIndex_Update(t *testing.T) {
+	x, advance := newTestIndex(time.Minute)
+	x.Update(1, raiwind)
+	advance(time.Second)
+	loc := x.Update(1, gulberg)
+
+	require.Equal(t, 1, x.Len())
+	got, ok := x.Get(1)
+	require.True(t, ok)
+	require.Equal(t, loc, got)
+	require.Equal(t, []int32{1}, ids(x.Nearby(lahore, 5, 0, nil)))
+	require.Empty(t, x.Nearby(raiwind, 5, 0, nil))
+
+	x.Remove(1)
+	_, ok = x.Get(1)
+	require.False(t, ok)
+	require.Empty(t, x.cells)
+}
+
+func TestIndex_Staleness(t *testing.T) {
+	x, advance := newTestIndex(time.Minute)
+	x.Update(1, gulberg)
+	advance(45 * time.Second)
+	x.Update(2, modelTown)
+	advance(30 * time.Second)
+
+	_, ok := x.Get(1)
+	require.False(t, ok)
+	require.Equal(t, []int32{2}, ids(x.Nearby(lahore, 50, 0, nil)))
+	require.Equal(t, 2, x.Len())
+
+	require.Equal(t, 1, x.EvictStale())
+	require.Equal(t, 1, x.Len())
+
+	advance(time.Minute)
+	require.Equal(t, 1, x.EvictStale())
+	require.Empty(t, x.cells)
+}
+
+// TestIndex_NearbyMatchesScan checks the grid search against a scan of every
+// location, around the poles and the antimeridian as well.
+func TestIndex_NearbyMatchesScan(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	centers := []Point{lahore, eastOfDate, westOfDate, {Lat: 89.99, Lng: 10}, {Lat: -89.99, Lng: -170}}
+
+	for _, center := range centers {
+		x, _ := newTestIndex(0)
+		points := make(map[int32]Point)
+		for id := int32(1); id <= 2000; id++ {
+			p := Point{
+				Lat: min(max(center.Lat+rnd.NormFloat64()*0.3, -90), 90),
+				Lng: center.Lng + rnd.NormFloat64()*0.3,
+			}
+			if p.Lng > 180 {
+				p.Lng -= 360
+			} else if p.Lng < -180 {
+				p.Lng += 360
+			}
+			points[id] = p
+			x.Update(id, p)
+		}
+
+		for _, radiusKm := range []float64{1, 5, 20} {
+			var expected []int32
+			for id, p := range points {
+				if Distance(center, p) <= radiusKm {
+					expected = append(expected, id)
+				}
+			}
+			found := x.Nearby(center, radiusKm, 0, nil)
+			got := ids(found)
+			sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+			sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+			require.Equal(t, len(expected), len(got), "center %v radius %v", center, radiusKm)
+			require.Equal(t, expected, got, "center %v radius %v", center, radiusKm)
+			require.True(t, sort.SliceIsSorted(found, func(i, j int) bool { return found[i].DistanceKm < found[j].DistanceKm }))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Package grpcerr builds gRPC status errors carrying google.rpc error details
+// (ErrorInfo, BadRequest, RetryInfo) so clients can act on failures without
+// parsing messages.
+package grpcerr
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain identifies this service in ErrorInfo details.
+const Domain = "driver.v1.DriverService"
+
+// Stable ErrorInfo reasons. Clients may switch on these, so never rename them.
+const (
+	ReasonInvalidRequest       = "INVALID_REQUEST"
+	ReasonValidationRule       = "VALIDATION_RULE_ERROR"
+	ReasonDriverNotFound       = "DRIVER_NOT_FOUND"
+	ReasonAlreadyExists        = "ALREADY_EXISTS"
+	ReasonForeignKeyViolation  = "FOREIGN_KEY_VIOLATION"
+	ReasonSerializationFailure = "SERIALIZATION_FAILURE"
+	ReasonDatabaseTimeout      = "DATABASE_TIMEOUT"
+	ReasonCanceled             = "CANCELED"
+	ReasonDatabaseError        = "DATABASE_ERROR"
+	ReasonGatewayError         = "GATEWAY_ERROR"
+)
+
+// DefaultRetryDelay is the back-off suggested to clients for transient failures.
+const DefaultRetryDelay = 100 * time.Millisecond
+
+// FieldViolation describes why a single request field is invalid.
+func FieldViolation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: description}
+}
+
+// InvalidArgument returns an InvalidArgument error with a BadRequest listing
+// every violation. The message reads "invalid <field>: <description>" for each;
+// a violation with an empty field applies to the request as a whole.
+func InvalidArgument(violations ...*errdetails.BadRequest_FieldViolation) error {
+	msgs := make([]string, 0, len(violations))
+	for _, v := range violations {
+		field := v.Field
+		if field == "" {
+			field = "request"
+		}
+		msgs = append(msgs, fmt.Sprintf("invalid %s: %s", field, v.Description))
+	}
+	return New(codes.InvalidArgument, ReasonInvalidRequest, strings.Join(msgs, "; "),
+		&errdetails.BadRequest{FieldViolations: violations})
+}
+
+// Retry suggests that the client retry after delay.
+func Retry(delay time.Duration) *errdetails.RetryInfo {
+	return &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}
+}
+
+// New returns a status error with an ErrorInfo for reason followed by details.
+func New(code codes.Code, reason, msg string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, msg)
+	all := append([]protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: reason, Domain: Domain}}, details...)
+	if withDetails, err := st.WithDetails(all...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
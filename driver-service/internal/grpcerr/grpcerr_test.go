@@ -0,0 +1,50 @@
+package grpcerr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInvalidArgument(t *testing.T) {
+	err := InvalidArgument(
+		FieldViolation("user_id", "must be a positive integer"),
+		FieldViolation("driver", "must be provided"),
+	)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Equal(t, "invalid user_id: must be a positive integer; invalid driver: must be provided", st.Message())
+
+	details := st.Details()
+	require.Len(t, details, 2)
+
+	info, ok := details[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, ReasonInvalidRequest, info.Reason)
+	require.Equal(t, Domain, info.Domain)
+
+	badRequest, ok := details[1].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 2)
+	require.Equal(t, "user_id", badRequest.FieldViolations[0].Field)
+	require.Equal(t, "driver", badRequest.FieldViolations[1].Field)
+}
+
+func TestNew_WithRetry(t *testing.T) {
+	err := New(codes.Aborted, ReasonSerializationFailure, "conflict", Retry(DefaultRetryDelay))
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.Aborted, st.Code())
+
+	details := st.Details()
+	require.Len(t, details, 2)
+	retry, ok := details[1].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	require.Equal(t, DefaultRetryDelay, retry.RetryDelay.AsDuration())
+}
@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"time"
+)
+
+func LoggingInterceptor(logger *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		// Log incoming request
+		logger.WithFields(logrus.Fields{
+			"method":  info.FullMethod,
+			"request": req,
+		}).Info("gRPC Request")
+
+		// Handle the request
+		resp, err := handler(ctx, req)
+
+		// Log response or error
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"method": info.FullMethod,
+				"error":  err.Error(),
+			}).Error("gRPC Response Error")
+		} else {
+			logger.WithFields(logrus.Fields{
+				"method":   info.FullMethod,
+				"response": resp,
+			}).Info("gRPC Response")
+		}
+
+		return resp, err
+	}
+}
+
+// StreamLoggingInterceptor logs the start and end of streaming calls.
+func StreamLoggingInterceptor(logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// Log stream start
+		logger.WithFields(logrus.Fields{
+			"method": info.FullMethod,
+		}).Info("gRPC Stream Started")
+
+		// Handle the stream
+		start := time.Now()
+		err := handler(srv, ss)
+
+		// Log stream end or error
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"method":   info.FullMethod,
+				"duration": time.Since(start).String(),
+				"error":    err.Error(),
+			}).Error("gRPC Stream Error")
+		} else {
+			logger.WithFields(logrus.Fields{
+				"method":   info.FullMethod,
+				"duration": time.Since(start).String(),
+			}).Info("gRPC Stream Ended")
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,25 @@
+package model
+
+// Driver statuses.
+const (
+	DriverOffline   = "OFFLINE"
+	DriverAvailable = "AVAILABLE"
+	DriverOnTrip    = "ON_TRIP"
+)
+
+type Driver struct {
+	ID            int32   // Driver ID
+	Name          string  // Driver's name
+	Phone         string  // Contact number
+	LicenseNumber string  // Driving license number, unique across drivers
+	Vehicle       Vehicle // Vehicle the driver drives
+	Status        string  // One of the Driver* statuses
+}
+
+type Vehicle struct {
+	Make        string // Manufacturer, e.g. Toyota
+	Model       string // Model, e.g. Corolla
+	Color       string // Body color
+	PlateNumber string // Registration plate, unique across drivers
+	Seats       int32  // Passenger seats
+}
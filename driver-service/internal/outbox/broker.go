@@ -0,0 +1,107 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Handler consumes a message. Returning an error makes the broker report the
+// publish as failed, so the message is delivered again later.
+type Handler func(ctx context.Context, msg Message) error
+
+// InProcessBroker delivers messages synchronously to handlers subscribed in
+// the same process. Messages on topics without subscribers are dropped.
+type InProcessBroker struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewInProcessBroker creates an InProcessBroker without subscribers.
+func NewInProcessBroker() *InProcessBroker {
+	return &InProcessBroker{handlers: make(map[string][]Handler)}
+}
+
+// Subscribe registers h for messages on topic.
+func (b *InProcessBroker) Subscribe(topic string, h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[topic] = append(b.handlers[topic], h)
+}
+
+// Publish calls every handler subscribed to msg's topic. All handlers are
+// called even if one fails.
+func (b *InProcessBroker) Publish(ctx context.Context, msg Message) error {
+	b.mu.RLock()
+	handlers := b.handlers[msg.Topic]
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, h := range handlers {
+		if err := h(ctx, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// FileBroker appends messages to a file as JSON lines, for inspecting events
+// locally or feeding them to another process. Read the file with ReadMessages.
+type FileBroker struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileBroker opens path for appending, creating it and its directory if needed.
+func NewFileBroker(path string) (*FileBroker, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create event directory: %v", err)
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event file: %v", err)
+	}
+	return &FileBroker{file: file}, nil
+}
+
+// Publish appends msg and syncs the file before returning.
+func (b *FileBroker) Publish(_ context.Context, msg Message) error {
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, err := b.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return b.file.Sync()
+}
+
+// Close closes the file.
+func (b *FileBroker) Close() error {
+	return b.file.Close()
+}
+
+// ReadMessages decodes the messages written by a FileBroker.
+func ReadMessages(r io.Reader) ([]Message, error) {
+	var msgs []Message
+	dec := json.NewDecoder(r)
+	for {
+		var m Message
+		if err := dec.Decode(&m); err == io.EOF {
+			return msgs, nil
+		} else if err != nil {
+			return msgs, err
+		}
+		msgs = append(msgs, m)
+	}
+}
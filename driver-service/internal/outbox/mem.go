@@ -0,0 +1,64 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+)
+
+// MemStore is an in-memory outbox for the in-memory stores. They call Add
+// while holding their own lock, so a message is recorded atomically with the
+// write that produced it.
+type MemStore struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewMemStore creates an empty MemStore.
+func NewMemStore() *MemStore {
+	return &MemStore{}
+}
+
+// Add records msg.
+func (s *MemStore) Add(msg Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, msg)
+}
+
+// Pending returns up to limit unpublished messages, oldest first.
+func (s *MemStore) Pending(ctx context.Context, limit int) ([]Message, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := min(limit, len(s.messages))
+	return append([]Message{}, s.messages[:n]...), nil
+}
+
+// MarkPublished drops the messages with the given IDs.
+func (s *MemStore) MarkPublished(ctx context.Context, ids []string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	published := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		published[id] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.messages[:0]
+	for _, m := range s.messages {
+		if !published[m.ID] {
+			kept = append(kept, m)
+		}
+	}
+	s.messages = kept
+	return nil
+}
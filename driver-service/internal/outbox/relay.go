@@ -0,0 +1,86 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultBatchSize is how many messages the relay reads from the outbox at once.
+const DefaultBatchSize = 100
+
+// Relay publishes outbox messages to a Broker in the order they were recorded.
+type Relay struct {
+	store     Store
+	broker    Broker
+	interval  time.Duration
+	batchSize int
+	log       *logrus.Logger
+}
+
+// NewRelay creates a Relay that polls store every interval.
+func NewRelay(store Store, broker Broker, interval time.Duration, logger *logrus.Logger) *Relay {
+	return &Relay{store: store, broker: broker, interval: interval, batchSize: DefaultBatchSize, log: logger}
+}
+
+// Run flushes the outbox every interval until ctx is canceled. Failed
+// messages are retried on the next tick.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Flush(ctx); err != nil && ctx.Err() == nil {
+			r.log.Error("Failed to relay outbox messages: ", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush publishes pending messages until the outbox is empty or a publish
+// fails, and returns how many were published. Messages are marked published
+// after the broker accepts them, so a crash in between causes a redelivery.
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		msgs, err := r.store.Pending(ctx, r.batchSize)
+		if err != nil {
+			return total, err
+		}
+		if len(msgs) == 0 {
+			return total, nil
+		}
+
+		// Stop at the first failure so later messages are not published
+		// ahead of it.
+		var publishErr error
+		published := make([]string, 0, len(msgs))
+		for _, msg := range msgs {
+			if publishErr = r.broker.Publish(ctx, msg); publishErr != nil {
+				publishErr = fmt.Errorf("failed to publish %s %s: %w", msg.Topic, msg.ID, publishErr)
+				break
+			}
+			published = append(published, msg.ID)
+		}
+
+		if len(published) > 0 {
+			if err := r.store.MarkPublished(ctx, published); err != nil {
+				return total, err
+			}
+			total += len(published)
+		}
+		if publishErr != nil {
+			return total, publishErr
+		}
+		if len(msgs) < r.batchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/golang_falcon_task/driver-service/internal/geo"
+	"github.com/golang_falcon_task/driver-service/internal/grpcerr"
+	"github.com/golang_falcon_task/driver-service/internal/model"
+	"github.com/golang_falcon_task/driver-service/internal/store"
+	pb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// DriverStore defines the interface for driver-related database operations.
+type DriverStore interface {
+	CreateDriver(ctx context.Context, driver *model.Driver) (*model.Driver, error)
+	GetDriver(ctx context.Context, driverID int32) (*model.Driver, error)
+	ListDrivers(ctx context.Context, status string) ([]model.Driver, error)
+	UpdateDriver(ctx context.Context, driverID int32, driver *model.Driver) error
+	UpdateDriverStatus(ctx context.Context, driverID int32, status string) (*model.Driver, error)
+	DeleteDriver(ctx context.Context, driverID int32) error
+}
+
+type DriverService struct {
+	driverStore DriverStore
+	locations   *geo.Index // Latest location of each driver
+	log         *logrus.Logger
+	pb.UnimplementedDriverServiceServer
+}
+
+// NewDriverService creates a new DriverService with a DriverStore dependency.
+// Driver locations are kept in locations.
+func NewDriverService(store DriverStore, locations *geo.Index, logger *logrus.Logger) *DriverService {
+	return &DriverService{driverStore: store, locations: locations, log: logger}
+}
+
+// CreateDriver registers a driver. New drivers are offline.
+func (s *DriverService) CreateDriver(ctx context.Context, req *pb.CreateDriverRequest) (*pb.CreateDriverResponse, error) {
+	// Input validation
+	if req.Driver == nil || req.Driver.Vehicle == nil {
+		s.log.Error("Driver and vehicle details must be provided")
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver", "driver and vehicle must be provided"))
+	}
+
+	driver, err := s.driverStore.CreateDriver(ctx, fromProto(req.Driver))
+	if err != nil {
+		s.log.Error("Failed to create driver", "license_number", req.Driver.LicenseNumber, "error", err.Error())
+		return nil, storeError(err, "failed to create driver")
+	}
+
+	s.log.Info("Driver successfully created", "driver_id", driver.ID)
+	return &pb.CreateDriverResponse{Driver: toProto(driver)}, nil
+}
+
+// GetDriver retrieves the details of a driver.
+func (s *DriverService) GetDriver(ctx context.Context, req *pb.GetDriverRequest) (*pb.GetDriverResponse, error) {
+	// Input validation
+	if req.DriverId <= 0 {
+		s.log.Error("Invalid driver_id: must be a positive integer", "driver_id", req.DriverId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver_id", "must be a positive integer"))
+	}
+
+	driver, err := s.driverStore.GetDriver(ctx, req.DriverId)
+	if err != nil {
+		if errors.Is(err, store.ErrDriverNotFound) {
+			s.log.Error("Driver not found", "driver_id", req.DriverId)
+		} else {
+			s.log.Error("Failed to get driver", "driver_id", req.DriverId, "error", err.Error())
+		}
+		return nil, storeError(err, fmt.Sprintf("failed to get driver with id %d", req.DriverId))
+	}
+
+	return &pb.GetDriverResponse{Driver: toProto(driver)}, nil
+}
+
+// ListDrivers lists drivers, optionally only those with a given status.
+func (s *DriverService) ListDrivers(ctx context.Context, req *pb.ListDriversRequest) (*pb.ListDriversResponse, error) {
+	status := store.StatusFromProto(req.Status)
+	if status == "" && req.Status != pb.DriverStatus_DRIVER_STATUS_UNSPECIFIED {
+		s.log.Error("Invalid status", "status", req.Status)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("status", "must be a defined driver status"))
+	}
+
+	drivers, err := s.driverStore.ListDrivers(ctx, status)
+	if err != nil {
+		s.log.Error("Failed to list drivers", "status", status, "error", err.Error())
+		return nil, storeError(err, "failed to list drivers")
+	}
+
+	res := &pb.ListDriversResponse{Drivers: make([]*pb.Driver, 0, len(drivers))}
+	for i := range drivers {
+		res.Drivers = append(res.Drivers, toProto(&drivers[i]))
+	}
+	return res, nil
+}
+
+// UpdateDriver updates the profile and vehicle of an existing driver.
+func (s *DriverService) UpdateDriver(ctx context.Context, req *pb.UpdateDriverRequest) (*pb.UpdateDriverResponse, error) {
+	// Input validation
+	if req.DriverId <= 0 {
+		s.log.Error("Invalid driver_id: must be a positive integer", "driver_id", req.DriverId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver_id", "must be a positive integer"))
+	}
+	if req.Driver == nil || req.Driver.Vehicle == nil {
+		s.log.Error("Driver and vehicle details must be provided", "driver_id", req.DriverId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver", "driver and vehicle must be provided"))
+	}
+
+	err := s.driverStore.UpdateDriver(ctx, req.DriverId, fromProto(req.Driver))
+	if err != nil {
+		if errors.Is(err, store.ErrDriverNotFound) {
+			s.log.Error("Driver not found", "driver_id", req.DriverId)
+		} else {
+			s.log.Error("Failed to update driver", "driver_id", req.DriverId, "error", err.Error())
+		}
+		return nil, storeError(err, fmt.Sprintf("failed to update driver with id %d", req.DriverId))
+	}
+
+	s.log.Info("Driver successfully updated", "driver_id", req.DriverId)
+	return &pb.UpdateDriverResponse{
+		Message: fmt.Sprintf("driver with id %d successfully updated", req.DriverId),
+	}, nil
+}
+
+// UpdateDriverStatus changes the availability of a driver.
+func (s *DriverService) UpdateDriverStatus(ctx context.Context, req *pb.UpdateDriverStatusRequest) (*pb.UpdateDriverStatusResponse, error) {
+	// Input validation
+	if req.DriverId <= 0 {
+		s.log.Error("Invalid driver_id: must be a positive integer", "driver_id", req.DriverId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver_id", "must be a positive integer"))
+	}
+	status := store.StatusFromProto(req.Status)
+	if status == "" {
+		s.log.Error("Invalid status", "driver_id", req.DriverId, "status", req.Status)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("status", "must be a defined driver status"))
+	}
+
+	driver, err := s.driverStore.UpdateDriverStatus(ctx, req.DriverId, status)
+	if err != nil {
+		if errors.Is(err, store.ErrDriverNotFound) {
+			s.log.Error("Driver not found", "driver_id", req.DriverId)
+		} else {
+			s.log.Error("Failed to update driver status", "driver_id", req.DriverId, "error", err.Error())
+		}
+		return nil, storeError(err, fmt.Sprintf("failed to update status of driver with id %d", req.DriverId))
+	}
+
+	s.log.Info("Driver status updated", "driver_id", req.DriverId, "status", status)
+	return &pb.UpdateDriverStatusResponse{Driver: toProto(driver)}, nil
+}
+
+// DeleteDriver deletes a driver that is not assigned to any ride.
+func (s *DriverService) DeleteDriver(ctx context.Context, req *pb.DeleteDriverRequest) (*pb.DeleteDriverResponse, error) {
+	// Input validation
+	if req.DriverId <= 0 {
+		s.log.Error("Invalid driver_id: must be a positive integer", "driver_id", req.DriverId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver_id", "must be a positive integer"))
+	}
+
+	err := s.driverStore.DeleteDriver(ctx, req.DriverId)
+	if err != nil {
+		if errors.Is(err, store.ErrDriverNotFound) {
+			s.log.Error("Driver not found", "driver_id", req.DriverId)
+		} else {
+			s.log.Error("Failed to delete driver", "driver_id", req.DriverId, "error", err.Error())
+		}
+		return nil, storeError(err, fmt.Sprintf("failed to delete driver with id %d", req.DriverId))
+	}
+
+	s.locations.Remove(req.DriverId)
+	s.log.Info("Driver successfully deleted", "driver_id", req.DriverId)
+	return &pb.DeleteDriverResponse{
+		Message: fmt.Sprintf("driver with id %d successfully deleted", req.DriverId),
+	}, nil
+}
+
+// fromProto converts driver details from the API to the stored form. The
+// status is not taken from the request.
+func fromProto(driver *pb.Driver) *model.Driver {
+	return &model.Driver{
+		Name:          driver.Name,
+		Phone:         driver.Phone,
+		LicenseNumber: driver.LicenseNumber,
+		Vehicle: model.Vehicle{
+			Make:        driver.Vehicle.Make,
+			Model:       driver.Vehicle.Model,
+			Color:       driver.Vehicle.Color,
+			PlateNumber: driver.Vehicle.PlateNumber,
+			Seats:       driver.Vehicle.Seats,
+		},
+	}
+}
+
+// toProto converts a stored driver to its API representation.
+func toProto(driver *model.Driver) *pb.Driver {
+	return &pb.Driver{
+		DriverId:      driver.ID,
+		Name:          driver.Name,
+		Phone:         driver.Phone,
+		LicenseNumber: driver.LicenseNumber,
+		Vehicle: &pb.Vehicle{
+			Make:        driver.Vehicle.Make,
+			Model:       driver.Vehicle.Model,
+			Color:       driver.Vehicle.Color,
+			PlateNumber: driver.Vehicle.PlateNumber,
+			Seats:       driver.Vehicle.Seats,
+		},
+		Status: store.StatusToProto(driver.Status),
+	}
+}
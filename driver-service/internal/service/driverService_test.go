@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang_falcon_task/driver-service/internal/geo"
+	"github.com/golang_falcon_task/driver-service/internal/model"
+	"github.com/golang_falcon_task/driver-service/internal/service/mocks"
+	"github.com/golang_falcon_task/driver-service/internal/store"
+	pb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"testing"
+	"time"
+)
+
+var (
+	testDriver = &model.Driver{
+		Name:          "Ali Raza",
+		Phone:         "+923001234567",
+		LicenseNumber: "LHR-10001",
+		Vehicle:       model.Vehicle{Make: "Toyota", Model: "Corolla", Color: "White", PlateNumber: "LEA-1234", Seats: 4},
+	}
+	testDriverProto = &pb.Driver{
+		Name:          "Ali Raza",
+		Phone:         "+923001234567",
+		LicenseNumber: "LHR-10001",
+		Vehicle:       &pb.Vehicle{Make: "Toyota", Model: "Corolla", Color: "White", PlateNumber: "LEA-1234", Seats: 4},
+	}
+)
+
+// storedDriver is testDriver as stored with id and status.
+func storedDriver(id int32, status string) *model.Driver {
+	driver := *testDriver
+	driver.ID, driver.Status = id, status
+	return &driver
+}
+
+func TestDriverService_CreateDriver(t *testing.T) {
+	mockStore := new(mocks.DriverStore)
+	logger := logrus.New()
+	service := NewDriverService(mockStore, geo.NewIndex(time.Minute), logger)
+
+	tests := []struct {
+		name           string
+		driver         *pb.Driver
+		setupMock      func()
+		expectedCode   codes.Code
+		expectedDriver *pb.Driver
+	}{
+		{
+			name:   "Success",
+			driver: testDriverProto,
+			setupMock: func() {
+				mockStore.On("CreateDriver", mock.Anything, testDriver).Return(storedDriver(1, model.DriverOffline), nil).Once()
+			},
+			expectedCode: codes.OK,
+			expectedDriver: &pb.Driver{
+				DriverId:      1,
+				Name:          "Ali Raza",
+				Phone:         "+923001234567",
+				LicenseNumber: "LHR-10001",
+				Vehicle:       &pb.Vehicle{Make: "Toyota", Model: "Corolla", Color: "White", PlateNumber: "LEA-1234", Seats: 4},
+				Status:        pb.DriverStatus_DRIVER_STATUS_OFFLINE,
+			},
+		},
+		{
+			name:         "Missing Vehicle",
+			driver:       &pb.Driver{Name: "Ali Raza"},
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:   "Duplicate License",
+			driver: testDriverProto,
+			setupMock: func() {
+				mockStore.On("CreateDriver", mock.Anything, testDriver).Return(nil, store.ErrAlreadyExists).Once()
+			},
+			expectedCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			resp, err := service.CreateDriver(context.Background(), &pb.CreateDriverRequest{Driver: tt.driver})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				require.True(t, proto.Equal(tt.expectedDriver, resp.Driver), "expected %v, got %v", tt.expectedDriver, resp.Driver)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDriverService_GetDriver(t *testing.T) {
+	mockStore := new(mocks.DriverStore)
+	logger := logrus.New()
+	service := NewDriverService(mockStore, geo.NewIndex(time.Minute), logger)
+
+	tests := []struct {
+		name           string
+		driverID       int32
+		setupMock      func()
+		expectedCode   codes.Code
+		expectedStatus pb.DriverStatus
+	}{
+		{
+			name:     "Success",
+			driverID: 1,
+			setupMock: func() {
+				mockStore.On("GetDriver", mock.Anything, int32(1)).Return(storedDriver(1, model.DriverOnTrip), nil)
+			},
+			expectedCode:   codes.OK,
+			expectedStatus: pb.DriverStatus_DRIVER_STATUS_ON_TRIP,
+		},
+		{
+			name:         "Invalid DriverID",
+			driverID:     0,
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Driver Not Found",
+			driverID: 2,
+			setupMock: func() {
+				mockStore.On("GetDriver", mock.Anything, int32(2)).Return(nil, store.ErrDriverNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:     "Internal Error",
+			driverID: 3,
+			setupMock: func() {
+				mockStore.On("GetDriver", mock.Anything, int32(3)).Return(nil, errors.New("database error"))
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			resp, err := service.GetDriver(context.Background(), &pb.GetDriverRequest{DriverId: tt.driverID})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.driverID, resp.Driver.DriverId)
+				require.Equal(t, tt.expectedStatus, resp.Driver.Status)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDriverService_ListDrivers(t *testing.T) {
+	mockStore := new(mocks.DriverStore)
+	logger := logrus.New()
+	service := NewDriverService(mockStore, geo.NewIndex(time.Minute), logger)
+
+	tests := []struct {
+		name         string
+		status       pb.DriverStatus
+		setupMock    func()
+		expectedCode codes.Code
+		expectedIDs  []int32
+	}{
+		{
+			name:   "All Drivers",
+			status: pb.DriverStatus_DRIVER_STATUS_UNSPECIFIED,
+			setupMock: func() {
+				mockStore.On("ListDrivers", mock.Anything, "").Return([]model.Driver{
+					*storedDriver(1, model.DriverAvailable), *storedDriver(2, model.DriverOffline),
+				}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedIDs:  []int32{1, 2},
+		},
+		{
+			name:   "Available Drivers",
+			status: pb.DriverStatus_DRIVER_STATUS_AVAILABLE,
+			setupMock: func() {
+				mockStore.On("ListDrivers", mock.Anything, model.DriverAvailable).Return([]model.Driver{*storedDriver(1, model.DriverAvailable)}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedIDs:  []int32{1},
+		},
+		{
+			name:         "Undefined Status",
+			status:       pb.DriverStatus(42),
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			resp, err := service.ListDrivers(context.Background(), &pb.ListDriversRequest{Status: tt.status})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				var ids []int32
+				for _, d := range resp.Drivers {
+					ids = append(ids, d.DriverId)
+				}
+				require.Equal(t, tt.expectedIDs, ids)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDriverService_UpdateDriver(t *testing.T) {
+	mockStore := new(mocks.DriverStore)
+	logger := logrus.New()
+	service := NewDriverService(mockStore, geo.NewIndex(time.Minute), logger)
+
+	tests := []struct {
+		name         string
+		driverID     int32
+		driver       *pb.Driver
+		setupMock    func()
+		expectedCode codes.Code
+		expectedMsg  string
+	}{
+		{
+			name:     "Success",
+			driverID: 1,
+			driver:   testDriverProto,
+			setupMock: func() {
+				mockStore.On("UpdateDriver", mock.Anything, int32(1), testDriver).Return(nil)
+			},
+			expectedCode: codes.OK,
+			expectedMsg:  "driver with id 1 successfully updated",
+		},
+		{
+			name:         "Invalid DriverID",
+			driverID:     0,
+			driver:       testDriverProto,
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Driver Not Found",
+			driverID: 2,
+			driver:   testDriverProto,
+			setupMock: func() {
+				mockStore.On("UpdateDriver", mock.Anything, int32(2), testDriver).Return(store.ErrDriverNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			res, err := service.UpdateDriver(context.Background(), &pb.UpdateDriverRequest{DriverId: tt.driverID, Driver: tt.driver})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedMsg, res.Message)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDriverService_UpdateDriverStatus(t *testing.T) {
+	mockStore := new(mocks.DriverStore)
+	logger := logrus.New()
+	service := NewDriverService(mockStore, geo.NewIndex(time.Minute), logger)
+
+	tests := []struct {
+		name         string
+		driverID     int32
+		status       pb.DriverStatus
+		setupMock    func()
+		expectedCode codes.Code
+	}{
+		{
+			name:     "Success",
+			driverID: 1,
+			status:   pb.DriverStatus_DRIVER_STATUS_AVAILABLE,
+			setupMock: func() {
+				mockStore.On("UpdateDriverStatus", mock.Anything, int32(1), model.DriverAvailable).Return(storedDriver(1, model.DriverAvailable), nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Unspecified Status",
+			driverID:     1,
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Driver Not Found",
+			driverID: 2,
+			status:   pb.DriverStatus_DRIVER_STATUS_OFFLINE,
+			setupMock: func() {
+				mockStore.On("UpdateDriverStatus", mock.Anything, int32(2), model.DriverOffline).Return(nil, store.ErrDriverNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			resp, err := service.UpdateDriverStatus(context.Background(), &pb.UpdateDriverStatusRequest{DriverId: tt.driverID, Status: tt.status})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.status, resp.Driver.Status)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDriverService_DeleteDriver(t *testing.T) {
+	mockStore := new(mocks.DriverStore)
+	logger := logrus.New()
+	service := NewDriverService(mockStore, geo.NewIndex(time.Minute), logger)
+
+	tests := []struct {
+		name         string
+		driverID     int32
+		setupMock    func()
+		expectedCode codes.Code
+	}{
+		{
+			name:     "Success",
+			driverID: 1,
+			setupMock: func() {
+				mockStore.On("DeleteDriver", mock.Anything, int32(1)).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Invalid DriverID",
+			driverID:     0,
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Driver Assigned To Rides",
+			driverID: 2,
+			setupMock: func() {
+				mockStore.On("DeleteDriver", mock.Anything, int32(2)).Return(store.ErrForeignKeyViolation)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			_, err := service.DeleteDriver(context.Background(), &pb.DeleteDriverRequest{DriverId: tt.driverID})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/golang_falcon_task/driver-service/internal/geo"
+	"github.com/golang_falcon_task/driver-service/internal/grpcerr"
+	"github.com/golang_falcon_task/driver-service/internal/model"
+	pb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"google.golang.org/grpc"
+)
+
+// defaultNearbyLimit is how many drivers FindNearbyDrivers returns when the
+// request sets no limit.
+const defaultNearbyLimit = 10
+
+// UpdateLocation records the GPS fixes a driver streams.
+func (s *DriverService) UpdateLocation(stream grpc.ClientStreamingServer[pb.UpdateLocationRequest, pb.UpdateLocationResponse]) error {
+	res, err := s.RecordLocations(stream.Context(), stream.Recv)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(res)
+}
+
+// RecordLocations implements UpdateLocation for any transport, reading fixes
+// with recv until it returns io.EOF. The stream belongs to the driver of its
+// first fix, which must exist; fixes already recorded stay recorded if a
+// later one is rejected.
+func (s *DriverService) RecordLocations(ctx context.Context, recv func() (*pb.UpdateLocationRequest, error)) (*pb.UpdateLocationResponse, error) {
+	var driverID, accepted int32
+	for {
+		req, err := recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Input validation
+		if driverID == 0 {
+			if req.DriverId <= 0 {
+				s.log.Error("Invalid driver_id: must be a positive integer", "driver_id", req.DriverId)
+				return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver_id", "must be a positive integer"))
+			}
+			if _, err := s.driverStore.GetDriver(ctx, req.DriverId); err != nil {
+				s.log.Error("Failed to get driver", "driver_id", req.DriverId, "error", err.Error())
+				return nil, storeError(err, fmt.Sprintf("failed to get driver with id %d", req.DriverId))
+			}
+			driverID = req.DriverId
+		} else if req.DriverId != driverID {
+			s.log.Error("Invalid driver_id: stream belongs to another driver", "driver_id", req.DriverId, "stream_driver_id", driverID)
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("driver_id", fmt.Sprintf("must be %d, the driver of the stream", driverID)))
+		}
+		point, err := pointFromProto("location", req.Location)
+		if err != nil {
+			s.log.Error("Invalid location", "driver_id", driverID, "error", err.Error())
+			return nil, err
+		}
+
+		s.locations.Update(driverID, point)
+		accepted++
+	}
+
+	s.log.Info("Driver locations recorded", "driver_id", driverID, "accepted", accepted)
+	return &pb.UpdateLocationResponse{Accepted: accepted}, nil
+}
+
+// FindNearbyDrivers returns the available drivers nearest to a point.
+func (s *DriverService) FindNearbyDrivers(ctx context.Context, req *pb.FindNearbyDriversRequest) (*pb.FindNearbyDriversResponse, error) {
+	// Input validation
+	center, err := pointFromProto("location", req.Location)
+	if err != nil {
+		s.log.Error("Invalid location", "error", err.Error())
+		return nil, err
+	}
+	if req.RadiusKm <= 0 {
+		s.log.Error("Invalid radius_km: must be positive", "radius_km", req.RadiusKm)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("radius_km", "must be positive"))
+	}
+	if req.Limit < 0 {
+		s.log.Error("Invalid limit: must not be negative", "limit", req.Limit)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("limit", "must not be negative"))
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultNearbyLimit
+	}
+
+	drivers, err := s.driverStore.ListDrivers(ctx, model.DriverAvailable)
+	if err != nil {
+		s.log.Error("Failed to list available drivers", "error", err.Error())
+		return nil, storeError(err, "failed to list available drivers")
+	}
+	available := make(map[int32]*model.Driver, len(drivers))
+	for i := range drivers {
+		available[drivers[i].ID] = &drivers[i]
+	}
+
+	neighbors := s.locations.Nearby(center, req.RadiusKm, limit, func(driverID int32) bool {
+		return available[driverID] != nil
+	})
+
+	res := &pb.FindNearbyDriversResponse{Drivers: make([]*pb.NearbyDriver, 0, len(neighbors))}
+	for _, n := range neighbors {
+		res.Drivers = append(res.Drivers, &pb.NearbyDriver{
+			Driver:            toProto(available[n.DriverID]),
+			Location:          &pb.Location{Latitude: n.Point.Lat, Longitude: n.Point.Lng},
+			DistanceKm:        n.DistanceKm,
+			LocationUpdatedAt: n.UpdatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return res, nil
+}
+
+// pointFromProto converts the location in field to a geo.Point, returning an
+// InvalidArgument error if it is missing or out of range.
+func pointFromProto(field string, loc *pb.Location) (geo.Point, error) {
+	switch {
+	case loc == nil:
+		return geo.Point{}, grpcerr.InvalidArgument(grpcerr.FieldViolation(field, "must be provided"))
+	case !(loc.Latitude >= -90 && loc.Latitude <= 90):
+		return geo.Point{}, grpcerr.InvalidArgument(grpcerr.FieldViolation(field+".latitude", "must be between -90 and 90"))
+	case !(loc.Longitude >= -180 && loc.Longitude <= 180):
+		return geo.Point{}, grpcerr.InvalidArgument(grpcerr.FieldViolation(field+".longitude", "must be between -180 and 180"))
+	}
+	return geo.Point{Lat: loc.Latitude, Lng: loc.Longitude}, nil
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/driver-service/internal/geo"
+	"github.com/golang_falcon_task/driver-service/internal/model"
+	"github.com/golang_falcon_task/driver-service/internal/service/mocks"
+	"github.com/golang_falcon_task/driver-service/internal/store"
+	pb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	liberty   = &pb.Location{Latitude: 31.5102, Longitude: 74.3441} // Liberty Market, Lahore
+	gulberg   = &pb.Location{Latitude: 31.5120, Longitude: 74.3450} // About 0.2 km from liberty
+	modelTown = &pb.Location{Latitude: 31.4840, Longitude: 74.3250} // About 3.4 km from liberty
+	raiwind   = &pb.Location{Latitude: 31.2500, Longitude: 74.2167} // About 31 km from liberty
+)
+
+// fixes returns a recv function that yields reqs, then io.EOF.
+func fixes(reqs ...*pb.UpdateLocationRequest) func() (*pb.UpdateLocationRequest, error) {
+	return func() (*pb.UpdateLocationRequest, error) {
+		if len(reqs) == 0 {
+			return nil, io.EOF
+		}
+		req := reqs[0]
+		reqs = reqs[1:]
+		return req, nil
+	}
+}
+
+func point(loc *pb.Location) geo.Point {
+	return geo.Point{Lat: loc.Latitude, Lng: loc.Longitude}
+}
+
+func TestDriverService_RecordLocations(t *testing.T) {
+	logger := logrus.New()
+
+	tests := []struct {
+		name             string
+		fixes            []*pb.UpdateLocationRequest
+		setupMock        func(*mocks.DriverStore)
+		expectedCode     codes.Code
+		expectedAccepted int32
+		expectedLocation *pb.Location // Location of driver 1 afterwards, nil if none
+	}{
+		{
+			name: "Success",
+			fixes: []*pb.UpdateLocationRequest{
+				{DriverId: 1, Location: raiwind},
+				{DriverId: 1, Location: modelTown},
+				{DriverId: 1, Location: gulberg},
+			},
+			setupMock: func(m *mocks.DriverStore) {
+				m.On("GetDriver", mock.Anything, int32(1)).Return(storedDriver(1, model.DriverAvailable), nil).Once()
+			},
+			expectedCode:     codes.OK,
+			expectedAccepted: 3,
+			expectedLocation: gulberg,
+		},
+		{
+			name:             "Empty Stream",
+			setupMock:        func(m *mocks.DriverStore) {},
+			expectedCode:     codes.OK,
+			expectedAccepted: 0,
+		},
+		{
+			name:         "Invalid DriverID",
+			fixes:        []*pb.UpdateLocationRequest{{Location: gulberg}},
+			setupMock:    func(m *mocks.DriverStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "Driver Not Found",
+			fixes: []*pb.UpdateLocationRequest{{DriverId: 1, Location: gulberg}},
+			setupMock: func(m *mocks.DriverStore) {
+				m.On("GetDriver", mock.Anything, int32(1)).Return(nil, store.ErrDriverNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "Other Driver In Stream",
+			fixes: []*pb.UpdateLocationRequest{
+				{DriverId: 1, Location: gulberg},
+				{DriverId: 2, Location: modelTown},
+			},
+			setupMock: func(m *mocks.DriverStore) {
+				m.On("GetDriver", mock.Anything, int32(1)).Return(storedDriver(1, model.DriverAvailable), nil).Once()
+			},
+			expectedCode:     codes.InvalidArgument,
+			expectedLocation: gulberg,
+		},
+		{
+			name:  "Missing Location",
+			fixes: []*pb.UpdateLocationRequest{{DriverId: 1}},
+			setupMock: func(m *mocks.DriverStore) {
+				m.On("GetDriver", mock.Anything, int32(1)).Return(storedDriver(1, model.DriverAvailable), nil).Once()
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "Latitude Out Of Range",
+			fixes: []*pb.UpdateLocationRequest{{DriverId: 1, Location: &pb.Location{Latitude: 91}}},
+			setupMock: func(m *mocks.DriverStore) {
+				m.On("GetDriver", mock.Anything, int32(1)).Return(storedDriver(1, model.DriverAvailable), nil).Once()
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.DriverStore)
+			tt.setupMock(mockStore)
+			locations := geo.NewIndex(time.Minute)
+			service := NewDriverService(mockStore, locations, logger)
+
+			resp, err := service.RecordLocations(context.Background(), fixes(tt.fixes...))
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedAccepted, resp.Accepted)
+			}
+
+			loc, ok := locations.Get(1)
+			if tt.expectedLocation == nil {
+				require.False(t, ok)
+			} else {
+				require.True(t, ok)
+				require.Equal(t, point(tt.expectedLocation), loc.Point)
+			}
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDriverService_RecordLocations_RecvError(t *testing.T) {
+	mockStore := new(mocks.DriverStore)
+	service := NewDriverService(mockStore, geo.NewIndex(time.Minute), logrus.New())
+	recvErr := status.Error(codes.Canceled, "context canceled")
+
+	_, err := service.RecordLocations(context.Background(), func() (*pb.UpdateLocationRequest, error) {
+		return nil, recvErr
+	})
+
+	require.Equal(t, recvErr, err)
+}
+
+func TestDriverService_FindNearbyDrivers(t *testing.T) {
+	logger := logrus.New()
+
+	tests := []struct {
+		name         string
+		req          *pb.FindNearbyDriversRequest
+		setupMock    func(*mocks.DriverStore)
+		expectedCode codes.Code
+		expectedIDs  []int32
+	}{
+		{
+			name: "Nearest Available First",
+			req:  &pb.FindNearbyDriversRequest{Location: liberty, RadiusKm: 50},
+			setupMock: func(m *mocks.DriverStore) {
+				m.On("ListDrivers", mock.Anything, model.DriverAvailable).Return([]model.Driver{
+					*storedDriver(1, model.DriverAvailable), *storedDriver(3, model.DriverAvailable), *storedDriver(4, model.DriverAvailable),
+				}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedIDs:  []int32{1, 3, 4},
+		},
+		{
+			name: "Within Radius And Limit",
+			req:  &pb.FindNearbyDriversRequest{Location: liberty, RadiusKm: 10, Limit: 1},
+			setupMock: func(m *mocks.DriverStore) {
+				m.On("ListDrivers", mock.Anything, model.DriverAvailable).Return([]model.Driver{
+					*storedDriver(2, model.DriverAvailable), *storedDriver(3, model.DriverAvailable),
+				}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedIDs:  []int32{2},
+		},
+		{
+			name: "No Available Drivers",
+			req:  &pb.FindNearbyDriversRequest{Location: liberty, RadiusKm: 50},
+			setupMock: func(m *mocks.DriverStore) {
+				m.On("ListDrivers", mock.Anything, model.DriverAvailable).Return([]model.Driver{}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedIDs:  []int32{},
+		},
+		{
+			name:         "Missing Location",
+			req:          &pb.FindNearbyDriversRequest{RadiusKm: 5},
+			setupMock:    func(m *mocks.DriverStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Invalid Radius",
+			req:          &pb.FindNearbyDriversRequest{Location: liberty},
+			setupMock:    func(m *mocks.DriverStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Longitude Out Of Range",
+			req:          &pb.FindNearbyDriversRequest{Location: &pb.Location{Latitude: 31.5, Longitude: 181}, RadiusKm: 5},
+			setupMock:    func(m *mocks.DriverStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Internal Error",
+			req:  &pb.FindNearbyDriversRequest{Location: liberty, RadiusKm: 5},
+			setupMock: func(m *mocks.DriverStore) {
+				m.On("ListDrivers", mock.Anything, model.DriverAvailable).Return(nil, errors.New("database error"))
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.DriverStore)
+			tt.setupMock(mockStore)
+			locations := geo.NewIndex(time.Minute)
+			locations.Update(1, point(gulberg))
+			locations.Update(2, point(modelTown))
+			locations.Update(3, point(raiwind))
+			locations.Update(4, geo.Point{Lat: 31.2, Lng: 74.2}) // About 37 km from liberty
+			locations.Update(5, point(gulberg))                  // Not available
+			service := NewDriverService(mockStore, locations, logger)
+
+			resp, err := service.FindNearbyDrivers(context.Background(), tt.req)
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				ids := make([]int32, 0, len(resp.Drivers))
+				for _, d := range resp.Drivers {
+					ids = append(ids, d.Driver.DriverId)
+					require.Equal(t, pb.DriverStatus_DRIVER_STATUS_AVAILABLE, d.Driver.Status)
+					require.LessOrEqual(t, d.DistanceKm, tt.req.RadiusKm)
+					require.NotEmpty(t, d.LocationUpdatedAt)
+				}
+				require.Equal(t, tt.expectedIDs, ids)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
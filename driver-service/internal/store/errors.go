@@ -0,0 +1,21 @@
+package store
+
+import "errors"
+
+var (
+	ErrDriverNotFound = errors.New("driver not found")
+
+	// ErrAlreadyExists is returned when a write violates a unique constraint.
+	ErrAlreadyExists = errors.New("record already exists")
+	// ErrForeignKeyViolation is returned when a write references a row that does not exist,
+	// or a delete would orphan rows that still reference it.
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+	// ErrSerializationFailure is returned when a transaction conflicts with a concurrent one and can be retried.
+	ErrSerializationFailure = errors.New("serialization failure")
+	// ErrTimeout is returned when a query exceeds its deadline or the statement timeout.
+	ErrTimeout = errors.New("database operation timed out")
+	// ErrCanceled is returned when the caller cancels the query.
+	ErrCanceled = errors.New("database operation canceled")
+
+	ErrDatabaseOperation = errors.New("database operation failed")
+)
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"github.com/golang_falcon_task/driver-service/internal/model"
+	"github.com/golang_falcon_task/driver-service/internal/outbox"
+	pb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+)
+
+// driverStatuses maps stored driver statuses to their API values.
+var driverStatuses = map[string]pb.DriverStatus{
+	model.DriverOffline:   pb.DriverStatus_DRIVER_STATUS_OFFLINE,
+	model.DriverAvailable: pb.DriverStatus_DRIVER_STATUS_AVAILABLE,
+	model.DriverOnTrip:    pb.DriverStatus_DRIVER_STATUS_ON_TRIP,
+}
+
+// StatusToProto converts a stored driver status to its API value.
+func StatusToProto(status string) pb.DriverStatus {
+	return driverStatuses[status]
+}
+
+// StatusFromProto converts an API driver status to its stored value, or ""
+// for DRIVER_STATUS_UNSPECIFIED.
+func StatusFromProto(status pb.DriverStatus) string {
+	for stored, value := range driverStatuses {
+		if value == status {
+			return stored
+		}
+	}
+	return ""
+}
+
+// driverStatusChanged encodes the DriverStatusChanged event for a driver
+// whose status changed from previous to status.
+func driverStatusChanged(driverID int32, previous, status string) (outbox.Message, error) {
+	return outbox.NewMessage(&pb.DriverStatusChanged{
+		DriverId:       driverID,
+		PreviousStatus: StatusToProto(previous),
+		Status:         StatusToProto(status),
+	})
+}
@@ -0,0 +1,196 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang_falcon_task/driver-service/internal/model"
+	"github.com/golang_falcon_task/driver-service/internal/outbox"
+)
+
+// MemDriverStore is a thread-safe in-memory DriverStore with the same
+// semantics as PGDriverStore: auto-increment ids, ErrDriverNotFound for
+// unknown drivers, ErrAlreadyExists for duplicate license or plate numbers
+// and events recorded in an outbox atomically with the writes. It is meant
+// for local runs and tests that should not need Postgres.
+type MemDriverStore struct {
+	mu      sync.RWMutex
+	outbox  *outbox.MemStore
+	drivers map[int32]model.Driver
+	lastID  int32
+}
+
+// NewMemDriverStore creates an empty MemDriverStore.
+func NewMemDriverStore() *MemDriverStore {
+	return &MemDriverStore{
+		drivers: make(map[int32]model.Driver),
+		outbox:  outbox.NewMemStore(),
+	}
+}
+
+// Outbox returns the outbox the store records events in.
+func (s *MemDriverStore) Outbox() *outbox.MemStore {
+	return s.outbox
+}
+
+// checkUnique returns ErrAlreadyExists if a driver other than driverID has
+// the license or plate number of driver. The caller must hold s.mu.
+func (s *MemDriverStore) checkUnique(driverID int32, driver *model.Driver) error {
+	for id, other := range s.drivers {
+		if id == driverID {
+			continue
+		}
+		if other.LicenseNumber == driver.LicenseNumber {
+			return fmt.Errorf("%w: license number %s is already registered", ErrAlreadyExists, driver.LicenseNumber)
+		}
+		if other.Vehicle.PlateNumber == driver.Vehicle.PlateNumber {
+			return fmt.Errorf("%w: plate number %s is already registered", ErrAlreadyExists, driver.Vehicle.PlateNumber)
+		}
+	}
+	return nil
+}
+
+// CreateDriver stores a new driver, offline.
+func (s *MemDriverStore) CreateDriver(ctx context.Context, driver *model.Driver) (*model.Driver, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkUnique(0, driver); err != nil {
+		return nil, err
+	}
+	s.lastID++
+	stored := *driver
+	stored.ID, stored.Status = s.lastID, model.DriverOffline
+	s.drivers[s.lastID] = stored
+	return &stored, nil
+}
+
+// GetDriver retrieves a driver by ID.
+func (s *MemDriverStore) GetDriver(ctx context.Context, driverID int32) (*model.Driver, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDriverNotFound)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	driver, ok := s.drivers[driverID]
+	if !ok {
+		return nil, ErrDriverNotFound
+	}
+	return &driver, nil
+}
+
+// ListDrivers lists drivers by ID. A non-empty status lists only drivers
+// with that status.
+func (s *MemDriverStore) ListDrivers(ctx context.Context, status string) ([]model.Driver, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	drivers := []model.Driver{}
+	for _, driver := range s.drivers {
+		if status == "" || driver.Status == status {
+			drivers = append(drivers, driver)
+		}
+	}
+	sort.Slice(drivers, func(i, j int) bool { return drivers[i].ID < drivers[j].ID })
+	return drivers, nil
+}
+
+// UpdateDriver updates the profile and vehicle of an existing driver.
+func (s *MemDriverStore) UpdateDriver(ctx context.Context, driverID int32, driver *model.Driver) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrDriverNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.drivers[driverID]
+	if !ok {
+		return ErrDriverNotFound
+	}
+	if err := s.checkUnique(driverID, driver); err != nil {
+		return err
+	}
+	stored := *driver
+	stored.ID, stored.Status = driverID, existing.Status
+	s.drivers[driverID] = stored
+	return nil
+}
+
+// UpdateDriverStatus sets the status of a driver and returns the driver. A
+// DriverStatusChanged event is recorded if the status changed.
+func (s *MemDriverStore) UpdateDriverStatus(ctx context.Context, driverID int32, status string) (*model.Driver, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDriverNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	driver, ok := s.drivers[driverID]
+	if !ok {
+		return nil, ErrDriverNotFound
+	}
+	if driver.Status != status {
+		msg, err := driverStatusChanged(driverID, driver.Status, status)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+		}
+		s.outbox.Add(msg)
+		driver.Status = status
+		s.drivers[driverID] = driver
+	}
+	return &driver, nil
+}
+
+// DeleteDriver deletes a driver by ID.
+func (s *MemDriverStore) DeleteDriver(ctx context.Context, driverID int32) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrDriverNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.drivers[driverID]; !ok {
+		return ErrDriverNotFound
+	}
+	delete(s.drivers, driverID)
+	return nil
+}
+
+// SeedDemoData loads the same drivers as docker/init.sql.
+func (s *MemDriverStore) SeedDemoData(ctx context.Context) error {
+	drivers := []model.Driver{
+		{Name: "Ali Raza", Phone: "+923001234567", LicenseNumber: "LHR-10001",
+			Vehicle: model.Vehicle{Make: "Toyota", Model: "Corolla", Color: "White", PlateNumber: "LEA-1234", Seats: 4}, Status: model.DriverAvailable},
+		{Name: "Bilal Khan", Phone: "+923007654321", LicenseNumber: "LHR-10002",
+			Vehicle: model.Vehicle{Make: "Suzuki", Model: "Alto", Color: "Silver", PlateNumber: "LEB-5678", Seats: 3}, Status: model.DriverAvailable},
+		{Name: "Sana Malik", Phone: "+923211112222", LicenseNumber: "ISB-20001",
+			Vehicle: model.Vehicle{Make: "Honda", Model: "City", Color: "Black", PlateNumber: "ICT-9012", Seats: 4}, Status: model.DriverOffline},
+	}
+	for i := range drivers {
+		created, err := s.CreateDriver(ctx, &drivers[i])
+		if err != nil {
+			return err
+		}
+		// Seeded statuses are not changes, so no events are recorded.
+		s.mu.Lock()
+		created.Status = drivers[i].Status
+		s.drivers[created.ID] = *created
+		s.mu.Unlock()
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/golang_falcon_task/driver-service/internal/store"
+	"github.com/golang_falcon_task/driver-service/internal/store/storetest"
+)
+
+func TestMemDriverStore_Conformance(t *testing.T) {
+	storetest.RunDriverStoreTests(t, func(t *testing.T) storetest.Harness {
+		s := store.NewMemDriverStore()
+		return storetest.Harness{Store: s, Outbox: s.Outbox()}
+	})
+}
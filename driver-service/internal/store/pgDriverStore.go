@@ -0,0 +1,151 @@
+package store
+
+import (
+	"context"
+
+	"github.com/golang_falcon_task/driver-service/internal/model"
+	"github.com/golang_falcon_task/driver-service/internal/outbox"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// driverColumns are the columns scanned by scanDriver, in order.
+const driverColumns = `driver_id, name, phone, license_number, vehicle_make, vehicle_model, vehicle_color, plate_number, seats, status`
+
+type PGDriverStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPGDriverStore creates a new PGDriverStore instance.
+func NewPGDriverStore(db *pgxpool.Pool) *PGDriverStore {
+	return &PGDriverStore{db: db}
+}
+
+// scanDriver scans a row of driverColumns.
+func scanDriver(row pgx.Row) (*model.Driver, error) {
+	var d model.Driver
+	err := row.Scan(&d.ID, &d.Name, &d.Phone, &d.LicenseNumber,
+		&d.Vehicle.Make, &d.Vehicle.Model, &d.Vehicle.Color, &d.Vehicle.PlateNumber, &d.Vehicle.Seats, &d.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// CreateDriver inserts a new driver, offline.
+func (s *PGDriverStore) CreateDriver(ctx context.Context, driver *model.Driver) (*model.Driver, error) {
+	created, err := scanDriver(s.db.QueryRow(ctx, `
+        INSERT INTO drivers (name, phone, license_number, vehicle_make, vehicle_model, vehicle_color, plate_number, seats)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        RETURNING `+driverColumns,
+		driver.Name, driver.Phone, driver.LicenseNumber,
+		driver.Vehicle.Make, driver.Vehicle.Model, driver.Vehicle.Color, driver.Vehicle.PlateNumber, driver.Vehicle.Seats))
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	return created, nil
+}
+
+// GetDriver retrieves a driver by ID.
+func (s *PGDriverStore) GetDriver(ctx context.Context, driverID int32) (*model.Driver, error) {
+	driver, err := scanDriver(s.db.QueryRow(ctx, `SELECT `+driverColumns+` FROM drivers WHERE driver_id = $1`, driverID))
+	if err != nil {
+		return nil, translateError(err, ErrDriverNotFound)
+	}
+	return driver, nil
+}
+
+// ListDrivers lists drivers by ID. A non-empty status lists only drivers
+// with that status.
+func (s *PGDriverStore) ListDrivers(ctx context.Context, status string) ([]model.Driver, error) {
+	rows, err := s.db.Query(ctx, `
+        SELECT `+driverColumns+`
+        FROM drivers
+        WHERE $1 = '' OR status = $1
+        ORDER BY driver_id
+    `, status)
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	defer rows.Close()
+
+	drivers := []model.Driver{}
+	for rows.Next() {
+		driver, err := scanDriver(rows)
+		if err != nil {
+			return nil, translateError(err, ErrDatabaseOperation)
+		}
+		drivers = append(drivers, *driver)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	return drivers, nil
+}
+
+// UpdateDriver updates the profile and vehicle of an existing driver.
+func (s *PGDriverStore) UpdateDriver(ctx context.Context, driverID int32, driver *model.Driver) error {
+	result, err := s.db.Exec(ctx, `
+        UPDATE drivers
+        SET name = $1, phone = $2, license_number = $3, vehicle_make = $4, vehicle_model = $5,
+            vehicle_color = $6, plate_number = $7, seats = $8
+        WHERE driver_id = $9
+    `, driver.Name, driver.Phone, driver.LicenseNumber, driver.Vehicle.Make, driver.Vehicle.Model,
+		driver.Vehicle.Color, driver.Vehicle.PlateNumber, driver.Vehicle.Seats, driverID)
+	if err != nil {
+		return translateError(err, ErrDriverNotFound)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrDriverNotFound
+	}
+	return nil
+}
+
+// UpdateDriverStatus sets the status of a driver and returns the driver. A
+// DriverStatusChanged event is recorded in the outbox in the same
+// transaction if the status changed.
+func (s *PGDriverStore) UpdateDriverStatus(ctx context.Context, driverID int32, status string) (*model.Driver, error) {
+	var updated *model.Driver
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		// Lock the row so concurrent changes record consistent previous statuses.
+		driver, err := scanDriver(tx.QueryRow(ctx, `SELECT `+driverColumns+` FROM drivers WHERE driver_id = $1 FOR UPDATE`, driverID))
+		if err != nil {
+			return err
+		}
+		if driver.Status == status {
+			updated = driver
+			return nil
+		}
+
+		if _, err := tx.Exec(ctx, `UPDATE drivers SET status = $1 WHERE driver_id = $2`, status, driverID); err != nil {
+			return err
+		}
+		msg, err := driverStatusChanged(driverID, driver.Status, status)
+		if err != nil {
+			return err
+		}
+		driver.Status = status
+		updated = driver
+		return outbox.Write(ctx, tx, msg)
+	})
+	if err != nil {
+		return nil, translateError(err, ErrDriverNotFound)
+	}
+	return updated, nil
+}
+
+// DeleteDriver deletes a driver by ID. Drivers still assigned to rides or
+// bookings cannot be deleted.
+func (s *PGDriverStore) DeleteDriver(ctx context.Context, driverID int32) error {
+	result, err := s.db.Exec(ctx, `DELETE FROM drivers WHERE driver_id = $1`, driverID)
+	if err != nil {
+		return translateError(err, ErrDriverNotFound)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrDriverNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/golang_falcon_task/driver-service/internal/outbox"
+	"github.com/golang_falcon_task/driver-service/internal/store"
+	"github.com/golang_falcon_task/driver-service/internal/store/storetest"
+)
+
+func TestPGDriverStore_Conformance(t *testing.T) {
+	storetest.RunDriverStoreTests(t, func(t *testing.T) storetest.Harness {
+		pool := storetest.NewPGPool(t)
+		return storetest.Harness{Store: store.NewPGDriverStore(pool), Outbox: outbox.NewPGStore(pool)}
+	})
+}
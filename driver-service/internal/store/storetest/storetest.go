@@ -0,0 +1,265 @@
+// Package storetest contains the conformance suite every DriverStore
+// implementation must pass, so the Postgres and in-memory stores stay
+// interchangeable.
+package storetest
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/golang_falcon_task/driver-service/internal/model"
+	"github.com/golang_falcon_task/driver-service/internal/outbox"
+	"github.com/golang_falcon_task/driver-service/internal/service"
+	"github.com/golang_falcon_task/driver-service/internal/store"
+	pb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// Harness gives the suite a fresh store and a view of its outbox.
+type Harness struct {
+	Store service.DriverStore
+
+	// Outbox reads the events the store records.
+	Outbox outbox.Store
+}
+
+// newDriver returns a driver with license and plate numbers derived from n,
+// so drivers made with different n do not conflict.
+func newDriver(n int) *model.Driver {
+	return &model.Driver{
+		Name:          "Ali Raza",
+		Phone:         "+923001234567",
+		LicenseNumber: "LHR-" + string(rune('A'+n)),
+		Vehicle: model.Vehicle{
+			Make: "Toyota", Model: "Corolla", Color: "White", PlateNumber: "LEA-" + string(rune('A'+n)), Seats: 4,
+		},
+	}
+}
+
+// RunDriverStoreTests runs the conformance suite. newHarness is called once
+// per subtest and must return an isolated store.
+func RunDriverStoreTests(t *testing.T, newHarness func(t *testing.T) Harness) {
+	ctx := context.Background()
+
+	t.Run("CreateDriver Assigns Increasing IDs", func(t *testing.T) {
+		h := newHarness(t)
+
+		first, err := h.Store.CreateDriver(ctx, newDriver(1))
+		require.NoError(t, err)
+		second, err := h.Store.CreateDriver(ctx, newDriver(2))
+		require.NoError(t, err)
+
+		require.Positive(t, first.ID)
+		require.Greater(t, second.ID, first.ID)
+	})
+
+	t.Run("CreateDriver Starts Offline", func(t *testing.T) {
+		h := newHarness(t)
+
+		driver := newDriver(1)
+		driver.Status = model.DriverAvailable
+		created, err := h.Store.CreateDriver(ctx, driver)
+		require.NoError(t, err)
+		require.Equal(t, model.DriverOffline, created.Status)
+
+		got, err := h.Store.GetDriver(ctx, created.ID)
+		require.NoError(t, err)
+		require.Equal(t, created, got)
+	})
+
+	t.Run("CreateDriver Duplicate License Or Plate", func(t *testing.T) {
+		h := newHarness(t)
+
+		_, err := h.Store.CreateDriver(ctx, newDriver(1))
+		require.NoError(t, err)
+
+		sameLicense := newDriver(2)
+		sameLicense.LicenseNumber = newDriver(1).LicenseNumber
+		_, err = h.Store.CreateDriver(ctx, sameLicense)
+		require.ErrorIs(t, err, store.ErrAlreadyExists)
+
+		samePlate := newDriver(3)
+		samePlate.Vehicle.PlateNumber = newDriver(1).Vehicle.PlateNumber
+		_, err = h.Store.CreateDriver(ctx, samePlate)
+		require.ErrorIs(t, err, store.ErrAlreadyExists)
+	})
+
+	t.Run("UpdateDriver Keeps Status", func(t *testing.T) {
+		h := newHarness(t)
+
+		created, err := h.Store.CreateDriver(ctx, newDriver(1))
+		require.NoError(t, err)
+		_, err = h.Store.UpdateDriverStatus(ctx, created.ID, model.DriverAvailable)
+		require.NoError(t, err)
+
+		updated := newDriver(1)
+		updated.Name, updated.Vehicle.Color, updated.Status = "Ali Raza Khan", "Blue", model.DriverOffline
+		require.NoError(t, h.Store.UpdateDriver(ctx, created.ID, updated))
+
+		driver, err := h.Store.GetDriver(ctx, created.ID)
+		require.NoError(t, err)
+		updated.ID, updated.Status = created.ID, model.DriverAvailable
+		require.Equal(t, updated, driver)
+	})
+
+	t.Run("UpdateDriver Duplicate Plate", func(t *testing.T) {
+		h := newHarness(t)
+
+		first, err := h.Store.CreateDriver(ctx, newDriver(1))
+		require.NoError(t, err)
+		_, err = h.Store.CreateDriver(ctx, newDriver(2))
+		require.NoError(t, err)
+
+		updated := newDriver(1)
+		updated.Vehicle.PlateNumber = newDriver(2).Vehicle.PlateNumber
+		require.ErrorIs(t, h.Store.UpdateDriver(ctx, first.ID, updated), store.ErrAlreadyExists)
+	})
+
+	t.Run("UpdateDriverStatus Records DriverStatusChanged", func(t *testing.T) {
+		h := newHarness(t)
+
+		created, err := h.Store.CreateDriver(ctx, newDriver(1))
+		require.NoError(t, err)
+
+		driver, err := h.Store.UpdateDriverStatus(ctx, created.ID, model.DriverAvailable)
+		require.NoError(t, err)
+		require.Equal(t, model.DriverAvailable, driver.Status)
+
+		// Setting the same status again is not a change.
+		_, err = h.Store.UpdateDriverStatus(ctx, created.ID, model.DriverAvailable)
+		require.NoError(t, err)
+
+		msgs, err := h.Outbox.Pending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.NotEmpty(t, msgs[0].ID)
+		require.Equal(t, "driver.v1.DriverStatusChanged", msgs[0].Topic)
+
+		var event pb.DriverStatusChanged
+		require.NoError(t, proto.Unmarshal(msgs[0].Payload, &event))
+		expected := &pb.DriverStatusChanged{
+			DriverId:       created.ID,
+			PreviousStatus: pb.DriverStatus_DRIVER_STATUS_OFFLINE,
+			Status:         pb.DriverStatus_DRIVER_STATUS_AVAILABLE,
+		}
+		require.True(t, proto.Equal(expected, &event), "expected %v, got %v", expected, &event)
+	})
+
+	t.Run("ListDrivers", func(t *testing.T) {
+		h := newHarness(t)
+
+		var ids []int32
+		for i := 1; i <= 3; i++ {
+			created, err := h.Store.CreateDriver(ctx, newDriver(i))
+			require.NoError(t, err)
+			ids = append(ids, created.ID)
+		}
+		_, err := h.Store.UpdateDriverStatus(ctx, ids[2], model.DriverAvailable)
+		require.NoError(t, err)
+		_, err = h.Store.UpdateDriverStatus(ctx, ids[0], model.DriverAvailable)
+		require.NoError(t, err)
+
+		all, err := h.Store.ListDrivers(ctx, "")
+		require.NoError(t, err)
+		require.Equal(t, ids, driverIDs(all))
+
+		available, err := h.Store.ListDrivers(ctx, model.DriverAvailable)
+		require.NoError(t, err)
+		require.Equal(t, []int32{ids[0], ids[2]}, driverIDs(available))
+
+		onTrip, err := h.Store.ListDrivers(ctx, model.DriverOnTrip)
+		require.NoError(t, err)
+		require.Empty(t, onTrip)
+	})
+
+	t.Run("DeleteDriver", func(t *testing.T) {
+		h := newHarness(t)
+
+		created, err := h.Store.CreateDriver(ctx, newDriver(1))
+		require.NoError(t, err)
+
+		require.NoError(t, h.Store.DeleteDriver(ctx, created.ID))
+
+		_, err = h.Store.GetDriver(ctx, created.ID)
+		require.ErrorIs(t, err, store.ErrDriverNotFound)
+		require.ErrorIs(t, h.Store.DeleteDriver(ctx, created.ID), store.ErrDriverNotFound)
+
+		// The license and plate numbers are free again.
+		_, err = h.Store.CreateDriver(ctx, newDriver(1))
+		require.NoError(t, err)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		h := newHarness(t)
+
+		_, err := h.Store.GetDriver(ctx, 1_000_000)
+		require.ErrorIs(t, err, store.ErrDriverNotFound)
+		require.ErrorIs(t, h.Store.UpdateDriver(ctx, 1_000_000, newDriver(1)), store.ErrDriverNotFound)
+		_, err = h.Store.UpdateDriverStatus(ctx, 1_000_000, model.DriverAvailable)
+		require.ErrorIs(t, err, store.ErrDriverNotFound)
+
+		msgs, err := h.Outbox.Pending(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+	})
+
+	t.Run("Canceled Context", func(t *testing.T) {
+		h := newHarness(t)
+
+		created, err := h.Store.CreateDriver(ctx, newDriver(1))
+		require.NoError(t, err)
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err = h.Store.UpdateDriverStatus(canceled, created.ID, model.DriverAvailable)
+		require.ErrorIs(t, err, store.ErrCanceled)
+	})
+
+	t.Run("Concurrent UpdateDriverStatus", func(t *testing.T) {
+		h := newHarness(t)
+
+		created, err := h.Store.CreateDriver(ctx, newDriver(1))
+		require.NoError(t, err)
+
+		const n = 20
+		statuses := []string{model.DriverAvailable, model.DriverOnTrip}
+		errs := make([]error, n)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = h.Store.UpdateDriverStatus(ctx, created.ID, statuses[i%2])
+			}(i)
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+
+		// Every event continues from the status the previous one left.
+		msgs, err := h.Outbox.Pending(ctx, n+1)
+		require.NoError(t, err)
+		previous := pb.DriverStatus_DRIVER_STATUS_OFFLINE
+		for _, msg := range msgs {
+			var event pb.DriverStatusChanged
+			require.NoError(t, proto.Unmarshal(msg.Payload, &event))
+			require.Equal(t, previous, event.PreviousStatus)
+			require.NotEqual(t, event.PreviousStatus, event.Status)
+			previous = event.Status
+		}
+	})
+}
+
+// driverIDs returns the IDs of drivers, in order.
+func driverIDs(drivers []model.Driver) []int32 {
+	ids := make([]int32, 0, len(drivers))
+	for _, d := range drivers {
+		ids = append(ids, d.ID)
+	}
+	return ids
+}
@@ -0,0 +1,360 @@
+// Package validation enforces the buf.validate (protovalidate) constraints
+// declared in the service's .proto files. It implements the standard rules
+// our API uses (required, int32, double, string and enum rules) plus CEL
+// expressions on fields and messages, so the .proto files stay the single
+// source of truth.
+package validation
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Violation describes a single failed constraint.
+type Violation struct {
+	Field   string // Dotted path of the offending field, empty for the request itself
+	RuleID  string // Rule identifier, e.g. "int32.gt" or the id of a CEL rule
+	Message string // Human readable description
+}
+
+// Validator evaluates constraints on proto messages. Compiled CEL programs are
+// cached, so a Validator should be created once and shared.
+type Validator struct {
+	mu       sync.Mutex
+	programs map[string]cel.Program
+	patterns map[string]*regexp.Regexp
+}
+
+// New creates a Validator.
+func New() *Validator {
+	return &Validator{
+		programs: make(map[string]cel.Program),
+		patterns: make(map[string]*regexp.Regexp),
+	}
+}
+
+// Validate checks msg against its declared constraints and returns every
+// violation found. An error is returned only if a constraint itself is
+// malformed or uses a rule this package does not support.
+func (v *Validator) Validate(msg proto.Message) ([]Violation, error) {
+	var violations []Violation
+	if err := v.validateMessage(msg.ProtoReflect(), "", &violations); err != nil {
+		return nil, err
+	}
+	return violations, nil
+}
+
+func (v *Validator) validateMessage(m protoreflect.Message, path string, out *[]Violation) error {
+	desc := m.Descriptor()
+
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if err := v.validateField(m, fields.Get(i), path, out); err != nil {
+			return err
+		}
+	}
+
+	rules, _ := proto.GetExtension(desc.Options(), validate.E_Message).(*validate.MessageRules)
+	for i, rule := range rules.GetCel() {
+		key := fmt.Sprintf("%s#%d", desc.FullName(), i)
+		if err := v.evalCEL(key, rule, cel.ObjectType(string(desc.FullName())), m.Interface(), path, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *Validator) validateField(m protoreflect.Message, fd protoreflect.FieldDescriptor, parent string, out *[]Violation) error {
+	path := string(fd.Name())
+	if parent != "" {
+		path = parent + "." + path
+	}
+
+	rules, _ := proto.GetExtension(fd.Options(), validate.E_Field).(*validate.FieldRules)
+	set := m.Has(fd)
+
+	if rules.GetIgnore() == validate.Ignore_IGNORE_ALWAYS {
+		return nil
+	}
+	if rules.GetRequired() && !set {
+		*out = append(*out, Violation{Field: path, RuleID: "required", Message: "value is required"})
+		return nil
+	}
+	if rules.GetIgnore() == validate.Ignore_IGNORE_IF_ZERO_VALUE && !set {
+		return nil
+	}
+
+	value := m.Get(fd)
+	if rules != nil {
+		if err := v.validateStandard(fd, value, rules, path, out); err != nil {
+			return err
+		}
+		for i, rule := range rules.GetCel() {
+			key := fmt.Sprintf("%s#%d", fd.FullName(), i)
+			celType, err := celTypeOf(fd)
+			if err != nil {
+				return err
+			}
+			if err := v.evalCEL(key, rule, celType, celValueOf(fd, value), path, out); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Descend into nested messages so their constraints apply as well.
+	if fd.Kind() != protoreflect.MessageKind || fd.IsMap() || !set {
+		return nil
+	}
+	if fd.IsList() {
+		list := value.List()
+		for i := 0; i < list.Len(); i++ {
+			if err := v.validateMessage(list.Get(i).Message(), fmt.Sprintf("%s[%d]", path, i), out); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return v.validateMessage(value.Message(), path, out)
+}
+
+func (v *Validator) validateStandard(fd protoreflect.FieldDescriptor, value protoreflect.Value, rules *validate.FieldRules, path string, out *[]Violation) error {
+	if rules.GetType() == nil {
+		return nil
+	}
+	if fd.IsList() || fd.IsMap() {
+		return fmt.Errorf("validation: %s: rules on repeated and map fields are not supported", fd.FullName())
+	}
+
+	add := func(ruleID, format string, args ...any) {
+		*out = append(*out, Violation{Field: path, RuleID: ruleID, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch {
+	case rules.GetInt32() != nil && fd.Kind() == protoreflect.Int32Kind:
+		r, n := rules.GetInt32(), int32(value.Int())
+		if r.HasConst() && n != r.GetConst() {
+			add("int32.const", "value must equal %d", r.GetConst())
+		}
+		if r.HasGt() && n <= r.GetGt() {
+			add("int32.gt", "value must be greater than %d", r.GetGt())
+		}
+		if r.HasGte() && n < r.GetGte() {
+			add("int32.gte", "value must be greater than or equal to %d", r.GetGte())
+		}
+		if r.HasLt() && n >= r.GetLt() {
+			add("int32.lt", "value must be less than %d", r.GetLt())
+		}
+		if r.HasLte() && n > r.GetLte() {
+			add("int32.lte", "value must be less than or equal to %d", r.GetLte())
+		}
+		if len(r.GetIn()) > 0 && !slices.Contains(r.GetIn(), n) {
+			add("int32.in", "value must be in list %v", r.GetIn())
+		}
+		if slices.Contains(r.GetNotIn(), n) {
+			add("int32.not_in", "value must not be in list %v", r.GetNotIn())
+		}
+
+	case rules.GetDouble() != nil && fd.Kind() == protoreflect.DoubleKind:
+		r, f := rules.GetDouble(), value.Float()
+		if r.HasConst() && f != r.GetConst() {
+			add("double.const", "value must equal %g", r.GetConst())
+		}
+		if r.HasGt() && !(f > r.GetGt()) {
+			add("double.gt", "value must be greater than %g", r.GetGt())
+		}
+		if r.HasGte() && !(f >= r.GetGte()) {
+			add("double.gte", "value must be greater than or equal to %g", r.GetGte())
+		}
+		if r.HasLt() && !(f < r.GetLt()) {
+			add("double.lt", "value must be less than %g", r.GetLt())
+		}
+		if r.HasLte() && !(f <= r.GetLte()) {
+			add("double.lte", "value must be less than or equal to %g", r.GetLte())
+		}
+		if r.GetFinite() && (math.IsNaN(f) || math.IsInf(f, 0)) {
+			add("double.finite", "value must be finite")
+		}
+
+	case rules.GetString() != nil && fd.Kind() == protoreflect.StringKind:
+		r, s := rules.GetString(), value.String()
+		length := uint64(utf8.RuneCountInString(s))
+		if r.HasConst() && s != r.GetConst() {
+			add("string.const", "value must equal `%s`", r.GetConst())
+		}
+		if r.HasLen() && length != r.GetLen() {
+			add("string.len", "value length must be %d characters", r.GetLen())
+		}
+		if r.HasMinLen() && length < r.GetMinLen() {
+			add("string.min_len", "value length must be at least %d characters", r.GetMinLen())
+		}
+		if r.HasMaxLen() && length > r.GetMaxLen() {
+			add("string.max_len", "value length must be at most %d characters", r.GetMaxLen())
+		}
+		if r.HasPrefix() && !strings.HasPrefix(s, r.GetPrefix()) {
+			add("string.prefix", "value does not have prefix `%s`", r.GetPrefix())
+		}
+		if r.HasSuffix() && !strings.HasSuffix(s, r.GetSuffix()) {
+			add("string.suffix", "value does not have suffix `%s`", r.GetSuffix())
+		}
+		if r.HasContains() && !strings.Contains(s, r.GetContains()) {
+			add("string.contains", "value does not contain substring `%s`", r.GetContains())
+		}
+		if r.HasPattern() {
+			re, err := v.pattern(r.GetPattern())
+			if err != nil {
+				return fmt.Errorf("validation: %s: %v", fd.FullName(), err)
+			}
+			if !re.MatchString(s) {
+				add("string.pattern", "value does not match regex pattern `%s`", r.GetPattern())
+			}
+		}
+		if len(r.GetIn()) > 0 && !slices.Contains(r.GetIn(), s) {
+			add("string.in", "value must be in list %v", r.GetIn())
+		}
+		if slices.Contains(r.GetNotIn(), s) {
+			add("string.not_in", "value must not be in list %v", r.GetNotIn())
+		}
+
+	case rules.GetEnum() != nil && fd.Kind() == protoreflect.EnumKind:
+		r, n := rules.GetEnum(), int32(value.Enum())
+		if r.HasConst() && n != r.GetConst() {
+			add("enum.const", "value must equal %d", r.GetConst())
+		}
+		if r.GetDefinedOnly() && fd.Enum().Values().ByNumber(protoreflect.EnumNumber(n)) == nil {
+			add("enum.defined_only", "value must be one of the defined enum values")
+		}
+		if len(r.GetIn()) > 0 && !slices.Contains(r.GetIn(), n) {
+			add("enum.in", "value must be in list %v", r.GetIn())
+		}
+		if slices.Contains(r.GetNotIn(), n) {
+			add("enum.not_in", "value must not be in list %v", r.GetNotIn())
+		}
+
+	default:
+		return fmt.Errorf("validation: %s: unsupported rule type %T", fd.FullName(), rules.GetType())
+	}
+	return nil
+}
+
+// evalCEL evaluates a CEL rule with `this` bound to value. A rule fails when
+// its expression yields false or a non-empty string.
+func (v *Validator) evalCEL(key string, rule *validate.Rule, thisType *cel.Type, value any, path string, out *[]Violation) error {
+	prg, err := v.program(key, rule.GetExpression(), thisType, value)
+	if err != nil {
+		return fmt.Errorf("validation: rule %q: %v", rule.GetId(), err)
+	}
+
+	result, _, err := prg.Eval(map[string]any{"this": value})
+	if err != nil {
+		return fmt.Errorf("validation: rule %q: %v", rule.GetId(), err)
+	}
+
+	msg := rule.GetMessage()
+	switch r := result.(type) {
+	case types.Bool:
+		if r {
+			return nil
+		}
+	case types.String:
+		if r == "" {
+			return nil
+		}
+		msg = string(r)
+	default:
+		return fmt.Errorf("validation: rule %q: expression must return bool or string, got %s", rule.GetId(), result.Type())
+	}
+
+	if msg == "" {
+		msg = fmt.Sprintf("failed rule %s", rule.GetId())
+	}
+	*out = append(*out, Violation{Field: path, RuleID: rule.GetId(), Message: msg})
+	return nil
+}
+
+func (v *Validator) program(key, expr string, thisType *cel.Type, value any) (cel.Program, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if prg, ok := v.programs[key]; ok {
+		return prg, nil
+	}
+
+	opts := []cel.EnvOption{cel.Variable("this", thisType)}
+	if msg, ok := value.(proto.Message); ok {
+		opts = append(opts, cel.Types(msg))
+	}
+	env, err := cel.NewEnv(opts...)
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	v.programs[key] = prg
+	return prg, nil
+}
+
+func (v *Validator) pattern(expr string) (*regexp.Regexp, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if re, ok := v.patterns[expr]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	v.patterns[expr] = re
+	return re, nil
+}
+
+// celTypeOf maps a singular field to the CEL type `this` takes in field rules.
+func celTypeOf(fd protoreflect.FieldDescriptor) (*cel.Type, error) {
+	if fd.IsList() || fd.IsMap() {
+		return nil, fmt.Errorf("validation: %s: CEL rules on repeated and map fields are not supported", fd.FullName())
+	}
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return cel.BoolType, nil
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind, protoreflect.EnumKind:
+		return cel.IntType, nil
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return cel.UintType, nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return cel.DoubleType, nil
+	case protoreflect.StringKind:
+		return cel.StringType, nil
+	case protoreflect.BytesKind:
+		return cel.BytesType, nil
+	case protoreflect.MessageKind:
+		return cel.ObjectType(string(fd.Message().FullName())), nil
+	}
+	return nil, fmt.Errorf("validation: %s: unsupported field kind %s", fd.FullName(), fd.Kind())
+}
+
+// celValueOf unwraps a field value into what CEL expects for `this`.
+func celValueOf(fd protoreflect.FieldDescriptor, value protoreflect.Value) any {
+	if fd.Kind() == protoreflect.MessageKind {
+		return value.Message().Interface()
+	}
+	return value.Interface()
+}
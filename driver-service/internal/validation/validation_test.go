@@ -0,0 +1,84 @@
+package validation
+
+import (
+	"testing"
+
+	pb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	validator := New()
+	vehicle := &pb.Vehicle{Make: "Toyota", Model: "Corolla", Color: "White", PlateNumber: "LEA-1234", Seats: 4}
+
+	tests := []struct {
+		name     string
+		req      proto.Message
+		expected []Violation
+	}{
+		{
+			name: "Valid",
+			req: &pb.UpdateDriverRequest{
+				DriverId: 1,
+				Driver:   &pb.Driver{Name: "Ali Raza", Phone: "+923001234567", LicenseNumber: "LHR-12345", Vehicle: vehicle},
+			},
+			expected: nil,
+		},
+		{
+			name: "Missing Driver",
+			req:  &pb.UpdateDriverRequest{DriverId: 1},
+			expected: []Violation{
+				{Field: "driver", RuleID: "required", Message: "value is required"},
+			},
+		},
+		{
+			name: "Invalid Phone And Seats",
+			req: &pb.CreateDriverRequest{
+				Driver: &pb.Driver{
+					Name:          "Ali Raza",
+					Phone:         "call me",
+					LicenseNumber: "LHR-12345",
+					Vehicle:       &pb.Vehicle{Make: "Toyota", Model: "Corolla", PlateNumber: "LEA-1234", Seats: 0},
+				},
+			},
+			expected: []Violation{
+				{Field: "driver.phone", RuleID: "string.pattern", Message: "value does not match regex pattern `^\\+?[0-9]{7,15}$`"},
+				{Field: "driver.vehicle.seats", RuleID: "int32.gt", Message: "value must be greater than 0"},
+			},
+		},
+		{
+			name: "Unspecified Status",
+			req:  &pb.UpdateDriverStatusRequest{DriverId: 1},
+			expected: []Violation{
+				{Field: "status", RuleID: "enum.not_in", Message: "value must not be in list [0]"},
+			},
+		},
+		{
+			name: "Undefined Status",
+			req:  &pb.ListDriversRequest{Status: pb.DriverStatus(42)},
+			expected: []Violation{
+				{Field: "status", RuleID: "enum.defined_only", Message: "value must be one of the defined enum values"},
+			},
+		},
+		{
+			name: "Location Out Of Range",
+			req: &pb.FindNearbyDriversRequest{
+				Location: &pb.Location{Latitude: 91, Longitude: -180},
+				RadiusKm: 0,
+			},
+			expected: []Violation{
+				{Field: "location.latitude", RuleID: "double.lte", Message: "value must be less than or equal to 90"},
+				{Field: "radius_km", RuleID: "double.gt", Message: "value must be greater than 0"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := validator.Validate(tt.req)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, violations)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        (unknown)
+// source: driver/v1/events.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DriverStatusChanged is published when a driver's availability changes.
+type DriverStatusChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DriverId       int32        `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	PreviousStatus DriverStatus `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3,enum=driver.v1.DriverStatus" json:"previous_status,omitempty"`
+	Status         DriverStatus `protobuf:"varint,3,opt,name=status,proto3,enum=driver.v1.DriverStatus" json:"status,omitempty"`
+}
+
+func (x *DriverStatusChanged) Reset() {
+	*x = DriverStatusChanged{}
+	mi := &file_driver_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverStatusChanged) ProtoMessage() {}
+
+func (x *DriverStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverStatusChanged.ProtoReflect.Descriptor instead.
+func (*DriverStatusChanged) Descriptor() ([]byte, []int) {
+	return file_driver_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DriverStatusChanged) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *DriverStatusChanged) GetPreviousStatus() DriverStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return DriverStatus_DRIVER_STATUS_UNSPECIFIED
+}
+
+func (x *DriverStatusChanged) GetStatus() DriverStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DriverStatus_DRIVER_STATUS_UNSPECIFIED
+}
+
+var File_driver_v1_events_proto protoreflect.FileDescriptor
+
+var file_driver_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x01, 0x0a, 0x13, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x3e, 0x5a, 0x3c, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67,
+	0x5f, 0x66, 0x61, 0x6c, 0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_driver_v1_events_proto_rawDescOnce sync.Once
+	file_driver_v1_events_proto_rawDescData = file_driver_v1_events_proto_rawDesc
+)
+
+func file_driver_v1_events_proto_rawDescGZIP() []byte {
+	file_driver_v1_events_proto_rawDescOnce.Do(func() {
+		file_driver_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_driver_v1_events_proto_rawDescData)
+	})
+	return file_driver_v1_events_proto_rawDescData
+}
+
+var file_driver_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_driver_v1_events_proto_goTypes = []any{
+	(*DriverStatusChanged)(nil), // 0: driver.v1.DriverStatusChanged
+	(DriverStatus)(0),           // 1: driver.v1.DriverStatus
+}
+var file_driver_v1_events_proto_depIdxs = []int32{
+	1, // 0: driver.v1.DriverStatusChanged.previous_status:type_name -> driver.v1.DriverStatus
+	1, // 1: driver.v1.DriverStatusChanged.status:type_name -> driver.v1.DriverStatus
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_driver_v1_events_proto_init() }
+func file_driver_v1_events_proto_init() {
+	if File_driver_v1_events_proto != nil {
+		return
+	}
+	file_driver_v1_driver_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_driver_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_driver_v1_events_proto_goTypes,
+		DependencyIndexes: file_driver_v1_events_proto_depIdxs,
+		MessageInfos:      file_driver_v1_events_proto_msgTypes,
+	}.Build()
+	File_driver_v1_events_proto = out.File
+	file_driver_v1_events_proto_rawDesc = nil
+	file_driver_v1_events_proto_goTypes = nil
+	file_driver_v1_events_proto_depIdxs = nil
+}
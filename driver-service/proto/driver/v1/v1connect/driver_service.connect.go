@@ -0,0 +1,333 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: driver/v1/driver_service.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DriverServiceName is the fully-qualified name of the DriverService service.
+	DriverServiceName = "driver.v1.DriverService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DriverServiceCreateDriverProcedure is the fully-qualified name of the DriverService's
+	// CreateDriver RPC.
+	DriverServiceCreateDriverProcedure = "/driver.v1.DriverService/CreateDriver"
+	// DriverServiceGetDriverProcedure is the fully-qualified name of the DriverService's GetDriver RPC.
+	DriverServiceGetDriverProcedure = "/driver.v1.DriverService/GetDriver"
+	// DriverServiceListDriversProcedure is the fully-qualified name of the DriverService's ListDrivers
+	// RPC.
+	DriverServiceListDriversProcedure = "/driver.v1.DriverService/ListDrivers"
+	// DriverServiceUpdateDriverProcedure is the fully-qualified name of the DriverService's
+	// UpdateDriver RPC.
+	DriverServiceUpdateDriverProcedure = "/driver.v1.DriverService/UpdateDriver"
+	// DriverServiceUpdateDriverStatusProcedure is the fully-qualified name of the DriverService's
+	// UpdateDriverStatus RPC.
+	DriverServiceUpdateDriverStatusProcedure = "/driver.v1.DriverService/UpdateDriverStatus"
+	// DriverServiceDeleteDriverProcedure is the fully-qualified name of the DriverService's
+	// DeleteDriver RPC.
+	DriverServiceDeleteDriverProcedure = "/driver.v1.DriverService/DeleteDriver"
+	// DriverServiceUpdateLocationProcedure is the fully-qualified name of the DriverService's
+	// UpdateLocation RPC.
+	DriverServiceUpdateLocationProcedure = "/driver.v1.DriverService/UpdateLocation"
+	// DriverServiceFindNearbyDriversProcedure is the fully-qualified name of the DriverService's
+	// FindNearbyDrivers RPC.
+	DriverServiceFindNearbyDriversProcedure = "/driver.v1.DriverService/FindNearbyDrivers"
+)
+
+// DriverServiceClient is a client for the driver.v1.DriverService service.
+type DriverServiceClient interface {
+	// CreateDriver registers a driver. License numbers and plate numbers are
+	// unique across drivers.
+	CreateDriver(context.Context, *connect.Request[v1.CreateDriverRequest]) (*connect.Response[v1.CreateDriverResponse], error)
+	GetDriver(context.Context, *connect.Request[v1.GetDriverRequest]) (*connect.Response[v1.GetDriverResponse], error)
+	// ListDrivers lists drivers by id, optionally only those with a status.
+	ListDrivers(context.Context, *connect.Request[v1.ListDriversRequest]) (*connect.Response[v1.ListDriversResponse], error)
+	// UpdateDriver updates a driver's profile and vehicle. The status is left
+	// unchanged.
+	UpdateDriver(context.Context, *connect.Request[v1.UpdateDriverRequest]) (*connect.Response[v1.UpdateDriverResponse], error)
+	// UpdateDriverStatus changes a driver's availability.
+	UpdateDriverStatus(context.Context, *connect.Request[v1.UpdateDriverStatusRequest]) (*connect.Response[v1.UpdateDriverStatusResponse], error)
+	DeleteDriver(context.Context, *connect.Request[v1.DeleteDriverRequest]) (*connect.Response[v1.DeleteDriverResponse], error)
+	// UpdateLocation streams the GPS fixes of one driver. Every message must
+	// carry the same driver_id; the latest fix is the driver's location until
+	// it goes stale. The response reports how many fixes were accepted.
+	UpdateLocation(context.Context) *connect.ClientStreamForClient[v1.UpdateLocationRequest, v1.UpdateLocationResponse]
+	// FindNearbyDrivers returns the available drivers with a fresh location
+	// within radius_km of a point, nearest first.
+	FindNearbyDrivers(context.Context, *connect.Request[v1.FindNearbyDriversRequest]) (*connect.Response[v1.FindNearbyDriversResponse], error)
+}
+
+// NewDriverServiceClient constructs a client for the driver.v1.DriverService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDriverServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DriverServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	driverServiceMethods := v1.File_driver_v1_driver_service_proto.Services().ByName("DriverService").Methods()
+	return &driverServiceClient{
+		createDriver: connect.NewClient[v1.CreateDriverRequest, v1.CreateDriverResponse](
+			httpClient,
+			baseURL+DriverServiceCreateDriverProcedure,
+			connect.WithSchema(driverServiceMethods.ByName("CreateDriver")),
+			connect.WithClientOptions(opts...),
+		),
+		getDriver: connect.NewClient[v1.GetDriverRequest, v1.GetDriverResponse](
+			httpClient,
+			baseURL+DriverServiceGetDriverProcedure,
+			connect.WithSchema(driverServiceMethods.ByName("GetDriver")),
+			connect.WithClientOptions(opts...),
+		),
+		listDrivers: connect.NewClient[v1.ListDriversRequest, v1.ListDriversResponse](
+			httpClient,
+			baseURL+DriverServiceListDriversProcedure,
+			connect.WithSchema(driverServiceMethods.ByName("ListDrivers")),
+			connect.WithClientOptions(opts...),
+		),
+		updateDriver: connect.NewClient[v1.UpdateDriverRequest, v1.UpdateDriverResponse](
+			httpClient,
+			baseURL+DriverServiceUpdateDriverProcedure,
+			connect.WithSchema(driverServiceMethods.ByName("UpdateDriver")),
+			connect.WithClientOptions(opts...),
+		),
+		updateDriverStatus: connect.NewClient[v1.UpdateDriverStatusRequest, v1.UpdateDriverStatusResponse](
+			httpClient,
+			baseURL+DriverServiceUpdateDriverStatusProcedure,
+			connect.WithSchema(driverServiceMethods.ByName("UpdateDriverStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteDriver: connect.NewClient[v1.DeleteDriverRequest, v1.DeleteDriverResponse](
+			httpClient,
+			baseURL+DriverServiceDeleteDriverProcedure,
+			connect.WithSchema(driverServiceMethods.ByName("DeleteDriver")),
+			connect.WithClientOptions(opts...),
+		),
+		updateLocation: connect.NewClient[v1.UpdateLocationRequest, v1.UpdateLocationResponse](
+			httpClient,
+			baseURL+DriverServiceUpdateLocationProcedure,
+			connect.WithSchema(driverServiceMethods.ByName("UpdateLocation")),
+			connect.WithClientOptions(opts...),
+		),
+		findNearbyDrivers: connect.NewClient[v1.FindNearbyDriversRequest, v1.FindNearbyDriversResponse](
+			httpClient,
+			baseURL+DriverServiceFindNearbyDriversProcedure,
+			connect.WithSchema(driverServiceMethods.ByName("FindNearbyDrivers")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// driverServiceClient implements DriverServiceClient.
+type driverServiceClient struct {
+	createDriver       *connect.Client[v1.CreateDriverRequest, v1.CreateDriverResponse]
+	getDriver          *connect.Client[v1.GetDriverRequest, v1.GetDriverResponse]
+	listDrivers        *connect.Client[v1.ListDriversRequest, v1.ListDriversResponse]
+	updateDriver       *connect.Client[v1.UpdateDriverRequest, v1.UpdateDriverResponse]
+	updateDriverStatus *connect.Client[v1.UpdateDriverStatusRequest, v1.UpdateDriverStatusResponse]
+	deleteDriver       *connect.Client[v1.DeleteDriverRequest, v1.DeleteDriverResponse]
+	updateLocation     *connect.Client[v1.UpdateLocationRequest, v1.UpdateLocationResponse]
+	findNearbyDrivers  *connect.Client[v1.FindNearbyDriversRequest, v1.FindNearbyDriversResponse]
+}
+
+// CreateDriver calls driver.v1.DriverService.CreateDriver.
+func (c *driverServiceClient) CreateDriver(ctx context.Context, req *connect.Request[v1.CreateDriverRequest]) (*connect.Response[v1.CreateDriverResponse], error) {
+	return c.createDriver.CallUnary(ctx, req)
+}
+
+// GetDriver calls driver.v1.DriverService.GetDriver.
+func (c *driverServiceClient) GetDriver(ctx context.Context, req *connect.Request[v1.GetDriverRequest]) (*connect.Response[v1.GetDriverResponse], error) {
+	return c.getDriver.CallUnary(ctx, req)
+}
+
+// ListDrivers calls driver.v1.DriverService.ListDrivers.
+func (c *driverServiceClient) ListDrivers(ctx context.Context, req *connect.Request[v1.ListDriversRequest]) (*connect.Response[v1.ListDriversResponse], error) {
+	return c.listDrivers.CallUnary(ctx, req)
+}
+
+// UpdateDriver calls driver.v1.DriverService.UpdateDriver.
+func (c *driverServiceClient) UpdateDriver(ctx context.Context, req *connect.Request[v1.UpdateDriverRequest]) (*connect.Response[v1.UpdateDriverResponse], error) {
+	return c.updateDriver.CallUnary(ctx, req)
+}
+
+// UpdateDriverStatus calls driver.v1.DriverService.UpdateDriverStatus.
+func (c *driverServiceClient) UpdateDriverStatus(ctx context.Context, req *connect.Request[v1.UpdateDriverStatusRequest]) (*connect.Response[v1.UpdateDriverStatusResponse], error) {
+	return c.updateDriverStatus.CallUnary(ctx, req)
+}
+
+// DeleteDriver calls driver.v1.DriverService.DeleteDriver.
+func (c *driverServiceClient) DeleteDriver(ctx context.Context, req *connect.Request[v1.DeleteDriverRequest]) (*connect.Response[v1.DeleteDriverResponse], error) {
+	return c.deleteDriver.CallUnary(ctx, req)
+}
+
+// UpdateLocation calls driver.v1.DriverService.UpdateLocation.
+func (c *driverServiceClient) UpdateLocation(ctx context.Context) *connect.ClientStreamForClient[v1.UpdateLocationRequest, v1.UpdateLocationResponse] {
+	return c.updateLocation.CallClientStream(ctx)
+}
+
+// FindNearbyDrivers calls driver.v1.DriverService.FindNearbyDrivers.
+func (c *driverServiceClient) FindNearbyDrivers(ctx context.Context, req *connect.Request[v1.FindNearbyDriversRequest]) (*connect.Response[v1.FindNearbyDriversResponse], error) {
+	return c.findNearbyDrivers.CallUnary(ctx, req)
+}
+
+// DriverServiceHandler is an implementation of the driver.v1.DriverService service.
+type DriverServiceHandler interface {
+	// CreateDriver registers a driver. License numbers and plate numbers are
+	// unique across drivers.
+	CreateDriver(context.Context, *connect.Request[v1.CreateDriverRequest]) (*connect.Response[v1.CreateDriverResponse], error)
+	GetDriver(context.Context, *connect.Request[v1.GetDriverRequest]) (*connect.Response[v1.GetDriverResponse], error)
+	// ListDrivers lists drivers by id, optionally only those with a status.
+	ListDrivers(context.Context, *connect.Request[v1.ListDriversRequest]) (*connect.Response[v1.ListDriversResponse], error)
+	// UpdateDriver updates a driver's profile and vehicle. The status is left
+	// unchanged.
+	UpdateDriver(context.Context, *connect.Request[v1.UpdateDriverRequest]) (*connect.Response[v1.UpdateDriverResponse], error)
+	// UpdateDriverStatus changes a driver's availability.
+	UpdateDriverStatus(context.Context, *connect.Request[v1.UpdateDriverStatusRequest]) (*connect.Response[v1.UpdateDriverStatusResponse], error)
+	DeleteDriver(context.Context, *connect.Request[v1.DeleteDriverRequest]) (*connect.Response[v1.DeleteDriverResponse], error)
+	// UpdateLocation streams the GPS fixes of one driver. Every message must
+	// carry the same driver_id; the latest fix is the driver's location until
+	// it goes stale. The response reports how many fixes were accepted.
+	UpdateLocation(context.Context, *connect.ClientStream[v1.UpdateLocationRequest]) (*connect.Response[v1.UpdateLocationResponse], error)
+	// FindNearbyDrivers returns the available drivers with a fresh location
+	// within radius_km of a point, nearest first.
+	FindNearbyDrivers(context.Context, *connect.Request[v1.FindNearbyDriversRequest]) (*connect.Response[v1.FindNearbyDriversResponse], error)
+}
+
+// NewDriverServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDriverServiceHandler(svc DriverServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	driverServiceMethods := v1.File_driver_v1_driver_service_proto.Services().ByName("DriverService").Methods()
+	driverServiceCreateDriverHandler := connect.NewUnaryHandler(
+		DriverServiceCreateDriverProcedure,
+		svc.CreateDriver,
+		connect.WithSchema(driverServiceMethods.ByName("CreateDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	driverServiceGetDriverHandler := connect.NewUnaryHandler(
+		DriverServiceGetDriverProcedure,
+		svc.GetDriver,
+		connect.WithSchema(driverServiceMethods.ByName("GetDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	driverServiceListDriversHandler := connect.NewUnaryHandler(
+		DriverServiceListDriversProcedure,
+		svc.ListDrivers,
+		connect.WithSchema(driverServiceMethods.ByName("ListDrivers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	driverServiceUpdateDriverHandler := connect.NewUnaryHandler(
+		DriverServiceUpdateDriverProcedure,
+		svc.UpdateDriver,
+		connect.WithSchema(driverServiceMethods.ByName("UpdateDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	driverServiceUpdateDriverStatusHandler := connect.NewUnaryHandler(
+		DriverServiceUpdateDriverStatusProcedure,
+		svc.UpdateDriverStatus,
+		connect.WithSchema(driverServiceMethods.ByName("UpdateDriverStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	driverServiceDeleteDriverHandler := connect.NewUnaryHandler(
+		DriverServiceDeleteDriverProcedure,
+		svc.DeleteDriver,
+		connect.WithSchema(driverServiceMethods.ByName("DeleteDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	driverServiceUpdateLocationHandler := connect.NewClientStreamHandler(
+		DriverServiceUpdateLocationProcedure,
+		svc.UpdateLocation,
+		connect.WithSchema(driverServiceMethods.ByName("UpdateLocation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	driverServiceFindNearbyDriversHandler := connect.NewUnaryHandler(
+		DriverServiceFindNearbyDriversProcedure,
+		svc.FindNearbyDrivers,
+		connect.WithSchema(driverServiceMethods.ByName("FindNearbyDrivers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/driver.v1.DriverService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DriverServiceCreateDriverProcedure:
+			driverServiceCreateDriverHandler.ServeHTTP(w, r)
+		case DriverServiceGetDriverProcedure:
+			driverServiceGetDriverHandler.ServeHTTP(w, r)
+		case DriverServiceListDriversProcedure:
+			driverServiceListDriversHandler.ServeHTTP(w, r)
+		case DriverServiceUpdateDriverProcedure:
+			driverServiceUpdateDriverHandler.ServeHTTP(w, r)
+		case DriverServiceUpdateDriverStatusProcedure:
+			driverServiceUpdateDriverStatusHandler.ServeHTTP(w, r)
+		case DriverServiceDeleteDriverProcedure:
+			driverServiceDeleteDriverHandler.ServeHTTP(w, r)
+		case DriverServiceUpdateLocationProcedure:
+			driverServiceUpdateLocationHandler.ServeHTTP(w, r)
+		case DriverServiceFindNearbyDriversProcedure:
+			driverServiceFindNearbyDriversHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDriverServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDriverServiceHandler struct{}
+
+func (UnimplementedDriverServiceHandler) CreateDriver(context.Context, *connect.Request[v1.CreateDriverRequest]) (*connect.Response[v1.CreateDriverResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("driver.v1.DriverService.CreateDriver is not implemented"))
+}
+
+func (UnimplementedDriverServiceHandler) GetDriver(context.Context, *connect.Request[v1.GetDriverRequest]) (*connect.Response[v1.GetDriverResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("driver.v1.DriverService.GetDriver is not implemented"))
+}
+
+func (UnimplementedDriverServiceHandler) ListDrivers(context.Context, *connect.Request[v1.ListDriversRequest]) (*connect.Response[v1.ListDriversResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("driver.v1.DriverService.ListDrivers is not implemented"))
+}
+
+func (UnimplementedDriverServiceHandler) UpdateDriver(context.Context, *connect.Request[v1.UpdateDriverRequest]) (*connect.Response[v1.UpdateDriverResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("driver.v1.DriverService.UpdateDriver is not implemented"))
+}
+
+func (UnimplementedDriverServiceHandler) UpdateDriverStatus(context.Context, *connect.Request[v1.UpdateDriverStatusRequest]) (*connect.Response[v1.UpdateDriverStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("driver.v1.DriverService.UpdateDriverStatus is not implemented"))
+}
+
+func (UnimplementedDriverServiceHandler) DeleteDriver(context.Context, *connect.Request[v1.DeleteDriverRequest]) (*connect.Response[v1.DeleteDriverResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("driver.v1.DriverService.DeleteDriver is not implemented"))
+}
+
+func (UnimplementedDriverServiceHandler) UpdateLocation(context.Context, *connect.ClientStream[v1.UpdateLocationRequest]) (*connect.Response[v1.UpdateLocationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("driver.v1.DriverService.UpdateLocation is not implemented"))
+}
+
+func (UnimplementedDriverServiceHandler) FindNearbyDrivers(context.Context, *connect.Request[v1.FindNearbyDriversRequest]) (*connect.Response[v1.FindNearbyDriversResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("driver.v1.DriverService.FindNearbyDrivers is not implemented"))
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/golang_falcon_task/driver-service/internal/service"
+	pb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"github.com/golang_falcon_task/driver-service/proto/driver/v1/v1connect"
+)
+
+// connectService exposes DriverService to the generated Connect handler,
+// which serves both the Connect and gRPC-Web protocols.
+type connectService struct {
+	svc *service.DriverService
+}
+
+var _ v1connect.DriverServiceHandler = (*connectService)(nil)
+
+func (s *connectService) CreateDriver(ctx context.Context, req *connect.Request[pb.CreateDriverRequest]) (*connect.Response[pb.CreateDriverResponse], error) {
+	res, err := s.svc.CreateDriver(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) GetDriver(ctx context.Context, req *connect.Request[pb.GetDriverRequest]) (*connect.Response[pb.GetDriverResponse], error) {
+	res, err := s.svc.GetDriver(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) ListDrivers(ctx context.Context, req *connect.Request[pb.ListDriversRequest]) (*connect.Response[pb.ListDriversResponse], error) {
+	res, err := s.svc.ListDrivers(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) UpdateDriver(ctx context.Context, req *connect.Request[pb.UpdateDriverRequest]) (*connect.Response[pb.UpdateDriverResponse], error) {
+	res, err := s.svc.UpdateDriver(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) UpdateDriverStatus(ctx context.Context, req *connect.Request[pb.UpdateDriverStatusRequest]) (*connect.Response[pb.UpdateDriverStatusResponse], error) {
+	res, err := s.svc.UpdateDriverStatus(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) DeleteDriver(ctx context.Context, req *connect.Request[pb.DeleteDriverRequest]) (*connect.Response[pb.DeleteDriverResponse], error) {
+	res, err := s.svc.DeleteDriver(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) UpdateLocation(ctx context.Context, stream *connect.ClientStream[pb.UpdateLocationRequest]) (*connect.Response[pb.UpdateLocationResponse], error) {
+	res, err := s.svc.RecordLocations(ctx, func() (*pb.UpdateLocationRequest, error) {
+		if !stream.Receive() {
+			if err := stream.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		return stream.Msg(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) FindNearbyDrivers(ctx context.Context, req *connect.Request[pb.FindNearbyDriversRequest]) (*connect.Response[pb.FindNearbyDriversResponse], error) {
+	res, err := s.svc.FindNearbyDrivers(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
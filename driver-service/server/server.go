@@ -0,0 +1,147 @@
+// Package server wires DriverService into a gRPC server with the production
+// interceptor chain, and serves it over Connect and gRPC-Web on the same port.
+// It is used by cmd/main.go and by the end-to-end harness.
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/golang_falcon_task/driver-service/internal/geo"
+	"github.com/golang_falcon_task/driver-service/internal/middleware"
+	"github.com/golang_falcon_task/driver-service/internal/outbox"
+	"github.com/golang_falcon_task/driver-service/internal/service"
+	"github.com/golang_falcon_task/driver-service/internal/store"
+	pb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"github.com/golang_falcon_task/driver-service/proto/driver/v1/v1connect"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// Options configures the server.
+type Options struct {
+	Logger *logrus.Logger
+
+	// DB backs the Postgres store. When nil, an in-memory store seeded with
+	// the docker/init.sql fixtures is used instead.
+	DB *pgxpool.Pool
+
+	// Broker receives the domain events relayed from the outbox. When nil,
+	// an in-process broker without subscribers is used.
+	Broker outbox.Broker
+
+	// OutboxPollInterval is how often the relay publishes pending events.
+	// Defaults to one second.
+	OutboxPollInterval time.Duration
+
+	// LocationMaxAge is how long a driver's location is used after it was
+	// reported. Defaults to two minutes.
+	LocationMaxAge time.Duration
+}
+
+// Server is DriverService behind the logging, metrics and validation
+// interceptors. The embedded gRPC server serves native gRPC clients; Handler
+// adds the Connect and gRPC-Web protocols for browsers.
+type Server struct {
+	*grpc.Server
+
+	// Relay publishes the events recorded by the store; run it with Relay.Run.
+	Relay *outbox.Relay
+
+	// Locations holds the drivers' latest locations; drop stale ones with
+	// Locations.RunEviction.
+	Locations *geo.Index
+
+	connect *http.ServeMux
+}
+
+// New creates a Server with DriverService registered.
+func New(opts Options) (*Server, error) {
+	var (
+		driverStore service.DriverStore
+		events      outbox.Store
+	)
+	if opts.DB != nil {
+		driverStore = store.NewPGDriverStore(opts.DB)
+		events = outbox.NewPGStore(opts.DB)
+	} else {
+		memStore := store.NewMemDriverStore()
+		if err := memStore.SeedDemoData(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to seed in-memory store: %v", err)
+		}
+		driverStore = memStore
+		events = memStore.Outbox()
+	}
+	maxAge := opts.LocationMaxAge
+	if maxAge == 0 {
+		maxAge = 2 * time.Minute
+	}
+	locations := geo.NewIndex(maxAge)
+	driverService := service.NewDriverService(driverStore, locations, opts.Logger)
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		middleware.LoggingInterceptor(opts.Logger), // Logs all requests and responses
+		middleware.MetricsInterceptor(),            // Captures Prometheus metrics
+		middleware.ValidationInterceptor(),         // Enforces buf.validate rules from the .proto files
+	}
+
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		middleware.StreamLoggingInterceptor(opts.Logger), // Logs the start and end of streams
+		middleware.StreamMetricsInterceptor(),            // Captures Prometheus metrics
+		middleware.StreamValidationInterceptor(),         // Enforces buf.validate rules on received messages
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	pb.RegisterDriverServiceServer(grpcServer, driverService)
+
+	// Enable reflection for testing
+	reflection.Register(grpcServer)
+
+	connectMux := http.NewServeMux()
+	connectMux.Handle(v1connect.NewDriverServiceHandler(
+		&connectService{svc: driverService},
+		connect.WithInterceptors(
+			middleware.ConnectInterceptor(interceptors...),
+			middleware.ConnectStreamInterceptor(streamInterceptors...),
+		),
+	))
+
+	broker := opts.Broker
+	if broker == nil {
+		broker = outbox.NewInProcessBroker()
+	}
+	interval := opts.OutboxPollInterval
+	if interval == 0 {
+		interval = time.Second
+	}
+	relay := outbox.NewRelay(events, broker, interval, opts.Logger)
+
+	return &Server{Server: grpcServer, Relay: relay, Locations: locations, connect: connectMux}, nil
+}
+
+// Handler serves native gRPC, Connect and gRPC-Web on one port, over HTTP/1.1
+// and cleartext HTTP/2 (h2c). Native gRPC requests go to the embedded gRPC
+// server; everything else is handled by the Connect handler.
+func (s *Server) Handler() http.Handler {
+	return h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType := r.Header.Get("Content-Type")
+		if r.ProtoMajor == 2 && strings.HasPrefix(contentType, "application/grpc") &&
+			!strings.HasPrefix(contentType, "application/grpc-web") {
+			s.Server.ServeHTTP(w, r)
+			return
+		}
+		s.connect.ServeHTTP(w, r)
+	}), &http2.Server{})
+}
@@ -0,0 +1,116 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	bookingpb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	gatewaypb "github.com/golang_falcon_task/gateway-service/proto/gateway/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func withAPIKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+key)
+}
+
+func TestGateway_RiderDashboard(t *testing.T) {
+	h := New(t)
+	ctx := withAPIKey(context.Background(), GatewayAPIKey)
+
+	res, err := gatewaypb.NewGatewayServiceClient(h.Gateway).GetRiderDashboard(ctx, &gatewaypb.GetRiderDashboardRequest{UserId: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Usman Attiq", res.Name)
+	require.Len(t, res.Bookings, 1)
+	require.Equal(t, "Downtown", res.Bookings[0].Ride.Source)
+	require.Equal(t, "Airport", res.Bookings[0].Ride.Destination)
+	require.Equal(t, res.Bookings[0].Ride.Distance, res.TotalDistance)
+	require.Len(t, res.TotalCosts, 1)
+	require.True(t, proto.Equal(res.Bookings[0].Ride.Cost, res.TotalCosts[0]), "expected %v, got %v", res.Bookings[0].Ride.Cost, res.TotalCosts[0])
+
+	_, err = gatewaypb.NewGatewayServiceClient(h.Gateway).GetRiderDashboard(ctx, &gatewaypb.GetRiderDashboardRequest{UserId: 1_000_000})
+	requireErrorInfo(t, err, codes.NotFound, "USER_NOT_FOUND")
+}
+
+func TestGateway_Proxy(t *testing.T) {
+	h := New(t)
+	ctx := withAPIKey(context.Background(), GatewayAPIKey)
+
+	user, err := userpb.NewUserServiceClient(h.Gateway).GetUser(ctx, &userpb.GetUserRequest{UserId: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Usman Attiq", user.Name)
+
+	booking, err := bookingpb.NewBookingServiceClient(h.Gateway).GetBooking(ctx, &bookingpb.GetBookingRequest{BookingId: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Usman Attiq", booking.Name)
+
+	driver, err := driverpb.NewDriverServiceClient(h.Gateway).GetDriver(ctx, &driverpb.GetDriverRequest{DriverId: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Ali Raza", driver.Driver.Name)
+
+	// Backend errors reach the client unchanged.
+	_, err = userpb.NewUserServiceClient(h.Gateway).GetUser(ctx, &userpb.GetUserRequest{UserId: 1_000_000})
+	requireErrorInfo(t, err, codes.NotFound, "USER_NOT_FOUND")
+
+	// Operator, driver and settlement methods are not forwarded.
+	_, err = bookingpb.NewBookingServiceClient(h.Gateway).CreatePromo(ctx, &bookingpb.CreatePromoRequest{})
+	requireErrorInfo(t, err, codes.PermissionDenied, "METHOD_NOT_ALLOWED")
+	_, err = bookingpb.NewBookingServiceClient(h.Gateway).CompleteBooking(ctx, &bookingpb.CompleteBookingRequest{BookingId: 1, DriverId: 1})
+	requireErrorInfo(t, err, codes.PermissionDenied, "METHOD_NOT_ALLOWED")
+	_, err = userpb.NewUserServiceClient(h.Gateway).ReleaseHold(ctx, &userpb.ReleaseHoldRequest{HoldId: 1})
+	requireErrorInfo(t, err, codes.PermissionDenied, "METHOD_NOT_ALLOWED")
+	_, err = userpb.NewUserServiceClient(h.Gateway).TopUpWallet(ctx, &userpb.TopUpWalletRequest{UserId: 1})
+	requireErrorInfo(t, err, codes.PermissionDenied, "METHOD_NOT_ALLOWED")
+}
+
+func TestGateway_Unauthenticated(t *testing.T) {
+	h := New(t)
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{name: "Missing Key", ctx: context.Background()},
+		{name: "Wrong Key", ctx: withAPIKey(context.Background(), "wrong-key")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := userpb.NewUserServiceClient(h.Gateway).GetUser(tt.ctx, &userpb.GetUserRequest{UserId: 1})
+			requireErrorInfo(t, err, codes.Unauthenticated, "UNAUTHENTICATED")
+
+			_, err = gatewaypb.NewGatewayServiceClient(h.Gateway).GetRiderDashboard(tt.ctx, &gatewaypb.GetRiderDashboardRequest{UserId: 1})
+			requireErrorInfo(t, err, codes.Unauthenticated, "UNAUTHENTICATED")
+		})
+	}
+}
+
+func TestGateway_RateLimit(t *testing.T) {
+	h := New(t)
+	conn := h.NewGateway(t, 0.001, 2)
+	ctx := withAPIKey(context.Background(), GatewayAPIKey)
+	client := userpb.NewUserServiceClient(conn)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetUser(ctx, &userpb.GetUserRequest{UserId: 1})
+		require.NoError(t, err)
+	}
+
+	_, err := client.GetUser(ctx, &userpb.GetUserRequest{UserId: 1})
+	requireErrorInfo(t, err, codes.ResourceExhausted, "RATE_LIMITED")
+
+	var retry *errdetails.RetryInfo
+	for _, d := range status.Convert(err).Details() {
+		if r, ok := d.(*errdetails.RetryInfo); ok {
+			retry = r
+		}
+	}
+	require.NotNil(t, retry)
+	require.Positive(t, retry.RetryDelay.AsDuration())
+}
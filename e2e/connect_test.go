@@ -0,0 +1,83 @@
+package e2e
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	bookingpb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"github.com/golang_falcon_task/booking-service/proto/booking/v1/v1connect"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	driverconnect "github.com/golang_falcon_task/driver-service/proto/driver/v1/v1connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestConnectProtocols(t *testing.T) {
+	h := New(t)
+
+	tests := []struct {
+		name string
+		opts []connect.ClientOption
+	}{
+		{name: "Connect JSON", opts: []connect.ClientOption{connect.WithProtoJSON()}},
+		{name: "Connect Binary"},
+		{name: "gRPC-Web", opts: []connect.ClientOption{connect.WithGRPCWeb()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := v1connect.NewBookingServiceClient(h.BookingsWeb, BaseURL, tt.opts...)
+			ctx := context.Background()
+
+			res, err := client.GetBooking(ctx, connect.NewRequest(&bookingpb.GetBookingRequest{BookingId: 1}))
+			require.NoError(t, err)
+			require.Equal(t, "Usman Attiq", res.Msg.Name)
+
+			_, err = client.GetBooking(ctx, connect.NewRequest(&bookingpb.GetBookingRequest{BookingId: 0}))
+			var connectErr *connect.Error
+			require.True(t, errors.As(err, &connectErr), "unexpected error %v", err)
+			require.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+
+			var reasons []string
+			var badRequest bool
+			for _, d := range connectErr.Details() {
+				msg, err := d.Value()
+				require.NoError(t, err)
+				switch msg := msg.(type) {
+				case *errdetails.ErrorInfo:
+					reasons = append(reasons, msg.Reason)
+				case *errdetails.BadRequest:
+					badRequest = true
+				}
+			}
+			require.Equal(t, []string{"INVALID_REQUEST"}, reasons)
+			require.True(t, badRequest)
+
+			// Server streaming works over every protocol.
+			created, err := client.CreateBooking(ctx, connect.NewRequest(&bookingpb.CreateBookingRequest{
+				UserId:  1,
+				Ride:    &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: rupees(250), Pickup: downtown, Dropoff: airport},
+				QuoteId: quote(t, h, downtown, airport),
+			}))
+			require.NoError(t, err)
+			watchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := client.WatchBooking(watchCtx, connect.NewRequest(&bookingpb.WatchBookingRequest{SagaId: created.Msg.SagaId}))
+			require.NoError(t, err)
+			require.True(t, stream.Receive(), "no update: %v", stream.Err())
+			require.Equal(t, bookingpb.SagaStatus_SAGA_STATUS_COMPLETED, stream.Msg().Saga.Status)
+			require.Equal(t, created.Msg.Booking.RideId, stream.Msg().Ride.RideId)
+
+			// So does client streaming.
+			drivers := driverconnect.NewDriverServiceClient(h.DriversWeb, BaseURL, tt.opts...)
+			fixes := drivers.UpdateLocation(ctx)
+			require.NoError(t, fixes.Send(&driverpb.UpdateLocationRequest{DriverId: 1, Location: &driverpb.Location{Latitude: 31.5120, Longitude: 74.3450}}))
+			require.NoError(t, fixes.Send(&driverpb.UpdateLocationRequest{DriverId: 1, Location: &driverpb.Location{Latitude: 31.5121, Longitude: 74.3451}}))
+			accepted, err := fixes.CloseAndReceive()
+			require.NoError(t, err)
+			require.Equal(t, int32(2), accepted.Msg.Accepted)
+		})
+	}
+}
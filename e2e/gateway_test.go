@@ -0,0 +1,189 @@
+package e2e
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGateway(t *testing.T) {
+	h := New(t)
+	quoteID := quote(t, h, downtown, airport)
+
+	tests := []struct {
+		name         string
+		handler      http.Handler
+		method       string
+		path         string
+		body         string
+		expectedCode int
+		expected     map[string]any
+	}{
+		{
+			name:         "Get User",
+			handler:      h.UsersHTTP,
+			method:       http.MethodGet,
+			path:         "/v1/users/1",
+			expectedCode: http.StatusOK,
+			expected:     map[string]any{"name": "Usman Attiq"},
+		},
+		{
+			name:         "Create User",
+			handler:      h.UsersHTTP,
+			method:       http.MethodPost,
+			path:         "/v1/users",
+			body:         `{"name": "Bilal"}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Get Booking",
+			handler:      h.BookingsHTTP,
+			method:       http.MethodGet,
+			path:         "/v1/bookings/1",
+			expectedCode: http.StatusOK,
+			expected:     map[string]any{"name": "Usman Attiq", "source": "Downtown", "destination": "Airport"},
+		},
+		{
+			name:         "Create Booking",
+			handler:      h.BookingsHTTP,
+			method:       http.MethodPost,
+			path:         "/v1/bookings",
+			body:         `{"user_id": 1, "ride": {"source": "Downtown", "destination": "Airport", "distance": 15, "cost": {"currency_code": "PKR", "units": "250"}, "pickup": {"latitude": 31.5497, "longitude": 74.25}, "dropoff": {"latitude": 31.5216, "longitude": 74.4036}}, "quote_id": "` + quoteID + `"}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Estimate Fare",
+			handler:      h.BookingsHTTP,
+			method:       http.MethodPost,
+			path:         "/v1/fares:estimate",
+			body:         `{"pickup": {"latitude": 31.5497, "longitude": 74.25}, "dropoff": {"latitude": 31.5216, "longitude": 74.4036}, "vehicle_class": "VEHICLE_CLASS_COMFORT"}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Update Ride",
+			handler:      h.RidesHTTP,
+			method:       http.MethodPut,
+			path:         "/v1/rides/1",
+			body:         `{"source": "Downtown", "destination": "Mall", "distance": 10, "cost": {"currency_code": "PKR", "units": "200"}, "pickup": {"latitude": 31.5497, "longitude": 74.25}, "dropoff": {"latitude": 31.466, "longitude": 74.277}}`,
+			expectedCode: http.StatusOK,
+			expected:     map[string]any{"message": "ride with id 1 successfully updated"},
+		},
+		{
+			name:         "Get Driver",
+			handler:      h.DriversHTTP,
+			method:       http.MethodGet,
+			path:         "/v1/drivers/1",
+			expectedCode: http.StatusOK,
+			expected: map[string]any{"driver": map[string]any{
+				"driver_id": float64(1), "name": "Ali Raza", "phone": "+923001234567", "license_number": "LHR-10001",
+				"vehicle": map[string]any{"make": "Toyota", "model": "Corolla", "color": "White", "plate_number": "LEA-1234", "seats": float64(4)},
+				"status":  "DRIVER_STATUS_AVAILABLE",
+			}},
+		},
+		{
+			name:         "Update Driver Status",
+			handler:      h.DriversHTTP,
+			method:       http.MethodPut,
+			path:         "/v1/drivers/2/status",
+			body:         `{"status": "DRIVER_STATUS_OFFLINE"}`,
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := do(tt.handler, tt.method, tt.path, tt.body)
+			require.Equal(t, tt.expectedCode, res.Code, res.Body.String())
+
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(res.Body.Bytes(), &body))
+			for k, v := range tt.expected {
+				require.Equal(t, v, body[k], k)
+			}
+		})
+	}
+}
+
+func TestGateway_Errors(t *testing.T) {
+	h := New(t)
+
+	tests := []struct {
+		name         string
+		handler      http.Handler
+		method       string
+		path         string
+		body         string
+		expectedCode int
+		reason       string
+	}{
+		{
+			name:         "User Not Found",
+			handler:      h.UsersHTTP,
+			method:       http.MethodGet,
+			path:         "/v1/users/1000000",
+			expectedCode: http.StatusNotFound,
+			reason:       "USER_NOT_FOUND",
+		},
+		{
+			name:         "Validation Failure",
+			handler:      h.RidesHTTP,
+			method:       http.MethodPut,
+			path:         "/v1/rides/1",
+			body:         `{"source": "Mall", "destination": "Mall", "distance": 10, "cost": {"currency_code": "PKR", "units": "200"}, "pickup": {"latitude": 31.5497, "longitude": 74.25}, "dropoff": {"latitude": 31.466, "longitude": 74.277}}`,
+			expectedCode: http.StatusBadRequest,
+			reason:       "INVALID_REQUEST",
+		},
+		{
+			name:         "Malformed JSON",
+			handler:      h.BookingsHTTP,
+			method:       http.MethodPost,
+			path:         "/v1/bookings",
+			body:         `{"user_id": `,
+			expectedCode: http.StatusBadRequest,
+			reason:       "INVALID_REQUEST",
+		},
+		{
+			name:         "Unknown Route",
+			handler:      h.BookingsHTTP,
+			method:       http.MethodGet,
+			path:         "/v1/unknown",
+			expectedCode: http.StatusNotFound,
+			reason:       "GATEWAY_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := do(tt.handler, tt.method, tt.path, tt.body)
+			require.Equal(t, tt.expectedCode, res.Code, res.Body.String())
+
+			var body struct {
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+				Details []struct {
+					Type   string `json:"@type"`
+					Reason string `json:"reason"`
+				} `json:"details"`
+			}
+			require.NoError(t, json.Unmarshal(res.Body.Bytes(), &body))
+			require.NotZero(t, body.Code)
+			require.NotEmpty(t, body.Message)
+			require.NotEmpty(t, body.Details)
+			require.Equal(t, "type.googleapis.com/google.rpc.ErrorInfo", body.Details[0].Type)
+			require.Equal(t, tt.reason, body.Details[0].Reason)
+		})
+	}
+}
+
+// do sends a request to handler and records the response.
+func do(handler http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	return res
+}
@@ -0,0 +1,280 @@
+// Package e2e boots UserService, BookingService, RideService and DriverService
+// in-process over bufconn, each behind its production interceptor chain and on
+// the same multi-protocol handler as in production, so tests can drive them
+// through the generated gRPC and Connect clients and the REST/JSON gateways.
+// The API gateway runs in front of them the same way.
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	bookingpb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	bookingserver "github.com/golang_falcon_task/booking-service/server"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	driverserver "github.com/golang_falcon_task/driver-service/server"
+	gatewayserver "github.com/golang_falcon_task/gateway-service/server"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	rideserver "github.com/golang_falcon_task/ride-service/server"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	userserver "github.com/golang_falcon_task/user-service/server"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+// BaseURL is the base URL for Connect and gRPC-Web clients built on the Web HTTP clients.
+const BaseURL = "http://bufnet"
+
+// GatewayAPIKey is the API key the harness gateway accepts.
+const GatewayAPIKey = "e2e-key"
+
+// Cancellation fees the harness charges, in rupees. Cancelling is never
+// free once a driver is assigned.
+const (
+	CancellationFee = 100
+	NoShowFee       = 200
+)
+
+// ScheduleDispatchLead is how long before their pickup the harness releases
+// scheduled bookings to dispatch. It exceeds the minimum lead of 30 minutes,
+// so a booking can be scheduled that is due for dispatch straight away.
+const ScheduleDispatchLead = time.Hour
+
+// Harness holds clients connected to the in-process services.
+type Harness struct {
+	Users    userpb.UserServiceClient
+	Bookings bookingpb.BookingServiceClient
+	Rides    ridepb.RideServiceClient
+	Drivers  driverpb.DriverServiceClient
+
+	// REST/JSON gateways forwarding to the same servers.
+	UsersHTTP    http.Handler
+	BookingsHTTP http.Handler
+	RidesHTTP    http.Handler
+	DriversHTTP  http.Handler
+
+	// HTTP clients dialing each server, for Connect and gRPC-Web clients
+	// created with BaseURL.
+	UsersWeb    *http.Client
+	BookingsWeb *http.Client
+	RidesWeb    *http.Client
+	DriversWeb  *http.Client
+
+	// Gateway is a connection to the API gateway, which serves
+	// gateway.v1.GatewayService and proxies the other services. Calls must
+	// carry GatewayAPIKey as a bearer token.
+	Gateway *grpc.ClientConn
+
+	// Dispatch runs one pass of the booking dispatch engine, which the
+	// harness does not run in the background.
+	Dispatch func(ctx context.Context) error
+
+	// Schedule runs one pass of the booking scheduler, which the harness
+	// does not run in the background either.
+	Schedule func(ctx context.Context) error
+
+	backends gatewayserver.Options
+
+	// Shared reports whether the services share one Postgres database. When
+	// false each service runs on its own in-memory store seeded with the
+	// docker/init.sql fixtures, so data written through one service is not
+	// visible to the others.
+	Shared bool
+}
+
+// New starts the services and returns clients for them. Everything is
+// torn down when the test finishes. If TEST_DATABASE_URL is set, the services
+// share a throwaway schema in that database; otherwise they use in-memory stores.
+func New(t *testing.T) *Harness {
+	t.Helper()
+
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	db := newPGPool(t)
+
+	userServer, err := userserver.New(userserver.Options{Logger: log, DB: db})
+	if err != nil {
+		t.Fatalf("failed to create user server: %v", err)
+	}
+	rideServer, err := rideserver.New(rideserver.Options{Logger: log, DB: db})
+	if err != nil {
+		t.Fatalf("failed to create ride server: %v", err)
+	}
+
+	driverServer, err := driverserver.New(driverserver.Options{Logger: log, DB: db})
+	if err != nil {
+		t.Fatalf("failed to create driver server: %v", err)
+	}
+
+	userConn, userWeb := serve(t, userServer.Handler())
+	rideConn, rideWeb := serve(t, rideServer.Handler())
+	driverConn, driverWeb := serve(t, driverServer.Handler())
+
+	// The booking saga calls the user and ride services, and dispatch the
+	// driver service.
+	bookingOpts := bookingserver.Options{Logger: log, DB: db, Users: userConn, Rides: rideConn, Drivers: driverConn}
+	bookingOpts.Payments.Cancellation.DriverAssignedFee, bookingOpts.Payments.Cancellation.NoShowFee = CancellationFee, NoShowFee
+	bookingOpts.Schedule.DispatchLead, bookingOpts.Schedule.ReminderLead = ScheduleDispatchLead, 2*ScheduleDispatchLead
+	bookingServer, err := bookingserver.New(bookingOpts)
+	if err != nil {
+		t.Fatalf("failed to create booking server: %v", err)
+	}
+	bookingConn, bookingWeb := serve(t, bookingServer.Handler())
+	listenCtx, stopListening := context.WithCancel(context.Background())
+	t.Cleanup(stopListening)
+	go bookingServer.ListenForChanges(listenCtx)
+
+	h := &Harness{
+		Users:       userpb.NewUserServiceClient(userConn),
+		Bookings:    bookingpb.NewBookingServiceClient(bookingConn),
+		Rides:       ridepb.NewRideServiceClient(rideConn),
+		Drivers:     driverpb.NewDriverServiceClient(driverConn),
+		UsersWeb:    userWeb,
+		BookingsWeb: bookingWeb,
+		RidesWeb:    rideWeb,
+		DriversWeb:  driverWeb,
+		Dispatch:    bookingServer.Dispatcher.RunOnce,
+		Schedule:    bookingServer.Scheduler.RunOnce,
+		Shared:      db != nil,
+		backends:    gatewayserver.Options{Users: userConn, Bookings: bookingConn, Rides: rideConn, Drivers: driverConn},
+	}
+	h.Gateway = h.NewGateway(t, 1000, 1000)
+
+	ctx := context.Background()
+	if h.UsersHTTP, err = userserver.NewGateway(ctx, userConn); err != nil {
+		t.Fatalf("failed to create user gateway: %v", err)
+	}
+	if h.BookingsHTTP, err = bookingserver.NewGateway(ctx, bookingConn); err != nil {
+		t.Fatalf("failed to create booking gateway: %v", err)
+	}
+	if h.RidesHTTP, err = rideserver.NewGateway(ctx, rideConn); err != nil {
+		t.Fatalf("failed to create ride gateway: %v", err)
+	}
+	if h.DriversHTTP, err = driverserver.NewGateway(ctx, driverConn); err != nil {
+		t.Fatalf("failed to create driver gateway: %v", err)
+	}
+	return h
+}
+
+// NewGateway starts another API gateway in front of the services with the
+// given per-key rate limit and returns a connection to it.
+func (h *Harness) NewGateway(t *testing.T, rps float64, burst int) *grpc.ClientConn {
+	t.Helper()
+
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	opts := h.backends
+	opts.Logger = log
+	opts.APIKeys = []string{GatewayAPIKey}
+	opts.RateLimitRPS = rps
+	opts.RateLimitBurst = burst
+
+	srv := gatewayserver.New(opts)
+	lis := bufconn.Listen(bufSize)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// serve runs handler on an in-memory listener and returns a gRPC client
+// connection and an HTTP client for it.
+func serve(t *testing.T, handler http.Handler) (*grpc.ClientConn, *http.Client) {
+	t.Helper()
+
+	lis := bufconn.Listen(bufSize)
+	srv := &http.Server{Handler: handler}
+	go srv.Serve(lis)
+	t.Cleanup(func() { srv.Close() })
+
+	dial := func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(dial),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dial(ctx, "")
+		},
+	}
+	t.Cleanup(transport.CloseIdleConnections)
+	return conn, &http.Client{Transport: transport}
+}
+
+// newPGPool returns a pool scoped to a fresh schema loaded from
+// docker/init.sql, or nil when TEST_DATABASE_URL is unset.
+func newPGPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		return nil
+	}
+
+	ctx := context.Background()
+	schema := fmt.Sprintf("e2e_%d", time.Now().UnixNano())
+
+	admin, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	t.Cleanup(admin.Close)
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		admin.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+	})
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("invalid TEST_DATABASE_URL: %v", err)
+	}
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	_, file, _, _ := runtime.Caller(0)
+	initSQL, err := os.ReadFile(filepath.Join(filepath.Dir(file), "..", "docker", "init.sql"))
+	if err != nil {
+		t.Fatalf("failed to read init.sql: %v", err)
+	}
+	if _, err := pool.Exec(ctx, string(initSQL)); err != nil {
+		t.Fatalf("failed to load init.sql: %v", err)
+	}
+	return pool
+}
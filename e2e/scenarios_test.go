@@ -0,0 +1,989 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	bookingpb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Seeded places, as in docker/init.sql. Downtown is 15 km from the airport
+// and 10 km from the mall.
+var (
+	downtown = &latlng.LatLng{Latitude: 31.5497, Longitude: 74.2500}
+	airport  = &latlng.LatLng{Latitude: 31.5216, Longitude: 74.4036}
+	mall     = &latlng.LatLng{Latitude: 31.4660, Longitude: 74.2770}
+)
+
+// rupees returns units Pakistani rupees.
+func rupees(units int64) *money.Money {
+	return &money.Money{CurrencyCode: "PKR", Units: units}
+}
+
+// quote estimates the economy fare from pickup to dropoff and returns the
+// quote ID CreateBooking charges it under.
+func quote(t *testing.T, h *Harness, pickup, dropoff *latlng.LatLng) string {
+	t.Helper()
+	estimate, err := h.Bookings.EstimateFare(context.Background(), &bookingpb.EstimateFareRequest{Pickup: pickup, Dropoff: dropoff})
+	require.NoError(t, err)
+	return estimate.QuoteId
+}
+
+func TestUserLifecycle(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	created, err := h.Users.CreateUser(ctx, &userpb.CreateUserRequest{Name: "Bilal"})
+	require.NoError(t, err)
+	require.Positive(t, created.UserId)
+
+	user, err := h.Users.GetUser(ctx, &userpb.GetUserRequest{UserId: created.UserId})
+	require.NoError(t, err)
+	require.Equal(t, "Bilal", user.Name)
+
+	_, err = h.Users.DeleteUser(ctx, &userpb.DeleteUserRequest{UserId: created.UserId})
+	require.NoError(t, err)
+
+	_, err = h.Users.GetUser(ctx, &userpb.GetUserRequest{UserId: created.UserId})
+	requireErrorInfo(t, err, codes.NotFound, "USER_NOT_FOUND")
+}
+
+func TestBookRide(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	estimate, err := h.Bookings.EstimateFare(ctx, &bookingpb.EstimateFareRequest{Pickup: downtown, Dropoff: airport})
+	require.NoError(t, err)
+	require.Equal(t, int32(15), estimate.Fare.Distance)
+	require.NotEmpty(t, estimate.Fare.TariffVersion)
+	require.Equal(t, "downtown", estimate.Fare.Zone)
+	require.Equal(t, 1.0, estimate.Fare.SurgeMultiplier)
+	require.NotEmpty(t, estimate.QuoteId)
+
+	// User 1 is part of the seed data in every store backend. The cost the
+	// client sends is ignored in favour of the quote's.
+	req := &bookingpb.CreateBookingRequest{
+		UserId:  1,
+		Ride:    &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: rupees(1), Pickup: downtown, Dropoff: airport},
+		QuoteId: estimate.QuoteId,
+	}
+	created, err := h.Bookings.CreateBooking(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), created.Booking.UserId)
+	require.Equal(t, estimate.Fare.TariffVersion, created.Booking.TariffVersion)
+
+	booking, err := h.Bookings.GetBooking(ctx, &bookingpb.GetBookingRequest{BookingId: created.Booking.BookingId})
+	require.NoError(t, err)
+	require.Equal(t, "Usman Attiq", booking.Name)
+	require.Equal(t, "Downtown", booking.Source)
+	require.Equal(t, "Airport", booking.Destination)
+	require.Equal(t, int32(15), booking.Distance)
+	require.True(t, proto.Equal(estimate.Fare.Cost, booking.Cost), "expected %v, got %v", estimate.Fare.Cost, booking.Cost)
+	require.Equal(t, estimate.Fare.TariffVersion, booking.TariffVersion)
+	require.Equal(t, downtown.Latitude, booking.Pickup.Latitude)
+	require.Equal(t, airport.Longitude, booking.Dropoff.Longitude)
+
+	// A quote books a single ride.
+	_, err = h.Bookings.CreateBooking(ctx, req)
+	requireErrorInfo(t, err, codes.FailedPrecondition, "QUOTE_USED")
+
+	// And only the ride it was quoted for.
+	req.QuoteId = quote(t, h, downtown, mall)
+	_, err = h.Bookings.CreateBooking(ctx, req)
+	requireErrorInfo(t, err, codes.InvalidArgument, "INVALID_REQUEST")
+
+	req.QuoteId = "7b0c2f4e-8a43-4a8e-9a57-5d1f0c6f2b11"
+	_, err = h.Bookings.CreateBooking(ctx, req)
+	requireErrorInfo(t, err, codes.NotFound, "QUOTE_NOT_FOUND")
+}
+
+func TestBookRide_Saga(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	created, err := h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+		UserId:  1,
+		Ride:    &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: rupees(250), Pickup: downtown, Dropoff: airport},
+		QuoteId: quote(t, h, downtown, airport),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.SagaId)
+
+	saga, err := h.Bookings.GetBookingSaga(ctx, &bookingpb.GetBookingSagaRequest{SagaId: created.SagaId})
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.SagaStatus_SAGA_STATUS_COMPLETED, saga.Saga.Status)
+	require.Equal(t, bookingpb.SagaStep_SAGA_STEP_DONE, saga.Saga.Step)
+	require.Equal(t, created.Booking.BookingId, saga.Saga.BookingId)
+	require.Equal(t, created.Booking.RideId, saga.Saga.Ride.RideId)
+	require.Equal(t, created.Booking.TariffVersion, saga.Saga.TariffVersion)
+
+	// The ride was created through RideService, in every store backend.
+	ride, err := h.Rides.GetRide(ctx, &ridepb.GetRideRequest{RideId: created.Booking.RideId})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(saga.Saga.Ride.Cost, ride.Ride.Cost), "expected %v, got %v", saga.Saga.Ride.Cost, ride.Ride.Cost)
+
+	_, err = h.Bookings.GetBookingSaga(ctx, &bookingpb.GetBookingSagaRequest{SagaId: "7b0c2f4e-8a43-4a8e-9a57-5d1f0c6f2b11"})
+	requireErrorInfo(t, err, codes.NotFound, "SAGA_NOT_FOUND")
+}
+
+func TestWatchBooking(t *testing.T) {
+	h := New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	created, err := h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+		UserId:  1,
+		Ride:    &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: rupees(250), Pickup: downtown, Dropoff: airport},
+		QuoteId: quote(t, h, downtown, airport),
+	})
+	require.NoError(t, err)
+
+	stream, err := h.Bookings.WatchBooking(ctx, &bookingpb.WatchBookingRequest{SagaId: created.SagaId})
+	require.NoError(t, err)
+	snapshot, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.SagaStatus_SAGA_STATUS_COMPLETED, snapshot.Saga.Status)
+	require.Equal(t, created.Booking.RideId, snapshot.Ride.RideId)
+	require.NotEmpty(t, snapshot.ResumeToken)
+
+	// Resuming from the start of the feed replays every step of the saga.
+	stream, err = h.Bookings.WatchBooking(ctx, &bookingpb.WatchBookingRequest{SagaId: created.SagaId, ResumeToken: "0"})
+	require.NoError(t, err)
+	var steps []bookingpb.SagaStep
+	for len(steps) == 0 || steps[len(steps)-1] != bookingpb.SagaStep_SAGA_STEP_DONE {
+		update, err := stream.Recv()
+		require.NoError(t, err)
+		steps = append(steps, update.Saga.Step)
+	}
+	require.Equal(t, []bookingpb.SagaStep{
+		bookingpb.SagaStep_SAGA_STEP_VALIDATE_USER,
+		bookingpb.SagaStep_SAGA_STEP_CREATE_RIDE,
+		bookingpb.SagaStep_SAGA_STEP_CREATE_BOOKING,
+		bookingpb.SagaStep_SAGA_STEP_DONE,
+	}, steps)
+
+	// Stream errors arrive on the first Recv.
+	stream, err = h.Bookings.WatchBooking(ctx, &bookingpb.WatchBookingRequest{SagaId: created.SagaId, ResumeToken: "next"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	requireErrorInfo(t, err, codes.InvalidArgument, "INVALID_REQUEST")
+}
+
+func TestBookRide_UnknownUser(t *testing.T) {
+	h := New(t)
+
+	_, err := h.Bookings.CreateBooking(context.Background(), &bookingpb.CreateBookingRequest{
+		UserId:  1_000_000,
+		Ride:    &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: rupees(250), Pickup: downtown, Dropoff: airport},
+		QuoteId: quote(t, h, downtown, airport),
+	})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "FOREIGN_KEY_VIOLATION")
+}
+
+func TestBookRide_Promo(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	// WELCOME20 is part of the seed data: 20% off, up to PKR 200, once per rider.
+	estimate, err := h.Bookings.EstimateFare(ctx, &bookingpb.EstimateFareRequest{Pickup: downtown, Dropoff: airport})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(rupees(625), estimate.Fare.Cost), "expected PKR 625, got %v", estimate.Fare.Cost)
+	req := &bookingpb.CreateBookingRequest{
+		UserId:    2,
+		Ride:      &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Pickup: downtown, Dropoff: airport},
+		QuoteId:   estimate.QuoteId,
+		PromoCode: "welcome20",
+	}
+	created, err := h.Bookings.CreateBooking(ctx, req)
+	require.NoError(t, err)
+	discount := &bookingpb.Discount{PromoCode: "WELCOME20", Fare: rupees(625), Amount: rupees(125), Total: rupees(500)}
+	require.True(t, proto.Equal(discount, created.Booking.Discount), "expected %v, got %v", discount, created.Booking.Discount)
+
+	booking, err := h.Bookings.GetBooking(ctx, &bookingpb.GetBookingRequest{BookingId: created.Booking.BookingId})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(rupees(500), booking.Cost), "expected PKR 500, got %v", booking.Cost)
+	require.True(t, proto.Equal(discount, booking.Discount), "expected %v, got %v", discount, booking.Discount)
+	ride, err := h.Rides.GetRide(ctx, &ridepb.GetRideRequest{RideId: created.Booking.RideId})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(rupees(500), ride.Ride.Cost), "expected PKR 500, got %v", ride.Ride.Cost)
+
+	// Each rider redeems the code once, and a failed booking gives its
+	// redemption back.
+	req.QuoteId = quote(t, h, downtown, airport)
+	_, err = h.Bookings.CreateBooking(ctx, req)
+	requireErrorInfo(t, err, codes.FailedPrecondition, "PROMO_LIMIT_REACHED")
+	_, err = h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+		UserId:    1_000_000,
+		Ride:      &bookingpb.Ride{Pickup: downtown, Dropoff: airport},
+		QuoteId:   quote(t, h, downtown, airport),
+		PromoCode: "WELCOME20",
+	})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "FOREIGN_KEY_VIOLATION")
+	promo, err := h.Bookings.GetPromo(ctx, &bookingpb.GetPromoRequest{Code: "welcome20"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), promo.Promo.Redemptions)
+
+	// Codes can be limited to vehicle classes.
+	_, err = h.Bookings.CreatePromo(ctx, &bookingpb.CreatePromoRequest{Promo: &bookingpb.Promo{
+		Code: "XL100", Type: bookingpb.DiscountType_DISCOUNT_TYPE_FLAT, AmountOff: rupees(100),
+		VehicleClasses: []bookingpb.VehicleClass{bookingpb.VehicleClass_VEHICLE_CLASS_XL},
+		StartsAt:       "2025-01-01T00:00:00Z", EndsAt: "2030-01-01T00:00:00Z",
+	}})
+	require.NoError(t, err)
+	req.UserId, req.QuoteId, req.PromoCode = 3, quote(t, h, downtown, airport), "XL100"
+	_, err = h.Bookings.CreateBooking(ctx, req)
+	requireErrorInfo(t, err, codes.FailedPrecondition, "PROMO_NOT_ELIGIBLE")
+
+	req.PromoCode = "NOSUCHCODE"
+	_, err = h.Bookings.CreateBooking(ctx, req)
+	requireErrorInfo(t, err, codes.NotFound, "PROMO_NOT_FOUND")
+}
+
+func TestRiderJourney(t *testing.T) {
+	h := New(t)
+	if !h.Shared {
+		t.Skip("cross-service journey needs a shared database, set TEST_DATABASE_URL")
+	}
+	ctx := context.Background()
+
+	user, err := h.Users.CreateUser(ctx, &userpb.CreateUserRequest{Name: "Bilal"})
+	require.NoError(t, err)
+
+	created, err := h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+		UserId:  user.UserId,
+		Ride:    &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: rupees(250), Pickup: downtown, Dropoff: airport},
+		QuoteId: quote(t, h, downtown, airport),
+	})
+	require.NoError(t, err)
+
+	// The booking fixed the ride's cost, but the rest of it can change.
+	ride, err := h.Rides.GetRide(ctx, &ridepb.GetRideRequest{RideId: created.Booking.RideId})
+	require.NoError(t, err)
+	_, err = h.Rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{
+		RideId: created.Booking.RideId,
+		Ride:   &ridepb.Ride{Source: "Downtown", Destination: "Mall", Distance: 10, Cost: rupees(200), Pickup: downtown, Dropoff: mall},
+	})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "RIDE_BOOKED")
+	_, err = h.Rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{
+		RideId: created.Booking.RideId,
+		Ride:   &ridepb.Ride{Source: "Downtown", Destination: "Mall", Distance: 10, Cost: ride.Ride.Cost, Pickup: downtown, Dropoff: mall},
+	})
+	require.NoError(t, err)
+
+	booking, err := h.Bookings.GetBooking(ctx, &bookingpb.GetBookingRequest{BookingId: created.Booking.BookingId})
+	require.NoError(t, err)
+	require.Equal(t, "Bilal", booking.Name)
+	require.Equal(t, "Mall", booking.Destination)
+	require.Equal(t, int32(10), booking.Distance)
+	require.True(t, proto.Equal(ride.Ride.Cost, booking.Cost), "expected %v, got %v", ride.Ride.Cost, booking.Cost)
+}
+
+func TestDriverLifecycle(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	vehicle := &driverpb.Vehicle{Make: "Kia", Model: "Picanto", Color: "Red", PlateNumber: "LEC-4321", Seats: 4}
+	created, err := h.Drivers.CreateDriver(ctx, &driverpb.CreateDriverRequest{
+		Driver: &driverpb.Driver{Name: "Hamza Ali", Phone: "+923331234567", LicenseNumber: "LHR-30001", Vehicle: vehicle},
+	})
+	require.NoError(t, err)
+	require.Equal(t, driverpb.DriverStatus_DRIVER_STATUS_OFFLINE, created.Driver.Status)
+
+	// Plate numbers are unique; LEA-1234 belongs to seeded driver 1.
+	_, err = h.Drivers.CreateDriver(ctx, &driverpb.CreateDriverRequest{
+		Driver: &driverpb.Driver{Name: "Hamza Ali", Phone: "+923331234567", LicenseNumber: "LHR-30002",
+			Vehicle: &driverpb.Vehicle{Make: "Kia", Model: "Picanto", PlateNumber: "LEA-1234", Seats: 4}},
+	})
+	requireErrorInfo(t, err, codes.AlreadyExists, "ALREADY_EXISTS")
+
+	updated, err := h.Drivers.UpdateDriverStatus(ctx, &driverpb.UpdateDriverStatusRequest{
+		DriverId: created.Driver.DriverId,
+		Status:   driverpb.DriverStatus_DRIVER_STATUS_AVAILABLE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, driverpb.DriverStatus_DRIVER_STATUS_AVAILABLE, updated.Driver.Status)
+
+	available, err := h.Drivers.ListDrivers(ctx, &driverpb.ListDriversRequest{Status: driverpb.DriverStatus_DRIVER_STATUS_AVAILABLE})
+	require.NoError(t, err)
+	var ids []int32
+	for _, d := range available.Drivers {
+		ids = append(ids, d.DriverId)
+	}
+	require.Contains(t, ids, created.Driver.DriverId)
+
+	_, err = h.Drivers.DeleteDriver(ctx, &driverpb.DeleteDriverRequest{DriverId: created.Driver.DriverId})
+	require.NoError(t, err)
+	_, err = h.Drivers.GetDriver(ctx, &driverpb.GetDriverRequest{DriverId: created.Driver.DriverId})
+	requireErrorInfo(t, err, codes.NotFound, "DRIVER_NOT_FOUND")
+}
+
+func TestAssignDriverToRide(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	// Driver 1 and ride 1 are part of the seed data.
+	_, err := h.Rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{
+		RideId: 1,
+		Ride:   &ridepb.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: rupees(150), DriverId: 1, Pickup: downtown, Dropoff: airport},
+	})
+	require.NoError(t, err)
+
+	ride, err := h.Rides.GetRide(ctx, &ridepb.GetRideRequest{RideId: 1})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), ride.Ride.DriverId)
+
+	if h.Shared {
+		// Postgres rejects rides assigned to unknown drivers, and drivers with rides.
+		_, err = h.Rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{
+			RideId: 1,
+			Ride:   &ridepb.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: rupees(150), DriverId: 1_000_000, Pickup: downtown, Dropoff: airport},
+		})
+		requireErrorInfo(t, err, codes.FailedPrecondition, "FOREIGN_KEY_VIOLATION")
+
+		_, err = h.Drivers.DeleteDriver(ctx, &driverpb.DeleteDriverRequest{DriverId: 1})
+		requireErrorInfo(t, err, codes.FailedPrecondition, "FOREIGN_KEY_VIOLATION")
+	}
+}
+
+func TestNearbyDrivers(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	// Seeded drivers 1 and 2 are available, driver 3 is offline.
+	fixes := map[int32][]*driverpb.Location{
+		1: {{Latitude: 31.4840, Longitude: 74.3250}, {Latitude: 31.5120, Longitude: 74.3450}},
+		2: {{Latitude: 31.4840, Longitude: 74.3250}},
+		3: {{Latitude: 31.5102, Longitude: 74.3441}},
+	}
+	for driverID, locations := range fixes {
+		stream, err := h.Drivers.UpdateLocation(ctx)
+		require.NoError(t, err)
+		for _, loc := range locations {
+			require.NoError(t, stream.Send(&driverpb.UpdateLocationRequest{DriverId: driverID, Location: loc}))
+		}
+		res, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, int32(len(locations)), res.Accepted)
+	}
+
+	nearby, err := h.Drivers.FindNearbyDrivers(ctx, &driverpb.FindNearbyDriversRequest{
+		Location: &driverpb.Location{Latitude: 31.5102, Longitude: 74.3441},
+		RadiusKm: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, nearby.Drivers, 2)
+	require.Equal(t, "Ali Raza", nearby.Drivers[0].Driver.Name)
+	require.InDelta(t, 0.2, nearby.Drivers[0].DistanceKm, 0.05)
+	require.Equal(t, "Bilal Khan", nearby.Drivers[1].Driver.Name)
+	require.InDelta(t, 3.4, nearby.Drivers[1].DistanceKm, 0.05)
+
+	// A stream carries the fixes of one driver only.
+	stream, err := h.Drivers.UpdateLocation(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&driverpb.UpdateLocationRequest{DriverId: 1, Location: fixes[1][1]}))
+	require.NoError(t, stream.Send(&driverpb.UpdateLocationRequest{DriverId: 2, Location: fixes[1][1]}))
+	_, err = stream.CloseAndRecv()
+	requireErrorInfo(t, err, codes.InvalidArgument, "INVALID_REQUEST")
+
+	// Every fix is validated.
+	stream, err = h.Drivers.UpdateLocation(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&driverpb.UpdateLocationRequest{DriverId: 1, Location: &driverpb.Location{Latitude: 91}}))
+	_, err = stream.CloseAndRecv()
+	requireErrorInfo(t, err, codes.InvalidArgument, "INVALID_REQUEST")
+
+	rec := do(h.DriversHTTP, http.MethodGet, "/v1/drivers:nearby?location.latitude=31.5102&location.longitude=74.3441&radius_km=1", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Contains(t, rec.Body.String(), `"name":"Ali Raza"`)
+	require.NotContains(t, rec.Body.String(), "Bilal Khan")
+}
+
+func TestDispatch(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	// Seeded drivers 1 and 2 are available, about 0.2 and 3.4 km from the pickup.
+	for driverID, loc := range map[int32]*driverpb.Location{
+		1: {Latitude: 31.5120, Longitude: 74.3450},
+		2: {Latitude: 31.4840, Longitude: 74.3250},
+	} {
+		stream, err := h.Drivers.UpdateLocation(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&driverpb.UpdateLocationRequest{DriverId: driverID, Location: loc}))
+		_, err = stream.CloseAndRecv()
+		require.NoError(t, err)
+	}
+
+	liberty := &latlng.LatLng{Latitude: 31.5102, Longitude: 74.3441}
+	created, err := h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+		UserId:  1,
+		Ride:    &bookingpb.Ride{Source: "Liberty Market", Destination: "Airport", Cost: rupees(250), Pickup: liberty, Dropoff: airport},
+		QuoteId: quote(t, h, liberty, airport),
+	})
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.BookingStatus_BOOKING_STATUS_PENDING, created.Booking.Status)
+
+	// The nearest driver is offered the booking first and declines it.
+	require.NoError(t, h.Dispatch(ctx))
+	offers, err := h.Bookings.ListDriverOffers(ctx, &bookingpb.ListDriverOffersRequest{DriverId: 1})
+	require.NoError(t, err)
+	require.Len(t, offers.Offers, 1)
+	require.Equal(t, created.Booking.BookingId, offers.Offers[0].BookingId)
+	require.InDelta(t, 0.2, offers.Offers[0].DistanceKm, 0.05)
+	declined, err := h.Bookings.RespondToOffer(ctx, &bookingpb.RespondToOfferRequest{OfferId: offers.Offers[0].OfferId, DriverId: 1})
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.OfferStatus_OFFER_STATUS_DECLINED, declined.Offer.Status)
+	require.Equal(t, bookingpb.BookingStatus_BOOKING_STATUS_PENDING, declined.Booking.Status)
+
+	// The next nearest driver accepts it over REST.
+	require.NoError(t, h.Dispatch(ctx))
+	offers, err = h.Bookings.ListDriverOffers(ctx, &bookingpb.ListDriverOffersRequest{DriverId: 1})
+	require.NoError(t, err)
+	require.Empty(t, offers.Offers)
+	offers, err = h.Bookings.ListDriverOffers(ctx, &bookingpb.ListDriverOffersRequest{DriverId: 2})
+	require.NoError(t, err)
+	require.Len(t, offers.Offers, 1)
+
+	rec := do(h.BookingsHTTP, http.MethodPost, fmt.Sprintf("/v1/offers/%d:respond", offers.Offers[0].OfferId), `{"driver_id":2,"accept":true}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Contains(t, rec.Body.String(), `"status":"BOOKING_STATUS_CONFIRMED"`)
+
+	booking, err := h.Bookings.GetBooking(ctx, &bookingpb.GetBookingRequest{BookingId: created.Booking.BookingId})
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.BookingStatus_BOOKING_STATUS_CONFIRMED, booking.Status)
+	ride, err := h.Rides.GetRide(ctx, &ridepb.GetRideRequest{RideId: created.Booking.RideId})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), ride.Ride.DriverId)
+	driver, err := h.Drivers.GetDriver(ctx, &driverpb.GetDriverRequest{DriverId: 2})
+	require.NoError(t, err)
+	require.Equal(t, driverpb.DriverStatus_DRIVER_STATUS_ON_TRIP, driver.Driver.Status)
+
+	// The offer is closed once answered.
+	_, err = h.Bookings.RespondToOffer(ctx, &bookingpb.RespondToOfferRequest{OfferId: offers.Offers[0].OfferId, DriverId: 2, Accept: true})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "OFFER_CLOSED")
+}
+
+func TestScheduledBooking(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	stream, err := h.Drivers.UpdateLocation(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&driverpb.UpdateLocationRequest{DriverId: 1, Location: &driverpb.Location{Latitude: 31.5490, Longitude: 74.2505}}))
+	_, err = stream.CloseAndRecv()
+	require.NoError(t, err)
+	book := func(pickupTime time.Time) (*bookingpb.CreateBookingResponse, error) {
+		return h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+			UserId:     1,
+			Ride:       &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Pickup: downtown, Dropoff: airport},
+			QuoteId:    quote(t, h, downtown, airport),
+			PickupTime: pickupTime.Format(time.RFC3339),
+		})
+	}
+
+	// Bookings are scheduled between 30 minutes and 7 days ahead.
+	_, err = book(time.Now().Add(10 * time.Minute))
+	requireErrorInfo(t, err, codes.InvalidArgument, "INVALID_REQUEST")
+	_, err = book(time.Now().Add(8 * 24 * time.Hour))
+	requireErrorInfo(t, err, codes.InvalidArgument, "INVALID_REQUEST")
+
+	// A booking for tomorrow stays scheduled, out of dispatch, and is
+	// cancelled for free.
+	tomorrow := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	later, err := book(tomorrow)
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.BookingStatus_BOOKING_STATUS_SCHEDULED, later.Booking.Status)
+	require.Equal(t, tomorrow.UTC().Format(time.RFC3339), later.Booking.PickupTime)
+	require.NoError(t, h.Schedule(ctx))
+	require.NoError(t, h.Dispatch(ctx))
+	booking, err := h.Bookings.GetBooking(ctx, &bookingpb.GetBookingRequest{BookingId: later.Booking.BookingId})
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.BookingStatus_BOOKING_STATUS_SCHEDULED, booking.Status)
+	require.Equal(t, later.Booking.PickupTime, booking.PickupTime)
+	offers, err := h.Bookings.ListDriverOffers(ctx, &bookingpb.ListDriverOffersRequest{DriverId: 1})
+	require.NoError(t, err)
+	require.Empty(t, offers.Offers)
+	cancelled, err := h.Bookings.CancelBooking(ctx, &bookingpb.CancelBookingRequest{BookingId: later.Booking.BookingId, UserId: 1})
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.BookingStatus_BOOKING_STATUS_CANCELLED, cancelled.Booking.Status)
+	require.Nil(t, cancelled.Booking.CancellationFee)
+
+	// A booking due within the dispatch lead is released to dispatch by
+	// the scheduler, then offered to a driver.
+	soon, err := book(time.Now().Add(ScheduleDispatchLead - 15*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.BookingStatus_BOOKING_STATUS_SCHEDULED, soon.Booking.Status)
+	require.NoError(t, h.Schedule(ctx))
+	booking, err = h.Bookings.GetBooking(ctx, &bookingpb.GetBookingRequest{BookingId: soon.Booking.BookingId})
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.BookingStatus_BOOKING_STATUS_PENDING, booking.Status)
+	require.NoError(t, h.Dispatch(ctx))
+	offers, err = h.Bookings.ListDriverOffers(ctx, &bookingpb.ListDriverOffersRequest{DriverId: 1})
+	require.NoError(t, err)
+	require.Len(t, offers.Offers, 1)
+	require.Equal(t, soon.Booking.BookingId, offers.Offers[0].BookingId)
+}
+
+func TestCompleteBooking(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	stream, err := h.Drivers.UpdateLocation(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&driverpb.UpdateLocationRequest{DriverId: 1, Location: &driverpb.Location{Latitude: 31.5490, Longitude: 74.2505}}))
+	_, err = stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	created, err := h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+		UserId:  1,
+		Ride:    &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Pickup: downtown, Dropoff: airport},
+		QuoteId: quote(t, h, downtown, airport),
+	})
+	require.NoError(t, err)
+	bookingID := created.Booking.BookingId
+
+	// A booking is completed by the driver who accepted it.
+	_, err = h.Bookings.CompleteBooking(ctx, &bookingpb.CompleteBookingRequest{BookingId: bookingID, DriverId: 1})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "BOOKING_NOT_CONFIRMED")
+	require.NoError(t, h.Dispatch(ctx))
+	offers, err := h.Bookings.ListDriverOffers(ctx, &bookingpb.ListDriverOffersRequest{DriverId: 1})
+	require.NoError(t, err)
+	require.Len(t, offers.Offers, 1)
+	_, err = h.Bookings.RespondToOffer(ctx, &bookingpb.RespondToOfferRequest{OfferId: offers.Offers[0].OfferId, DriverId: 1, Accept: true})
+	require.NoError(t, err)
+
+	// The rider pays the fare they booked at, even if the ride is repriced
+	// later; with a shared database repricing a booked ride is refused.
+	_, err = h.Rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{
+		RideId: created.Booking.RideId,
+		Ride:   &ridepb.Ride{Source: "Downtown", Destination: "Airport", Cost: rupees(900), Pickup: downtown, Dropoff: airport, DriverId: 1},
+	})
+	if h.Shared {
+		requireErrorInfo(t, err, codes.FailedPrecondition, "RIDE_BOOKED")
+	} else {
+		require.NoError(t, err)
+	}
+
+	_, err = h.Bookings.CompleteBooking(ctx, &bookingpb.CompleteBookingRequest{BookingId: bookingID, DriverId: 2})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "BOOKING_NOT_CONFIRMED")
+	_, err = h.Bookings.GetReceipt(ctx, &bookingpb.GetReceiptRequest{BookingId: bookingID})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "NO_RECEIPT")
+
+	// The PKR 625 fare is split between the driver and a 20% commission.
+	rec := do(h.BookingsHTTP, http.MethodPost, fmt.Sprintf("/v1/bookings/%d:complete", bookingID), `{"driver_id":1}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Contains(t, rec.Body.String(), `"status":"BOOKING_STATUS_COMPLETED"`)
+	require.Contains(t, rec.Body.String(), `"kind":"JOURNAL_ENTRY_KIND_RIDE_COMPLETED"`)
+	driver, err := h.Drivers.GetDriver(ctx, &driverpb.GetDriverRequest{DriverId: 1})
+	require.NoError(t, err)
+	require.Equal(t, driverpb.DriverStatus_DRIVER_STATUS_AVAILABLE, driver.Driver.Status)
+
+	for _, tt := range []struct {
+		account *bookingpb.Account
+		balance *money.Money
+	}{
+		{&bookingpb.Account{Type: bookingpb.AccountType_ACCOUNT_TYPE_RIDER, OwnerId: 1}, rupees(-625)},
+		{&bookingpb.Account{Type: bookingpb.AccountType_ACCOUNT_TYPE_DRIVER, OwnerId: 1}, rupees(500)},
+		{&bookingpb.Account{Type: bookingpb.AccountType_ACCOUNT_TYPE_PLATFORM}, rupees(125)},
+	} {
+		balance, err := h.Bookings.GetAccountBalance(ctx, &bookingpb.GetAccountBalanceRequest{Account: tt.account})
+		require.NoError(t, err)
+		require.Len(t, balance.Balances, 1)
+		require.True(t, proto.Equal(tt.balance, balance.Balances[0]), "%v: expected %v, got %v", tt.account, tt.balance, balance.Balances[0])
+	}
+	statement, err := h.Bookings.GetAccountStatement(ctx, &bookingpb.GetAccountStatementRequest{
+		Account: &bookingpb.Account{Type: bookingpb.AccountType_ACCOUNT_TYPE_DRIVER, OwnerId: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, statement.Lines, 1)
+	require.Equal(t, bookingID, statement.Lines[0].BookingId)
+	require.Empty(t, statement.NextPageToken)
+
+	// A booking is completed, and paid for, once.
+	_, err = h.Bookings.CompleteBooking(ctx, &bookingpb.CompleteBookingRequest{BookingId: bookingID, DriverId: 1})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "BOOKING_NOT_CONFIRMED")
+
+	// The receipt is served as is over REST, and described in its extensions over gRPC.
+	rec = do(h.BookingsHTTP, http.MethodGet, fmt.Sprintf("/v1/bookings/%d/receipt?format=RECEIPT_FORMAT_PDF", bookingID), "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "application/pdf", rec.Header().Get("Content-Type"))
+	require.True(t, strings.HasPrefix(rec.Body.String(), "%PDF-"))
+	rec = do(h.BookingsHTTP, http.MethodGet, fmt.Sprintf("/v1/bookings/%d/receipt?locale=en-PK&time_zone=Asia/Karachi", bookingID), "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Contains(t, rec.Body.String(), "Usman Attiq")
+	require.Contains(t, rec.Body.String(), "Rs 625.00")
+	body, err := h.Bookings.GetReceipt(ctx, &bookingpb.GetReceiptRequest{BookingId: bookingID})
+	require.NoError(t, err)
+	require.Len(t, body.Extensions, 1)
+	receipt := new(bookingpb.Receipt)
+	require.NoError(t, body.Extensions[0].UnmarshalTo(receipt))
+	require.Equal(t, bookingID, receipt.BookingId)
+	require.True(t, strings.HasSuffix(receipt.ReceiptNumber, fmt.Sprintf("-%06d", bookingID)), receipt.ReceiptNumber)
+	require.True(t, proto.Equal(rupees(625), receipt.Total))
+	again, err := h.Bookings.GetReceipt(ctx, &bookingpb.GetReceiptRequest{BookingId: bookingID})
+	require.NoError(t, err)
+	require.Equal(t, body.Data, again.Data)
+}
+
+func TestCancellationFees(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	stream, err := h.Drivers.UpdateLocation(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&driverpb.UpdateLocationRequest{DriverId: 1, Location: &driverpb.Location{Latitude: 31.5490, Longitude: 74.2505}}))
+	_, err = stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	// confirmed books a ride and has driver 1 accept it.
+	confirmed := func() int32 {
+		t.Helper()
+		created, err := h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+			UserId:  1,
+			Ride:    &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Pickup: downtown, Dropoff: airport},
+			QuoteId: quote(t, h, downtown, airport),
+		})
+		require.NoError(t, err)
+		require.NoError(t, h.Dispatch(ctx))
+		offers, err := h.Bookings.ListDriverOffers(ctx, &bookingpb.ListDriverOffersRequest{DriverId: 1})
+		require.NoError(t, err)
+		require.Len(t, offers.Offers, 1)
+		_, err = h.Bookings.RespondToOffer(ctx, &bookingpb.RespondToOfferRequest{OfferId: offers.Offers[0].OfferId, DriverId: 1, Accept: true})
+		require.NoError(t, err)
+		return created.Booking.BookingId
+	}
+	requireBalance := func(account *bookingpb.Account, units int64) {
+		t.Helper()
+		balance, err := h.Bookings.GetAccountBalance(ctx, &bookingpb.GetAccountBalanceRequest{Account: account})
+		require.NoError(t, err)
+		require.Len(t, balance.Balances, 1)
+		require.True(t, proto.Equal(rupees(units), balance.Balances[0]), "%v: expected %d, got %v", account, units, balance.Balances[0])
+	}
+	rider := &bookingpb.Account{Type: bookingpb.AccountType_ACCOUNT_TYPE_RIDER, OwnerId: 1}
+	driver := &bookingpb.Account{Type: bookingpb.AccountType_ACCOUNT_TYPE_DRIVER, OwnerId: 1}
+
+	// Cancelling after a driver is assigned costs a fee, which the driver
+	// earns less commission. Cancelling again charges nothing more.
+	bookingID := confirmed()
+	cancelled, err := h.Bookings.CancelBooking(ctx, &bookingpb.CancelBookingRequest{BookingId: bookingID, UserId: 1})
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.CancellationReason_CANCELLATION_REASON_RIDER, cancelled.Booking.CancellationReason)
+	require.True(t, proto.Equal(rupees(CancellationFee), cancelled.Booking.CancellationFee))
+	require.Equal(t, bookingpb.JournalEntryKind_JOURNAL_ENTRY_KIND_CANCELLATION_FEE, cancelled.Entry.Kind)
+	again, err := h.Bookings.CancelBooking(ctx, &bookingpb.CancelBookingRequest{BookingId: bookingID, UserId: 1})
+	require.NoError(t, err)
+	require.Nil(t, again.Entry)
+	requireBalance(rider, -CancellationFee)
+	requireBalance(driver, CancellationFee*8/10)
+
+	// The fee is waived with a refund, once.
+	rec := do(h.BookingsHTTP, http.MethodPost, fmt.Sprintf("/v1/bookings/%d:refund", bookingID), `{"reason":"REFUND_REASON_FEE_WAIVED"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Contains(t, rec.Body.String(), `"refund_reason":"REFUND_REASON_FEE_WAIVED"`)
+	require.Contains(t, rec.Body.String(), `"kind":"JOURNAL_ENTRY_KIND_REFUND"`)
+	_, err = h.Bookings.RefundBooking(ctx, &bookingpb.RefundBookingRequest{BookingId: bookingID, Reason: bookingpb.RefundReason_REFUND_REASON_OTHER})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "BOOKING_NOT_REFUNDABLE")
+	requireBalance(rider, 0)
+
+	// Only the assigned driver reports that the rider did not show up.
+	bookingID = confirmed()
+	_, err = h.Bookings.ReportNoShow(ctx, &bookingpb.ReportNoShowRequest{BookingId: bookingID, DriverId: 2})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "BOOKING_NOT_CANCELLABLE")
+	noShow, err := h.Bookings.ReportNoShow(ctx, &bookingpb.ReportNoShowRequest{BookingId: bookingID, DriverId: 1})
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.CancellationReason_CANCELLATION_REASON_NO_SHOW, noShow.Booking.CancellationReason)
+	require.True(t, proto.Equal(rupees(NoShowFee), noShow.Booking.CancellationFee))
+	requireBalance(rider, -NoShowFee)
+	d, err := h.Drivers.GetDriver(ctx, &driverpb.GetDriverRequest{DriverId: 1})
+	require.NoError(t, err)
+	require.Equal(t, driverpb.DriverStatus_DRIVER_STATUS_AVAILABLE, d.Driver.Status)
+
+	// Completed rides are refunded in part, up to the fare.
+	bookingID = confirmed()
+	_, err = h.Bookings.CompleteBooking(ctx, &bookingpb.CompleteBookingRequest{BookingId: bookingID, DriverId: 1})
+	require.NoError(t, err)
+	refunded, err := h.Bookings.RefundBooking(ctx, &bookingpb.RefundBookingRequest{BookingId: bookingID, Amount: rupees(125),
+		Reason: bookingpb.RefundReason_REFUND_REASON_SERVICE_ISSUE})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(rupees(125), refunded.Booking.Refunded))
+	_, err = h.Bookings.RefundBooking(ctx, &bookingpb.RefundBookingRequest{BookingId: bookingID, Amount: rupees(501),
+		Reason: bookingpb.RefundReason_REFUND_REASON_SERVICE_ISSUE})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	requireBalance(rider, -NoShowFee-625+125)
+
+	booking, err := h.Bookings.GetBooking(ctx, &bookingpb.GetBookingRequest{BookingId: bookingID})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(rupees(125), booking.Refunded))
+	require.Equal(t, bookingpb.RefundReason_REFUND_REASON_SERVICE_ISSUE, booking.RefundReason)
+}
+
+func TestWalletBooking(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	stream, err := h.Drivers.UpdateLocation(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&driverpb.UpdateLocationRequest{DriverId: 1, Location: &driverpb.Location{Latitude: 31.5490, Longitude: 74.2505}}))
+	_, err = stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	book := func() (*bookingpb.CreateBookingResponse, error) {
+		return h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+			UserId:        1,
+			Ride:          &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Pickup: downtown, Dropoff: airport},
+			QuoteId:       quote(t, h, downtown, airport),
+			PaymentMethod: bookingpb.PaymentMethod_PAYMENT_METHOD_WALLET,
+		})
+	}
+	requireWallet := func(balance, held int64) {
+		t.Helper()
+		wallet, err := h.Users.GetWallet(ctx, &userpb.GetWalletRequest{UserId: 1})
+		require.NoError(t, err)
+		require.Len(t, wallet.Wallet.Balances, 1)
+		b := wallet.Wallet.Balances[0]
+		require.True(t, proto.Equal(rupees(balance), b.Balance), "expected balance %d, got %v", balance, b.Balance)
+		require.True(t, proto.Equal(rupees(held), b.Held), "expected %d held, got %v", held, b.Held)
+	}
+
+	// Wallets are only topped up over gRPC, once the rider was charged.
+	rec := do(h.UsersHTTP, http.MethodPost, "/v1/users/1/wallet:topUp", `{"amount":{"currency_code":"PKR","units":"1000"}}`)
+	require.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+	_, err = h.Users.TopUpWallet(ctx, &userpb.TopUpWalletRequest{UserId: 1, Amount: rupees(1000)})
+	require.NoError(t, err)
+
+	// The PKR 625 fare is held when booking, so a second ride does not fit.
+	created, err := book()
+	require.NoError(t, err)
+	require.Equal(t, bookingpb.PaymentMethod_PAYMENT_METHOD_WALLET, created.Booking.PaymentMethod)
+	require.NotZero(t, created.Booking.HoldId)
+	requireWallet(1000, 625)
+	_, err = book()
+	requireErrorInfo(t, err, codes.FailedPrecondition, "INSUFFICIENT_FUNDS")
+
+	// Cancelling a booking releases its hold.
+	_, err = h.Users.TopUpWallet(ctx, &userpb.TopUpWalletRequest{UserId: 1, Amount: rupees(1000)})
+	require.NoError(t, err)
+	second, err := book()
+	require.NoError(t, err)
+	requireWallet(2000, 1250)
+	_, err = h.Bookings.CancelBooking(ctx, &bookingpb.CancelBookingRequest{BookingId: second.Booking.BookingId, UserId: 2})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "BOOKING_NOT_CANCELLABLE")
+	rec = do(h.BookingsHTTP, http.MethodPost, fmt.Sprintf("/v1/bookings/%d:cancel", second.Booking.BookingId), `{"user_id":1}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Contains(t, rec.Body.String(), `"status":"BOOKING_STATUS_CANCELLED"`)
+	requireWallet(2000, 625)
+	_, err = h.Bookings.CancelBooking(ctx, &bookingpb.CancelBookingRequest{BookingId: second.Booking.BookingId, UserId: 1})
+	require.NoError(t, err)
+	requireWallet(2000, 625)
+
+	// Completing the first booking captures its hold instead of charging a card.
+	require.NoError(t, h.Dispatch(ctx))
+	offers, err := h.Bookings.ListDriverOffers(ctx, &bookingpb.ListDriverOffersRequest{DriverId: 1})
+	require.NoError(t, err)
+	require.Len(t, offers.Offers, 1)
+	require.Equal(t, created.Booking.BookingId, offers.Offers[0].BookingId)
+	_, err = h.Bookings.RespondToOffer(ctx, &bookingpb.RespondToOfferRequest{OfferId: offers.Offers[0].OfferId, DriverId: 1, Accept: true})
+	require.NoError(t, err)
+	completed, err := h.Bookings.CompleteBooking(ctx, &bookingpb.CompleteBookingRequest{BookingId: created.Booking.BookingId, DriverId: 1})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("wallet-hold-%d", created.Booking.HoldId), completed.Entry.Reference)
+	requireWallet(1375, 0)
+
+	transactions, err := h.Users.ListWalletTransactions(ctx, &userpb.ListWalletTransactionsRequest{UserId: 1})
+	require.NoError(t, err)
+	var kinds []userpb.WalletTransactionKind
+	for _, tx := range transactions.Transactions {
+		kinds = append(kinds, tx.Kind)
+	}
+	require.Equal(t, []userpb.WalletTransactionKind{
+		userpb.WalletTransactionKind_WALLET_TRANSACTION_KIND_CAPTURE,
+		userpb.WalletTransactionKind_WALLET_TRANSACTION_KIND_RELEASE,
+		userpb.WalletTransactionKind_WALLET_TRANSACTION_KIND_HOLD,
+		userpb.WalletTransactionKind_WALLET_TRANSACTION_KIND_TOP_UP,
+		userpb.WalletTransactionKind_WALLET_TRANSACTION_KIND_HOLD,
+		userpb.WalletTransactionKind_WALLET_TRANSACTION_KIND_TOP_UP,
+	}, kinds)
+
+	// Refunds go back into the wallet.
+	refunded, err := h.Bookings.RefundBooking(ctx, &bookingpb.RefundBookingRequest{BookingId: created.Booking.BookingId, Amount: rupees(25),
+		Reason: bookingpb.RefundReason_REFUND_REASON_FARE_DISPUTE})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("wallet-hold-%d", created.Booking.HoldId), refunded.Entry.Reference)
+	requireWallet(1400, 0)
+}
+
+func TestUpdateRide(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	res, err := h.Rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{
+		RideId: 1,
+		Ride:   &ridepb.Ride{Source: "Downtown", Destination: "Mall", Distance: 10, Cost: rupees(200), Pickup: downtown, Dropoff: mall},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ride with id 1 successfully updated", res.Message)
+
+	_, err = h.Rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{
+		RideId: 1_000_000,
+		Ride:   &ridepb.Ride{Source: "Downtown", Destination: "Mall", Distance: 10, Cost: rupees(200), Pickup: downtown, Dropoff: mall},
+	})
+	requireErrorInfo(t, err, codes.NotFound, "RIDE_NOT_FOUND")
+
+	// A ride is priced in one currency for good.
+	_, err = h.Rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{
+		RideId: 1,
+		Ride: &ridepb.Ride{Source: "Downtown", Destination: "Mall", Distance: 10, Pickup: downtown, Dropoff: mall,
+			Cost: &money.Money{CurrencyCode: "USD", Units: 2}},
+	})
+	requireErrorInfo(t, err, codes.FailedPrecondition, "CURRENCY_MISMATCH")
+
+	_, err = h.Rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{
+		RideId: 1,
+		Ride: &ridepb.Ride{Source: "Downtown", Destination: "Mall", Distance: 10, Pickup: downtown, Dropoff: mall,
+			Cost: &money.Money{CurrencyCode: "PKR", Units: 200, Nanos: 5_000_000}},
+	})
+	requireErrorInfo(t, err, codes.InvalidArgument, "INVALID_REQUEST")
+}
+
+func TestValidation(t *testing.T) {
+	h := New(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		call   func() error
+		fields []string
+	}{
+		{
+			name: "Empty User Name",
+			call: func() error {
+				_, err := h.Users.CreateUser(ctx, &userpb.CreateUserRequest{})
+				return err
+			},
+			fields: []string{"name"},
+		},
+		{
+			name: "Booking Without Ride",
+			call: func() error {
+				_, err := h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{UserId: 1, QuoteId: quote(t, h, downtown, airport)})
+				return err
+			},
+			fields: []string{"ride"},
+		},
+		{
+			name: "Booking Without Quote",
+			call: func() error {
+				_, err := h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+					UserId: 1,
+					Ride:   &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Pickup: downtown, Dropoff: airport},
+				})
+				return err
+			},
+			fields: []string{"quote_id"},
+		},
+		{
+			name: "Booking Same Source And Destination",
+			call: func() error {
+				_, err := h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+					UserId:  1,
+					Ride:    &bookingpb.Ride{Source: "Airport", Destination: "Airport", Cost: rupees(10), Pickup: downtown, Dropoff: airport},
+					QuoteId: quote(t, h, downtown, airport),
+				})
+				return err
+			},
+			fields: []string{"ride"},
+		},
+		{
+			name: "Ride Negative Distance And Cost",
+			call: func() error {
+				_, err := h.Rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{
+					RideId: 1,
+					Ride:   &ridepb.Ride{Source: "Downtown", Destination: "Mall", Distance: -1, Cost: rupees(-1), Pickup: downtown, Dropoff: mall},
+				})
+				return err
+			},
+			fields: []string{"ride.distance", "ride.cost"},
+		},
+		{
+			name: "Ride Without Pickup",
+			call: func() error {
+				_, err := h.Rides.UpdateRide(ctx, &ridepb.UpdateRideRequest{
+					RideId: 1,
+					Ride:   &ridepb.Ride{Source: "Downtown", Destination: "Mall", Cost: rupees(200), Dropoff: mall},
+				})
+				return err
+			},
+			fields: []string{"ride.pickup"},
+		},
+		{
+			name: "Ride Without Cost",
+			call: func() error {
+				_, err := h.Rides.CreateRide(ctx, &ridepb.CreateRideRequest{
+					Ride: &ridepb.Ride{Source: "Downtown", Destination: "Mall", Pickup: downtown, Dropoff: mall},
+				})
+				return err
+			},
+			fields: []string{"ride.cost"},
+		},
+		{
+			name: "Booking Distance Far From Route",
+			call: func() error {
+				_, err := h.Bookings.CreateBooking(ctx, &bookingpb.CreateBookingRequest{
+					UserId:  1,
+					Ride:    &bookingpb.Ride{Source: "Downtown", Destination: "Airport", Distance: 40, Cost: rupees(250), Pickup: downtown, Dropoff: airport},
+					QuoteId: quote(t, h, downtown, airport),
+				})
+				return err
+			},
+			fields: []string{"ride.distance"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			requireErrorInfo(t, err, codes.InvalidArgument, "INVALID_REQUEST")
+
+			var fields []string
+			for _, d := range status.Convert(err).Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok {
+					for _, v := range br.FieldViolations {
+						fields = append(fields, v.Field)
+					}
+				}
+			}
+			require.Equal(t, tt.fields, fields)
+		})
+	}
+}
+
+// requireErrorInfo asserts err is a status with code and an ErrorInfo with reason.
+func requireErrorInfo(t *testing.T, err error, code codes.Code, reason string) {
+	t.Helper()
+
+	require.Error(t, err)
+	st := status.Convert(err)
+	require.Equal(t, code, st.Code(), st.Message())
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			require.Equal(t, reason, info.Reason)
+			return
+		}
+	}
+	t.Fatalf("status %v has no ErrorInfo detail", st)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"github.com/golang_falcon_task/gateway-service/internal/config"
+	"github.com/golang_falcon_task/gateway-service/internal/logging"
+	"github.com/golang_falcon_task/gateway-service/internal/metrics"
+	"github.com/golang_falcon_task/gateway-service/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"net"
+)
+
+func main() {
+	// Initialize logger
+	logging.InitLogger()
+	log := logging.Logger
+
+	// Load configuration
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	// Initialize metrics
+	metrics.InitMetrics()
+	metrics.StartMetricsServer(":9004")
+
+	// Connect to the backend services
+	conns := make(map[string]*grpc.ClientConn)
+	for name, addr := range map[string]string{
+		"user":    cfg.UserServiceAddr,
+		"booking": cfg.BookingServiceAddr,
+		"ride":    cfg.RideServiceAddr,
+		"driver":  cfg.DriverServiceAddr,
+	} {
+		conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatalf("failed to create %s-service client: %v", name, err)
+		}
+		defer conn.Close()
+		conns[name] = conn
+	}
+
+	grpcServer := server.New(server.Options{
+		Logger:            log,
+		Users:             conns["user"],
+		Bookings:          conns["booking"],
+		Rides:             conns["ride"],
+		Drivers:           conns["driver"],
+		APIKeys:           cfg.APIKeys,
+		RateLimitRPS:      cfg.RateLimitRPS,
+		RateLimitBurst:    cfg.RateLimitBurst,
+		DashboardCacheTTL: cfg.DashboardCacheTTL,
+	})
+
+	// Start gRPC server
+	lis, err := net.Listen("tcp", ":50050")
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	log.Println("GatewayService is running on port 50050")
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
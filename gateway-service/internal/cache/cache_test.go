@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC)
+	c := New[int32, string](time.Minute, 2)
+	c.now = func() time.Time { return now }
+
+	c.Set(1, "one")
+	v, ok := c.Get(1)
+	require.True(t, ok)
+	require.Equal(t, "one", v)
+
+	_, ok = c.Get(2)
+	require.False(t, ok)
+
+	// Entries expire after the TTL.
+	now = now.Add(time.Minute)
+	_, ok = c.Get(1)
+	require.False(t, ok)
+
+	// A full cache evicts to make room.
+	c.Set(1, "one")
+	c.Set(2, "two")
+	c.Set(3, "three")
+	require.Len(t, c.entries, 2)
+	v, ok = c.Get(3)
+	require.True(t, ok)
+	require.Equal(t, "three", v)
+
+	c.Delete(3)
+	_, ok = c.Get(3)
+	require.False(t, ok)
+}
+
+func TestCache_Disabled(t *testing.T) {
+	c := New[int32, string](0, 10)
+
+	c.Set(1, "one")
+	_, ok := c.Get(1)
+	require.False(t, ok)
+}
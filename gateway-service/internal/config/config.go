@@ -0,0 +1,117 @@
+package config
+
+import (
+	"fmt"
+	"github.com/joho/godotenv"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Config struct {
+	// Backend gRPC addresses
+	UserServiceAddr    string
+	BookingServiceAddr string
+	RideServiceAddr    string
+	DriverServiceAddr  string
+
+	// APIKeys are the bearer tokens accepted from clients.
+	APIKeys []string
+
+	// Per API key token bucket: sustained requests per second and burst size.
+	RateLimitRPS   float64
+	RateLimitBurst int
+
+	// DashboardCacheTTL is how long a rider dashboard is served from cache.
+	// Zero disables caching.
+	DashboardCacheTTL time.Duration
+}
+
+func LoadConfig() (*Config, error) {
+	err := godotenv.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{
+		UserServiceAddr:    getEnv("USER_SERVICE_ADDR", "localhost:50051"),
+		BookingServiceAddr: getEnv("BOOKING_SERVICE_ADDR", "localhost:50052"),
+		RideServiceAddr:    getEnv("RIDE_SERVICE_ADDR", "localhost:50053"),
+		DriverServiceAddr:  getEnv("DRIVER_SERVICE_ADDR", "localhost:50054"),
+	}
+
+	for _, key := range strings.Split(os.Getenv("GATEWAY_API_KEYS"), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			cfg.APIKeys = append(cfg.APIKeys, key)
+		}
+	}
+	if cfg.RateLimitRPS, err = getEnvFloat("RATE_LIMIT_RPS", 20); err != nil {
+		return nil, err
+	}
+	if cfg.RateLimitBurst, err = getEnvInt("RATE_LIMIT_BURST", 40); err != nil {
+		return nil, err
+	}
+	if cfg.DashboardCacheTTL, err = getEnvDuration("DASHBOARD_CACHE_TTL", 5*time.Second); err != nil {
+		return nil, err
+	}
+
+	if len(cfg.APIKeys) == 0 {
+		return nil, fmt.Errorf("GATEWAY_API_KEYS must list at least one key")
+	}
+	if cfg.RateLimitRPS <= 0 || cfg.RateLimitBurst <= 0 {
+		return nil, fmt.Errorf("RATE_LIMIT_RPS and RATE_LIMIT_BURST must be positive")
+	}
+	if cfg.DashboardCacheTTL < 0 {
+		return nil, fmt.Errorf("DASHBOARD_CACHE_TTL must not be negative, got %s", cfg.DashboardCacheTTL)
+	}
+
+	return cfg, nil
+}
+
+// getEnv returns the value of an environment variable or a fallback if it is unset.
+func getEnv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
+
+// getEnvInt parses an integer environment variable, returning fallback if it is unset.
+func getEnvInt(key string, fallback int) (int, error) {
+	v := getEnv(key, "")
+	if v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", key, v, err)
+	}
+	return n, nil
+}
+
+// getEnvFloat parses a floating point environment variable, returning fallback if it is unset.
+func getEnvFloat(key string, fallback float64) (float64, error) {
+	v := getEnv(key, "")
+	if v == "" {
+		return fallback, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", key, v, err)
+	}
+	return f, nil
+}
+
+// getEnvDuration parses a duration environment variable (e.g. "30s"), returning fallback if it is unset.
+func getEnvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	v := getEnv(key, "")
+	if v == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", key, v, err)
+	}
+	return d, nil
+}
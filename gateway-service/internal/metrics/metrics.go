@@ -0,0 +1,29 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+)
+
+var (
+	RequestCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_requests_total",
+			Help: "Total number of gRPC requests",
+		},
+		[]string{"method", "status"},
+	)
+)
+
+func InitMetrics() {
+	prometheus.MustRegister(RequestCount)
+}
+
+// StartMetricsServer starts a Prometheus metrics server.
+func StartMetricsServer(port string) {
+	http.Handle("/metrics", promhttp.Handler())
+	go func() {
+		http.ListenAndServe(port, nil)
+	}()
+}
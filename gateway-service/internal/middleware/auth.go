@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+
+	"github.com/golang_falcon_task/gateway-service/internal/grpcerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+type clientKey struct{}
+
+// ClientFromContext returns the ID of the authenticated client. The ID is
+// derived from the client's API key but does not reveal it, so it is safe to
+// log and to key per-client state such as rate limits.
+func ClientFromContext(ctx context.Context) string {
+	client, _ := ctx.Value(clientKey{}).(string)
+	return client
+}
+
+// AuthInterceptor rejects calls without an "authorization: Bearer <key>"
+// header carrying one of keys.
+func AuthInterceptor(keys []string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, err := authenticate(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor is AuthInterceptor for streaming and proxied calls.
+func StreamAuthInterceptor(keys []string) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authenticate(stream.Context(), keys)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func authenticate(ctx context.Context, keys []string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, grpcerr.New(codes.Unauthenticated, grpcerr.ReasonUnauthenticated, "missing authorization header")
+	}
+
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return nil, grpcerr.New(codes.Unauthenticated, grpcerr.ReasonUnauthenticated, "authorization header must use the Bearer scheme")
+	}
+	for _, key := range keys {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(key)) == 1 {
+			sum := sha256.Sum256([]byte(key))
+			return context.WithValue(ctx, clientKey{}, hex.EncodeToString(sum[:8])), nil
+		}
+	}
+	return nil, grpcerr.New(codes.Unauthenticated, grpcerr.ReasonUnauthenticated, "invalid API key")
+}
+
+// contextStream overrides the context of a server stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context { return s.ctx }
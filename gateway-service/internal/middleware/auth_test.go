@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	interceptor := AuthInterceptor([]string{"key-1", "key-2"})
+	info := &grpc.UnaryServerInfo{FullMethod: "/gateway.v1.GatewayService/GetRiderDashboard"}
+
+	tests := []struct {
+		name          string
+		authorization []string
+		expectedCode  codes.Code
+	}{
+		{name: "Valid Key", authorization: []string{"Bearer key-2"}, expectedCode: codes.OK},
+		{name: "Missing Header", expectedCode: codes.Unauthenticated},
+		{name: "Wrong Scheme", authorization: []string{"Basic key-1"}, expectedCode: codes.Unauthenticated},
+		{name: "Unknown Key", authorization: []string{"Bearer key-3"}, expectedCode: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			if tt.authorization != nil {
+				md.Set("authorization", tt.authorization...)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			var client string
+			_, err := interceptor(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+				client = ClientFromContext(ctx)
+				return nil, nil
+			})
+
+			require.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				require.Len(t, client, 16)
+				require.NotContains(t, client, "key")
+			}
+		})
+	}
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	interceptor := RateLimitInterceptor(NewRateLimiter(1, 2))
+	info := &grpc.UnaryServerInfo{FullMethod: "/gateway.v1.GatewayService/GetRiderDashboard"}
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) { return nil, nil }
+
+	alice := context.WithValue(context.Background(), clientKey{}, "alice")
+	bob := context.WithValue(context.Background(), clientKey{}, "bob")
+
+	// The burst is allowed, then the bucket is empty.
+	for i := 0; i < 2; i++ {
+		_, err := interceptor(alice, nil, info, handler)
+		require.NoError(t, err)
+	}
+	_, err := interceptor(alice, nil, info, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Other clients have their own bucket.
+	_, err = interceptor(bob, nil, info, handler)
+	require.NoError(t, err)
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang_falcon_task/gateway-service/internal/grpcerr"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// RateLimiter keeps a token bucket per authenticated client.
+type RateLimiter struct {
+	mu       sync.Mutex
+	limit    rate.Limit
+	burst    int
+	limiters map[string]*rate.Limiter
+}
+
+// NewRateLimiter allows each client rps requests per second on average, with
+// bursts of up to burst requests.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		limit:    rate.Limit(rps),
+		burst:    burst,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// allow takes a token for the client in ctx, or returns a ResourceExhausted
+// error telling the client when to retry.
+func (l *RateLimiter) allow(ctx context.Context) error {
+	client := ClientFromContext(ctx)
+
+	l.mu.Lock()
+	limiter, ok := l.limiters[client]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[client] = limiter
+	}
+	l.mu.Unlock()
+
+	r := limiter.Reserve()
+	if delay := r.Delay(); delay > 0 {
+		r.Cancel()
+		return grpcerr.New(codes.ResourceExhausted, grpcerr.ReasonRateLimited, "rate limit exceeded", grpcerr.Retry(delay))
+	}
+	return nil
+}
+
+// RateLimitInterceptor enforces limiter. It must run after AuthInterceptor.
+func RateLimitInterceptor(limiter *RateLimiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := limiter.allow(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamRateLimitInterceptor is RateLimitInterceptor for streaming and
+// proxied calls. A stream costs one token, however many messages it carries.
+func StreamRateLimitInterceptor(limiter *RateLimiter) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := limiter.allow(stream.Context()); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
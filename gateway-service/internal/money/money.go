@@ -0,0 +1,200 @@
+// Package money represents amounts of money exactly, as whole minor units of
+// a currency, and converts them to and from google.type.Money.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+// DefaultCurrency is the currency rides are priced in unless a tariff says
+// otherwise.
+const DefaultCurrency = "PKR"
+
+var (
+	// ErrUnknownCurrency is returned for a currency code this package does
+	// not know the minor unit of.
+	ErrUnknownCurrency = errors.New("unknown currency")
+
+	// ErrCurrencyMismatch is returned when combining amounts in different
+	// currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+
+	// ErrOverflow is returned when an amount does not fit in int64 minor
+	// units.
+	ErrOverflow = errors.New("amount out of range")
+
+	// ErrPrecision is returned for an amount finer than its currency's minor
+	// unit, such as PKR 1.005.
+	ErrPrecision = errors.New("amount is finer than the currency's minor unit")
+
+	// ErrInvalid is returned for a google.type.Money whose units and nanos
+	// are out of range or differ in sign.
+	ErrInvalid = errors.New("invalid amount")
+)
+
+// exponents are the ISO 4217 currencies supported, with the number of
+// decimal places of their minor unit.
+var exponents = map[string]int{
+	"AED": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"JPY": 0,
+	"KWD": 3,
+	"PKR": 2,
+	"SAR": 2,
+	"USD": 2,
+}
+
+// Money is an amount of money in the minor units of its currency, e.g.
+// {PKR 62550} is PKR 625.50. The zero Money has no currency and is not
+// valid; use New or Zero.
+type Money struct {
+	Currency string // ISO 4217 code
+	Minor    int64  // Amount in minor units of Currency
+}
+
+// Exponent returns the number of decimal places of currency's minor unit.
+func Exponent(currency string) (int, error) {
+	exp, ok := exponents[currency]
+	if !ok {
+		return 0, fmt.Errorf("%w %q", ErrUnknownCurrency, currency)
+	}
+	return exp, nil
+}
+
+// New returns minor units of currency.
+func New(currency string, minor int64) (Money, error) {
+	if _, err := Exponent(currency); err != nil {
+		return Money{}, err
+	}
+	return Money{Currency: currency, Minor: minor}, nil
+}
+
+// Zero returns no money in currency. currency must be known.
+func Zero(currency string) Money {
+	return Money{Currency: currency}
+}
+
+// FromMajor converts an amount in major units of currency, such as a fare
+// computed in floating point, to Money. It rounds to the nearest minor unit,
+// halves away from zero, so PKR 812.505 is PKR 812.51.
+func FromMajor(currency string, amount float64) (Money, error) {
+	exp, err := Exponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	minor := math.Round(amount * math.Pow10(exp))
+	if math.IsNaN(minor) || minor >= math.MaxInt64 || minor < math.MinInt64 {
+		return Money{}, fmt.Errorf("%w: %g %s", ErrOverflow, amount, currency)
+	}
+	return Money{Currency: currency, Minor: int64(minor)}, nil
+}
+
+// Add returns m + n. Both must be in the same currency.
+func (m Money) Add(n Money) (Money, error) {
+	if m.Currency != n.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, n.Currency)
+	}
+	if (n.Minor > 0 && m.Minor > math.MaxInt64-n.Minor) || (n.Minor < 0 && m.Minor < math.MinInt64-n.Minor) {
+		return Money{}, fmt.Errorf("%w: %s + %s", ErrOverflow, m, n)
+	}
+	return Money{Currency: m.Currency, Minor: m.Minor + n.Minor}, nil
+}
+
+// Sub returns m - n. Both must be in the same currency.
+func (m Money) Sub(n Money) (Money, error) {
+	if n.Minor == math.MinInt64 {
+		return Money{}, fmt.Errorf("%w: %s - %s", ErrOverflow, m, n)
+	}
+	return m.Add(Money{Currency: n.Currency, Minor: -n.Minor})
+}
+
+// Mul returns m scaled by factor, rounded to the nearest minor unit with
+// halves away from zero.
+func (m Money) Mul(factor float64) (Money, error) {
+	minor := math.Round(float64(m.Minor) * factor)
+	if math.IsNaN(minor) || minor >= math.MaxInt64 || minor < math.MinInt64 {
+		return Money{}, fmt.Errorf("%w: %s * %g", ErrOverflow, m, factor)
+	}
+	return Money{Currency: m.Currency, Minor: int64(minor)}, nil
+}
+
+// Cmp compares m and n, which must be in the same currency, returning -1, 0
+// or +1 as m is less than, equal to or greater than n.
+func (m Money) Cmp(n Money) (int, error) {
+	if m.Currency != n.Currency {
+		return 0, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, n.Currency)
+	}
+	switch {
+	case m.Minor < n.Minor:
+		return -1, nil
+	case m.Minor > n.Minor:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// IsZero reports whether m is no money.
+func (m Money) IsZero() bool {
+	return m.Minor == 0
+}
+
+// IsNegative reports whether m is less than zero.
+func (m Money) IsNegative() bool {
+	return m.Minor < 0
+}
+
+// String formats m as its currency and major units, e.g. "PKR 625.50".
+func (m Money) String() string {
+	exp, err := Exponent(m.Currency)
+	if err != nil {
+		return fmt.Sprintf("%s %d", m.Currency, m.Minor)
+	}
+	sign, abs := "", uint64(m.Minor)
+	if m.Minor < 0 {
+		sign, abs = "-", uint64(-(m.Minor+1))+1
+	}
+	if exp == 0 {
+		return fmt.Sprintf("%s %s%d", m.Currency, sign, abs)
+	}
+	pow := uint64(math.Pow10(exp))
+	return fmt.Sprintf("%s %s%d.%0*d", m.Currency, sign, abs/pow, exp, abs%pow)
+}
+
+// FromProto converts a google.type.Money. It fails with ErrUnknownCurrency,
+// ErrInvalid, ErrPrecision or ErrOverflow if the amount cannot be
+// represented exactly in minor units.
+func FromProto(p *moneypb.Money) (Money, error) {
+	exp, err := Exponent(p.GetCurrencyCode())
+	if err != nil {
+		return Money{}, err
+	}
+	units, nanos := p.GetUnits(), int64(p.GetNanos())
+	if nanos <= -1e9 || nanos >= 1e9 || (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return Money{}, fmt.Errorf("%w: units %d and nanos %d", ErrInvalid, units, nanos)
+	}
+	step := int64(math.Pow10(9 - exp))
+	if nanos%step != 0 {
+		return Money{}, fmt.Errorf("%w: %s has %d decimal places", ErrPrecision, p.GetCurrencyCode(), exp)
+	}
+	pow := int64(math.Pow10(exp))
+	if units > math.MaxInt64/pow || units < math.MinInt64/pow {
+		return Money{}, fmt.Errorf("%w: %d %s", ErrOverflow, units, p.GetCurrencyCode())
+	}
+	return Money{Currency: p.GetCurrencyCode(), Minor: units * pow}.Add(Money{Currency: p.GetCurrencyCode(), Minor: nanos / step})
+}
+
+// Proto converts m to a google.type.Money.
+func (m Money) Proto() *moneypb.Money {
+	exp, _ := Exponent(m.Currency)
+	pow := int64(math.Pow10(exp))
+	return &moneypb.Money{
+		CurrencyCode: m.Currency,
+		Units:        m.Minor / pow,
+		Nanos:        int32(m.Minor % pow * int64(math.Pow10(9-exp))),
+	}
+}
@@ -0,0 +1,176 @@
+// Package proxy forwards the rider-facing gRPC calls the gateway does not
+// serve itself to the backend service that owns them. Messages are relayed
+// as raw bytes, so the gateway needs no knowledge of the backend messages
+// and supports every RPC type.
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	bookingpb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"github.com/golang_falcon_task/gateway-service/internal/grpcerr"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// frame is a message relayed without decoding.
+type frame struct {
+	payload []byte
+}
+
+// Codec relays frames unchanged and encodes everything else as protobuf, so
+// proxied and locally served methods can share one gRPC server.
+type Codec struct{}
+
+func (Codec) Marshal(v any) ([]byte, error) {
+	if f, ok := v.(*frame); ok {
+		return f.payload, nil
+	}
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("proxy: cannot marshal %T", v)
+	}
+	return proto.Marshal(msg)
+}
+
+func (Codec) Unmarshal(data []byte, v any) error {
+	if f, ok := v.(*frame); ok {
+		f.payload = append([]byte(nil), data...)
+		return nil
+	}
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("proxy: cannot unmarshal into %T", v)
+	}
+	return proto.Unmarshal(data, msg)
+}
+
+// Name replaces the default codec, which handles the same content type.
+func (Codec) Name() string { return "proto" }
+
+// riderMethods are the backend methods the gateway forwards: those a rider's
+// client calls. Operator, driver and settlement methods, such as CreatePromo,
+// CompleteBooking, RefundBooking and the wallet holds, are only served to
+// other services over the internal network.
+var riderMethods = map[string]bool{
+	userpb.UserService_GetUser_FullMethodName:                true,
+	userpb.UserService_CreateUser_FullMethodName:             true,
+	userpb.UserService_GetWallet_FullMethodName:              true,
+	userpb.UserService_ListWalletTransactions_FullMethodName: true,
+
+	bookingpb.BookingService_CreateBooking_FullMethodName:  true,
+	bookingpb.BookingService_GetBooking_FullMethodName:     true,
+	bookingpb.BookingService_ListBookings_FullMethodName:   true,
+	bookingpb.BookingService_GetBookingSaga_FullMethodName: true,
+	bookingpb.BookingService_WatchBooking_FullMethodName:   true,
+	bookingpb.BookingService_EstimateFare_FullMethodName:   true,
+	bookingpb.BookingService_GetPromo_FullMethodName:       true,
+	bookingpb.BookingService_CancelBooking_FullMethodName:  true,
+	bookingpb.BookingService_GetReceipt_FullMethodName:     true,
+
+	ridepb.RideService_GetRide_FullMethodName: true,
+
+	driverpb.DriverService_GetDriver_FullMethodName: true,
+}
+
+// Router maps fully-qualified service names (e.g. "user.v1.UserService") to
+// the connection of the backend serving them.
+type Router struct {
+	routes map[string]grpc.ClientConnInterface
+}
+
+// NewRouter creates a Router for routes.
+func NewRouter(routes map[string]grpc.ClientConnInterface) *Router {
+	return &Router{routes: routes}
+}
+
+// Handler proxies a call to its backend. Install it with
+// grpc.UnknownServiceHandler together with grpc.ForceServerCodec(Codec{}).
+// Every method riders do not call fails with PermissionDenied.
+// Incoming metadata is forwarded except for the caller's credentials, and
+// backend headers, trailers and status errors are returned unchanged.
+func (r *Router) Handler(_ any, stream grpc.ServerStream) error {
+	method, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return grpcerr.New(codes.Internal, grpcerr.ReasonUnknownService, "method missing from stream")
+	}
+	if !riderMethods[method] {
+		return grpcerr.New(codes.PermissionDenied, grpcerr.ReasonNotAllowed, fmt.Sprintf("method %s is not available through the gateway", method))
+	}
+	conn, ok := r.routes[serviceName(method)]
+	if !ok {
+		return grpcerr.New(codes.Unimplemented, grpcerr.ReasonUnknownService, fmt.Sprintf("unknown method %s", method))
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Delete("authorization")
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	desc := &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}
+	backend, err := conn.NewStream(ctx, desc, method, grpc.ForceCodec(Codec{}))
+	if err != nil {
+		return err
+	}
+
+	// Relay requests to the backend until the client half-closes.
+	go func() {
+		for {
+			f := &frame{}
+			if err := stream.RecvMsg(f); err != nil {
+				if err == io.EOF {
+					backend.CloseSend()
+				} else {
+					cancel()
+				}
+				return
+			}
+			if err := backend.SendMsg(f); err != nil {
+				return
+			}
+		}
+	}()
+
+	// Relay responses back to the client.
+	headerSent := false
+	for {
+		f := &frame{}
+		err := backend.RecvMsg(f)
+		if !headerSent {
+			if header, herr := backend.Header(); herr == nil {
+				stream.SetHeader(header)
+			}
+			headerSent = true
+		}
+		if err != nil {
+			stream.SetTrailer(backend.Trailer())
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := stream.SendMsg(f); err != nil {
+			return err
+		}
+	}
+}
+
+// serviceName extracts "pkg.Service" from "/pkg.Service/Method".
+func serviceName(method string) string {
+	method = strings.TrimPrefix(method, "/")
+	if i := strings.LastIndex(method, "/"); i >= 0 {
+		return method[:i]
+	}
+	return method
+}
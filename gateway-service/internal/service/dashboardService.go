@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	bookingpb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	"github.com/golang_falcon_task/gateway-service/internal/cache"
+	"github.com/golang_falcon_task/gateway-service/internal/grpcerr"
+	"github.com/golang_falcon_task/gateway-service/internal/money"
+	pb "github.com/golang_falcon_task/gateway-service/proto/gateway/v1"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"maps"
+	"slices"
+	"time"
+)
+
+// maxRideLookups bounds the concurrent GetRide calls of one dashboard.
+const maxRideLookups = 8
+
+// maxCachedDashboards bounds the dashboard cache.
+const maxCachedDashboards = 10_000
+
+type DashboardService struct {
+	users    userpb.UserServiceClient
+	bookings bookingpb.BookingServiceClient
+	rides    ridepb.RideServiceClient
+	cache    *cache.Cache[int32, *pb.GetRiderDashboardResponse]
+	log      *logrus.Logger
+	pb.UnimplementedGatewayServiceServer
+}
+
+// NewDashboardService creates a DashboardService reading from the given
+// backends. Dashboards are cached for cacheTTL; zero disables the cache.
+func NewDashboardService(users userpb.UserServiceClient, bookings bookingpb.BookingServiceClient, rides ridepb.RideServiceClient, cacheTTL time.Duration, logger *logrus.Logger) *DashboardService {
+	return &DashboardService{
+		users:    users,
+		bookings: bookings,
+		rides:    rides,
+		cache:    cache.New[int32, *pb.GetRiderDashboardResponse](cacheTTL, maxCachedDashboards),
+		log:      logger,
+	}
+}
+
+// GetRiderDashboard combines a rider's profile from UserService, their
+// bookings from BookingService and each booked ride from RideService.
+// Backend errors are returned unchanged, so clients see the owning service's
+// status and error details.
+func (s *DashboardService) GetRiderDashboard(ctx context.Context, req *pb.GetRiderDashboardRequest) (*pb.GetRiderDashboardResponse, error) {
+	// Input validation
+	if req.UserId <= 0 {
+		s.log.Error("Invalid user_id: must be a positive integer", "user_id", req.UserId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("user_id", "must be a positive integer"))
+	}
+
+	if cached, ok := s.cache.Get(req.UserId); ok {
+		return proto.Clone(cached).(*pb.GetRiderDashboardResponse), nil
+	}
+
+	// The profile and the bookings are independent, so fetch them together.
+	var (
+		user     *userpb.GetUserResponse
+		bookings *bookingpb.ListBookingsResponse
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		user, err = s.users.GetUser(gctx, &userpb.GetUserRequest{UserId: req.UserId})
+		return err
+	})
+	g.Go(func() (err error) {
+		bookings, err = s.bookings.ListBookings(gctx, &bookingpb.ListBookingsRequest{UserId: req.UserId})
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		s.log.Error("Failed to fetch rider", "user_id", req.UserId, "error", err.Error())
+		return nil, err
+	}
+
+	rides, err := s.fetchRides(ctx, bookings.Bookings)
+	if err != nil {
+		s.log.Error("Failed to fetch rides", "user_id", req.UserId, "error", err.Error())
+		return nil, err
+	}
+
+	res := &pb.GetRiderDashboardResponse{
+		UserId:   req.UserId,
+		Name:     user.Name,
+		Bookings: make([]*pb.DashboardBooking, 0, len(bookings.Bookings)),
+	}
+	totals := make(map[string]money.Money)
+	for _, b := range bookings.Bookings {
+		booking := &pb.DashboardBooking{BookingId: b.BookingId, Time: b.Time}
+		if ride, ok := rides[b.RideId]; ok {
+			booking.Ride = &pb.Ride{
+				RideId:      ride.RideId,
+				Source:      ride.Source,
+				Destination: ride.Destination,
+				Distance:    ride.Distance,
+				Cost:        ride.Cost,
+			}
+			res.TotalDistance += ride.Distance
+			if err := addCost(totals, ride.Cost); err != nil {
+				s.log.Error("Failed to total ride costs", "user_id", req.UserId, "ride_id", ride.RideId, "error", err.Error())
+				return nil, status.Errorf(codes.Internal, "failed to total the cost of ride %d: %v", ride.RideId, err)
+			}
+		}
+		res.Bookings = append(res.Bookings, booking)
+	}
+	for _, currency := range slices.Sorted(maps.Keys(totals)) {
+		res.TotalCosts = append(res.TotalCosts, totals[currency].Proto())
+	}
+
+	s.cache.Set(req.UserId, proto.Clone(res).(*pb.GetRiderDashboardResponse))
+	return res, nil
+}
+
+// addCost adds the cost of a ride to the total of its currency. Rides
+// without a cost add nothing.
+func addCost(totals map[string]money.Money, cost *moneypb.Money) error {
+	if cost == nil {
+		return nil
+	}
+	m, err := money.FromProto(cost)
+	if err != nil {
+		return err
+	}
+	total, ok := totals[m.Currency]
+	if !ok {
+		total = money.Zero(m.Currency)
+	}
+	sum, err := total.Add(m)
+	if err != nil {
+		return err
+	}
+	totals[m.Currency] = sum
+	return nil
+}
+
+// fetchRides looks up the ride of every booking. Rides RideService no longer
+// knows are left out rather than failing the whole dashboard.
+func (s *DashboardService) fetchRides(ctx context.Context, bookings []*bookingpb.Booking) (map[int32]*ridepb.Ride, error) {
+	ids := make([]int32, 0, len(bookings))
+	seen := make(map[int32]bool, len(bookings))
+	for _, b := range bookings {
+		if !seen[b.RideId] {
+			seen[b.RideId] = true
+			ids = append(ids, b.RideId)
+		}
+	}
+
+	found := make([]*ridepb.Ride, len(ids))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxRideLookups)
+	for i, id := range ids {
+		g.Go(func() error {
+			res, err := s.rides.GetRide(gctx, &ridepb.GetRideRequest{RideId: id})
+			if status.Code(err) == codes.NotFound {
+				s.log.Warn("Ride of booking not found", "ride_id", id)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			found[i] = res.Ride
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	rides := make(map[int32]*ridepb.Ride, len(ids))
+	for i, ride := range found {
+		if ride != nil {
+			rides[ids[i]] = ride
+		}
+	}
+	return rides, nil
+}
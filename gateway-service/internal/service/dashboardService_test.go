@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bookingpb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	pb "github.com/golang_falcon_task/gateway-service/proto/gateway/v1"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeUsers struct {
+	userpb.UserServiceClient
+	calls atomic.Int32
+}
+
+func (f *fakeUsers) GetUser(_ context.Context, req *userpb.GetUserRequest, _ ...grpc.CallOption) (*userpb.GetUserResponse, error) {
+	f.calls.Add(1)
+	if req.UserId != 1 {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &userpb.GetUserResponse{Name: "John Doe"}, nil
+}
+
+type fakeBookings struct {
+	bookingpb.BookingServiceClient
+}
+
+func (f *fakeBookings) ListBookings(_ context.Context, req *bookingpb.ListBookingsRequest, _ ...grpc.CallOption) (*bookingpb.ListBookingsResponse, error) {
+	return &bookingpb.ListBookingsResponse{Bookings: []*bookingpb.Booking{
+		{BookingId: 1, UserId: req.UserId, RideId: 10, Time: "2024-12-01T10:30:00Z"},
+		{BookingId: 2, UserId: req.UserId, RideId: 20, Time: "2024-12-02T10:30:00Z"},
+		{BookingId: 3, UserId: req.UserId, RideId: 30, Time: "2024-12-03T10:30:00Z"},
+		{BookingId: 4, UserId: req.UserId, RideId: 40, Time: "2024-12-04T10:30:00Z"},
+	}}, nil
+}
+
+type fakeRides struct {
+	ridepb.RideServiceClient
+	err error
+}
+
+func (f *fakeRides) GetRide(_ context.Context, req *ridepb.GetRideRequest, _ ...grpc.CallOption) (*ridepb.GetRideResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	switch req.RideId {
+	case 10:
+		return &ridepb.GetRideResponse{Ride: &ridepb.Ride{RideId: 10, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: &money.Money{CurrencyCode: "PKR", Units: 150}}}, nil
+	case 20:
+		return &ridepb.GetRideResponse{Ride: &ridepb.Ride{RideId: 20, Source: "City Center", Destination: "Mall", Distance: 8, Cost: &money.Money{CurrencyCode: "PKR", Units: 80, Nanos: 500_000_000}}}, nil
+	case 40:
+		return &ridepb.GetRideResponse{Ride: &ridepb.Ride{RideId: 40, Source: "JFK", Destination: "Manhattan", Distance: 20, Cost: &money.Money{CurrencyCode: "USD", Units: 70, Nanos: 250_000_000}}}, nil
+	}
+	return nil, status.Error(codes.NotFound, "ride not found")
+}
+
+func TestDashboardService_GetRiderDashboard(t *testing.T) {
+	logger := logrus.New()
+
+	tests := []struct {
+		name         string
+		userID       int32
+		ridesErr     error
+		expectedCode codes.Code
+		expected     *pb.GetRiderDashboardResponse
+	}{
+		{
+			name:         "Success",
+			userID:       1,
+			expectedCode: codes.OK,
+			expected: &pb.GetRiderDashboardResponse{
+				UserId: 1,
+				Name:   "John Doe",
+				Bookings: []*pb.DashboardBooking{
+					{BookingId: 1, Time: "2024-12-01T10:30:00Z", Ride: &pb.Ride{RideId: 10, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: &money.Money{CurrencyCode: "PKR", Units: 150}}},
+					{BookingId: 2, Time: "2024-12-02T10:30:00Z", Ride: &pb.Ride{RideId: 20, Source: "City Center", Destination: "Mall", Distance: 8, Cost: &money.Money{CurrencyCode: "PKR", Units: 80, Nanos: 500_000_000}}},
+					{BookingId: 3, Time: "2024-12-03T10:30:00Z"},
+					{BookingId: 4, Time: "2024-12-04T10:30:00Z", Ride: &pb.Ride{RideId: 40, Source: "JFK", Destination: "Manhattan", Distance: 20, Cost: &money.Money{CurrencyCode: "USD", Units: 70, Nanos: 250_000_000}}},
+				},
+				TotalDistance: 43,
+				TotalCosts: []*money.Money{
+					{CurrencyCode: "PKR", Units: 230, Nanos: 500_000_000},
+					{CurrencyCode: "USD", Units: 70, Nanos: 250_000_000},
+				},
+			},
+		},
+		{
+			name:         "Invalid User ID",
+			userID:       0,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "User Not Found",
+			userID:       2,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "Ride Service Unavailable",
+			userID:       1,
+			ridesErr:     status.Error(codes.Unavailable, "connection refused"),
+			expectedCode: codes.Unavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewDashboardService(&fakeUsers{}, &fakeBookings{}, &fakeRides{err: tt.ridesErr}, 0, logger)
+
+			res, err := service.GetRiderDashboard(context.Background(), &pb.GetRiderDashboardRequest{UserId: tt.userID})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tt.expectedCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+				require.True(t, proto.Equal(tt.expected, res), "expected %v, got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestDashboardService_Cache(t *testing.T) {
+	users := &fakeUsers{}
+	service := NewDashboardService(users, &fakeBookings{}, &fakeRides{}, time.Minute, logrus.New())
+	ctx := context.Background()
+
+	first, err := service.GetRiderDashboard(ctx, &pb.GetRiderDashboardRequest{UserId: 1})
+	require.NoError(t, err)
+
+	// Callers may modify responses without corrupting the cache.
+	first.Name = "changed"
+
+	second, err := service.GetRiderDashboard(ctx, &pb.GetRiderDashboardRequest{UserId: 1})
+	require.NoError(t, err)
+	require.Equal(t, "John Doe", second.Name)
+	require.Equal(t, int32(1), users.calls.Load())
+
+	// Errors are not cached.
+	_, err = service.GetRiderDashboard(ctx, &pb.GetRiderDashboardRequest{UserId: 2})
+	require.Error(t, err)
+	_, err = service.GetRiderDashboard(ctx, &pb.GetRiderDashboardRequest{UserId: 2})
+	require.Error(t, err)
+	require.Equal(t, int32(3), users.calls.Load())
+}
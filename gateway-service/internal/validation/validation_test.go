@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"testing"
+
+	pb "github.com/golang_falcon_task/gateway-service/proto/gateway/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	validator := New()
+
+	tests := []struct {
+		name     string
+		req      *pb.GetRiderDashboardRequest
+		expected []Violation
+	}{
+		{
+			name:     "Valid",
+			req:      &pb.GetRiderDashboardRequest{UserId: 1},
+			expected: nil,
+		},
+		{
+			name: "Invalid User ID",
+			req:  &pb.GetRiderDashboardRequest{UserId: 0},
+			expected: []Violation{
+				{Field: "user_id", RuleID: "int32.gt", Message: "value must be greater than 0"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := validator.Validate(tt.req)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, violations)
+		})
+	}
+}
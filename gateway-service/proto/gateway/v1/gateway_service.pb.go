@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        (unknown)
+// source: gateway/v1/gateway_service.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Ride details as owned by RideService
+type Ride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RideId      int32        `protobuf:"varint,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	Source      string       `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string       `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Distance    int32        `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"` // Distance in kilometers
+	Cost        *money.Money `protobuf:"bytes,6,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *Ride) Reset() {
+	*x = Ride{}
+	mi := &file_gateway_v1_gateway_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ride) ProtoMessage() {}
+
+func (x *Ride) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ride.ProtoReflect.Descriptor instead.
+func (*Ride) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ride) GetRideId() int32 {
+	if x != nil {
+		return x.RideId
+	}
+	return 0
+}
+
+func (x *Ride) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Ride) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Ride) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *Ride) GetCost() *money.Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+type DashboardBooking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId int32  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Time      string `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"` // Timestamp of the booking
+	Ride      *Ride  `protobuf:"bytes,3,opt,name=ride,proto3" json:"ride,omitempty"` // Unset if RideService no longer knows the ride
+}
+
+func (x *DashboardBooking) Reset() {
+	*x = DashboardBooking{}
+	mi := &file_gateway_v1_gateway_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DashboardBooking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DashboardBooking) ProtoMessage() {}
+
+func (x *DashboardBooking) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DashboardBooking.ProtoReflect.Descriptor instead.
+func (*DashboardBooking) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DashboardBooking) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *DashboardBooking) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *DashboardBooking) GetRide() *Ride {
+	if x != nil {
+		return x.Ride
+	}
+	return nil
+}
+
+type GetRiderDashboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetRiderDashboardRequest) Reset() {
+	*x = GetRiderDashboardRequest{}
+	mi := &file_gateway_v1_gateway_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiderDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiderDashboardRequest) ProtoMessage() {}
+
+func (x *GetRiderDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiderDashboardRequest.ProtoReflect.Descriptor instead.
+func (*GetRiderDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRiderDashboardRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetRiderDashboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        int32               `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bookings      []*DashboardBooking `protobuf:"bytes,3,rep,name=bookings,proto3" json:"bookings,omitempty"`                                 // Oldest first
+	TotalDistance int32               `protobuf:"varint,4,opt,name=total_distance,json=totalDistance,proto3" json:"total_distance,omitempty"` // Sum over all rides, in kilometers
+	// Sum over all rides in each currency they cost, ordered by currency code
+	TotalCosts []*money.Money `protobuf:"bytes,6,rep,name=total_costs,json=totalCosts,proto3" json:"total_costs,omitempty"`
+}
+
+func (x *GetRiderDashboardResponse) Reset() {
+	*x = GetRiderDashboardResponse{}
+	mi := &file_gateway_v1_gateway_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiderDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiderDashboardResponse) ProtoMessage() {}
+
+func (x *GetRiderDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiderDashboardResponse.ProtoReflect.Descriptor instead.
+func (*GetRiderDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRiderDashboardResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetRiderDashboardResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetRiderDashboardResponse) GetBookings() []*DashboardBooking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *GetRiderDashboardResponse) GetTotalDistance() int32 {
+	if x != nil {
+		return x.TotalDistance
+	}
+	return 0
+}
+
+func (x *GetRiderDashboardResponse) GetTotalCosts() []*money.Money {
+	if x != nil {
+		return x.TotalCosts
+	}
+	return nil
+}
+
+var File_gateway_v1_gateway_service_proto protoreflect.FileDescriptor
+
+var file_gateway_v1_gateway_service_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1b,
+	0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa3, 0x01, 0x0a, 0x04, 0x52, 0x69, 0x64, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x72, 0x69, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x6b, 0x0a, 0x10, 0x44, 0x61,
+	0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69, 0x64,
+	0x65, 0x52, 0x04, 0x72, 0x69, 0x64, 0x65, 0x22, 0x3c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x69,
+	0x64, 0x65, 0x72, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe4, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x69, 0x64,
+	0x65, 0x72, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x38, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x33, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x32, 0x72, 0x0a, 0x0e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x69, 0x64, 0x65, 0x72, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x24, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x69, 0x64, 0x65, 0x72, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x69, 0x64, 0x65, 0x72, 0x44,
+	0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
+	0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x6c, 0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x73,
+	0x6b, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gateway_v1_gateway_service_proto_rawDescOnce sync.Once
+	file_gateway_v1_gateway_service_proto_rawDescData = file_gateway_v1_gateway_service_proto_rawDesc
+)
+
+func file_gateway_v1_gateway_service_proto_rawDescGZIP() []byte {
+	file_gateway_v1_gateway_service_proto_rawDescOnce.Do(func() {
+		file_gateway_v1_gateway_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_gateway_v1_gateway_service_proto_rawDescData)
+	})
+	return file_gateway_v1_gateway_service_proto_rawDescData
+}
+
+var file_gateway_v1_gateway_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_gateway_v1_gateway_service_proto_goTypes = []any{
+	(*Ride)(nil),                      // 0: gateway.v1.Ride
+	(*DashboardBooking)(nil),          // 1: gateway.v1.DashboardBooking
+	(*GetRiderDashboardRequest)(nil),  // 2: gateway.v1.GetRiderDashboardRequest
+	(*GetRiderDashboardResponse)(nil), // 3: gateway.v1.GetRiderDashboardResponse
+	(*money.Money)(nil),               // 4: google.type.Money
+}
+var file_gateway_v1_gateway_service_proto_depIdxs = []int32{
+	4, // 0: gateway.v1.Ride.cost:type_name -> google.type.Money
+	0, // 1: gateway.v1.DashboardBooking.ride:type_name -> gateway.v1.Ride
+	1, // 2: gateway.v1.GetRiderDashboardResponse.bookings:type_name -> gateway.v1.DashboardBooking
+	4, // 3: gateway.v1.GetRiderDashboardResponse.total_costs:type_name -> google.type.Money
+	2, // 4: gateway.v1.GatewayService.GetRiderDashboard:input_type -> gateway.v1.GetRiderDashboardRequest
+	3, // 5: gateway.v1.GatewayService.GetRiderDashboard:output_type -> gateway.v1.GetRiderDashboardResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_gateway_v1_gateway_service_proto_init() }
+func file_gateway_v1_gateway_service_proto_init() {
+	if File_gateway_v1_gateway_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gateway_v1_gateway_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gateway_v1_gateway_service_proto_goTypes,
+		DependencyIndexes: file_gateway_v1_gateway_service_proto_depIdxs,
+		MessageInfos:      file_gateway_v1_gateway_service_proto_msgTypes,
+	}.Build()
+	File_gateway_v1_gateway_service_proto = out.File
+	file_gateway_v1_gateway_service_proto_rawDesc = nil
+	file_gateway_v1_gateway_service_proto_goTypes = nil
+	file_gateway_v1_gateway_service_proto_depIdxs = nil
+}
@@ -0,0 +1,87 @@
+// Package server wires GatewayService into a gRPC server that also proxies
+// every UserService, BookingService, RideService and DriverService method to
+// its backend, behind one authentication, rate limiting and observability
+// chain. It is used by cmd/main.go and by the end-to-end harness.
+package server
+
+import (
+	"time"
+
+	bookingpb "github.com/golang_falcon_task/booking-service/proto/booking/v1"
+	driverpb "github.com/golang_falcon_task/driver-service/proto/driver/v1"
+	"github.com/golang_falcon_task/gateway-service/internal/middleware"
+	"github.com/golang_falcon_task/gateway-service/internal/proxy"
+	"github.com/golang_falcon_task/gateway-service/internal/service"
+	pb "github.com/golang_falcon_task/gateway-service/proto/gateway/v1"
+	ridepb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	userpb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// Options configures the server.
+type Options struct {
+	Logger *logrus.Logger
+
+	// Connections to the backend services.
+	Users    grpc.ClientConnInterface
+	Bookings grpc.ClientConnInterface
+	Rides    grpc.ClientConnInterface
+	Drivers  grpc.ClientConnInterface
+
+	// APIKeys are the bearer tokens accepted from clients.
+	APIKeys []string
+
+	// Per API key token bucket: sustained requests per second and burst size.
+	RateLimitRPS   float64
+	RateLimitBurst int
+
+	// DashboardCacheTTL is how long a rider dashboard is served from cache.
+	DashboardCacheTTL time.Duration
+}
+
+// New creates a gRPC server with GatewayService registered and every other
+// method routed to the backend owning it.
+func New(opts Options) *grpc.Server {
+	dashboardService := service.NewDashboardService(
+		userpb.NewUserServiceClient(opts.Users),
+		bookingpb.NewBookingServiceClient(opts.Bookings),
+		ridepb.NewRideServiceClient(opts.Rides),
+		opts.DashboardCacheTTL,
+		opts.Logger,
+	)
+
+	router := proxy.NewRouter(map[string]grpc.ClientConnInterface{
+		userpb.UserService_ServiceDesc.ServiceName:       opts.Users,
+		bookingpb.BookingService_ServiceDesc.ServiceName: opts.Bookings,
+		ridepb.RideService_ServiceDesc.ServiceName:       opts.Rides,
+		driverpb.DriverService_ServiceDesc.ServiceName:   opts.Drivers,
+	})
+	limiter := middleware.NewRateLimiter(opts.RateLimitRPS, opts.RateLimitBurst)
+
+	grpcServer := grpc.NewServer(
+		grpc.ForceServerCodec(proxy.Codec{}),
+		grpc.UnknownServiceHandler(router.Handler),
+		grpc.ChainUnaryInterceptor(
+			middleware.LoggingInterceptor(opts.Logger), // Logs all requests and responses
+			middleware.MetricsInterceptor(),            // Captures Prometheus metrics
+			middleware.AuthInterceptor(opts.APIKeys),   // Requires a valid API key
+			middleware.RateLimitInterceptor(limiter),   // Limits each API key's request rate
+			middleware.ValidationInterceptor(),         // Enforces buf.validate rules from the .proto files
+		),
+		// Proxied calls are handled as streams, so they pass through these.
+		grpc.ChainStreamInterceptor(
+			middleware.StreamLoggingInterceptor(opts.Logger),
+			middleware.StreamMetricsInterceptor(),
+			middleware.StreamAuthInterceptor(opts.APIKeys),
+			middleware.StreamRateLimitInterceptor(limiter),
+		),
+	)
+	pb.RegisterGatewayServiceServer(grpcServer, dashboardService)
+
+	// Enable reflection for testing
+	reflection.Register(grpcServer)
+
+	return grpcServer
+}
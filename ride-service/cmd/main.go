@@ -1,18 +1,18 @@
 package main
 
 import (
-	"github.com/golang_falcon_task/ride-service/internal/logging"
-	"github.com/golang_falcon_task/ride-service/internal/metrics"
-	"github.com/golang_falcon_task/ride-service/internal/middleware"
-	"github.com/golang_falcon_task/ride-service/internal/store"
-	"net"
-
+	"context"
 	"github.com/golang_falcon_task/ride-service/internal/config"
 	"github.com/golang_falcon_task/ride-service/internal/db"
-	"github.com/golang_falcon_task/ride-service/internal/service"
-	pb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	"github.com/golang_falcon_task/ride-service/internal/logging"
+	"github.com/golang_falcon_task/ride-service/internal/metrics"
+	"github.com/golang_falcon_task/ride-service/internal/outbox"
+	"github.com/golang_falcon_task/ride-service/server"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/credentials/insecure"
+	"net"
+	"net/http"
 )
 
 func main() {
@@ -30,35 +30,71 @@ func main() {
 	metrics.InitMetrics()
 	metrics.StartMetricsServer(":9007")
 
-	// Initialize database
-	database, err := db.InitDB(cfg)
+	// Initialize database, unless running on the in-memory store
+	var database *pgxpool.Pool
+	if cfg.StoreBackend == config.StoreMemory {
+		log.Println("Using in-memory store")
+	} else {
+		database, err = db.InitDB(cfg, log)
+		if err != nil {
+			log.Fatalf("failed to connect to database: %v", err)
+		}
+		defer database.Close()
+	}
+
+	// Pick the broker outbox events are relayed to
+	var broker outbox.Broker
+	if cfg.EventBroker == config.BrokerFile {
+		fileBroker, err := outbox.NewFileBroker(cfg.EventFile)
+		if err != nil {
+			log.Fatalf("failed to open event file: %v", err)
+		}
+		defer fileBroker.Close()
+		log.Printf("Publishing events to %s", cfg.EventFile)
+		broker = fileBroker
+	} else {
+		broker = outbox.NewInProcessBroker()
+	}
+
+	srv, err := server.New(server.Options{
+		Logger:             log,
+		DB:                 database,
+		Broker:             broker,
+		OutboxPollInterval: cfg.OutboxPollInterval,
+	})
 	if err != nil {
-		log.Fatalf("failed to connect to database: %v", err)
+		log.Fatalf("failed to create server: %v", err)
 	}
-	defer database.Close()
 
-	rideStore := store.NewPGRideStore(database)
-	rideService := service.NewRideService(rideStore, log)
+	// Relay outbox events in the background
+	go srv.Relay.Run(context.Background())
 
-	// Start gRPC server
+	// Listen for gRPC, Connect and gRPC-Web on one port
 	lis, err := net.Listen("tcp", ":50053")
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			middleware.LoggingInterceptor(log), // Logs all requests and responses
-			middleware.MetricsInterceptor(),    // Captures Prometheus metrics
-		),
-	)
-	pb.RegisterRideServiceServer(grpcServer, rideService)
+	// Start the REST/JSON gateway, which forwards to the gRPC server
+	conn, err := grpc.NewClient("localhost:50053", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to create gateway client: %v", err)
+	}
+	defer conn.Close()
 
-	// Enable reflection for testing
-	reflection.Register(grpcServer)
+	gateway, err := server.NewGateway(context.Background(), conn)
+	if err != nil {
+		log.Fatalf("failed to create gateway: %v", err)
+	}
+	go func() {
+		log.Println("RideService REST gateway is running on port 8053")
+		if err := http.ListenAndServe(":8053", gateway); err != nil {
+			log.Fatalf("failed to serve gateway: %v", err)
+		}
+	}()
 
-	log.Println("RideService is running on port 50053")
-	if err := grpcServer.Serve(lis); err != nil {
+	log.Println("RideService is running on port 50053 (gRPC, Connect and gRPC-Web)")
+	if err := http.Serve(lis, srv.Handler()); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
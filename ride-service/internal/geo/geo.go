@@ -0,0 +1,30 @@
+// Package geo computes distances between points on the Earth.
+package geo
+
+import (
+	"math"
+
+	"github.com/golang_falcon_task/ride-service/internal/model"
+)
+
+// EarthRadiusKm is the mean radius of the Earth.
+const EarthRadiusKm = 6371.0
+
+// Distance returns the great-circle distance between a and b in kilometers.
+func Distance(a, b model.LatLng) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat, dLng := lat2-lat1, radians(b.Lng-a.Lng)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusKm * math.Asin(math.Sqrt(math.Min(h, 1)))
+}
+
+// Kilometers rounds a distance to whole kilometers, at least 1 so every ride
+// has a positive distance.
+func Kilometers(km float64) int32 {
+	return max(1, int32(math.Round(km)))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
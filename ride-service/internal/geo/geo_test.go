@@ -0,0 +1,43 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/golang_falcon_task/ride-service/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	downtown   = model.LatLng{Lat: 31.5497, Lng: 74.2500}
+	airport    = model.LatLng{Lat: 31.5216, Lng: 74.4036}
+	islamabad  = model.LatLng{Lat: 33.6844, Lng: 73.0479}
+	eastOfDate = model.LatLng{Lat: 0, Lng: 179.99}
+	westOfDate = model.LatLng{Lat: 0, Lng: -179.99}
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     model.LatLng
+		expected float64
+	}{
+		{name: "Same Point", a: downtown, b: downtown, expected: 0},
+		{name: "Downtown To Airport", a: downtown, b: airport, expected: 14.9},
+		{name: "Downtown To Islamabad", a: downtown, b: islamabad, expected: 262.7},
+		{name: "Across Antimeridian", a: eastOfDate, b: westOfDate, expected: 2.2},
+		{name: "Pole To Pole", a: model.LatLng{Lat: 90}, b: model.LatLng{Lat: -90}, expected: 20015.1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.InDelta(t, tt.expected, Distance(tt.a, tt.b), 0.1)
+			require.InDelta(t, tt.expected, Distance(tt.b, tt.a), 0.1)
+		})
+	}
+}
+
+func TestKilometers(t *testing.T) {
+	require.Equal(t, int32(15), Kilometers(14.89))
+	require.Equal(t, int32(14), Kilometers(14.49))
+	require.Equal(t, int32(1), Kilometers(0.2))
+}
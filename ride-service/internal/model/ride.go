@@ -1,8 +1,20 @@
 package model
 
+import "github.com/golang_falcon_task/ride-service/internal/money"
+
 type Ride struct {
-	Source      string // Source location
-	Destination string // Destination location
-	Distance    int32  // Distance in kilometers
-	Cost        int32  // Cost in currency units
+	ID          int32       // Ride ID
+	Source      string      // Label of the pickup, may be empty
+	Destination string      // Label of the dropoff, may be empty
+	Distance    int32       // Great-circle distance from pickup to dropoff in kilometers
+	Cost        money.Money // What the ride costs
+	DriverID    int32       // Assigned driver, 0 if none
+	Pickup      *LatLng     // Where the rider is picked up, nil if unknown
+	Dropoff     *LatLng     // Where the rider is dropped off, nil if unknown
+}
+
+// LatLng is a point on the Earth in degrees (WGS84).
+type LatLng struct {
+	Lat float64
+	Lng float64
 }
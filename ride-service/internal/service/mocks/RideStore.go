@@ -14,6 +14,84 @@ type RideStore struct {
 	mock.Mock
 }
 
+// CreateRide provides a mock function with given fields: ctx, ride, requestID
+func (_m *RideStore) CreateRide(ctx context.Context, ride *model.Ride, requestID string) (*model.Ride, error) {
+	ret := _m.Called(ctx, ride, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRide")
+	}
+
+	var r0 *model.Ride
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Ride, string) (*model.Ride, error)); ok {
+		return rf(ctx, ride, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Ride, string) *model.Ride); ok {
+		r0 = rf(ctx, ride, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Ride)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Ride, string) error); ok {
+		r1 = rf(ctx, ride, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRide provides a mock function with given fields: ctx, rideID
+func (_m *RideStore) DeleteRide(ctx context.Context, rideID int32) error {
+	ret := _m.Called(ctx, rideID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRide")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, rideID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRide provides a mock function with given fields: ctx, rideID
+func (_m *RideStore) GetRide(ctx context.Context, rideID int32) (*model.Ride, error) {
+	ret := _m.Called(ctx, rideID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRide")
+	}
+
+	var r0 *model.Ride
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (*model.Ride, error)); ok {
+		return rf(ctx, rideID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) *model.Ride); ok {
+		r0 = rf(ctx, rideID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Ride)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, rideID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRide provides a mock function with given fields: ctx, rideID, ride
 func (_m *RideStore) UpdateRide(ctx context.Context, rideID int32, ride *model.Ride) error {
 	ret := _m.Called(ctx, rideID, ride)
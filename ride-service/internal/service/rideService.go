@@ -4,19 +4,32 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/golang_falcon_task/ride-service/internal/geo"
+	"github.com/golang_falcon_task/ride-service/internal/grpcerr"
 	"github.com/golang_falcon_task/ride-service/internal/model"
+	"github.com/golang_falcon_task/ride-service/internal/money"
 	"github.com/golang_falcon_task/ride-service/internal/store"
 	pb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"math"
 )
 
 // RideStore defines the interface for ride-related database operations.
 type RideStore interface {
+	CreateRide(ctx context.Context, ride *model.Ride, requestID string) (*model.Ride, error)
+	GetRide(ctx context.Context, rideID int32) (*model.Ride, error)
 	UpdateRide(ctx context.Context, rideID int32, ride *model.Ride) error
+	DeleteRide(ctx context.Context, rideID int32) error
 }
 
+// A distance sent by the client may differ from the great-circle distance
+// between pickup and dropoff by distanceToleranceKm, or by distanceTolerance
+// of it if that is more.
+const (
+	distanceToleranceKm = 1.0
+	distanceTolerance   = 0.1
+)
+
 type RideService struct {
 	rideStore RideStore
 	log       *logrus.Logger
@@ -28,35 +41,80 @@ func NewRideService(store RideStore, logger *logrus.Logger) *RideService {
 	return &RideService{rideStore: store, log: logger}
 }
 
+// CreateRide creates a ride. Calls with the same non-empty request_id return
+// the ride created by the first one.
+func (s *RideService) CreateRide(ctx context.Context, req *pb.CreateRideRequest) (*pb.CreateRideResponse, error) {
+	// Input validation
+	if req.Ride == nil {
+		s.log.Error("Ride details must be provided", "request_id", req.RequestId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride", "must be provided"))
+	}
+
+	ride, err := rideFromProto(req.Ride)
+	if err != nil {
+		s.log.Error("Invalid ride", "request_id", req.RequestId, "error", err.Error())
+		return nil, err
+	}
+
+	ride, err = s.rideStore.CreateRide(ctx, ride, req.RequestId)
+	if err != nil {
+		s.log.Error("Failed to create ride", "request_id", req.RequestId, "error", err.Error())
+		return nil, storeError(err, "failed to create ride")
+	}
+
+	s.log.Info("Ride successfully created", "ride_id", ride.ID, "request_id", req.RequestId)
+	return &pb.CreateRideResponse{Ride: toProto(ride)}, nil
+}
+
+// GetRide retrieves the details of a ride.
+func (s *RideService) GetRide(ctx context.Context, req *pb.GetRideRequest) (*pb.GetRideResponse, error) {
+	// Input validation
+	if req.RideId <= 0 {
+		s.log.Error("Invalid ride_id: must be a positive integer", "ride_id", req.RideId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride_id", "must be a positive integer"))
+	}
+
+	ride, err := s.rideStore.GetRide(ctx, req.RideId)
+	if err != nil {
+		if errors.Is(err, store.ErrRideNotFound) {
+			s.log.Error("Ride not found", "ride_id", req.RideId)
+		} else {
+			s.log.Error("Failed to get ride", "ride_id", req.RideId, "error", err.Error())
+		}
+		return nil, storeError(err, fmt.Sprintf("failed to get ride with id %d", req.RideId))
+	}
+
+	return &pb.GetRideResponse{Ride: toProto(ride)}, nil
+}
+
 // UpdateRide updates the details of an existing ride.
 func (s *RideService) UpdateRide(ctx context.Context, req *pb.UpdateRideRequest) (*pb.UpdateRideResponse, error) {
 	// Input validation
 	if req.RideId <= 0 {
 		s.log.Error("Invalid ride_id: must be a positive integer", "ride_id", req.RideId)
-		return nil, status.Errorf(codes.InvalidArgument, "invalid ride_id: must be a positive integer")
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride_id", "must be a positive integer"))
 	}
 	if req.Ride == nil {
 		s.log.Error("Ride details must be provided", "ride_id", req.RideId)
-		return nil, status.Errorf(codes.InvalidArgument, "ride details must be provided")
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride", "must be provided"))
 	}
 
 	// Convert gRPC ride details to model
-	ride := &model.Ride{
-		Source:      req.Ride.Source,
-		Destination: req.Ride.Destination,
-		Distance:    req.Ride.Distance,
-		Cost:        req.Ride.Cost,
+	ride, err := rideFromProto(req.Ride)
+	if err != nil {
+		s.log.Error("Invalid ride", "ride_id", req.RideId, "error", err.Error())
+		return nil, err
 	}
 
 	// Update the ride in the database
-	err := s.rideStore.UpdateRide(ctx, req.RideId, ride)
+	err = s.rideStore.UpdateRide(ctx, req.RideId, ride)
 	if err != nil {
 		if errors.Is(err, store.ErrRideNotFound) {
 			s.log.Error("Ride not found", "ride_id", req.RideId)
-			return nil, status.Errorf(codes.NotFound, "ride with id %d not found", req.RideId)
+		} else {
+			s.log.Error("Failed to update ride", "ride_id", req.RideId, "error", err.Error())
 		}
-		s.log.Error("Failed to update ride", "ride_id", req.RideId, "error", err.Error())
-		return nil, status.Errorf(codes.Internal, "failed to update ride: %v", err)
+		return nil, storeError(err, fmt.Sprintf("failed to update ride with id %d", req.RideId))
 	}
 
 	s.log.Info("Ride successfully updated", "ride_id", req.RideId)
@@ -65,3 +123,85 @@ func (s *RideService) UpdateRide(ctx context.Context, req *pb.UpdateRideRequest)
 		Message: fmt.Sprintf("ride with id %d successfully updated", req.RideId),
 	}, nil
 }
+
+// DeleteRide deletes a ride that is not booked.
+func (s *RideService) DeleteRide(ctx context.Context, req *pb.DeleteRideRequest) (*pb.DeleteRideResponse, error) {
+	// Input validation
+	if req.RideId <= 0 {
+		s.log.Error("Invalid ride_id: must be a positive integer", "ride_id", req.RideId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride_id", "must be a positive integer"))
+	}
+
+	err := s.rideStore.DeleteRide(ctx, req.RideId)
+	if err != nil {
+		if errors.Is(err, store.ErrRideNotFound) {
+			s.log.Error("Ride not found", "ride_id", req.RideId)
+		} else {
+			s.log.Error("Failed to delete ride", "ride_id", req.RideId, "error", err.Error())
+		}
+		return nil, storeError(err, fmt.Sprintf("failed to delete ride with id %d", req.RideId))
+	}
+
+	s.log.Info("Ride successfully deleted", "ride_id", req.RideId)
+	return &pb.DeleteRideResponse{
+		Message: fmt.Sprintf("ride with id %d successfully deleted", req.RideId),
+	}, nil
+}
+
+// rideFromProto converts the ride of a create or update request to the model,
+// computing its distance from pickup to dropoff. It returns an InvalidArgument
+// error if a point or the cost is missing, the cost is negative or not a whole
+// number of minor units of a known currency, or the client sent a distance
+// that disagrees with the computed one by more than distanceToleranceKm, or by
+// more than distanceTolerance of it for longer rides.
+func rideFromProto(ride *pb.Ride) (*model.Ride, error) {
+	switch {
+	case ride.Pickup == nil:
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride.pickup", "must be provided"))
+	case ride.Dropoff == nil:
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride.dropoff", "must be provided"))
+	case ride.Cost == nil:
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride.cost", "must be provided"))
+	}
+	cost, err := money.FromProto(ride.Cost)
+	if err != nil {
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride.cost", err.Error()))
+	}
+	if cost.IsNegative() {
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride.cost", "must not be negative"))
+	}
+	pickup, dropoff := store.LatLngFromProto(ride.Pickup), store.LatLngFromProto(ride.Dropoff)
+
+	km := geo.Distance(*pickup, *dropoff)
+	if ride.Distance != 0 {
+		tolerance := max(distanceToleranceKm, distanceTolerance*km)
+		if math.Abs(float64(ride.Distance)-km) > tolerance {
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("ride.distance",
+				fmt.Sprintf("must be within %.1f km of %.1f km, the great-circle distance from pickup to dropoff", tolerance, km)))
+		}
+	}
+
+	return &model.Ride{
+		Source:      ride.Source,
+		Destination: ride.Destination,
+		Distance:    geo.Kilometers(km),
+		Cost:        cost,
+		DriverID:    ride.DriverId,
+		Pickup:      pickup,
+		Dropoff:     dropoff,
+	}, nil
+}
+
+// toProto converts a stored ride to its API representation.
+func toProto(ride *model.Ride) *pb.Ride {
+	return &pb.Ride{
+		RideId:      ride.ID,
+		Source:      ride.Source,
+		Destination: ride.Destination,
+		Distance:    ride.Distance,
+		Cost:        ride.Cost.Proto(),
+		DriverId:    ride.DriverID,
+		Pickup:      store.LatLngToProto(ride.Pickup),
+		Dropoff:     store.LatLngToProto(ride.Dropoff),
+	}
+}
@@ -4,17 +4,46 @@ import (
 	"context"
 	"errors"
 	"github.com/golang_falcon_task/ride-service/internal/model"
+	"github.com/golang_falcon_task/ride-service/internal/money"
 	"github.com/golang_falcon_task/ride-service/internal/service/mocks"
 	"github.com/golang_falcon_task/ride-service/internal/store"
 	pb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"testing"
 )
 
+var (
+	downtown = &latlng.LatLng{Latitude: 31.5497, Longitude: 74.2500}
+	airport  = &latlng.LatLng{Latitude: 31.5216, Longitude: 74.4036} // About 14.9 km from downtown
+	rs500    = &moneypb.Money{CurrencyCode: "PKR", Units: 500}
+)
+
+// downtownToAirport is a ride from downtown to the airport with the given
+// client distance.
+func downtownToAirport(distance int32) *pb.Ride {
+	return &pb.Ride{Source: "Downtown", Destination: "Airport", Distance: distance, Cost: rs500, Pickup: downtown, Dropoff: airport}
+}
+
+// storedDowntownToAirport is downtownToAirport as passed to the store, with
+// the computed distance.
+func storedDowntownToAirport() *model.Ride {
+	return &model.Ride{
+		Source:      "Downtown",
+		Destination: "Airport",
+		Distance:    15,
+		Cost:        money.Money{Currency: "PKR", Minor: 50000},
+		Pickup:      store.LatLngFromProto(downtown),
+		Dropoff:     store.LatLngFromProto(airport),
+	}
+}
+
 func TestRideService_UpdateRide(t *testing.T) {
 	// Create a mock RideStore
 	mockStore := new(mocks.RideStore)
@@ -33,21 +62,11 @@ func TestRideService_UpdateRide(t *testing.T) {
 		expectedMsg  string
 	}{
 		{
-			name:   "Success",
-			rideID: 1,
-			rideDetails: &pb.Ride{
-				Source:      "Downtown",
-				Destination: "Airport",
-				Distance:    20,
-				Cost:        500,
-			},
+			name:        "Success",
+			rideID:      1,
+			rideDetails: downtownToAirport(15),
 			setupMock: func() {
-				mockStore.On("UpdateRide", mock.Anything, int32(1), &model.Ride{
-					Source:      "Downtown",
-					Destination: "Airport",
-					Distance:    20,
-					Cost:        500,
-				}).Return(nil)
+				mockStore.On("UpdateRide", mock.Anything, int32(1), storedDowntownToAirport()).Return(nil)
 			},
 			expectedCode: codes.OK,
 			expectedMsg:  "ride with id 1 successfully updated",
@@ -61,41 +80,71 @@ func TestRideService_UpdateRide(t *testing.T) {
 			expectedMsg:  "",
 		},
 		{
-			name:   "Ride Not Found",
-			rideID: 2,
-			rideDetails: &pb.Ride{
-				Source:      "Downtown",
-				Destination: "Airport",
-				Distance:    20,
-				Cost:        500,
+			name:         "Missing Dropoff",
+			rideID:       1,
+			rideDetails:  &pb.Ride{Source: "Downtown", Destination: "Airport", Cost: rs500, Pickup: downtown},
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+			expectedMsg:  "",
+		},
+		{
+			name:         "Distance Disagrees",
+			rideID:       1,
+			rideDetails:  downtownToAirport(20),
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+			expectedMsg:  "",
+		},
+		{
+			name:   "Unknown Currency",
+			rideID: 1,
+			rideDetails: &pb.Ride{Source: "Downtown", Destination: "Airport", Pickup: downtown, Dropoff: airport,
+				Cost: &moneypb.Money{CurrencyCode: "XYZ", Units: 500}},
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:   "Fraction Of A Paisa",
+			rideID: 1,
+			rideDetails: &pb.Ride{Source: "Downtown", Destination: "Airport", Pickup: downtown, Dropoff: airport,
+				Cost: &moneypb.Money{CurrencyCode: "PKR", Units: 500, Nanos: 5_000_000}},
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:        "Currency Mismatch",
+			rideID:      4,
+			rideDetails: downtownToAirport(15),
+			setupMock: func() {
+				mockStore.On("UpdateRide", mock.Anything, int32(4), storedDowntownToAirport()).Return(store.ErrCurrencyMismatch)
 			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:        "Ride Booked",
+			rideID:      5,
+			rideDetails: downtownToAirport(15),
 			setupMock: func() {
-				mockStore.On("UpdateRide", mock.Anything, int32(2), &model.Ride{
-					Source:      "Downtown",
-					Destination: "Airport",
-					Distance:    20,
-					Cost:        500,
-				}).Return(store.ErrRideNotFound)
+				mockStore.On("UpdateRide", mock.Anything, int32(5), storedDowntownToAirport()).Return(store.ErrRideBooked)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:        "Ride Not Found",
+			rideID:      2,
+			rideDetails: downtownToAirport(15),
+			setupMock: func() {
+				mockStore.On("UpdateRide", mock.Anything, int32(2), storedDowntownToAirport()).Return(store.ErrRideNotFound)
 			},
 			expectedCode: codes.NotFound,
 			expectedMsg:  "",
 		},
 		{
-			name:   "Internal Error",
-			rideID: 3,
-			rideDetails: &pb.Ride{
-				Source:      "Downtown",
-				Destination: "Airport",
-				Distance:    20,
-				Cost:        500,
-			},
+			name:        "Internal Error",
+			rideID:      3,
+			rideDetails: downtownToAirport(15),
 			setupMock: func() {
-				mockStore.On("UpdateRide", mock.Anything, int32(3), &model.Ride{
-					Source:      "Downtown",
-					Destination: "Airport",
-					Distance:    20,
-					Cost:        500,
-				}).Return(errors.New("database error"))
+				mockStore.On("UpdateRide", mock.Anything, int32(3), storedDowntownToAirport()).Return(errors.New("database error"))
 			},
 			expectedCode: codes.Internal,
 			expectedMsg:  "",
@@ -128,3 +177,249 @@ func TestRideService_UpdateRide(t *testing.T) {
 		})
 	}
 }
+
+func TestRideService_GetRide(t *testing.T) {
+	mockStore := new(mocks.RideStore)
+	logger := logrus.New()
+	service := NewRideService(mockStore, logger)
+
+	tests := []struct {
+		name         string
+		rideID       int32
+		setupMock    func()
+		expectedCode codes.Code
+		expectedRide *pb.Ride
+	}{
+		{
+			name:   "Success",
+			rideID: 1,
+			setupMock: func() {
+				mockStore.On("GetRide", mock.Anything, int32(1)).Return(&model.Ride{
+					ID:          1,
+					Source:      "Downtown",
+					Destination: "Airport",
+					Distance:    20,
+					Cost:        money.Money{Currency: "PKR", Minor: 50000},
+				}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedRide: &pb.Ride{RideId: 1, Source: "Downtown", Destination: "Airport", Distance: 20, Cost: rs500},
+		},
+		{
+			name:         "Invalid RideID",
+			rideID:       0,
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:   "Ride Not Found",
+			rideID: 2,
+			setupMock: func() {
+				mockStore.On("GetRide", mock.Anything, int32(2)).Return(nil, store.ErrRideNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:   "Internal Error",
+			rideID: 3,
+			setupMock: func() {
+				mockStore.On("GetRide", mock.Anything, int32(3)).Return(nil, errors.New("database error"))
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			resp, err := service.GetRide(context.Background(), &pb.GetRideRequest{RideId: tt.rideID})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				require.True(t, proto.Equal(tt.expectedRide, resp.Ride), "expected %v, got %v", tt.expectedRide, resp.Ride)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRideService_CreateRide(t *testing.T) {
+	mockStore := new(mocks.RideStore)
+	logger := logrus.New()
+	service := NewRideService(mockStore, logger)
+
+	created := func() *model.Ride {
+		ride := storedDowntownToAirport()
+		ride.ID = 7
+		return ride
+	}
+	expectedRide := downtownToAirport(15)
+	expectedRide.RideId = 7
+
+	tests := []struct {
+		name         string
+		requestID    string
+		ride         *pb.Ride
+		setupMock    func()
+		expectedCode codes.Code
+		expectedRide *pb.Ride
+	}{
+		{
+			name:      "Success",
+			requestID: "request-1",
+			ride:      downtownToAirport(0),
+			setupMock: func() {
+				mockStore.On("CreateRide", mock.Anything, storedDowntownToAirport(), "request-1").Return(created(), nil)
+			},
+			expectedCode: codes.OK,
+			expectedRide: expectedRide,
+		},
+		{
+			name:      "Client Distance Within Tolerance",
+			requestID: "request-2",
+			ride:      downtownToAirport(16),
+			setupMock: func() {
+				mockStore.On("CreateRide", mock.Anything, storedDowntownToAirport(), "request-2").Return(created(), nil)
+			},
+			expectedCode: codes.OK,
+			expectedRide: expectedRide,
+		},
+		{
+			name:         "Client Distance Too Long",
+			requestID:    "request-3",
+			ride:         downtownToAirport(17),
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Client Distance Too Short",
+			requestID:    "request-3",
+			ride:         downtownToAirport(13),
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Missing Ride",
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Missing Pickup",
+			ride:         &pb.Ride{Cost: rs500, Dropoff: airport},
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Missing Cost",
+			ride:         &pb.Ride{Pickup: downtown, Dropoff: airport},
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Negative Cost",
+			ride: &pb.Ride{Pickup: downtown, Dropoff: airport,
+				Cost: &moneypb.Money{CurrencyCode: "PKR", Units: -1}},
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:      "Internal Error",
+			requestID: "request-4",
+			ride:      downtownToAirport(15),
+			setupMock: func() {
+				mockStore.On("CreateRide", mock.Anything, storedDowntownToAirport(), "request-4").Return(nil, errors.New("database error"))
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			resp, err := service.CreateRide(context.Background(), &pb.CreateRideRequest{RequestId: tt.requestID, Ride: tt.ride})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+				require.True(t, proto.Equal(tt.expectedRide, resp.Ride), "expected %v, got %v", tt.expectedRide, resp.Ride)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRideService_DeleteRide(t *testing.T) {
+	mockStore := new(mocks.RideStore)
+	logger := logrus.New()
+	service := NewRideService(mockStore, logger)
+
+	tests := []struct {
+		name         string
+		rideID       int32
+		setupMock    func()
+		expectedCode codes.Code
+	}{
+		{
+			name:   "Success",
+			rideID: 1,
+			setupMock: func() {
+				mockStore.On("DeleteRide", mock.Anything, int32(1)).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Invalid RideID",
+			rideID:       0,
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:   "Ride Not Found",
+			rideID: 2,
+			setupMock: func() {
+				mockStore.On("DeleteRide", mock.Anything, int32(2)).Return(store.ErrRideNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:   "Ride Still Booked",
+			rideID: 3,
+			setupMock: func() {
+				mockStore.On("DeleteRide", mock.Anything, int32(3)).Return(store.ErrForeignKeyViolation)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			_, err := service.DeleteRide(context.Background(), &pb.DeleteRideRequest{RideId: tt.rideID})
+
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				grpcErr, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedCode, grpcErr.Code())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
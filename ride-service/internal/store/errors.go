@@ -3,6 +3,28 @@ package store
 import "errors"
 
 var (
-	ErrRideNotFound      = errors.New("ride not found")
+	ErrRideNotFound = errors.New("ride not found")
+
+	// ErrCurrencyMismatch is returned when an update would change the
+	// currency a ride is priced in.
+	ErrCurrencyMismatch = errors.New("ride is priced in another currency")
+
+	// ErrRideBooked is returned when an update would change the cost of a
+	// ride that a booking refers to, or give it a driver other than the
+	// one the booking was confirmed with.
+	ErrRideBooked = errors.New("ride is booked")
+
+	// ErrAlreadyExists is returned when a write violates a unique constraint.
+	ErrAlreadyExists = errors.New("record already exists")
+	// ErrForeignKeyViolation is returned when a write references a row that does not exist,
+	// or a delete would orphan rows that still reference it.
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+	// ErrSerializationFailure is returned when a transaction conflicts with a concurrent one and can be retried.
+	ErrSerializationFailure = errors.New("serialization failure")
+	// ErrTimeout is returned when a query exceeds its deadline or the statement timeout.
+	ErrTimeout = errors.New("database operation timed out")
+	// ErrCanceled is returned when the caller cancels the query.
+	ErrCanceled = errors.New("database operation canceled")
+
 	ErrDatabaseOperation = errors.New("database operation failed")
 )
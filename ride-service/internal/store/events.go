@@ -0,0 +1,40 @@
+package store
+
+import (
+	"github.com/golang_falcon_task/ride-service/internal/model"
+	"github.com/golang_falcon_task/ride-service/internal/outbox"
+	pb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	"google.golang.org/genproto/googleapis/type/latlng"
+)
+
+// rideUpdated encodes the RideUpdated event for a ride as stored after an update.
+func rideUpdated(ride model.Ride) (outbox.Message, error) {
+	return outbox.NewMessage(&pb.RideUpdated{
+		Ride: &pb.Ride{
+			RideId:      ride.ID,
+			Source:      ride.Source,
+			Destination: ride.Destination,
+			Distance:    ride.Distance,
+			Cost:        ride.Cost.Proto(),
+			DriverId:    ride.DriverID,
+			Pickup:      LatLngToProto(ride.Pickup),
+			Dropoff:     LatLngToProto(ride.Dropoff),
+		},
+	})
+}
+
+// LatLngToProto converts a point to its API representation, nil if unknown.
+func LatLngToProto(p *model.LatLng) *latlng.LatLng {
+	if p == nil {
+		return nil
+	}
+	return &latlng.LatLng{Latitude: p.Lat, Longitude: p.Lng}
+}
+
+// LatLngFromProto converts an API point to the model, nil if unset.
+func LatLngFromProto(p *latlng.LatLng) *model.LatLng {
+	if p == nil {
+		return nil
+	}
+	return &model.LatLng{Lat: p.Latitude, Lng: p.Longitude}
+}
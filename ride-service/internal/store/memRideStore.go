@@ -0,0 +1,161 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/golang_falcon_task/ride-service/internal/model"
+	"github.com/golang_falcon_task/ride-service/internal/money"
+	"github.com/golang_falcon_task/ride-service/internal/outbox"
+)
+
+// MemRideStore is a thread-safe in-memory RideStore with the same semantics
+// as PGRideStore: auto-increment ids, ErrRideNotFound for unknown rides and
+// events recorded in an outbox atomically with the writes. It is meant for
+// local runs and tests that should not need Postgres.
+type MemRideStore struct {
+	mu       sync.RWMutex
+	outbox   *outbox.MemStore
+	rides    map[int32]model.Ride
+	requests map[string]int32 // request ID -> ride ID
+	lastID   int32
+}
+
+// NewMemRideStore creates an empty MemRideStore.
+func NewMemRideStore() *MemRideStore {
+	return &MemRideStore{
+		rides:    make(map[int32]model.Ride),
+		requests: make(map[string]int32),
+		outbox:   outbox.NewMemStore(),
+	}
+}
+
+// Outbox returns the outbox the store records events in.
+func (s *MemRideStore) Outbox() *outbox.MemStore {
+	return s.outbox
+}
+
+// CreateRide stores a new ride. A non-empty requestID makes the call
+// idempotent: if a ride was already created with it, that ride is returned.
+func (s *MemRideStore) CreateRide(ctx context.Context, ride *model.Ride, requestID string) (*model.Ride, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id, ok := s.requests[requestID]; ok {
+		existing := s.rides[id]
+		return &existing, nil
+	}
+
+	s.lastID++
+	stored := cloneRide(ride)
+	stored.ID = s.lastID
+	s.rides[s.lastID] = stored
+	if requestID != "" {
+		s.requests[requestID] = s.lastID
+	}
+	return &stored, nil
+}
+
+// GetRide retrieves a ride by ID.
+func (s *MemRideStore) GetRide(ctx context.Context, rideID int32) (*model.Ride, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrRideNotFound)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ride, ok := s.rides[rideID]
+	if !ok {
+		return nil, ErrRideNotFound
+	}
+	return &ride, nil
+}
+
+// UpdateRide updates the details of an existing ride and records a RideUpdated
+// event. It fails with ErrCurrencyMismatch if the ride's cost is in another
+// currency. Bookings are not stored with the rides, so unlike PGRideStore it
+// never fails with ErrRideBooked.
+func (s *MemRideStore) UpdateRide(ctx context.Context, rideID int32, ride *model.Ride) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrRideNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.rides[rideID]
+	if !ok {
+		return ErrRideNotFound
+	}
+	if existing.Cost.Currency != ride.Cost.Currency {
+		return fmt.Errorf("%w: %s, not %s", ErrCurrencyMismatch, existing.Cost.Currency, ride.Cost.Currency)
+	}
+	stored := cloneRide(ride)
+	stored.ID = rideID
+	msg, err := rideUpdated(stored)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+	s.outbox.Add(msg)
+	s.rides[rideID] = stored
+	return nil
+}
+
+// DeleteRide deletes a ride by ID.
+func (s *MemRideStore) DeleteRide(ctx context.Context, rideID int32) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrRideNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.rides[rideID]; !ok {
+		return ErrRideNotFound
+	}
+	delete(s.rides, rideID)
+	for requestID, id := range s.requests {
+		if id == rideID {
+			delete(s.requests, requestID)
+		}
+	}
+	return nil
+}
+
+// cloneRide copies a ride so the store does not share its points with the caller.
+func cloneRide(ride *model.Ride) model.Ride {
+	c := *ride
+	if ride.Pickup != nil {
+		pickup := *ride.Pickup
+		c.Pickup = &pickup
+	}
+	if ride.Dropoff != nil {
+		dropoff := *ride.Dropoff
+		c.Dropoff = &dropoff
+	}
+	return c
+}
+
+// SeedDemoData loads the same rides as docker/init.sql.
+func (s *MemRideStore) SeedDemoData(ctx context.Context) error {
+	rides := []model.Ride{
+		{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: money.Money{Currency: "PKR", Minor: 15000},
+			Pickup: &model.LatLng{Lat: 31.5497, Lng: 74.2500}, Dropoff: &model.LatLng{Lat: 31.5216, Lng: 74.4036}},
+		{Source: "City Center", Destination: "Mall", Distance: 8, Cost: money.Money{Currency: "PKR", Minor: 8000},
+			Pickup: &model.LatLng{Lat: 31.5102, Lng: 74.3441}, Dropoff: &model.LatLng{Lat: 31.4660, Lng: 74.2770}},
+		{Source: "Train Station", Destination: "University", Distance: 12, Cost: money.Money{Currency: "PKR", Minor: 12000},
+			Pickup: &model.LatLng{Lat: 31.5770, Lng: 74.3361}, Dropoff: &model.LatLng{Lat: 31.4740, Lng: 74.3000}},
+	}
+	for i := range rides {
+		if _, err := s.CreateRide(ctx, &rides[i], ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/golang_falcon_task/ride-service/internal/store"
+	"github.com/golang_falcon_task/ride-service/internal/store/storetest"
+)
+
+func TestMemRideStore_Conformance(t *testing.T) {
+	storetest.RunRideStoreTests(t, func(t *testing.T) storetest.Harness {
+		s := store.NewMemRideStore()
+		return storetest.Harness{Store: s, Outbox: s.Outbox()}
+	})
+}
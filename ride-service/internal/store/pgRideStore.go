@@ -2,9 +2,12 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/golang_falcon_task/ride-service/internal/model"
+	"github.com/golang_falcon_task/ride-service/internal/outbox"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -17,15 +20,147 @@ func NewPGRideStore(db *pgxpool.Pool) *PGRideStore {
 	return &PGRideStore{db: db}
 }
 
-// UpdateRide updates the details of an existing ride.
+// rideColumns are the columns scanRide reads, in order.
+const rideColumns = `ride_id, source, destination, distance, cost, currency, COALESCE(driver_id, 0),
+    pickup_lat, pickup_lng, dropoff_lat, dropoff_lng`
+
+// scanRide reads a row selected with rideColumns.
+func scanRide(row pgx.Row) (*model.Ride, error) {
+	var ride model.Ride
+	var pickupLat, pickupLng, dropoffLat, dropoffLng *float64
+	if err := row.Scan(&ride.ID, &ride.Source, &ride.Destination, &ride.Distance, &ride.Cost.Minor, &ride.Cost.Currency, &ride.DriverID,
+		&pickupLat, &pickupLng, &dropoffLat, &dropoffLng); err != nil {
+		return nil, err
+	}
+	ride.Pickup = latLngOf(pickupLat, pickupLng)
+	ride.Dropoff = latLngOf(dropoffLat, dropoffLng)
+	return &ride, nil
+}
+
+// latLngOf returns the point in a pair of nullable columns, nil if unset.
+func latLngOf(lat, lng *float64) *model.LatLng {
+	if lat == nil || lng == nil {
+		return nil
+	}
+	return &model.LatLng{Lat: *lat, Lng: *lng}
+}
+
+// latLngArgs returns the latitude and longitude parameters for a point.
+func latLngArgs(p *model.LatLng) (lat, lng *float64) {
+	if p == nil {
+		return nil, nil
+	}
+	return &p.Lat, &p.Lng
+}
+
+// CreateRide inserts a new ride. A non-empty requestID makes the call
+// idempotent: if a ride was already created with it, that ride is returned.
+func (s *PGRideStore) CreateRide(ctx context.Context, ride *model.Ride, requestID string) (*model.Ride, error) {
+	pickupLat, pickupLng := latLngArgs(ride.Pickup)
+	dropoffLat, dropoffLng := latLngArgs(ride.Dropoff)
+	created, err := scanRide(s.db.QueryRow(ctx, `
+        INSERT INTO rides (source, destination, distance, cost, currency, driver_id, request_id,
+                           pickup_lat, pickup_lng, dropoff_lat, dropoff_lng)
+        VALUES ($1, $2, $3, $4, $5, NULLIF($6, 0), NULLIF($7, ''), $8, $9, $10, $11)
+        ON CONFLICT (request_id) DO NOTHING
+        RETURNING `+rideColumns,
+		ride.Source, ride.Destination, ride.Distance, ride.Cost.Minor, ride.Cost.Currency, ride.DriverID, requestID,
+		pickupLat, pickupLng, dropoffLat, dropoffLng))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Another call with the same request ID got there first.
+		created, err = scanRide(s.db.QueryRow(ctx, `SELECT `+rideColumns+` FROM rides WHERE request_id = $1`, requestID))
+	}
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	return created, nil
+}
+
+// GetRide retrieves a ride by ID.
+func (s *PGRideStore) GetRide(ctx context.Context, rideID int32) (*model.Ride, error) {
+	ride, err := scanRide(s.db.QueryRow(ctx, `SELECT `+rideColumns+` FROM rides WHERE ride_id = $1`, rideID))
+	if err != nil {
+		return nil, translateError(err, ErrRideNotFound)
+	}
+	return ride, nil
+}
+
+// UpdateRide updates the details of an existing ride and records a
+// RideUpdated event in the outbox in the same transaction. It fails with
+// ErrCurrencyMismatch if the ride's cost is in another currency, and with
+// ErrRideBooked if it would change the cost of a booked ride or give it a
+// driver other than its booking's.
 func (s *PGRideStore) UpdateRide(ctx context.Context, rideID int32, ride *model.Ride) error {
-	result, err := s.db.Exec(ctx, `
-        UPDATE rides
-        SET source = $1, destination = $2, distance = $3, cost = $4
-        WHERE ride_id = $5
-    `, ride.Source, ride.Destination, ride.Distance, ride.Cost, rideID)
+	pickupLat, pickupLng := latLngArgs(ride.Pickup)
+	dropoffLat, dropoffLng := latLngArgs(ride.Dropoff)
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		var (
+			cost     int64
+			currency string
+			driverID int32
+		)
+		err := tx.QueryRow(ctx, `SELECT cost, currency, COALESCE(driver_id, 0) FROM rides WHERE ride_id = $1 FOR UPDATE`,
+			rideID).Scan(&cost, &currency, &driverID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRideNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if currency != ride.Cost.Currency {
+			return fmt.Errorf("%w: %s, not %s", ErrCurrencyMismatch, currency, ride.Cost.Currency)
+		}
+		if cost != ride.Cost.Minor || driverID != ride.DriverID {
+			// Checked after locking the ride, which a booking referring to it
+			// must also lock, so one made meanwhile has committed and is seen.
+			var booked, otherDriver bool
+			err := tx.QueryRow(ctx, `
+                SELECT COUNT(*) > 0, COALESCE(BOOL_OR(COALESCE(driver_id, 0) <> $2), false)
+                FROM bookings
+                WHERE ride_id = $1
+            `, rideID, ride.DriverID).Scan(&booked, &otherDriver)
+			if err != nil {
+				return err
+			}
+			if booked && cost != ride.Cost.Minor {
+				return fmt.Errorf("%w: ride %d cost cannot change", ErrRideBooked, rideID)
+			}
+			if otherDriver && driverID != ride.DriverID {
+				return fmt.Errorf("%w: ride %d driver must be its booking's", ErrRideBooked, rideID)
+			}
+		}
+
+		_, err = tx.Exec(ctx, `
+            UPDATE rides
+            SET source = $1, destination = $2, distance = $3, cost = $4, driver_id = NULLIF($5, 0),
+                pickup_lat = $6, pickup_lng = $7, dropoff_lat = $8, dropoff_lng = $9
+            WHERE ride_id = $10
+        `, ride.Source, ride.Destination, ride.Distance, ride.Cost.Minor, ride.DriverID,
+			pickupLat, pickupLng, dropoffLat, dropoffLng, rideID)
+		if err != nil {
+			return err
+		}
+
+		updated := *ride
+		updated.ID = rideID
+		msg, err := rideUpdated(updated)
+		if err != nil {
+			return err
+		}
+		return outbox.Write(ctx, tx, msg)
+	})
+	switch {
+	case errors.Is(err, ErrRideNotFound), errors.Is(err, ErrCurrencyMismatch), errors.Is(err, ErrRideBooked):
+		return err
+	}
+	return translateError(err, ErrRideNotFound)
+}
+
+// DeleteRide deletes a ride by ID. Rides that are still booked cannot be deleted.
+func (s *PGRideStore) DeleteRide(ctx context.Context, rideID int32) error {
+	result, err := s.db.Exec(ctx, `DELETE FROM rides WHERE ride_id = $1`, rideID)
 	if err != nil {
-		return fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+		return translateError(err, ErrRideNotFound)
 	}
 
 	if result.RowsAffected() == 0 {
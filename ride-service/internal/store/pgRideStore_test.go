@@ -0,0 +1,27 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang_falcon_task/ride-service/internal/outbox"
+	"github.com/golang_falcon_task/ride-service/internal/store"
+	"github.com/golang_falcon_task/ride-service/internal/store/storetest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPGRideStore_Conformance(t *testing.T) {
+	storetest.RunRideStoreTests(t, func(t *testing.T) storetest.Harness {
+		pool := storetest.NewPGPool(t)
+		return storetest.Harness{
+			Store:  store.NewPGRideStore(pool),
+			Outbox: outbox.NewPGStore(pool),
+			Book: func(t *testing.T, rideID, driverID int32) {
+				// User 1 and the drivers are seeded by init.sql.
+				_, err := pool.Exec(context.Background(), `INSERT INTO bookings (user_id, ride_id, driver_id) VALUES (1, $1, NULLIF($2, 0))`,
+					rideID, driverID)
+				require.NoError(t, err)
+			},
+		}
+	})
+}
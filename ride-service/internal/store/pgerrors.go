@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Postgres SQLSTATE codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgUniqueViolation      = "23505"
+	pgForeignKeyViolation  = "23503"
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+	pgQueryCanceled        = "57014"
+)
+
+// translateError maps a pgx error to one of the typed store errors. notFound is
+// returned when the query matched no rows. The original error is kept in the
+// message for logging; callers should match with errors.Is.
+func translateError(err error, notFound error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return notFound
+	}
+	if errors.Is(err, context.Canceled) {
+		return fmt.Errorf("%w: %v", ErrCanceled, err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) || pgconn.Timeout(err) {
+		return fmt.Errorf("%w: %v", ErrTimeout, err)
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgUniqueViolation:
+			return fmt.Errorf("%w: %s", ErrAlreadyExists, pgErr.Detail)
+		case pgForeignKeyViolation:
+			return fmt.Errorf("%w: %s", ErrForeignKeyViolation, pgErr.Detail)
+		case pgSerializationFailure, pgDeadlockDetected:
+			return fmt.Errorf("%w: %v", ErrSerializationFailure, err)
+		case pgQueryCanceled:
+			// Raised both for statement_timeout and for pg_cancel_backend.
+			return fmt.Errorf("%w: %v", ErrTimeout, err)
+		}
+	}
+
+	return fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+}
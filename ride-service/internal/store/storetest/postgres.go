@@ -0,0 +1,66 @@
+package storetest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NewPGPool connects to the database in TEST_DATABASE_URL and returns a pool
+// scoped to a fresh schema loaded from docker/init.sql. The schema is dropped
+// when the test finishes. The test is skipped if TEST_DATABASE_URL is unset.
+func NewPGPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping Postgres tests")
+	}
+
+	ctx := context.Background()
+	schema := fmt.Sprintf("storetest_%d", time.Now().UnixNano())
+
+	admin, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	t.Cleanup(admin.Close)
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		admin.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+	})
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("invalid TEST_DATABASE_URL: %v", err)
+	}
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	initSQL, err := os.ReadFile(initSQLPath())
+	if err != nil {
+		t.Fatalf("failed to read init.sql: %v", err)
+	}
+	if _, err := pool.Exec(ctx, string(initSQL)); err != nil {
+		t.Fatalf("failed to load init.sql: %v", err)
+	}
+	return pool
+}
+
+// initSQLPath locates docker/init.sql relative to this source file.
+func initSQLPath() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..", "..", "docker", "init.sql")
+}
@@ -0,0 +1,301 @@
+// Package storetest contains the conformance suite every RideStore
+// implementation must pass, so the Postgres and in-memory stores stay
+// interchangeable.
+package storetest
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/golang_falcon_task/ride-service/internal/model"
+	"github.com/golang_falcon_task/ride-service/internal/money"
+	"github.com/golang_falcon_task/ride-service/internal/outbox"
+	"github.com/golang_falcon_task/ride-service/internal/service"
+	"github.com/golang_falcon_task/ride-service/internal/store"
+	pb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/protobuf/proto"
+)
+
+// Harness gives the suite a fresh store and a view of its outbox.
+type Harness struct {
+	Store service.RideStore
+
+	// Outbox reads the events the store records.
+	Outbox outbox.Store
+
+	// Book makes a booking of a ride confirmed with driverID, or pending if
+	// it is 0. It is nil if the store cannot see bookings.
+	Book func(t *testing.T, rideID, driverID int32)
+}
+
+// RunRideStoreTests runs the conformance suite. newHarness is called once
+// per subtest and must return an isolated store.
+func RunRideStoreTests(t *testing.T, newHarness func(t *testing.T) Harness) {
+	ctx := context.Background()
+
+	t.Run("CreateRide Assigns Increasing IDs", func(t *testing.T) {
+		h := newHarness(t)
+
+		first, err := createRide(ctx, h.Store, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)})
+		require.NoError(t, err)
+		second, err := createRide(ctx, h.Store, &model.Ride{Source: "City Center", Destination: "Mall", Distance: 8, Cost: pkr(8000)})
+		require.NoError(t, err)
+
+		require.Positive(t, first)
+		require.Greater(t, second, first)
+	})
+
+	t.Run("CreateRide With Request ID", func(t *testing.T) {
+		h := newHarness(t)
+
+		ride := &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)}
+		first, err := h.Store.CreateRide(ctx, ride, "request-1")
+		require.NoError(t, err)
+		ride.ID = first.ID
+		require.Equal(t, ride, first)
+
+		// A retry returns the same ride.
+		retry, err := h.Store.CreateRide(ctx, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)}, "request-1")
+		require.NoError(t, err)
+		require.Equal(t, first, retry)
+
+		other, err := h.Store.CreateRide(ctx, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)}, "request-2")
+		require.NoError(t, err)
+		require.NotEqual(t, first.ID, other.ID)
+	})
+
+	t.Run("DeleteRide", func(t *testing.T) {
+		h := newHarness(t)
+
+		rideID, err := createRide(ctx, h.Store, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)})
+		require.NoError(t, err)
+
+		require.NoError(t, h.Store.DeleteRide(ctx, rideID))
+
+		_, err = h.Store.GetRide(ctx, rideID)
+		require.ErrorIs(t, err, store.ErrRideNotFound)
+		require.ErrorIs(t, h.Store.DeleteRide(ctx, rideID), store.ErrRideNotFound)
+	})
+
+	t.Run("UpdateRide", func(t *testing.T) {
+		h := newHarness(t)
+
+		rideID, err := createRide(ctx, h.Store, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)})
+		require.NoError(t, err)
+
+		updated := &model.Ride{Source: "Downtown", Destination: "Mall", Distance: 10, Cost: pkr(20000)}
+		require.NoError(t, h.Store.UpdateRide(ctx, rideID, updated))
+
+		ride, err := h.Store.GetRide(ctx, rideID)
+		require.NoError(t, err)
+		updated.ID = rideID
+		require.Equal(t, updated, ride)
+	})
+
+	t.Run("UpdateRide Assigns Driver", func(t *testing.T) {
+		h := newHarness(t)
+
+		rideID, err := createRide(ctx, h.Store, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)})
+		require.NoError(t, err)
+		ride, err := h.Store.GetRide(ctx, rideID)
+		require.NoError(t, err)
+		require.Zero(t, ride.DriverID)
+
+		// Driver 1 is part of the seed data.
+		require.NoError(t, h.Store.UpdateRide(ctx, rideID, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000), DriverID: 1}))
+		ride, err = h.Store.GetRide(ctx, rideID)
+		require.NoError(t, err)
+		require.Equal(t, int32(1), ride.DriverID)
+
+		require.NoError(t, h.Store.UpdateRide(ctx, rideID, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)}))
+		ride, err = h.Store.GetRide(ctx, rideID)
+		require.NoError(t, err)
+		require.Zero(t, ride.DriverID)
+	})
+
+	t.Run("Pickup And Dropoff", func(t *testing.T) {
+		h := newHarness(t)
+
+		pickup := &model.LatLng{Lat: 31.5102, Lng: 74.3441}
+		dropoff := &model.LatLng{Lat: 31.5216, Lng: 74.4036}
+		created, err := h.Store.CreateRide(ctx, &model.Ride{Distance: 6, Cost: pkr(15000), Pickup: pickup, Dropoff: dropoff}, "")
+		require.NoError(t, err)
+		require.Equal(t, pickup, created.Pickup)
+		require.Equal(t, dropoff, created.Dropoff)
+
+		ride, err := h.Store.GetRide(ctx, created.ID)
+		require.NoError(t, err)
+		require.Equal(t, pickup, ride.Pickup)
+		require.Equal(t, dropoff, ride.Dropoff)
+		require.Empty(t, ride.Source)
+		require.Empty(t, ride.Destination)
+
+		require.NoError(t, h.Store.UpdateRide(ctx, created.ID, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)}))
+		ride, err = h.Store.GetRide(ctx, created.ID)
+		require.NoError(t, err)
+		require.Nil(t, ride.Pickup)
+		require.Nil(t, ride.Dropoff)
+	})
+
+	t.Run("UpdateRide Records RideUpdated", func(t *testing.T) {
+		h := newHarness(t)
+
+		rideID, err := createRide(ctx, h.Store, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)})
+		require.NoError(t, err)
+		require.NoError(t, h.Store.UpdateRide(ctx, rideID, &model.Ride{Source: "Downtown", Destination: "Mall", Distance: 10, Cost: pkr(20000),
+			Pickup: &model.LatLng{Lat: 31.5102, Lng: 74.3441}, Dropoff: &model.LatLng{Lat: 31.4660, Lng: 74.2770}}))
+
+		msgs, err := h.Outbox.Pending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.NotEmpty(t, msgs[0].ID)
+		require.Equal(t, "ride.v1.RideUpdated", msgs[0].Topic)
+
+		var event pb.RideUpdated
+		require.NoError(t, proto.Unmarshal(msgs[0].Payload, &event))
+		expected := &pb.RideUpdated{Ride: &pb.Ride{
+			RideId: rideID, Source: "Downtown", Destination: "Mall", Distance: 10, Cost: pkr(20000).Proto(),
+			Pickup:  &latlng.LatLng{Latitude: 31.5102, Longitude: 74.3441},
+			Dropoff: &latlng.LatLng{Latitude: 31.4660, Longitude: 74.2770},
+		}}
+		require.True(t, proto.Equal(expected, &event), "expected %v, got %v", expected, &event)
+
+		require.NoError(t, h.Outbox.MarkPublished(ctx, []string{msgs[0].ID}))
+		msgs, err = h.Outbox.Pending(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+	})
+
+	t.Run("GetRide", func(t *testing.T) {
+		h := newHarness(t)
+
+		rideID, err := createRide(ctx, h.Store, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)})
+		require.NoError(t, err)
+
+		ride, err := h.Store.GetRide(ctx, rideID)
+		require.NoError(t, err)
+		require.Equal(t, &model.Ride{ID: rideID, Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)}, ride)
+	})
+
+	t.Run("GetRide Not Found", func(t *testing.T) {
+		h := newHarness(t)
+
+		_, err := h.Store.GetRide(ctx, 1_000_000)
+		require.ErrorIs(t, err, store.ErrRideNotFound)
+	})
+
+	t.Run("UpdateRide Not Found", func(t *testing.T) {
+		h := newHarness(t)
+
+		err := h.Store.UpdateRide(ctx, 1_000_000, &model.Ride{Source: "Downtown", Destination: "Mall", Distance: 10, Cost: pkr(20000)})
+		require.ErrorIs(t, err, store.ErrRideNotFound)
+
+		msgs, err := h.Outbox.Pending(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+	})
+
+	t.Run("UpdateRide Currency Mismatch", func(t *testing.T) {
+		h := newHarness(t)
+
+		rideID, err := createRide(ctx, h.Store, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)})
+		require.NoError(t, err)
+
+		err = h.Store.UpdateRide(ctx, rideID, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15,
+			Cost: money.Money{Currency: "USD", Minor: 150}})
+		require.ErrorIs(t, err, store.ErrCurrencyMismatch)
+
+		ride, err := h.Store.GetRide(ctx, rideID)
+		require.NoError(t, err)
+		require.Equal(t, pkr(15000), ride.Cost)
+		msgs, err := h.Outbox.Pending(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+	})
+
+	t.Run("UpdateRide Booked", func(t *testing.T) {
+		h := newHarness(t)
+		if h.Book == nil {
+			t.Skip("store cannot see bookings")
+		}
+
+		rideID, err := createRide(ctx, h.Store, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)})
+		require.NoError(t, err)
+		h.Book(t, rideID, 1)
+
+		// The booking was priced with the ride's cost, so it cannot change,
+		// and its driver is the one the booking was confirmed with.
+		err = h.Store.UpdateRide(ctx, rideID, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(20000)})
+		require.ErrorIs(t, err, store.ErrRideBooked)
+		err = h.Store.UpdateRide(ctx, rideID, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000), DriverID: 2})
+		require.ErrorIs(t, err, store.ErrRideBooked)
+
+		ride, err := h.Store.GetRide(ctx, rideID)
+		require.NoError(t, err)
+		require.Equal(t, pkr(15000), ride.Cost)
+		require.Zero(t, ride.DriverID)
+
+		err = h.Store.UpdateRide(ctx, rideID, &model.Ride{Source: "Downtown", Destination: "Harbour", Distance: 15, Cost: pkr(15000), DriverID: 1})
+		require.NoError(t, err)
+		ride, err = h.Store.GetRide(ctx, rideID)
+		require.NoError(t, err)
+		require.Equal(t, "Harbour", ride.Destination)
+		require.Equal(t, int32(1), ride.DriverID)
+	})
+
+	t.Run("Canceled Context", func(t *testing.T) {
+		h := newHarness(t)
+
+		rideID, err := createRide(ctx, h.Store, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)})
+		require.NoError(t, err)
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err = h.Store.UpdateRide(canceled, rideID, &model.Ride{Source: "Downtown", Destination: "Mall", Distance: 10, Cost: pkr(20000)})
+		require.ErrorIs(t, err, store.ErrCanceled)
+	})
+
+	t.Run("Concurrent UpdateRide", func(t *testing.T) {
+		h := newHarness(t)
+
+		rideID, err := createRide(ctx, h.Store, &model.Ride{Source: "Downtown", Destination: "Airport", Distance: 15, Cost: pkr(15000)})
+		require.NoError(t, err)
+
+		const n = 20
+		errs := make([]error, n)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = h.Store.UpdateRide(ctx, rideID, &model.Ride{Source: "Downtown", Destination: "Mall", Distance: int32(i + 1), Cost: pkr(20000)})
+			}(i)
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+		ride, err := h.Store.GetRide(ctx, rideID)
+		require.NoError(t, err)
+		require.Equal(t, "Mall", ride.Destination)
+	})
+}
+
+// pkr returns minor units of Pakistani rupees.
+func pkr(minor int64) money.Money {
+	return money.Money{Currency: "PKR", Minor: minor}
+}
+
+// createRide creates a ride without a request ID and returns its ID.
+func createRide(ctx context.Context, s service.RideStore, ride *model.Ride) (int32, error) {
+	created, err := s.CreateRide(ctx, ride, "")
+	if err != nil {
+		return 0, err
+	}
+	return created.ID, nil
+}
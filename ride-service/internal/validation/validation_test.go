@@ -0,0 +1,116 @@
+package validation
+
+import (
+	"testing"
+
+	pb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+var (
+	downtown = &latlng.LatLng{Latitude: 31.5497, Longitude: 74.2500}
+	mall     = &latlng.LatLng{Latitude: 31.4660, Longitude: 74.2770}
+	rs200    = &money.Money{CurrencyCode: "PKR", Units: 200}
+)
+
+func TestValidator_Validate(t *testing.T) {
+	validator := New()
+
+	tests := []struct {
+		name     string
+		req      *pb.UpdateRideRequest
+		expected []Violation
+	}{
+		{
+			name: "Valid",
+			req: &pb.UpdateRideRequest{
+				RideId: 1,
+				Ride:   &pb.Ride{Source: "Downtown", Destination: "Mall", Distance: 10, Cost: rs200, Pickup: downtown, Dropoff: mall},
+			},
+			expected: nil,
+		},
+		{
+			name: "Labels Optional",
+			req: &pb.UpdateRideRequest{
+				RideId: 1,
+				Ride:   &pb.Ride{Cost: rs200, Pickup: downtown, Dropoff: mall},
+			},
+			expected: nil,
+		},
+		{
+			name: "Missing Ride",
+			req:  &pb.UpdateRideRequest{RideId: 1},
+			expected: []Violation{
+				{Field: "ride", RuleID: "required", Message: "value is required"},
+			},
+		},
+		{
+			name: "Missing Pickup, Dropoff And Cost",
+			req: &pb.UpdateRideRequest{
+				RideId: 1,
+				Ride:   &pb.Ride{Source: "Downtown", Destination: "Mall"},
+			},
+			expected: []Violation{
+				{Field: "ride.pickup", RuleID: "required", Message: "value is required"},
+				{Field: "ride.dropoff", RuleID: "required", Message: "value is required"},
+				{Field: "ride.cost", RuleID: "required", Message: "value is required"},
+			},
+		},
+		{
+			name: "Negative Distance And Cost",
+			req: &pb.UpdateRideRequest{
+				RideId: 1,
+				Ride:   &pb.Ride{Source: "Downtown", Destination: "Mall", Distance: -10, Cost: &money.Money{CurrencyCode: "PKR", Units: -200}, Pickup: downtown, Dropoff: mall},
+			},
+			expected: []Violation{
+				{Field: "ride.distance", RuleID: "int32.gte", Message: "value must be greater than or equal to 0"},
+				{Field: "ride.cost", RuleID: "ride.cost_non_negative", Message: "cost must not be negative"},
+			},
+		},
+		{
+			name: "Same Source And Destination",
+			req: &pb.UpdateRideRequest{
+				RideId: 1,
+				Ride:   &pb.Ride{Source: "Mall", Destination: "Mall", Cost: rs200, Pickup: downtown, Dropoff: mall},
+			},
+			expected: []Violation{
+				{Field: "ride", RuleID: "ride.distinct_labels", Message: "source and destination must differ"},
+			},
+		},
+		{
+			name: "Same Pickup And Dropoff",
+			req: &pb.UpdateRideRequest{
+				RideId: 1,
+				Ride:   &pb.Ride{Cost: rs200, Pickup: mall, Dropoff: &latlng.LatLng{Latitude: mall.Latitude, Longitude: mall.Longitude}},
+			},
+			expected: []Violation{
+				{Field: "ride", RuleID: "ride.distinct_endpoints", Message: "pickup and dropoff must differ"},
+			},
+		},
+		{
+			name: "Pickup And Dropoff Out Of Range",
+			req: &pb.UpdateRideRequest{
+				RideId: 1,
+				Ride: &pb.Ride{
+					Source: "Downtown", Destination: "Mall", Distance: 10, Cost: rs200,
+					Pickup:  &latlng.LatLng{Latitude: 91, Longitude: 74.3441},
+					Dropoff: &latlng.LatLng{Latitude: 31.4660, Longitude: -181},
+				},
+			},
+			expected: []Violation{
+				{Field: "ride.pickup", RuleID: "ride.pickup_range", Message: "latitude must be between -90 and 90 and longitude between -180 and 180"},
+				{Field: "ride.dropoff", RuleID: "ride.dropoff_range", Message: "latitude must be between -90 and 90 and longitude between -180 and 180"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := validator.Validate(tt.req)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, violations)
+		})
+	}
+}
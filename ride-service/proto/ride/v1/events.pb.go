@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        (unknown)
+// source: ride/v1/events.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RideUpdated is published when a ride's details change. It carries the ride
+// as stored after the update.
+type RideUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ride *Ride `protobuf:"bytes,1,opt,name=ride,proto3" json:"ride,omitempty"`
+}
+
+func (x *RideUpdated) Reset() {
+	*x = RideUpdated{}
+	mi := &file_ride_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RideUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RideUpdated) ProtoMessage() {}
+
+func (x *RideUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RideUpdated.ProtoReflect.Descriptor instead.
+func (*RideUpdated) Descriptor() ([]byte, []int) {
+	return file_ride_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RideUpdated) GetRide() *Ride {
+	if x != nil {
+		return x.Ride
+	}
+	return nil
+}
+
+var File_ride_v1_events_proto protoreflect.FileDescriptor
+
+var file_ride_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x1a,
+	0x1a, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x30, 0x0a, 0x0b, 0x52,
+	0x69, 0x64, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x69,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x69, 0x64, 0x65, 0x52, 0x04, 0x72, 0x69, 0x64, 0x65, 0x42, 0x3a, 0x5a,
+	0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6c, 0x61,
+	0x6e, 0x67, 0x5f, 0x66, 0x61, 0x6c, 0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x72,
+	0x69, 0x64, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_ride_v1_events_proto_rawDescOnce sync.Once
+	file_ride_v1_events_proto_rawDescData = file_ride_v1_events_proto_rawDesc
+)
+
+func file_ride_v1_events_proto_rawDescGZIP() []byte {
+	file_ride_v1_events_proto_rawDescOnce.Do(func() {
+		file_ride_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_ride_v1_events_proto_rawDescData)
+	})
+	return file_ride_v1_events_proto_rawDescData
+}
+
+var file_ride_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ride_v1_events_proto_goTypes = []any{
+	(*RideUpdated)(nil), // 0: ride.v1.RideUpdated
+	(*Ride)(nil),        // 1: ride.v1.Ride
+}
+var file_ride_v1_events_proto_depIdxs = []int32{
+	1, // 0: ride.v1.RideUpdated.ride:type_name -> ride.v1.Ride
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_ride_v1_events_proto_init() }
+func file_ride_v1_events_proto_init() {
+	if File_ride_v1_events_proto != nil {
+		return
+	}
+	file_ride_v1_ride_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ride_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ride_v1_events_proto_goTypes,
+		DependencyIndexes: file_ride_v1_events_proto_depIdxs,
+		MessageInfos:      file_ride_v1_events_proto_msgTypes,
+	}.Build()
+	File_ride_v1_events_proto = out.File
+	file_ride_v1_events_proto_rawDesc = nil
+	file_ride_v1_events_proto_goTypes = nil
+	file_ride_v1_events_proto_depIdxs = nil
+}
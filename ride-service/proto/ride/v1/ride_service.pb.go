@@ -7,6 +7,10 @@
 package v1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	latlng "google.golang.org/genproto/googleapis/type/latlng"
+	money "google.golang.org/genproto/googleapis/type/money"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,17 +24,26 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Ride definition, specific to RideService
+// Ride definition, specific to RideService. A ride goes from pickup to
+// dropoff; source and destination are optional labels for them.
 type Ride struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	RideId      int32  `protobuf:"varint,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
-	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
-	Distance    int32  `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"` // Distance in kilometers
-	Cost        int32  `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`         // Cost in currency units
+	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`           // Label of the pickup, e.g. "Downtown"
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"` // Label of the dropoff
+	// Great-circle distance from pickup to dropoff in kilometers, computed by the
+	// service. A distance sent by the client must agree with it.
+	Distance int32 `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	DriverId int32 `protobuf:"varint,6,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"` // Assigned driver, 0 if none
+	// Where the rider is picked up
+	Pickup *latlng.LatLng `protobuf:"bytes,7,opt,name=pickup,proto3" json:"pickup,omitempty"`
+	// Where the rider is dropped off
+	Dropoff *latlng.LatLng `protobuf:"bytes,8,opt,name=dropoff,proto3" json:"dropoff,omitempty"`
+	// What the ride costs. UpdateRide cannot change its currency.
+	Cost *money.Money `protobuf:"bytes,9,opt,name=cost,proto3" json:"cost,omitempty"`
 }
 
 func (x *Ride) Reset() {
@@ -91,13 +104,222 @@ func (x *Ride) GetDistance() int32 {
 	return 0
 }
 
-func (x *Ride) GetCost() int32 {
+func (x *Ride) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *Ride) GetPickup() *latlng.LatLng {
+	if x != nil {
+		return x.Pickup
+	}
+	return nil
+}
+
+func (x *Ride) GetDropoff() *latlng.LatLng {
+	if x != nil {
+		return x.Dropoff
+	}
+	return nil
+}
+
+func (x *Ride) GetCost() *money.Money {
 	if x != nil {
 		return x.Cost
 	}
+	return nil
+}
+
+type CreateRideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // Optional idempotency key
+	Ride      *Ride  `protobuf:"bytes,2,opt,name=ride,proto3" json:"ride,omitempty"`
+}
+
+func (x *CreateRideRequest) Reset() {
+	*x = CreateRideRequest{}
+	mi := &file_ride_v1_ride_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRideRequest) ProtoMessage() {}
+
+func (x *CreateRideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_v1_ride_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRideRequest.ProtoReflect.Descriptor instead.
+func (*CreateRideRequest) Descriptor() ([]byte, []int) {
+	return file_ride_v1_ride_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRideRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreateRideRequest) GetRide() *Ride {
+	if x != nil {
+		return x.Ride
+	}
+	return nil
+}
+
+type CreateRideResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ride *Ride `protobuf:"bytes,1,opt,name=ride,proto3" json:"ride,omitempty"`
+}
+
+func (x *CreateRideResponse) Reset() {
+	*x = CreateRideResponse{}
+	mi := &file_ride_v1_ride_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRideResponse) ProtoMessage() {}
+
+func (x *CreateRideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_v1_ride_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRideResponse.ProtoReflect.Descriptor instead.
+func (*CreateRideResponse) Descriptor() ([]byte, []int) {
+	return file_ride_v1_ride_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateRideResponse) GetRide() *Ride {
+	if x != nil {
+		return x.Ride
+	}
+	return nil
+}
+
+type GetRideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RideId int32 `protobuf:"varint,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+}
+
+func (x *GetRideRequest) Reset() {
+	*x = GetRideRequest{}
+	mi := &file_ride_v1_ride_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRideRequest) ProtoMessage() {}
+
+func (x *GetRideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_v1_ride_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRideRequest.ProtoReflect.Descriptor instead.
+func (*GetRideRequest) Descriptor() ([]byte, []int) {
+	return file_ride_v1_ride_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRideRequest) GetRideId() int32 {
+	if x != nil {
+		return x.RideId
+	}
 	return 0
 }
 
+type GetRideResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ride *Ride `protobuf:"bytes,1,opt,name=ride,proto3" json:"ride,omitempty"`
+}
+
+func (x *GetRideResponse) Reset() {
+	*x = GetRideResponse{}
+	mi := &file_ride_v1_ride_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRideResponse) ProtoMessage() {}
+
+func (x *GetRideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_v1_ride_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRideResponse.ProtoReflect.Descriptor instead.
+func (*GetRideResponse) Descriptor() ([]byte, []int) {
+	return file_ride_v1_ride_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRideResponse) GetRide() *Ride {
+	if x != nil {
+		return x.Ride
+	}
+	return nil
+}
+
 type UpdateRideRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -109,7 +331,7 @@ type UpdateRideRequest struct {
 
 func (x *UpdateRideRequest) Reset() {
 	*x = UpdateRideRequest{}
-	mi := &file_ride_v1_ride_service_proto_msgTypes[1]
+	mi := &file_ride_v1_ride_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -121,7 +343,7 @@ func (x *UpdateRideRequest) String() string {
 func (*UpdateRideRequest) ProtoMessage() {}
 
 func (x *UpdateRideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ride_v1_ride_service_proto_msgTypes[1]
+	mi := &file_ride_v1_ride_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -134,7 +356,7 @@ func (x *UpdateRideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRideRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRideRequest) Descriptor() ([]byte, []int) {
-	return file_ride_v1_ride_service_proto_rawDescGZIP(), []int{1}
+	return file_ride_v1_ride_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateRideRequest) GetRideId() int32 {
@@ -161,7 +383,7 @@ type UpdateRideResponse struct {
 
 func (x *UpdateRideResponse) Reset() {
 	*x = UpdateRideResponse{}
-	mi := &file_ride_v1_ride_service_proto_msgTypes[2]
+	mi := &file_ride_v1_ride_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +395,7 @@ func (x *UpdateRideResponse) String() string {
 func (*UpdateRideResponse) ProtoMessage() {}
 
 func (x *UpdateRideResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ride_v1_ride_service_proto_msgTypes[2]
+	mi := &file_ride_v1_ride_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +408,7 @@ func (x *UpdateRideResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRideResponse.ProtoReflect.Descriptor instead.
 func (*UpdateRideResponse) Descriptor() ([]byte, []int) {
-	return file_ride_v1_ride_service_proto_rawDescGZIP(), []int{2}
+	return file_ride_v1_ride_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateRideResponse) GetMessage() string {
@@ -196,38 +418,239 @@ func (x *UpdateRideResponse) GetMessage() string {
 	return ""
 }
 
+type DeleteRideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RideId int32 `protobuf:"varint,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+}
+
+func (x *DeleteRideRequest) Reset() {
+	*x = DeleteRideRequest{}
+	mi := &file_ride_v1_ride_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRideRequest) ProtoMessage() {}
+
+func (x *DeleteRideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_v1_ride_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRideRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRideRequest) Descriptor() ([]byte, []int) {
+	return file_ride_v1_ride_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteRideRequest) GetRideId() int32 {
+	if x != nil {
+		return x.RideId
+	}
+	return 0
+}
+
+type DeleteRideResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteRideResponse) Reset() {
+	*x = DeleteRideResponse{}
+	mi := &file_ride_v1_ride_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRideResponse) ProtoMessage() {}
+
+func (x *DeleteRideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_v1_ride_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRideResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRideResponse) Descriptor() ([]byte, []int) {
+	return file_ride_v1_ride_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteRideResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_ride_v1_ride_service_proto protoreflect.FileDescriptor
 
 var file_ride_v1_ride_service_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x72, 0x69,
-	0x64, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x89, 0x01, 0x0a, 0x04, 0x52, 0x69, 0x64, 0x65, 0x12, 0x17,
-	0x0a, 0x07, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x72, 0x69, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x73,
-	0x74, 0x22, 0x4f, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x69, 0x64, 0x65, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x04, 0x72, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x72, 0x69, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69, 0x64, 0x65, 0x52, 0x04, 0x72, 0x69,
-	0x64, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x32, 0x54, 0x0a, 0x0b, 0x52, 0x69, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x45, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x12,
-	0x1a, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x18, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x6c, 0x61,
+	0x74, 0x6c, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x08, 0x0a, 0x04, 0x52, 0x69, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72,
+	0x69, 0x64, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0xfc,
+	0x01, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x61,
+	0x74, 0x4c, 0x6e, 0x67, 0x42, 0xce, 0x01, 0xba, 0x48, 0xca, 0x01, 0xba, 0x01, 0xc3, 0x01, 0x0a,
+	0x11, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x46, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x6d, 0x75, 0x73,
+	0x74, 0x20, 0x62, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x2d, 0x39, 0x30,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x39, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x2d, 0x31,
+	0x38, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x38, 0x30, 0x1a, 0x66, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3e, 0x3d, 0x20, 0x2d, 0x39, 0x30,
+	0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x20, 0x3c, 0x3d, 0x20, 0x39, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74,
+	0x68, 0x69, 0x73, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3e, 0x3d,
+	0x20, 0x2d, 0x31, 0x38, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3c, 0x3d, 0x20, 0x31, 0x38, 0x30,
+	0x2e, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x12, 0xff, 0x01,
+	0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x61,
+	0x74, 0x4c, 0x6e, 0x67, 0x42, 0xcf, 0x01, 0xba, 0x48, 0xcb, 0x01, 0xba, 0x01, 0xc4, 0x01, 0x0a,
+	0x12, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x46, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x6d, 0x75,
+	0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x2d, 0x39,
+	0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x39, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x2d,
+	0x31, 0x38, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x38, 0x30, 0x1a, 0x66, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3e, 0x3d, 0x20, 0x2d, 0x39,
+	0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x20, 0x3c, 0x3d, 0x20, 0x39, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3e,
+	0x3d, 0x20, 0x2d, 0x31, 0x38, 0x30, 0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x3c, 0x3d, 0x20, 0x31, 0x38,
+	0x30, 0x2e, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66, 0x66, 0x12,
+	0x88, 0x01, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x42, 0x60, 0xba, 0x48, 0x5d, 0xba, 0x01, 0x57, 0x0a, 0x16, 0x72, 0x69, 0x64, 0x65,
+	0x2e, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x19, 0x63, 0x6f, 0x73, 0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x62, 0x65, 0x20, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x22, 0x74,
+	0x68, 0x69, 0x73, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x20, 0x3e, 0x3d, 0x20, 0x30, 0x20, 0x26,
+	0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x20, 0x3e, 0x3d, 0x20,
+	0x30, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x3a, 0xfa, 0x01, 0xba, 0x48, 0xf6,
+	0x01, 0x1a, 0x81, 0x01, 0x0a, 0x17, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x63, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x70,
+	0x69, 0x63, 0x6b, 0x75, 0x70, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x64, 0x72, 0x6f, 0x70, 0x6f, 0x66,
+	0x66, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x1a, 0x46, 0x21,
+	0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x29,
+	0x20, 0x7c, 0x7c, 0x20, 0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x72,
+	0x6f, 0x70, 0x6f, 0x66, 0x66, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x70,
+	0x69, 0x63, 0x6b, 0x75, 0x70, 0x20, 0x21, 0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x72,
+	0x6f, 0x70, 0x6f, 0x66, 0x66, 0x1a, 0x70, 0x0a, 0x14, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x64, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x22, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x64, 0x69, 0x66, 0x66, 0x65,
+	0x72, 0x1a, 0x34, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x3d,
+	0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x66, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x69,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x69, 0x64, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x04, 0x72, 0x69, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x72,
+	0x69, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x69, 0x64, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69, 0x64, 0x65, 0x52, 0x04, 0x72, 0x69, 0x64, 0x65, 0x22, 0x32,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x07, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x72, 0x69, 0x64, 0x65,
+	0x49, 0x64, 0x22, 0x34, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x69, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69,
+	0x64, 0x65, 0x52, 0x04, 0x72, 0x69, 0x64, 0x65, 0x22, 0x60, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x07, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x72, 0x69, 0x64, 0x65, 0x49, 0x64, 0x12,
+	0x29, 0x0a, 0x04, 0x72, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x72, 0x69, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69, 0x64, 0x65, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x72, 0x69, 0x64, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x35, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x07, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x72, 0x69, 0x64, 0x65, 0x49,
+	0x64, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x32, 0x93, 0x03, 0x0a, 0x0b, 0x52, 0x69, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x5b, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x12,
+	0x1a, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x69,
-	0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x5f, 0x66, 0x61,
-	0x6c, 0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x72, 0x69, 0x64, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e,
+	0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x59,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x69, 0x64, 0x65, 0x12, 0x17, 0x2e, 0x72, 0x69, 0x64, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x73, 0x2f,
+	0x7b, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x68, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x04, 0x72, 0x69, 0x64, 0x65, 0x1a, 0x13,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0x62, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x69, 0x64,
+	0x65, 0x12, 0x1a, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x72, 0x69, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x69,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x2a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x72,
+	0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x6c,
+	0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x69, 0x64, 0x65,
+	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -242,21 +665,41 @@ func file_ride_v1_ride_service_proto_rawDescGZIP() []byte {
 	return file_ride_v1_ride_service_proto_rawDescData
 }
 
-var file_ride_v1_ride_service_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ride_v1_ride_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_ride_v1_ride_service_proto_goTypes = []any{
 	(*Ride)(nil),               // 0: ride.v1.Ride
-	(*UpdateRideRequest)(nil),  // 1: ride.v1.UpdateRideRequest
-	(*UpdateRideResponse)(nil), // 2: ride.v1.UpdateRideResponse
+	(*CreateRideRequest)(nil),  // 1: ride.v1.CreateRideRequest
+	(*CreateRideResponse)(nil), // 2: ride.v1.CreateRideResponse
+	(*GetRideRequest)(nil),     // 3: ride.v1.GetRideRequest
+	(*GetRideResponse)(nil),    // 4: ride.v1.GetRideResponse
+	(*UpdateRideRequest)(nil),  // 5: ride.v1.UpdateRideRequest
+	(*UpdateRideResponse)(nil), // 6: ride.v1.UpdateRideResponse
+	(*DeleteRideRequest)(nil),  // 7: ride.v1.DeleteRideRequest
+	(*DeleteRideResponse)(nil), // 8: ride.v1.DeleteRideResponse
+	(*latlng.LatLng)(nil),      // 9: google.type.LatLng
+	(*money.Money)(nil),        // 10: google.type.Money
 }
 var file_ride_v1_ride_service_proto_depIdxs = []int32{
-	0, // 0: ride.v1.UpdateRideRequest.ride:type_name -> ride.v1.Ride
-	1, // 1: ride.v1.RideService.UpdateRide:input_type -> ride.v1.UpdateRideRequest
-	2, // 2: ride.v1.RideService.UpdateRide:output_type -> ride.v1.UpdateRideResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	9,  // 0: ride.v1.Ride.pickup:type_name -> google.type.LatLng
+	9,  // 1: ride.v1.Ride.dropoff:type_name -> google.type.LatLng
+	10, // 2: ride.v1.Ride.cost:type_name -> google.type.Money
+	0,  // 3: ride.v1.CreateRideRequest.ride:type_name -> ride.v1.Ride
+	0,  // 4: ride.v1.CreateRideResponse.ride:type_name -> ride.v1.Ride
+	0,  // 5: ride.v1.GetRideResponse.ride:type_name -> ride.v1.Ride
+	0,  // 6: ride.v1.UpdateRideRequest.ride:type_name -> ride.v1.Ride
+	1,  // 7: ride.v1.RideService.CreateRide:input_type -> ride.v1.CreateRideRequest
+	3,  // 8: ride.v1.RideService.GetRide:input_type -> ride.v1.GetRideRequest
+	5,  // 9: ride.v1.RideService.UpdateRide:input_type -> ride.v1.UpdateRideRequest
+	7,  // 10: ride.v1.RideService.DeleteRide:input_type -> ride.v1.DeleteRideRequest
+	2,  // 11: ride.v1.RideService.CreateRide:output_type -> ride.v1.CreateRideResponse
+	4,  // 12: ride.v1.RideService.GetRide:output_type -> ride.v1.GetRideResponse
+	6,  // 13: ride.v1.RideService.UpdateRide:output_type -> ride.v1.UpdateRideResponse
+	8,  // 14: ride.v1.RideService.DeleteRide:output_type -> ride.v1.DeleteRideResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_ride_v1_ride_service_proto_init() }
@@ -270,7 +713,7 @@ func file_ride_v1_ride_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ride_v1_ride_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
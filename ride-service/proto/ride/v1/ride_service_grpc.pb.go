@@ -19,14 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
+	RideService_CreateRide_FullMethodName = "/ride.v1.RideService/CreateRide"
+	RideService_GetRide_FullMethodName    = "/ride.v1.RideService/GetRide"
 	RideService_UpdateRide_FullMethodName = "/ride.v1.RideService/UpdateRide"
+	RideService_DeleteRide_FullMethodName = "/ride.v1.RideService/DeleteRide"
 )
 
 // RideServiceClient is the client API for RideService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RideServiceClient interface {
+	// CreateRide creates a ride. Retrying with the same request_id returns the
+	// ride created by the first call instead of creating another one.
+	CreateRide(ctx context.Context, in *CreateRideRequest, opts ...grpc.CallOption) (*CreateRideResponse, error)
+	GetRide(ctx context.Context, in *GetRideRequest, opts ...grpc.CallOption) (*GetRideResponse, error)
 	UpdateRide(ctx context.Context, in *UpdateRideRequest, opts ...grpc.CallOption) (*UpdateRideResponse, error)
+	DeleteRide(ctx context.Context, in *DeleteRideRequest, opts ...grpc.CallOption) (*DeleteRideResponse, error)
 }
 
 type rideServiceClient struct {
@@ -37,6 +45,26 @@ func NewRideServiceClient(cc grpc.ClientConnInterface) RideServiceClient {
 	return &rideServiceClient{cc}
 }
 
+func (c *rideServiceClient) CreateRide(ctx context.Context, in *CreateRideRequest, opts ...grpc.CallOption) (*CreateRideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRideResponse)
+	err := c.cc.Invoke(ctx, RideService_CreateRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) GetRide(ctx context.Context, in *GetRideRequest, opts ...grpc.CallOption) (*GetRideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRideResponse)
+	err := c.cc.Invoke(ctx, RideService_GetRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rideServiceClient) UpdateRide(ctx context.Context, in *UpdateRideRequest, opts ...grpc.CallOption) (*UpdateRideResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateRideResponse)
@@ -47,11 +75,26 @@ func (c *rideServiceClient) UpdateRide(ctx context.Context, in *UpdateRideReques
 	return out, nil
 }
 
+func (c *rideServiceClient) DeleteRide(ctx context.Context, in *DeleteRideRequest, opts ...grpc.CallOption) (*DeleteRideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRideResponse)
+	err := c.cc.Invoke(ctx, RideService_DeleteRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RideServiceServer is the server API for RideService service.
 // All implementations must embed UnimplementedRideServiceServer
 // for forward compatibility.
 type RideServiceServer interface {
+	// CreateRide creates a ride. Retrying with the same request_id returns the
+	// ride created by the first call instead of creating another one.
+	CreateRide(context.Context, *CreateRideRequest) (*CreateRideResponse, error)
+	GetRide(context.Context, *GetRideRequest) (*GetRideResponse, error)
 	UpdateRide(context.Context, *UpdateRideRequest) (*UpdateRideResponse, error)
+	DeleteRide(context.Context, *DeleteRideRequest) (*DeleteRideResponse, error)
 	mustEmbedUnimplementedRideServiceServer()
 }
 
@@ -62,9 +105,18 @@ type RideServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedRideServiceServer struct{}
 
+func (UnimplementedRideServiceServer) CreateRide(context.Context, *CreateRideRequest) (*CreateRideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRide not implemented")
+}
+func (UnimplementedRideServiceServer) GetRide(context.Context, *GetRideRequest) (*GetRideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRide not implemented")
+}
 func (UnimplementedRideServiceServer) UpdateRide(context.Context, *UpdateRideRequest) (*UpdateRideResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRide not implemented")
 }
+func (UnimplementedRideServiceServer) DeleteRide(context.Context, *DeleteRideRequest) (*DeleteRideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRide not implemented")
+}
 func (UnimplementedRideServiceServer) mustEmbedUnimplementedRideServiceServer() {}
 func (UnimplementedRideServiceServer) testEmbeddedByValue()                     {}
 
@@ -86,6 +138,42 @@ func RegisterRideServiceServer(s grpc.ServiceRegistrar, srv RideServiceServer) {
 	s.RegisterService(&RideService_ServiceDesc, srv)
 }
 
+func _RideService_CreateRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).CreateRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_CreateRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).CreateRide(ctx, req.(*CreateRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_GetRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).GetRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_GetRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).GetRide(ctx, req.(*GetRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RideService_UpdateRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateRideRequest)
 	if err := dec(in); err != nil {
@@ -104,6 +192,24 @@ func _RideService_UpdateRide_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RideService_DeleteRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).DeleteRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_DeleteRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).DeleteRide(ctx, req.(*DeleteRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RideService_ServiceDesc is the grpc.ServiceDesc for RideService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,10 +217,22 @@ var RideService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "ride.v1.RideService",
 	HandlerType: (*RideServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRide",
+			Handler:    _RideService_CreateRide_Handler,
+		},
+		{
+			MethodName: "GetRide",
+			Handler:    _RideService_GetRide_Handler,
+		},
 		{
 			MethodName: "UpdateRide",
 			Handler:    _RideService_UpdateRide_Handler,
 		},
+		{
+			MethodName: "DeleteRide",
+			Handler:    _RideService_DeleteRide_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ride/v1/ride_service.proto",
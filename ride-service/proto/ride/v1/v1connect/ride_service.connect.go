@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ride/v1/ride_service.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RideServiceName is the fully-qualified name of the RideService service.
+	RideServiceName = "ride.v1.RideService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RideServiceCreateRideProcedure is the fully-qualified name of the RideService's CreateRide RPC.
+	RideServiceCreateRideProcedure = "/ride.v1.RideService/CreateRide"
+	// RideServiceGetRideProcedure is the fully-qualified name of the RideService's GetRide RPC.
+	RideServiceGetRideProcedure = "/ride.v1.RideService/GetRide"
+	// RideServiceUpdateRideProcedure is the fully-qualified name of the RideService's UpdateRide RPC.
+	RideServiceUpdateRideProcedure = "/ride.v1.RideService/UpdateRide"
+	// RideServiceDeleteRideProcedure is the fully-qualified name of the RideService's DeleteRide RPC.
+	RideServiceDeleteRideProcedure = "/ride.v1.RideService/DeleteRide"
+)
+
+// RideServiceClient is a client for the ride.v1.RideService service.
+type RideServiceClient interface {
+	// CreateRide creates a ride. Retrying with the same request_id returns the
+	// ride created by the first call instead of creating another one.
+	CreateRide(context.Context, *connect.Request[v1.CreateRideRequest]) (*connect.Response[v1.CreateRideResponse], error)
+	GetRide(context.Context, *connect.Request[v1.GetRideRequest]) (*connect.Response[v1.GetRideResponse], error)
+	UpdateRide(context.Context, *connect.Request[v1.UpdateRideRequest]) (*connect.Response[v1.UpdateRideResponse], error)
+	DeleteRide(context.Context, *connect.Request[v1.DeleteRideRequest]) (*connect.Response[v1.DeleteRideResponse], error)
+}
+
+// NewRideServiceClient constructs a client for the ride.v1.RideService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRideServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RideServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	rideServiceMethods := v1.File_ride_v1_ride_service_proto.Services().ByName("RideService").Methods()
+	return &rideServiceClient{
+		createRide: connect.NewClient[v1.CreateRideRequest, v1.CreateRideResponse](
+			httpClient,
+			baseURL+RideServiceCreateRideProcedure,
+			connect.WithSchema(rideServiceMethods.ByName("CreateRide")),
+			connect.WithClientOptions(opts...),
+		),
+		getRide: connect.NewClient[v1.GetRideRequest, v1.GetRideResponse](
+			httpClient,
+			baseURL+RideServiceGetRideProcedure,
+			connect.WithSchema(rideServiceMethods.ByName("GetRide")),
+			connect.WithClientOptions(opts...),
+		),
+		updateRide: connect.NewClient[v1.UpdateRideRequest, v1.UpdateRideResponse](
+			httpClient,
+			baseURL+RideServiceUpdateRideProcedure,
+			connect.WithSchema(rideServiceMethods.ByName("UpdateRide")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRide: connect.NewClient[v1.DeleteRideRequest, v1.DeleteRideResponse](
+			httpClient,
+			baseURL+RideServiceDeleteRideProcedure,
+			connect.WithSchema(rideServiceMethods.ByName("DeleteRide")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// rideServiceClient implements RideServiceClient.
+type rideServiceClient struct {
+	createRide *connect.Client[v1.CreateRideRequest, v1.CreateRideResponse]
+	getRide    *connect.Client[v1.GetRideRequest, v1.GetRideResponse]
+	updateRide *connect.Client[v1.UpdateRideRequest, v1.UpdateRideResponse]
+	deleteRide *connect.Client[v1.DeleteRideRequest, v1.DeleteRideResponse]
+}
+
+// CreateRide calls ride.v1.RideService.CreateRide.
+func (c *rideServiceClient) CreateRide(ctx context.Context, req *connect.Request[v1.CreateRideRequest]) (*connect.Response[v1.CreateRideResponse], error) {
+	return c.createRide.CallUnary(ctx, req)
+}
+
+// GetRide calls ride.v1.RideService.GetRide.
+func (c *rideServiceClient) GetRide(ctx context.Context, req *connect.Request[v1.GetRideRequest]) (*connect.Response[v1.GetRideResponse], error) {
+	return c.getRide.CallUnary(ctx, req)
+}
+
+// UpdateRide calls ride.v1.RideService.UpdateRide.
+func (c *rideServiceClient) UpdateRide(ctx context.Context, req *connect.Request[v1.UpdateRideRequest]) (*connect.Response[v1.UpdateRideResponse], error) {
+	return c.updateRide.CallUnary(ctx, req)
+}
+
+// DeleteRide calls ride.v1.RideService.DeleteRide.
+func (c *rideServiceClient) DeleteRide(ctx context.Context, req *connect.Request[v1.DeleteRideRequest]) (*connect.Response[v1.DeleteRideResponse], error) {
+	return c.deleteRide.CallUnary(ctx, req)
+}
+
+// RideServiceHandler is an implementation of the ride.v1.RideService service.
+type RideServiceHandler interface {
+	// CreateRide creates a ride. Retrying with the same request_id returns the
+	// ride created by the first call instead of creating another one.
+	CreateRide(context.Context, *connect.Request[v1.CreateRideRequest]) (*connect.Response[v1.CreateRideResponse], error)
+	GetRide(context.Context, *connect.Request[v1.GetRideRequest]) (*connect.Response[v1.GetRideResponse], error)
+	UpdateRide(context.Context, *connect.Request[v1.UpdateRideRequest]) (*connect.Response[v1.UpdateRideResponse], error)
+	DeleteRide(context.Context, *connect.Request[v1.DeleteRideRequest]) (*connect.Response[v1.DeleteRideResponse], error)
+}
+
+// NewRideServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRideServiceHandler(svc RideServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	rideServiceMethods := v1.File_ride_v1_ride_service_proto.Services().ByName("RideService").Methods()
+	rideServiceCreateRideHandler := connect.NewUnaryHandler(
+		RideServiceCreateRideProcedure,
+		svc.CreateRide,
+		connect.WithSchema(rideServiceMethods.ByName("CreateRide")),
+		connect.WithHandlerOptions(opts...),
+	)
+	rideServiceGetRideHandler := connect.NewUnaryHandler(
+		RideServiceGetRideProcedure,
+		svc.GetRide,
+		connect.WithSchema(rideServiceMethods.ByName("GetRide")),
+		connect.WithHandlerOptions(opts...),
+	)
+	rideServiceUpdateRideHandler := connect.NewUnaryHandler(
+		RideServiceUpdateRideProcedure,
+		svc.UpdateRide,
+		connect.WithSchema(rideServiceMethods.ByName("UpdateRide")),
+		connect.WithHandlerOptions(opts...),
+	)
+	rideServiceDeleteRideHandler := connect.NewUnaryHandler(
+		RideServiceDeleteRideProcedure,
+		svc.DeleteRide,
+		connect.WithSchema(rideServiceMethods.ByName("DeleteRide")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ride.v1.RideService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RideServiceCreateRideProcedure:
+			rideServiceCreateRideHandler.ServeHTTP(w, r)
+		case RideServiceGetRideProcedure:
+			rideServiceGetRideHandler.ServeHTTP(w, r)
+		case RideServiceUpdateRideProcedure:
+			rideServiceUpdateRideHandler.ServeHTTP(w, r)
+		case RideServiceDeleteRideProcedure:
+			rideServiceDeleteRideHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRideServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRideServiceHandler struct{}
+
+func (UnimplementedRideServiceHandler) CreateRide(context.Context, *connect.Request[v1.CreateRideRequest]) (*connect.Response[v1.CreateRideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.v1.RideService.CreateRide is not implemented"))
+}
+
+func (UnimplementedRideServiceHandler) GetRide(context.Context, *connect.Request[v1.GetRideRequest]) (*connect.Response[v1.GetRideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.v1.RideService.GetRide is not implemented"))
+}
+
+func (UnimplementedRideServiceHandler) UpdateRide(context.Context, *connect.Request[v1.UpdateRideRequest]) (*connect.Response[v1.UpdateRideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.v1.RideService.UpdateRide is not implemented"))
+}
+
+func (UnimplementedRideServiceHandler) DeleteRide(context.Context, *connect.Request[v1.DeleteRideRequest]) (*connect.Response[v1.DeleteRideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.v1.RideService.DeleteRide is not implemented"))
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	pb "github.com/golang_falcon_task/ride-service/proto/ride/v1"
+	"github.com/golang_falcon_task/ride-service/proto/ride/v1/v1connect"
+)
+
+// connectService exposes RideService to the generated Connect handler,
+// which serves both the Connect and gRPC-Web protocols.
+type connectService struct {
+	svc pb.RideServiceServer
+}
+
+var _ v1connect.RideServiceHandler = (*connectService)(nil)
+
+func (s *connectService) GetRide(ctx context.Context, req *connect.Request[pb.GetRideRequest]) (*connect.Response[pb.GetRideResponse], error) {
+	res, err := s.svc.GetRide(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) UpdateRide(ctx context.Context, req *connect.Request[pb.UpdateRideRequest]) (*connect.Response[pb.UpdateRideResponse], error) {
+	res, err := s.svc.UpdateRide(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) CreateRide(ctx context.Context, req *connect.Request[pb.CreateRideRequest]) (*connect.Response[pb.CreateRideResponse], error) {
+	res, err := s.svc.CreateRide(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) DeleteRide(ctx context.Context, req *connect.Request[pb.DeleteRideRequest]) (*connect.Response[pb.DeleteRideResponse], error) {
+	res, err := s.svc.DeleteRide(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
@@ -1,17 +1,18 @@
 package main
 
 import (
+	"context"
 	"github.com/golang_falcon_task/user-service/internal/config"
 	"github.com/golang_falcon_task/user-service/internal/db"
 	"github.com/golang_falcon_task/user-service/internal/logging"
 	"github.com/golang_falcon_task/user-service/internal/metrics"
-	"github.com/golang_falcon_task/user-service/internal/middleware"
-	"github.com/golang_falcon_task/user-service/internal/service"
-	"github.com/golang_falcon_task/user-service/internal/store"
-	pb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/golang_falcon_task/user-service/internal/outbox"
+	"github.com/golang_falcon_task/user-service/server"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/credentials/insecure"
 	"net"
+	"net/http"
 )
 
 func main() {
@@ -29,36 +30,71 @@ func main() {
 	metrics.InitMetrics()
 	metrics.StartMetricsServer(":9005")
 
-	// Initialize database
-	database, err := db.InitDB(cfg)
+	// Initialize database, unless running on the in-memory store
+	var database *pgxpool.Pool
+	if cfg.StoreBackend == config.StoreMemory {
+		log.Println("Using in-memory store")
+	} else {
+		database, err = db.InitDB(cfg, log)
+		if err != nil {
+			log.Fatalf("failed to connect to database: %v", err)
+		}
+		defer database.Close()
+	}
+
+	// Pick the broker outbox events are relayed to
+	var broker outbox.Broker
+	if cfg.EventBroker == config.BrokerFile {
+		fileBroker, err := outbox.NewFileBroker(cfg.EventFile)
+		if err != nil {
+			log.Fatalf("failed to open event file: %v", err)
+		}
+		defer fileBroker.Close()
+		log.Printf("Publishing events to %s", cfg.EventFile)
+		broker = fileBroker
+	} else {
+		broker = outbox.NewInProcessBroker()
+	}
+
+	srv, err := server.New(server.Options{
+		Logger:             log,
+		DB:                 database,
+		Broker:             broker,
+		OutboxPollInterval: cfg.OutboxPollInterval,
+	})
 	if err != nil {
-		log.Fatalf("failed to connect to database: %v", err)
+		log.Fatalf("failed to create server: %v", err)
 	}
-	defer database.Close()
 
-	userStore := store.NewPGUserStore(database)
-	userService := service.NewUserService(userStore, log)
+	// Relay outbox events in the background
+	go srv.Relay.Run(context.Background())
 
-	// Start gRPC server
+	// Listen for gRPC, Connect and gRPC-Web on one port
 	lis, err := net.Listen("tcp", ":50051")
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			middleware.LoggingInterceptor(log), // Logs all requests and responses
-			middleware.MetricsInterceptor(),    // Captures Prometheus metrics
-		),
-	)
-
-	pb.RegisterUserServiceServer(grpcServer, userService)
+	// Start the REST/JSON gateway, which forwards to the gRPC server
+	conn, err := grpc.NewClient("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to create gateway client: %v", err)
+	}
+	defer conn.Close()
 
-	// Enable server reflection
-	reflection.Register(grpcServer)
+	gateway, err := server.NewGateway(context.Background(), conn)
+	if err != nil {
+		log.Fatalf("failed to create gateway: %v", err)
+	}
+	go func() {
+		log.Println("UserService REST gateway is running on port 8051")
+		if err := http.ListenAndServe(":8051", gateway); err != nil {
+			log.Fatalf("failed to serve gateway: %v", err)
+		}
+	}()
 
-	log.Println("UserService is running on port 50051")
-	if err := grpcServer.Serve(lis); err != nil {
+	log.Println("UserService is running on port 50051 (gRPC, Connect and gRPC-Web)")
+	if err := http.Serve(lis, srv.Handler()); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
@@ -3,18 +3,104 @@ package db
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
 	"github.com/golang_falcon_task/user-service/internal/config"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	initialBackoff = 250 * time.Millisecond
+	maxBackoff     = 5 * time.Second
+
+	// defaultConnectTimeout is used when DBConnectTimeout is not positive.
+	defaultConnectTimeout = 30 * time.Second
 )
 
-func InitDB(cfg *config.Config) (*pgxpool.Pool, error) {
-	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s",
-		cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBPort, cfg.DBName,
-	)
+// BuildDSN builds a Postgres connection URL from the config, escaping
+// credentials and the database name so special characters are preserved.
+func BuildDSN(cfg *config.Config) string {
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(cfg.DBUser, cfg.DBPassword),
+		Host:   net.JoinHostPort(cfg.DBHost, cfg.DBPort),
+		Path:   "/" + cfg.DBName,
+	}
+	if cfg.DBSSLMode != "" {
+		q := url.Values{}
+		q.Set("sslmode", cfg.DBSSLMode)
+		u.RawQuery = q.Encode()
+	}
+	return u.String()
+}
+
+// InitDB creates a tuned connection pool and waits for Postgres to accept
+// connections, retrying with exponential backoff up to cfg.DBConnectTimeout,
+// or defaultConnectTimeout if that is not positive.
+func InitDB(cfg *config.Config, log *logrus.Logger) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(BuildDSN(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("invalid database config: %v", err)
+	}
+
+	poolCfg.MaxConns = cfg.DBMaxConns
+	poolCfg.MinConns = cfg.DBMinConns
+	poolCfg.MaxConnLifetime = cfg.DBMaxConnLifetime
+	poolCfg.MaxConnIdleTime = cfg.DBMaxConnIdleTime
+	poolCfg.HealthCheckPeriod = cfg.DBHealthCheckPeriod
 
-	pool, err := pgxpool.New(context.Background(), dsn)
+	// Postgres enforces statement_timeout on every query run over the connection.
+	if cfg.DBStatementTimeout > 0 {
+		poolCfg.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.DBStatementTimeout.Milliseconds(), 10)
+	}
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), poolCfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to the database: %v", err)
 	}
+
+	timeout := cfg.DBConnectTimeout
+	if timeout <= 0 {
+		timeout = defaultConnectTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := waitForDB(ctx, pool, log); err != nil {
+		pool.Close()
+		return nil, err
+	}
 	return pool, nil
 }
+
+// waitForDB pings the pool until it succeeds or ctx expires, doubling the
+// delay between attempts up to maxBackoff.
+func waitForDB(ctx context.Context, pool *pgxpool.Pool, log *logrus.Logger) error {
+	backoff := initialBackoff
+	for attempt := 1; ; attempt++ {
+		err := pool.Ping(ctx)
+		if err == nil {
+			return nil
+		}
+
+		log.WithFields(logrus.Fields{
+			"attempt": attempt,
+			"retry":   backoff.String(),
+			"error":   err.Error(),
+		}).Warn("Database not ready")
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("database not reachable after %d attempts: %v", attempt, err)
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
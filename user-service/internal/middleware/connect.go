@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// ConnectInterceptor runs gRPC unary interceptors for calls served over the
+// Connect and gRPC-Web protocols, so those calls are logged, measured and
+// validated exactly like native gRPC calls. gRPC status errors returned by the
+// chain are converted to Connect errors, keeping their details.
+func ConnectInterceptor(interceptors ...grpc.UnaryServerInterceptor) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			info := &grpc.UnaryServerInfo{FullMethod: req.Spec().Procedure}
+
+			var res connect.AnyResponse
+			handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+				var err error
+				if res, err = next(ctx, req); err != nil {
+					return nil, err
+				}
+				return res.Any(), nil
+			}
+			for i := len(interceptors) - 1; i >= 0; i-- {
+				handler = chainHandler(interceptors[i], info, handler)
+			}
+
+			if _, err := handler(ctx, req.Any()); err != nil {
+				return nil, connectError(err)
+			}
+			return res, nil
+		}
+	})
+}
+
+func chainHandler(interceptor grpc.UnaryServerInterceptor, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
+// connectError converts a gRPC status error to the equivalent Connect error.
+func connectError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	connectErr := connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, d := range st.Proto().GetDetails() {
+		if detail, err := connect.NewErrorDetail(d); err == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+	return connectErr
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/golang_falcon_task/user-service/internal/grpcerr"
+	"github.com/golang_falcon_task/user-service/internal/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidationInterceptor rejects requests that violate the buf.validate
+// constraints declared in the .proto files with a structured InvalidArgument
+// error, before they reach the handler.
+func ValidationInterceptor() grpc.UnaryServerInterceptor {
+	validator := validation.New()
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		violations, err := validator.Validate(msg)
+		if err != nil {
+			return nil, grpcerr.New(codes.Internal, grpcerr.ReasonValidationRule, err.Error())
+		}
+		if len(violations) > 0 {
+			fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0, len(violations))
+			for _, v := range violations {
+				fieldViolations = append(fieldViolations, grpcerr.FieldViolation(v.Field, v.Message))
+			}
+			return nil, grpcerr.InvalidArgument(fieldViolations...)
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"github.com/golang_falcon_task/user-service/internal/money"
+)
+
+// Hold statuses. Only a held hold can be captured or released.
+const (
+	HoldHeld     = "HELD"
+	HoldCaptured = "CAPTURED"
+	HoldReleased = "RELEASED"
+)
+
+// Wallet transaction kinds.
+const (
+	TransactionTopUp   = "TOP_UP"
+	TransactionHold    = "HOLD"
+	TransactionCapture = "CAPTURE"
+	TransactionRelease = "RELEASE"
+	TransactionRefund  = "REFUND"
+)
+
+// WalletBalance is the money in a user's wallet in one currency. Held money
+// is set aside for holds; the rest is available.
+type WalletBalance struct {
+	Balance money.Money
+	Held    money.Money
+}
+
+// Available returns the money that can be held.
+func (b WalletBalance) Available() money.Money {
+	return money.Money{Currency: b.Balance.Currency, Minor: b.Balance.Minor - b.Held.Minor}
+}
+
+// Hold is money in a wallet set aside until it is captured or released.
+type Hold struct {
+	ID        int32
+	UserID    int32
+	Amount    money.Money
+	Captured  money.Money // Set once captured; at most Amount
+	Refunded  money.Money // Given back of Captured; zero if none
+	Status    string      // One of the Hold* statuses
+	Reference string      // Unique per user, e.g. the booking the hold pays for
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WalletTransaction records a change to a wallet. Amount is added to the
+// balance by top-ups and refunds and taken out by captures, and taken out of
+// the available money by holds and given back by releases.
+type WalletTransaction struct {
+	ID        int64
+	UserID    int32
+	Kind      string      // One of the Transaction* kinds
+	Amount    money.Money // Negative when money is taken out
+	HoldID    int32       // 0 for top-ups
+	CreatedAt time.Time
+}
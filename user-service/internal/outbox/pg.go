@@ -0,0 +1,69 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Write records msg in the outbox table as part of tx, so it is committed or
+// rolled back together with the write that produced it.
+func Write(ctx context.Context, tx pgx.Tx, msg Message) error {
+	_, err := tx.Exec(ctx, `
+        INSERT INTO outbox (event_id, source, topic, payload, created_at)
+        VALUES ($1, $2, $3, $4, $5)
+    `, msg.ID, Source, msg.Topic, msg.Payload, msg.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to write %s to outbox: %w", msg.Topic, err)
+	}
+	return nil
+}
+
+// PGStore reads this service's messages from the outbox table.
+type PGStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPGStore creates a new PGStore instance.
+func NewPGStore(db *pgxpool.Pool) *PGStore {
+	return &PGStore{db: db}
+}
+
+// Pending returns up to limit unpublished messages, oldest first.
+func (s *PGStore) Pending(ctx context.Context, limit int) ([]Message, error) {
+	rows, err := s.db.Query(ctx, `
+        SELECT event_id, topic, payload, created_at
+        FROM outbox
+        WHERE source = $1 AND published_at IS NULL
+        ORDER BY id
+        LIMIT $2
+    `, Source, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+	defer rows.Close()
+
+	msgs := []Message{}
+	for rows.Next() {
+		var m Message
+		if err := rows.Scan(&m.ID, &m.Topic, &m.Payload, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read outbox: %w", err)
+		}
+		msgs = append(msgs, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+	return msgs, nil
+}
+
+// MarkPublished records that the messages with the given IDs were published.
+func (s *PGStore) MarkPublished(ctx context.Context, ids []string) error {
+	_, err := s.db.Exec(ctx, `UPDATE outbox SET published_at = now() WHERE event_id = ANY($1)`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox messages published: %w", err)
+	}
+	return nil
+}
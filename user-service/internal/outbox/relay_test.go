@@ -0,0 +1,147 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// flakyBroker records published messages and fails the first failures calls.
+type flakyBroker struct {
+	failures  int
+	published []string
+}
+
+func (b *flakyBroker) Publish(_ context.Context, msg Message) error {
+	if b.failures > 0 {
+		b.failures--
+		return errors.New("broker unavailable")
+	}
+	b.published = append(b.published, msg.ID)
+	return nil
+}
+
+func newTestMessages(t *testing.T, store *MemStore, n int) []string {
+	t.Helper()
+
+	var ids []string
+	for i := 0; i < n; i++ {
+		msg, err := NewMessage(wrapperspb.Int32(int32(i)))
+		require.NoError(t, err)
+		store.Add(msg)
+		ids = append(ids, msg.ID)
+	}
+	return ids
+}
+
+func TestNewMessage(t *testing.T) {
+	a, err := NewMessage(wrapperspb.String("hello"))
+	require.NoError(t, err)
+	b, err := NewMessage(wrapperspb.String("hello"))
+	require.NoError(t, err)
+
+	require.Equal(t, "google.protobuf.StringValue", a.Topic)
+	require.NotEmpty(t, a.ID)
+	require.NotEqual(t, a.ID, b.ID)
+	require.Equal(t, a.Payload, b.Payload)
+}
+
+func TestRelay_Flush(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		messages          int
+		failures          int
+		expectedPublished int
+		expectedErr       bool
+	}{
+		{name: "Empty Outbox", messages: 0, expectedPublished: 0},
+		{name: "Publishes In Order", messages: 3, expectedPublished: 3},
+		{name: "Spans Batches", messages: DefaultBatchSize + 5, expectedPublished: DefaultBatchSize + 5},
+		{name: "Broker Down", messages: 3, failures: 1, expectedPublished: 0, expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemStore()
+			ids := newTestMessages(t, store, tt.messages)
+			broker := &flakyBroker{failures: tt.failures}
+			relay := NewRelay(store, broker, time.Second, logger)
+
+			n, err := relay.Flush(ctx)
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expectedPublished, n)
+			require.Len(t, broker.published, tt.expectedPublished)
+			for i, id := range broker.published {
+				require.Equal(t, ids[i], id)
+			}
+
+			pending, err := store.Pending(ctx, len(ids)+1)
+			require.NoError(t, err)
+			require.Len(t, pending, tt.messages-tt.expectedPublished)
+		})
+	}
+}
+
+func TestRelay_RetriesFailedMessages(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	ctx := context.Background()
+
+	store := NewMemStore()
+	ids := newTestMessages(t, store, 2)
+	broker := &flakyBroker{failures: 1}
+	relay := NewRelay(store, broker, time.Second, logger)
+
+	_, err := relay.Flush(ctx)
+	require.Error(t, err)
+
+	n, err := relay.Flush(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, ids, broker.published)
+}
+
+func TestRelay_Run(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	store := NewMemStore()
+	broker := NewInProcessBroker()
+	received := make(chan Message, 1)
+	broker.Subscribe("google.protobuf.Int32Value", func(_ context.Context, msg Message) error {
+		received <- msg
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		NewRelay(store, broker, 10*time.Millisecond, logger).Run(ctx)
+		close(done)
+	}()
+
+	ids := newTestMessages(t, store, 1)
+	select {
+	case msg := <-received:
+		require.Equal(t, ids[0], msg.ID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message was not relayed")
+	}
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang_falcon_task/user-service/internal/grpcerr"
+	"github.com/golang_falcon_task/user-service/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// storeErrorMapping describes how a typed store error surfaces over gRPC.
+type storeErrorMapping struct {
+	target    error
+	code      codes.Code
+	reason    string
+	retryable bool
+}
+
+var storeErrorMappings = []storeErrorMapping{
+	{store.ErrUserNotFound, codes.NotFound, grpcerr.ReasonUserNotFound, false},
+	{store.ErrInsufficientFunds, codes.FailedPrecondition, grpcerr.ReasonInsufficientFunds, false},
+	{store.ErrHoldNotFound, codes.NotFound, grpcerr.ReasonHoldNotFound, false},
+	{store.ErrHoldNotHeld, codes.FailedPrecondition, grpcerr.ReasonHoldNotHeld, false},
+	{store.ErrInvalidCapture, codes.InvalidArgument, grpcerr.ReasonInvalidCapture, false},
+	{store.ErrHoldNotCaptured, codes.FailedPrecondition, grpcerr.ReasonHoldNotCaptured, false},
+	{store.ErrInvalidRefund, codes.InvalidArgument, grpcerr.ReasonInvalidRefund, false},
+	{store.ErrAlreadyExists, codes.AlreadyExists, grpcerr.ReasonAlreadyExists, false},
+	{store.ErrForeignKeyViolation, codes.FailedPrecondition, grpcerr.ReasonForeignKeyViolation, false},
+	{store.ErrSerializationFailure, codes.Aborted, grpcerr.ReasonSerializationFailure, true},
+	{store.ErrTimeout, codes.DeadlineExceeded, grpcerr.ReasonDatabaseTimeout, true},
+	{store.ErrCanceled, codes.Canceled, grpcerr.ReasonCanceled, false},
+}
+
+// storeError converts an error returned by the UserStore into a gRPC status
+// error carrying an ErrorInfo detail, plus RetryInfo when the failure is transient.
+// msg describes the failed operation and prefixes the status message.
+func storeError(err error, msg string) error {
+	code, reason, retryable := codes.Internal, grpcerr.ReasonDatabaseError, false
+	for _, m := range storeErrorMappings {
+		if errors.Is(err, m.target) {
+			code, reason, retryable = m.code, m.reason, m.retryable
+			break
+		}
+	}
+
+	var details []protoadapt.MessageV1
+	if retryable {
+		details = append(details, grpcerr.Retry(grpcerr.DefaultRetryDelay))
+	}
+	return grpcerr.New(code, reason, fmt.Sprintf("%s: %v", msg, err), details...)
+}
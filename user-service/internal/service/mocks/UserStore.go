@@ -7,6 +7,10 @@ import (
 
 	model "github.com/golang_falcon_task/user-service/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	money "github.com/golang_falcon_task/user-service/internal/money"
+
+	time "time"
 )
 
 // UserStore is an autogenerated mock type for the UserStore type
@@ -14,6 +18,36 @@ type UserStore struct {
 	mock.Mock
 }
 
+// CaptureHold provides a mock function with given fields: ctx, holdID, amount, now
+func (_m *UserStore) CaptureHold(ctx context.Context, holdID int32, amount money.Money, now time.Time) (*model.Hold, error) {
+	ret := _m.Called(ctx, holdID, amount, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CaptureHold")
+	}
+
+	var r0 *model.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, money.Money, time.Time) (*model.Hold, error)); ok {
+		return rf(ctx, holdID, amount, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, money.Money, time.Time) *model.Hold); ok {
+		r0 = rf(ctx, holdID, amount, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, money.Money, time.Time) error); ok {
+		r1 = rf(ctx, holdID, amount, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateUser provides a mock function with given fields: ctx, name
 func (_m *UserStore) CreateUser(ctx context.Context, name string) (int32, error) {
 	ret := _m.Called(ctx, name)
@@ -90,6 +124,186 @@ func (_m *UserStore) GetUser(ctx context.Context, id int32) (*model.User, error)
 	return r0, r1
 }
 
+// GetWallet provides a mock function with given fields: ctx, userID
+func (_m *UserStore) GetWallet(ctx context.Context, userID int32) ([]model.WalletBalance, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWallet")
+	}
+
+	var r0 []model.WalletBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]model.WalletBalance, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []model.WalletBalance); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WalletBalance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HoldFunds provides a mock function with given fields: ctx, userID, amount, reference, now
+func (_m *UserStore) HoldFunds(ctx context.Context, userID int32, amount money.Money, reference string, now time.Time) (*model.Hold, error) {
+	ret := _m.Called(ctx, userID, amount, reference, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HoldFunds")
+	}
+
+	var r0 *model.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, money.Money, string, time.Time) (*model.Hold, error)); ok {
+		return rf(ctx, userID, amount, reference, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, money.Money, string, time.Time) *model.Hold); ok {
+		r0 = rf(ctx, userID, amount, reference, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, money.Money, string, time.Time) error); ok {
+		r1 = rf(ctx, userID, amount, reference, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWalletTransactions provides a mock function with given fields: ctx, userID, beforeID, limit
+func (_m *UserStore) ListWalletTransactions(ctx context.Context, userID int32, beforeID int64, limit int) ([]model.WalletTransaction, error) {
+	ret := _m.Called(ctx, userID, beforeID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWalletTransactions")
+	}
+
+	var r0 []model.WalletTransaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int64, int) ([]model.WalletTransaction, error)); ok {
+		return rf(ctx, userID, beforeID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int64, int) []model.WalletTransaction); ok {
+		r0 = rf(ctx, userID, beforeID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WalletTransaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int64, int) error); ok {
+		r1 = rf(ctx, userID, beforeID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefundHold provides a mock function with given fields: ctx, holdID, refunded, now
+func (_m *UserStore) RefundHold(ctx context.Context, holdID int32, refunded money.Money, now time.Time) (*model.Hold, error) {
+	ret := _m.Called(ctx, holdID, refunded, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundHold")
+	}
+
+	var r0 *model.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, money.Money, time.Time) (*model.Hold, error)); ok {
+		return rf(ctx, holdID, refunded, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, money.Money, time.Time) *model.Hold); ok {
+		r0 = rf(ctx, holdID, refunded, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, money.Money, time.Time) error); ok {
+		r1 = rf(ctx, holdID, refunded, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseHold provides a mock function with given fields: ctx, holdID, now
+func (_m *UserStore) ReleaseHold(ctx context.Context, holdID int32, now time.Time) (*model.Hold, error) {
+	ret := _m.Called(ctx, holdID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseHold")
+	}
+
+	var r0 *model.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time) (*model.Hold, error)); ok {
+		return rf(ctx, holdID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time) *model.Hold); ok {
+		r0 = rf(ctx, holdID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, time.Time) error); ok {
+		r1 = rf(ctx, holdID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TopUp provides a mock function with given fields: ctx, userID, amount, now
+func (_m *UserStore) TopUp(ctx context.Context, userID int32, amount money.Money, now time.Time) (*model.WalletTransaction, error) {
+	ret := _m.Called(ctx, userID, amount, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TopUp")
+	}
+
+	var r0 *model.WalletTransaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, money.Money, time.Time) (*model.WalletTransaction, error)); ok {
+		return rf(ctx, userID, amount, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, money.Money, time.Time) *model.WalletTransaction); ok {
+		r0 = rf(ctx, userID, amount, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WalletTransaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, money.Money, time.Time) error); ok {
+		r1 = rf(ctx, userID, amount, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewUserStore creates a new instance of UserStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserStore(t interface {
@@ -4,12 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/golang_falcon_task/user-service/internal/grpcerr"
 	"github.com/golang_falcon_task/user-service/internal/model"
+	"github.com/golang_falcon_task/user-service/internal/money"
 	"github.com/golang_falcon_task/user-service/internal/store"
 	pb "github.com/golang_falcon_task/user-service/proto/user/v1"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"time"
 )
 
 // UserStore defines the interface for user-related operations.
@@ -17,6 +18,13 @@ type UserStore interface {
 	GetUser(ctx context.Context, id int32) (*model.User, error)
 	CreateUser(ctx context.Context, name string) (int32, error)
 	DeleteUser(ctx context.Context, id int32) error
+	TopUp(ctx context.Context, userID int32, amount money.Money, now time.Time) (*model.WalletTransaction, error)
+	GetWallet(ctx context.Context, userID int32) ([]model.WalletBalance, error)
+	ListWalletTransactions(ctx context.Context, userID int32, beforeID int64, limit int) ([]model.WalletTransaction, error)
+	HoldFunds(ctx context.Context, userID int32, amount money.Money, reference string, now time.Time) (*model.Hold, error)
+	CaptureHold(ctx context.Context, holdID int32, amount money.Money, now time.Time) (*model.Hold, error)
+	ReleaseHold(ctx context.Context, holdID int32, now time.Time) (*model.Hold, error)
+	RefundHold(ctx context.Context, holdID int32, refunded money.Money, now time.Time) (*model.Hold, error)
 }
 
 type UserService struct {
@@ -31,16 +39,20 @@ func NewUserService(store UserStore, logger *logrus.Logger) *UserService {
 }
 
 func (s *UserService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	if req.UserId <= 0 {
+		s.log.Error("Invalid user_id: must be a positive integer", "user_id", req.UserId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("user_id", "must be a positive integer"))
+	}
+
 	user, err := s.store.GetUser(ctx, req.UserId)
 	if err != nil {
 		switch {
 		case errors.Is(err, store.ErrUserNotFound):
 			s.log.Warn(fmt.Sprintf("user with id %d not found: ", req.UserId), err)
-			return nil, status.Errorf(codes.NotFound, "user with id %d not found", req.UserId)
 		default:
 			s.log.Error("Failed to get User: ", err)
-			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 		}
+		return nil, storeError(err, fmt.Sprintf("failed to get user with id %d", req.UserId))
 	}
 	return &pb.GetUserResponse{Name: user.Name}, nil
 }
@@ -49,22 +61,26 @@ func (s *UserService) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 	userID, err := s.store.CreateUser(ctx, req.Name)
 	if err != nil {
 		s.log.Error("Failed to create User: ", err)
-		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
+		return nil, storeError(err, "failed to create user")
 	}
 	return &pb.CreateUserResponse{UserId: userID}, nil
 }
 
 func (s *UserService) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	if req.UserId <= 0 {
+		s.log.Error("Invalid user_id: must be a positive integer", "user_id", req.UserId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("user_id", "must be a positive integer"))
+	}
+
 	err := s.store.DeleteUser(ctx, req.UserId)
 	if err != nil {
 		switch {
 		case errors.Is(err, store.ErrUserNotFound):
 			s.log.Warn(fmt.Sprintf("user with id %d not found: ", req.UserId), err)
-			return nil, status.Errorf(codes.NotFound, "user with id %d not found", req.UserId)
 		default:
 			s.log.Error(fmt.Sprintf("Failed to Delete User with id %d: ", req.UserId), err)
-			return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 		}
+		return nil, storeError(err, fmt.Sprintf("failed to delete user with id %d", req.UserId))
 	}
 	return &pb.DeleteUserResponse{Message: "User deleted successfully"}, nil
 }
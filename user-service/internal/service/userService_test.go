@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/golang_falcon_task/user-service/internal/grpcerr"
 	"github.com/golang_falcon_task/user-service/internal/model"
 	"github.com/golang_falcon_task/user-service/internal/service/mocks"
 	"github.com/golang_falcon_task/user-service/internal/store"
@@ -10,6 +12,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"testing"
@@ -40,6 +43,13 @@ func TestUserService_GetUser(t *testing.T) {
 			expectedCode: codes.OK,
 			expectedName: "John Doe",
 		},
+		{
+			name:         "Invalid User ID",
+			userID:       0,
+			setupMock:    func() {},
+			expectedCode: codes.InvalidArgument,
+			expectedName: "",
+		},
 		{
 			name:   "User Not Found",
 			userID: 2,
@@ -159,6 +169,22 @@ func TestUserService_DeleteUser(t *testing.T) {
 			},
 			expectedCode: codes.NotFound,
 		},
+		{
+			name:   "User Still Referenced",
+			userID: 4,
+			setupMock: func() {
+				mockStore.On("DeleteUser", mock.Anything, int32(4)).Return(fmt.Errorf("%w: bookings reference user", store.ErrForeignKeyViolation))
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:   "Serialization Failure",
+			userID: 5,
+			setupMock: func() {
+				mockStore.On("DeleteUser", mock.Anything, int32(5)).Return(fmt.Errorf("%w: concurrent update", store.ErrSerializationFailure))
+			},
+			expectedCode: codes.Aborted,
+		},
 		{
 			name:   "Internal Error",
 			userID: 3,
@@ -186,3 +212,26 @@ func TestUserService_DeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreError_Details(t *testing.T) {
+	err := storeError(fmt.Errorf("%w: concurrent update", store.ErrSerializationFailure), "failed to delete user")
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.Aborted, st.Code())
+
+	var info *errdetails.ErrorInfo
+	var retry *errdetails.RetryInfo
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.RetryInfo:
+			retry = d
+		}
+	}
+	require.NotNil(t, info)
+	require.Equal(t, "SERIALIZATION_FAILURE", info.Reason)
+	require.Equal(t, grpcerr.Domain, info.Domain)
+	require.NotNil(t, retry)
+}
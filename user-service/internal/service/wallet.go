@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang_falcon_task/user-service/internal/grpcerr"
+	"github.com/golang_falcon_task/user-service/internal/money"
+	"github.com/golang_falcon_task/user-service/internal/store"
+	pb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+// Wallet transaction page sizes.
+const (
+	defaultTransactionPageSize = 20
+	maxTransactionPageSize     = 100
+)
+
+// TopUpWallet adds money to a user's wallet, opening a balance in its
+// currency if the user has none. It charges nothing itself: callers credit
+// the wallet only once the rider was charged.
+func (s *UserService) TopUpWallet(ctx context.Context, req *pb.TopUpWalletRequest) (*pb.TopUpWalletResponse, error) {
+	// Input validation
+	if req.UserId <= 0 {
+		s.log.Error("Invalid user_id: must be a positive integer", "user_id", req.UserId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("user_id", "must be a positive integer"))
+	}
+	amount, err := positiveAmount("amount", req.Amount)
+	if err != nil {
+		s.log.Error("Invalid top-up amount", "user_id", req.UserId, "error", err.Error())
+		return nil, err
+	}
+
+	tx, err := s.store.TopUp(ctx, req.UserId, amount, time.Now())
+	if err != nil {
+		s.log.Error("Failed to top up wallet", "user_id", req.UserId, "amount", amount.String(), "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to top up wallet of user %d", req.UserId))
+	}
+	balances, err := s.store.GetWallet(ctx, req.UserId)
+	if err != nil {
+		s.log.Error("Failed to fetch wallet", "user_id", req.UserId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to fetch wallet of user %d", req.UserId))
+	}
+
+	s.log.Info("Wallet topped up", "user_id", req.UserId, "amount", amount.String(), "transaction_id", tx.ID)
+	return &pb.TopUpWalletResponse{
+		Wallet:      store.WalletToProto(req.UserId, balances),
+		Transaction: store.WalletTransactionToProto(tx),
+	}, nil
+}
+
+// GetWallet returns a user's wallet balances. A user who never topped up has
+// none.
+func (s *UserService) GetWallet(ctx context.Context, req *pb.GetWalletRequest) (*pb.GetWalletResponse, error) {
+	// Input validation
+	if req.UserId <= 0 {
+		s.log.Error("Invalid user_id: must be a positive integer", "user_id", req.UserId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("user_id", "must be a positive integer"))
+	}
+
+	balances, err := s.store.GetWallet(ctx, req.UserId)
+	if err != nil {
+		s.log.Error("Failed to fetch wallet", "user_id", req.UserId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to fetch wallet of user %d", req.UserId))
+	}
+	return &pb.GetWalletResponse{Wallet: store.WalletToProto(req.UserId, balances)}, nil
+}
+
+// ListWalletTransactions lists the transactions of a user's wallet, newest
+// first, a page at a time. A page token is the ID of the last transaction
+// listed.
+func (s *UserService) ListWalletTransactions(ctx context.Context, req *pb.ListWalletTransactionsRequest) (*pb.ListWalletTransactionsResponse, error) {
+	// Input validation
+	if req.UserId <= 0 {
+		s.log.Error("Invalid user_id: must be a positive integer", "user_id", req.UserId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("user_id", "must be a positive integer"))
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 0 || pageSize > maxTransactionPageSize {
+		s.log.Error("Invalid page_size", "page_size", req.PageSize)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("page_size", fmt.Sprintf("must be between 0 and %d", maxTransactionPageSize)))
+	}
+	if pageSize == 0 {
+		pageSize = defaultTransactionPageSize
+	}
+	var before int64
+	if req.PageToken != "" {
+		var err error
+		if before, err = strconv.ParseInt(req.PageToken, 10, 64); err != nil || before <= 0 {
+			s.log.Error("Invalid page_token", "page_token", req.PageToken)
+			return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("page_token", "must be a next_page_token returned by ListWalletTransactions"))
+		}
+	}
+
+	// Fetch one transaction more than a page to tell whether another page
+	// follows.
+	transactions, err := s.store.ListWalletTransactions(ctx, req.UserId, before, pageSize+1)
+	if err != nil {
+		s.log.Error("Failed to list wallet transactions", "user_id", req.UserId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to list wallet transactions of user %d", req.UserId))
+	}
+
+	res := &pb.ListWalletTransactionsResponse{Transactions: make([]*pb.WalletTransaction, 0, min(len(transactions), pageSize))}
+	if len(transactions) > pageSize {
+		transactions = transactions[:pageSize]
+		res.NextPageToken = strconv.FormatInt(transactions[pageSize-1].ID, 10)
+	}
+	for i := range transactions {
+		res.Transactions = append(res.Transactions, store.WalletTransactionToProto(&transactions[i]))
+	}
+	return res, nil
+}
+
+// HoldFunds sets money in a user's wallet aside, failing with
+// INSUFFICIENT_FUNDS unless that much is available. Holds are idempotent:
+// holding again with the reference of an existing hold returns that hold.
+func (s *UserService) HoldFunds(ctx context.Context, req *pb.HoldFundsRequest) (*pb.HoldFundsResponse, error) {
+	// Input validation
+	if req.UserId <= 0 {
+		s.log.Error("Invalid user_id: must be a positive integer", "user_id", req.UserId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("user_id", "must be a positive integer"))
+	}
+	if req.Reference == "" {
+		s.log.Error("Invalid reference: must be provided", "user_id", req.UserId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("reference", "must be provided"))
+	}
+	amount, err := positiveAmount("amount", req.Amount)
+	if err != nil {
+		s.log.Error("Invalid hold amount", "user_id", req.UserId, "error", err.Error())
+		return nil, err
+	}
+
+	hold, err := s.store.HoldFunds(ctx, req.UserId, amount, req.Reference, time.Now())
+	if err != nil {
+		s.log.Error("Failed to hold funds", "user_id", req.UserId, "amount", amount.String(), "reference", req.Reference, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to hold %s in wallet of user %d", amount, req.UserId))
+	}
+
+	s.log.Info("Funds held", "hold_id", hold.ID, "user_id", hold.UserID, "amount", amount.String(), "reference", hold.Reference)
+	return &pb.HoldFundsResponse{Hold: store.HoldToProto(hold)}, nil
+}
+
+// CaptureHold takes held money out of the wallet, all of it unless the
+// request names a smaller amount, and releases the rest. Capturing a
+// captured hold again for the same amount returns it.
+func (s *UserService) CaptureHold(ctx context.Context, req *pb.CaptureHoldRequest) (*pb.CaptureHoldResponse, error) {
+	// Input validation
+	if req.HoldId <= 0 {
+		s.log.Error("Invalid hold_id: must be a positive integer", "hold_id", req.HoldId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("hold_id", "must be a positive integer"))
+	}
+	var amount money.Money
+	if req.Amount != nil {
+		var err error
+		if amount, err = positiveAmount("amount", req.Amount); err != nil {
+			s.log.Error("Invalid capture amount", "hold_id", req.HoldId, "error", err.Error())
+			return nil, err
+		}
+	}
+
+	hold, err := s.store.CaptureHold(ctx, req.HoldId, amount, time.Now())
+	if err != nil {
+		s.log.Error("Failed to capture hold", "hold_id", req.HoldId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to capture hold %d", req.HoldId))
+	}
+
+	s.log.Info("Hold captured", "hold_id", hold.ID, "user_id", hold.UserID, "captured", hold.Captured.String())
+	return &pb.CaptureHoldResponse{Hold: store.HoldToProto(hold)}, nil
+}
+
+// ReleaseHold gives held money back to the wallet. Releasing a released hold
+// again returns it.
+func (s *UserService) ReleaseHold(ctx context.Context, req *pb.ReleaseHoldRequest) (*pb.ReleaseHoldResponse, error) {
+	// Input validation
+	if req.HoldId <= 0 {
+		s.log.Error("Invalid hold_id: must be a positive integer", "hold_id", req.HoldId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("hold_id", "must be a positive integer"))
+	}
+
+	hold, err := s.store.ReleaseHold(ctx, req.HoldId, time.Now())
+	if err != nil {
+		s.log.Error("Failed to release hold", "hold_id", req.HoldId, "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to release hold %d", req.HoldId))
+	}
+
+	s.log.Info("Hold released", "hold_id", hold.ID, "user_id", hold.UserID, "amount", hold.Amount.String())
+	return &pb.ReleaseHoldResponse{Hold: store.HoldToProto(hold)}, nil
+}
+
+// RefundHold gives captured money back to the wallet until the requested
+// total is refunded of the capture. Refunding again with the same total
+// refunds nothing more, so a retry cannot refund twice.
+func (s *UserService) RefundHold(ctx context.Context, req *pb.RefundHoldRequest) (*pb.RefundHoldResponse, error) {
+	// Input validation
+	if req.HoldId <= 0 {
+		s.log.Error("Invalid hold_id: must be a positive integer", "hold_id", req.HoldId)
+		return nil, grpcerr.InvalidArgument(grpcerr.FieldViolation("hold_id", "must be a positive integer"))
+	}
+	refunded, err := positiveAmount("refunded", req.Refunded)
+	if err != nil {
+		s.log.Error("Invalid refund amount", "hold_id", req.HoldId, "error", err.Error())
+		return nil, err
+	}
+
+	hold, err := s.store.RefundHold(ctx, req.HoldId, refunded, time.Now())
+	if err != nil {
+		s.log.Error("Failed to refund hold", "hold_id", req.HoldId, "refunded", refunded.String(), "error", err.Error())
+		return nil, storeError(err, fmt.Sprintf("failed to refund hold %d", req.HoldId))
+	}
+
+	s.log.Info("Hold refunded", "hold_id", hold.ID, "user_id", hold.UserID, "refunded", hold.Refunded.String())
+	return &pb.RefundHoldResponse{Hold: store.HoldToProto(hold)}, nil
+}
+
+// positiveAmount converts an API amount, returning an InvalidArgument error
+// for field unless it is a positive amount in a known currency.
+func positiveAmount(field string, p *moneypb.Money) (money.Money, error) {
+	if p == nil {
+		return money.Money{}, grpcerr.InvalidArgument(grpcerr.FieldViolation(field, "must be provided"))
+	}
+	amount, err := money.FromProto(p)
+	if err != nil {
+		return money.Money{}, grpcerr.InvalidArgument(grpcerr.FieldViolation(field, err.Error()))
+	}
+	if amount.Minor <= 0 {
+		return money.Money{}, grpcerr.InvalidArgument(grpcerr.FieldViolation(field, "must be positive"))
+	}
+	return amount, nil
+}
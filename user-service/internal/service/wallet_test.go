@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/user-service/internal/model"
+	"github.com/golang_falcon_task/user-service/internal/money"
+	"github.com/golang_falcon_task/user-service/internal/service/mocks"
+	"github.com/golang_falcon_task/user-service/internal/store"
+	pb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func pkr(minor int64) money.Money {
+	return money.Money{Currency: "PKR", Minor: minor}
+}
+
+func TestUserService_TopUpWallet(t *testing.T) {
+	tests := []struct {
+		name         string
+		userID       int32
+		amount       *moneypb.Money
+		topUpErr     error
+		expectedCode codes.Code
+	}{
+		{name: "Success", userID: 1, amount: &moneypb.Money{CurrencyCode: "PKR", Units: 500}, expectedCode: codes.OK},
+		{name: "Invalid User ID", userID: 0, amount: &moneypb.Money{CurrencyCode: "PKR", Units: 500}, expectedCode: codes.InvalidArgument},
+		{name: "Missing Amount", userID: 1, expectedCode: codes.InvalidArgument},
+		{name: "Negative Amount", userID: 1, amount: &moneypb.Money{CurrencyCode: "PKR", Units: -5}, expectedCode: codes.InvalidArgument},
+		{name: "Unknown Currency", userID: 1, amount: &moneypb.Money{CurrencyCode: "XYZ", Units: 5}, expectedCode: codes.InvalidArgument},
+		{name: "User Not Found", userID: 2, amount: &moneypb.Money{CurrencyCode: "PKR", Units: 500}, topUpErr: store.ErrUserNotFound,
+			expectedCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.UserStore)
+			mockStore.On("TopUp", mock.Anything, tt.userID, pkr(50000), mock.Anything).
+				Return(&model.WalletTransaction{ID: 1, UserID: tt.userID, Kind: model.TransactionTopUp, Amount: pkr(50000)}, tt.topUpErr).Maybe()
+			mockStore.On("GetWallet", mock.Anything, tt.userID).
+				Return([]model.WalletBalance{{Balance: pkr(80000), Held: pkr(30000)}}, nil).Maybe()
+			service := NewUserService(mockStore, logrus.New())
+
+			res, err := service.TopUpWallet(context.Background(), &pb.TopUpWalletRequest{UserId: tt.userID, Amount: tt.amount})
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, pb.WalletTransactionKind_WALLET_TRANSACTION_KIND_TOP_UP, res.Transaction.Kind)
+			require.Len(t, res.Wallet.Balances, 1)
+			require.Equal(t, int64(800), res.Wallet.Balances[0].Balance.Units)
+			require.Equal(t, int64(500), res.Wallet.Balances[0].Available.Units)
+		})
+	}
+}
+
+func TestUserService_HoldFunds(t *testing.T) {
+	tests := []struct {
+		name         string
+		reference    string
+		amount       *moneypb.Money
+		holdErr      error
+		expectedCode codes.Code
+		reason       string
+	}{
+		{name: "Success", reference: "booking-1", amount: &moneypb.Money{CurrencyCode: "PKR", Units: 600}, expectedCode: codes.OK},
+		{name: "Missing Reference", amount: &moneypb.Money{CurrencyCode: "PKR", Units: 600}, expectedCode: codes.InvalidArgument},
+		{name: "Zero Amount", reference: "booking-1", amount: &moneypb.Money{CurrencyCode: "PKR"}, expectedCode: codes.InvalidArgument},
+		{name: "Insufficient Funds", reference: "booking-1", amount: &moneypb.Money{CurrencyCode: "PKR", Units: 600},
+			holdErr: store.ErrInsufficientFunds, expectedCode: codes.FailedPrecondition, reason: "INSUFFICIENT_FUNDS"},
+		{name: "Reference Reused", reference: "booking-1", amount: &moneypb.Money{CurrencyCode: "PKR", Units: 600},
+			holdErr: fmt.Errorf("%w: different amount", store.ErrAlreadyExists), expectedCode: codes.AlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.UserStore)
+			hold := &model.Hold{ID: 7, UserID: 1, Amount: pkr(60000), Status: model.HoldHeld, Reference: "booking-1", CreatedAt: time.Now()}
+			if tt.holdErr != nil {
+				hold = nil
+			}
+			mockStore.On("HoldFunds", mock.Anything, int32(1), pkr(60000), "booking-1", mock.Anything).Return(hold, tt.holdErr).Maybe()
+			service := NewUserService(mockStore, logrus.New())
+
+			res, err := service.HoldFunds(context.Background(), &pb.HoldFundsRequest{UserId: 1, Amount: tt.amount, Reference: tt.reference})
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				if tt.reason != "" {
+					info, ok := status.Convert(err).Details()[0].(*errdetails.ErrorInfo)
+					require.True(t, ok)
+					require.Equal(t, tt.reason, info.Reason)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, int32(7), res.Hold.HoldId)
+			require.Equal(t, pb.HoldStatus_HOLD_STATUS_HELD, res.Hold.Status)
+			require.Nil(t, res.Hold.Captured)
+		})
+	}
+}
+
+func TestUserService_CaptureHold(t *testing.T) {
+	captured := &model.Hold{ID: 7, UserID: 1, Amount: pkr(60000), Captured: pkr(60000), Status: model.HoldCaptured}
+
+	tests := []struct {
+		name           string
+		amount         *moneypb.Money
+		expectedAmount money.Money
+		captureErr     error
+		expectedCode   codes.Code
+	}{
+		{name: "Whole Hold", expectedAmount: money.Money{}, expectedCode: codes.OK},
+		{name: "Part Of Hold", amount: &moneypb.Money{CurrencyCode: "PKR", Units: 450}, expectedAmount: pkr(45000), expectedCode: codes.OK},
+		{name: "Negative Amount", amount: &moneypb.Money{CurrencyCode: "PKR", Units: -1}, expectedCode: codes.InvalidArgument},
+		{name: "More Than Hold", amount: &moneypb.Money{CurrencyCode: "PKR", Units: 700}, expectedAmount: pkr(70000),
+			captureErr: store.ErrInvalidCapture, expectedCode: codes.InvalidArgument},
+		{name: "Released", expectedAmount: money.Money{}, captureErr: store.ErrHoldNotHeld, expectedCode: codes.FailedPrecondition},
+		{name: "Not Found", expectedAmount: money.Money{}, captureErr: store.ErrHoldNotFound, expectedCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.UserStore)
+			hold := captured
+			if tt.captureErr != nil {
+				hold = nil
+			}
+			mockStore.On("CaptureHold", mock.Anything, int32(7), tt.expectedAmount, mock.Anything).Return(hold, tt.captureErr).Maybe()
+			service := NewUserService(mockStore, logrus.New())
+
+			res, err := service.CaptureHold(context.Background(), &pb.CaptureHoldRequest{HoldId: 7, Amount: tt.amount})
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, pb.HoldStatus_HOLD_STATUS_CAPTURED, res.Hold.Status)
+			require.Equal(t, int64(600), res.Hold.Captured.Units)
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserService_ListWalletTransactions(t *testing.T) {
+	transactions := func(ids ...int64) []model.WalletTransaction {
+		var res []model.WalletTransaction
+		for _, id := range ids {
+			res = append(res, model.WalletTransaction{ID: id, UserID: 1, Kind: model.TransactionTopUp, Amount: pkr(100)})
+		}
+		return res
+	}
+
+	tests := []struct {
+		name              string
+		pageSize          int32
+		pageToken         string
+		expectedBefore    int64
+		expectedLimit     int
+		stored            []model.WalletTransaction
+		expectedIDs       []int64
+		expectedNextToken string
+		expectedCode      codes.Code
+	}{
+		{name: "Default Page Size", expectedLimit: 21, stored: transactions(3, 2, 1), expectedIDs: []int64{3, 2, 1}, expectedCode: codes.OK},
+		{name: "More Pages", pageSize: 2, expectedLimit: 3, stored: transactions(3, 2, 1), expectedIDs: []int64{3, 2},
+			expectedNextToken: "2", expectedCode: codes.OK},
+		{name: "Next Page", pageSize: 2, pageToken: "2", expectedBefore: 2, expectedLimit: 3, stored: transactions(1),
+			expectedIDs: []int64{1}, expectedCode: codes.OK},
+		{name: "Invalid Page Token", pageToken: "abc", expectedCode: codes.InvalidArgument},
+		{name: "Page Size Too Large", pageSize: 101, expectedCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.UserStore)
+			mockStore.On("ListWalletTransactions", mock.Anything, int32(1), tt.expectedBefore, tt.expectedLimit).Return(tt.stored, nil).Maybe()
+			service := NewUserService(mockStore, logrus.New())
+
+			res, err := service.ListWalletTransactions(context.Background(), &pb.ListWalletTransactionsRequest{
+				UserId:    1,
+				PageSize:  tt.pageSize,
+				PageToken: tt.pageToken,
+			})
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				mockStore.AssertNotCalled(t, "ListWalletTransactions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			var ids []int64
+			for _, tx := range res.Transactions {
+				ids = append(ids, tx.TransactionId)
+			}
+			require.Equal(t, tt.expectedIDs, ids)
+			require.Equal(t, tt.expectedNextToken, res.NextPageToken)
+		})
+	}
+}
+
+func TestUserService_RefundHold(t *testing.T) {
+	tests := []struct {
+		name         string
+		refunded     *moneypb.Money
+		refundErr    error
+		expectedCode codes.Code
+	}{
+		{name: "Success", refunded: &moneypb.Money{CurrencyCode: "PKR", Units: 200}, expectedCode: codes.OK},
+		{name: "Missing Amount", expectedCode: codes.InvalidArgument},
+		{name: "More Than Captured", refunded: &moneypb.Money{CurrencyCode: "PKR", Units: 200}, refundErr: store.ErrInvalidRefund,
+			expectedCode: codes.InvalidArgument},
+		{name: "Not Captured", refunded: &moneypb.Money{CurrencyCode: "PKR", Units: 200}, refundErr: store.ErrHoldNotCaptured,
+			expectedCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(mocks.UserStore)
+			hold := &model.Hold{ID: 7, UserID: 1, Amount: pkr(60000), Captured: pkr(60000), Refunded: pkr(20000), Status: model.HoldCaptured}
+			if tt.refundErr != nil {
+				hold = nil
+			}
+			mockStore.On("RefundHold", mock.Anything, int32(7), pkr(20000), mock.Anything).Return(hold, tt.refundErr).Maybe()
+			service := NewUserService(mockStore, logrus.New())
+
+			res, err := service.RefundHold(context.Background(), &pb.RefundHoldRequest{HoldId: 7, Refunded: tt.refunded})
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, int64(200), res.Hold.Refunded.Units)
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
@@ -5,5 +5,43 @@ import "errors"
 // ErrUserNotFound is returned when a user is not found in the database.
 var ErrUserNotFound = errors.New("user not found")
 
+// ErrInsufficientFunds is returned when a wallet does not have the money
+// available for a hold.
+var ErrInsufficientFunds = errors.New("insufficient funds in wallet")
+
+// ErrHoldNotFound is returned when a wallet hold is not found in the database.
+var ErrHoldNotFound = errors.New("hold not found")
+
+// ErrHoldNotHeld is returned when capturing or releasing a hold that was
+// already settled the other way, or capturing it again for another amount.
+var ErrHoldNotHeld = errors.New("hold is no longer held")
+
+// ErrInvalidCapture is returned when capturing more than a hold, or in
+// another currency.
+var ErrInvalidCapture = errors.New("capture must not exceed the hold")
+
+// ErrHoldNotCaptured is returned when refunding a hold that was not captured.
+var ErrHoldNotCaptured = errors.New("hold was not captured")
+
+// ErrInvalidRefund is returned when refunding more than was captured of a
+// hold, or in another currency.
+var ErrInvalidRefund = errors.New("refund must not exceed the capture")
+
+// ErrAlreadyExists is returned when a write violates a unique constraint.
+var ErrAlreadyExists = errors.New("record already exists")
+
+// ErrForeignKeyViolation is returned when a write references a row that does not exist,
+// or a delete would orphan rows that still reference it.
+var ErrForeignKeyViolation = errors.New("foreign key violation")
+
+// ErrSerializationFailure is returned when a transaction conflicts with a concurrent one and can be retried.
+var ErrSerializationFailure = errors.New("serialization failure")
+
+// ErrTimeout is returned when a query exceeds its deadline or the statement timeout.
+var ErrTimeout = errors.New("database operation timed out")
+
+// ErrCanceled is returned when the caller cancels the query.
+var ErrCanceled = errors.New("database operation canceled")
+
 // ErrDatabaseOperation is returned for generic database operation errors.
 var ErrDatabaseOperation = errors.New("database operation failed")
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"time"
+
+	"github.com/golang_falcon_task/user-service/internal/model"
+	"github.com/golang_falcon_task/user-service/internal/outbox"
+	pb "github.com/golang_falcon_task/user-service/proto/user/v1"
+)
+
+// userDeleted encodes the UserDeleted event for a deleted user.
+func userDeleted(userID int32) (outbox.Message, error) {
+	return outbox.NewMessage(&pb.UserDeleted{UserId: userID})
+}
+
+var holdStatuses = map[string]pb.HoldStatus{
+	model.HoldHeld:     pb.HoldStatus_HOLD_STATUS_HELD,
+	model.HoldCaptured: pb.HoldStatus_HOLD_STATUS_CAPTURED,
+	model.HoldReleased: pb.HoldStatus_HOLD_STATUS_RELEASED,
+}
+
+var transactionKinds = map[string]pb.WalletTransactionKind{
+	model.TransactionTopUp:   pb.WalletTransactionKind_WALLET_TRANSACTION_KIND_TOP_UP,
+	model.TransactionHold:    pb.WalletTransactionKind_WALLET_TRANSACTION_KIND_HOLD,
+	model.TransactionCapture: pb.WalletTransactionKind_WALLET_TRANSACTION_KIND_CAPTURE,
+	model.TransactionRelease: pb.WalletTransactionKind_WALLET_TRANSACTION_KIND_RELEASE,
+	model.TransactionRefund:  pb.WalletTransactionKind_WALLET_TRANSACTION_KIND_REFUND,
+}
+
+// WalletToProto converts the wallet balances of a user to the API message.
+func WalletToProto(userID int32, balances []model.WalletBalance) *pb.Wallet {
+	w := &pb.Wallet{UserId: userID, Balances: make([]*pb.WalletBalance, 0, len(balances))}
+	for _, b := range balances {
+		w.Balances = append(w.Balances, &pb.WalletBalance{
+			Balance:   b.Balance.Proto(),
+			Held:      b.Held.Proto(),
+			Available: b.Available().Proto(),
+		})
+	}
+	return w
+}
+
+// HoldToProto converts a model hold to the API message.
+func HoldToProto(h *model.Hold) *pb.Hold {
+	res := &pb.Hold{
+		HoldId:    h.ID,
+		UserId:    h.UserID,
+		Amount:    h.Amount.Proto(),
+		Status:    holdStatuses[h.Status],
+		Reference: h.Reference,
+		CreatedAt: h.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: h.UpdatedAt.Format(time.RFC3339),
+	}
+	if h.Status == model.HoldCaptured {
+		res.Captured = h.Captured.Proto()
+	}
+	if !h.Refunded.IsZero() {
+		res.Refunded = h.Refunded.Proto()
+	}
+	return res
+}
+
+// WalletTransactionToProto converts a model wallet transaction to the API
+// message.
+func WalletTransactionToProto(t *model.WalletTransaction) *pb.WalletTransaction {
+	return &pb.WalletTransaction{
+		TransactionId: t.ID,
+		UserId:        t.UserID,
+		Kind:          transactionKinds[t.Kind],
+		Amount:        t.Amount.Proto(),
+		HoldId:        t.HoldID,
+		CreatedAt:     t.CreatedAt.Format(time.RFC3339),
+	}
+}
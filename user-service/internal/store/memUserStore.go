@@ -0,0 +1,350 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang_falcon_task/user-service/internal/model"
+	"github.com/golang_falcon_task/user-service/internal/money"
+	"github.com/golang_falcon_task/user-service/internal/outbox"
+)
+
+// MemUserStore is a thread-safe in-memory UserStore with the same semantics
+// as PGUserStore: auto-increment ids, ErrUserNotFound for unknown users and
+// events recorded in an outbox atomically with the writes. It is meant for
+// local runs and tests that should not need Postgres.
+type MemUserStore struct {
+	mu     sync.RWMutex
+	outbox *outbox.MemStore
+	users  map[int32]model.User
+	lastID int32
+
+	wallets      map[walletKey]*model.WalletBalance
+	holds        []model.Hold              // Index is the hold ID - 1
+	holdsByRef   map[holdRef]int32         // Hold ID by user and reference
+	transactions []model.WalletTransaction // Index is the transaction ID - 1
+}
+
+// walletKey identifies a user's balance in one currency.
+type walletKey struct {
+	userID   int32
+	currency string
+}
+
+type holdRef struct {
+	userID    int32
+	reference string
+}
+
+// NewMemUserStore creates an empty MemUserStore.
+func NewMemUserStore() *MemUserStore {
+	return &MemUserStore{
+		users:      make(map[int32]model.User),
+		outbox:     outbox.NewMemStore(),
+		wallets:    make(map[walletKey]*model.WalletBalance),
+		holdsByRef: make(map[holdRef]int32),
+	}
+}
+
+// Outbox returns the outbox the store records events in.
+func (s *MemUserStore) Outbox() *outbox.MemStore {
+	return s.outbox
+}
+
+// GetUser retrieves a user by ID.
+func (s *MemUserStore) GetUser(ctx context.Context, id int32) (*model.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrUserNotFound)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	return &user, nil
+}
+
+// CreateUser creates a new user and returns their ID.
+func (s *MemUserStore) CreateUser(ctx context.Context, name string) (int32, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastID++
+	s.users[s.lastID] = model.User{ID: s.lastID, Name: name}
+	return s.lastID, nil
+}
+
+// DeleteUser deletes a user by ID and records a UserDeleted event.
+func (s *MemUserStore) DeleteUser(ctx context.Context, id int32) error {
+	if err := ctx.Err(); err != nil {
+		return translateError(err, ErrUserNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[id]; !ok {
+		return ErrUserNotFound
+	}
+	for key := range s.wallets {
+		if key.userID == id {
+			return fmt.Errorf("%w: user %d has a wallet", ErrForeignKeyViolation, id)
+		}
+	}
+	msg, err := userDeleted(id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+	s.outbox.Add(msg)
+	delete(s.users, id)
+	return nil
+}
+
+// TopUp adds amount to the user's wallet.
+func (s *MemUserStore) TopUp(ctx context.Context, userID int32, amount money.Money, now time.Time) (*model.WalletTransaction, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return nil, ErrUserNotFound
+	}
+	key := walletKey{userID, amount.Currency}
+	wallet, ok := s.wallets[key]
+	if !ok {
+		wallet = &model.WalletBalance{Balance: money.Zero(amount.Currency), Held: money.Zero(amount.Currency)}
+	}
+	balance, err := wallet.Balance.Add(amount)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+	}
+	wallet.Balance = balance
+	s.wallets[key] = wallet
+	tx := s.addTransaction(userID, model.TransactionTopUp, amount, 0, now)
+	return &tx, nil
+}
+
+// GetWallet returns the user's balance in each currency topped up, sorted by
+// currency.
+func (s *MemUserStore) GetWallet(ctx context.Context, userID int32) ([]model.WalletBalance, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrUserNotFound)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return nil, ErrUserNotFound
+	}
+	balances := []model.WalletBalance{}
+	for key, wallet := range s.wallets {
+		if key.userID == userID {
+			balances = append(balances, *wallet)
+		}
+	}
+	sort.Slice(balances, func(i, j int) bool { return balances[i].Balance.Currency < balances[j].Balance.Currency })
+	return balances, nil
+}
+
+// ListWalletTransactions returns up to limit transactions of the user's
+// wallet, newest first, starting before the transaction with ID
+// beforeID, or with the newest if beforeID is 0.
+func (s *MemUserStore) ListWalletTransactions(ctx context.Context, userID int32, beforeID int64, limit int) ([]model.WalletTransaction, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	transactions := []model.WalletTransaction{}
+	for i := len(s.transactions) - 1; i >= 0 && len(transactions) < limit; i-- {
+		tx := s.transactions[i]
+		if tx.UserID == userID && (beforeID == 0 || tx.ID < beforeID) {
+			transactions = append(transactions, tx)
+		}
+	}
+	return transactions, nil
+}
+
+// HoldFunds sets amount aside in the user's wallet, failing with
+// ErrInsufficientFunds unless that much is available. Holding again with the
+// reference of an existing hold returns that hold, or ErrAlreadyExists if
+// its amount differs.
+func (s *MemUserStore) HoldFunds(ctx context.Context, userID int32, amount money.Money, reference string, now time.Time) (*model.Hold, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id, ok := s.holdsByRef[holdRef{userID, reference}]; ok {
+		hold := s.holds[id-1]
+		if hold.Amount != amount {
+			return nil, fmt.Errorf("%w: hold %q of user %d is for %s", ErrAlreadyExists, reference, userID, hold.Amount)
+		}
+		return &hold, nil
+	}
+	wallet, ok := s.wallets[walletKey{userID, amount.Currency}]
+	if !ok || wallet.Available().Minor < amount.Minor {
+		return nil, ErrInsufficientFunds
+	}
+
+	wallet.Held.Minor += amount.Minor
+	hold := model.Hold{
+		ID:        int32(len(s.holds) + 1),
+		UserID:    userID,
+		Amount:    amount,
+		Status:    model.HoldHeld,
+		Reference: reference,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	s.holds = append(s.holds, hold)
+	s.holdsByRef[holdRef{userID, reference}] = hold.ID
+	s.addTransaction(userID, model.TransactionHold, money.Money{Currency: amount.Currency, Minor: -amount.Minor}, hold.ID, now)
+	return &hold, nil
+}
+
+// CaptureHold takes amount of a held hold out of the wallet and releases the
+// rest; Money{} captures all of it. Capturing a captured hold again for the
+// same amount returns it.
+func (s *MemUserStore) CaptureHold(ctx context.Context, holdID int32, amount money.Money, now time.Time) (*model.Hold, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrHoldNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if holdID <= 0 || int(holdID) > len(s.holds) {
+		return nil, ErrHoldNotFound
+	}
+	hold := &s.holds[holdID-1]
+	if amount == (money.Money{}) {
+		amount = hold.Amount
+	}
+	if amount.Currency != hold.Amount.Currency || amount.Minor > hold.Amount.Minor {
+		return nil, fmt.Errorf("%w: capturing %s of %s", ErrInvalidCapture, amount, hold.Amount)
+	}
+	switch {
+	case hold.Status == model.HoldCaptured && hold.Captured == amount:
+		captured := *hold
+		return &captured, nil
+	case hold.Status != model.HoldHeld:
+		return nil, fmt.Errorf("%w: hold %d is %s", ErrHoldNotHeld, holdID, hold.Status)
+	}
+
+	wallet := s.wallets[walletKey{hold.UserID, amount.Currency}]
+	wallet.Balance.Minor -= amount.Minor
+	wallet.Held.Minor -= hold.Amount.Minor
+	hold.Status, hold.Captured, hold.UpdatedAt = model.HoldCaptured, amount, now
+	s.addTransaction(hold.UserID, model.TransactionCapture, money.Money{Currency: amount.Currency, Minor: -amount.Minor}, hold.ID, now)
+	if rest := hold.Amount.Minor - amount.Minor; rest > 0 {
+		s.addTransaction(hold.UserID, model.TransactionRelease, money.Money{Currency: amount.Currency, Minor: rest}, hold.ID, now)
+	}
+	captured := *hold
+	return &captured, nil
+}
+
+// ReleaseHold gives the money of a held hold back to the wallet. Releasing a
+// released hold again returns it.
+func (s *MemUserStore) ReleaseHold(ctx context.Context, holdID int32, now time.Time) (*model.Hold, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrHoldNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if holdID <= 0 || int(holdID) > len(s.holds) {
+		return nil, ErrHoldNotFound
+	}
+	hold := &s.holds[holdID-1]
+	switch hold.Status {
+	case model.HoldReleased:
+		released := *hold
+		return &released, nil
+	case model.HoldCaptured:
+		return nil, fmt.Errorf("%w: hold %d is %s", ErrHoldNotHeld, holdID, hold.Status)
+	}
+
+	s.wallets[walletKey{hold.UserID, hold.Amount.Currency}].Held.Minor -= hold.Amount.Minor
+	hold.Status, hold.UpdatedAt = model.HoldReleased, now
+	s.addTransaction(hold.UserID, model.TransactionRelease, hold.Amount, hold.ID, now)
+	released := *hold
+	return &released, nil
+}
+
+// RefundHold gives captured money of a hold back to the wallet until
+// refunded of it is refunded in total. A total no greater than what was
+// refunded already returns the hold unchanged.
+func (s *MemUserStore) RefundHold(ctx context.Context, holdID int32, refunded money.Money, now time.Time) (*model.Hold, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, translateError(err, ErrHoldNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if holdID <= 0 || int(holdID) > len(s.holds) {
+		return nil, ErrHoldNotFound
+	}
+	hold := &s.holds[holdID-1]
+	if hold.Status != model.HoldCaptured {
+		return nil, fmt.Errorf("%w: hold %d is %s", ErrHoldNotCaptured, holdID, hold.Status)
+	}
+	if refunded.Currency != hold.Captured.Currency || refunded.Minor > hold.Captured.Minor {
+		return nil, fmt.Errorf("%w: refunding %s of %s", ErrInvalidRefund, refunded, hold.Captured)
+	}
+	if refunded.Minor <= hold.Refunded.Minor {
+		unchanged := *hold
+		return &unchanged, nil
+	}
+
+	amount := money.Money{Currency: refunded.Currency, Minor: refunded.Minor - hold.Refunded.Minor}
+	s.wallets[walletKey{hold.UserID, amount.Currency}].Balance.Minor += amount.Minor
+	hold.Refunded, hold.UpdatedAt = refunded, now
+	s.addTransaction(hold.UserID, model.TransactionRefund, amount, hold.ID, now)
+	res := *hold
+	return &res, nil
+}
+
+// addTransaction records a wallet transaction. s.mu must be held.
+func (s *MemUserStore) addTransaction(userID int32, kind string, amount money.Money, holdID int32, now time.Time) model.WalletTransaction {
+	tx := model.WalletTransaction{
+		ID:        int64(len(s.transactions) + 1),
+		UserID:    userID,
+		Kind:      kind,
+		Amount:    amount,
+		HoldID:    holdID,
+		CreatedAt: now,
+	}
+	s.transactions = append(s.transactions, tx)
+	return tx
+}
+
+// SeedDemoData loads the same users as docker/init.sql.
+func (s *MemUserStore) SeedDemoData(ctx context.Context) error {
+	for _, name := range []string{"Usman Attiq", "Adeel Ahmed", "Zaid Iqbal"} {
+		if _, err := s.CreateUser(ctx, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
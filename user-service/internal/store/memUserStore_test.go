@@ -0,0 +1,15 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/golang_falcon_task/user-service/internal/store"
+	"github.com/golang_falcon_task/user-service/internal/store/storetest"
+)
+
+func TestMemUserStore_Conformance(t *testing.T) {
+	storetest.RunUserStoreTests(t, func(t *testing.T) storetest.Harness {
+		s := store.NewMemUserStore()
+		return storetest.Harness{Store: s, Outbox: s.Outbox()}
+	})
+}
@@ -2,9 +2,14 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/golang_falcon_task/user-service/internal/model"
+	"github.com/golang_falcon_task/user-service/internal/money"
+	"github.com/golang_falcon_task/user-service/internal/outbox"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -22,10 +27,7 @@ func (s *PGUserStore) GetUser(ctx context.Context, id int32) (*model.User, error
 	var user model.User
 	err := s.db.QueryRow(ctx, `SELECT user_id, name FROM users WHERE user_id = $1`, id).Scan(&user.ID, &user.Name)
 	if err != nil {
-		if err.Error() == "no rows in result set" {
-			return nil, ErrUserNotFound
-		}
-		return nil, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+		return nil, translateError(err, ErrUserNotFound)
 	}
 	return &user, nil
 }
@@ -35,22 +37,369 @@ func (s *PGUserStore) CreateUser(ctx context.Context, name string) (int32, error
 	var userID int32
 	err := s.db.QueryRow(ctx, `INSERT INTO users (name) VALUES ($1) RETURNING user_id`, name).Scan(&userID)
 	if err != nil {
-		return 0, fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+		return 0, translateError(err, ErrDatabaseOperation)
 	}
 	return userID, nil
 }
 
-// DeleteUser deletes a user by ID.
+// DeleteUser deletes a user by ID and records a UserDeleted event in the
+// outbox in the same transaction.
 func (s *PGUserStore) DeleteUser(ctx context.Context, id int32) error {
-	result, err := s.db.Exec(ctx, `DELETE FROM users WHERE user_id = $1`, id)
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, `DELETE FROM users WHERE user_id = $1`, id)
+		if err != nil {
+			return err
+		}
+
+		// Check the number of rows affected
+		if result.RowsAffected() == 0 {
+			return ErrUserNotFound
+		}
+
+		msg, err := userDeleted(id)
+		if err != nil {
+			return err
+		}
+		return outbox.Write(ctx, tx, msg)
+	})
+	if errors.Is(err, ErrUserNotFound) {
+		return ErrUserNotFound
+	}
+	return translateError(err, ErrUserNotFound)
+}
+
+// TopUp adds amount to the user's wallet.
+func (s *PGUserStore) TopUp(ctx context.Context, userID int32, amount money.Money, now time.Time) (*model.WalletTransaction, error) {
+	var tx *model.WalletTransaction
+	err := pgx.BeginFunc(ctx, s.db, func(dbTx pgx.Tx) error {
+		_, err := dbTx.Exec(ctx, `
+            INSERT INTO wallets (user_id, currency, balance) VALUES ($1, $2, $3)
+            ON CONFLICT (user_id, currency) DO UPDATE SET balance = wallets.balance + EXCLUDED.balance
+        `, userID, amount.Currency, amount.Minor)
+		if err != nil {
+			return err
+		}
+		tx, err = insertTransaction(ctx, dbTx, userID, model.TransactionTopUp, amount, 0, now)
+		return err
+	})
+	if err != nil {
+		err = translateError(err, ErrDatabaseOperation)
+		if errors.Is(err, ErrForeignKeyViolation) {
+			// The wallet references the user.
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return tx, nil
+}
+
+// GetWallet returns the user's balance in each currency topped up, sorted by
+// currency.
+func (s *PGUserStore) GetWallet(ctx context.Context, userID int32) ([]model.WalletBalance, error) {
+	rows, err := s.db.Query(ctx, `
+        SELECT w.currency, w.balance, w.held
+        FROM users u
+        LEFT JOIN wallets w ON w.user_id = u.user_id
+        WHERE u.user_id = $1
+        ORDER BY w.currency
+    `, userID)
 	if err != nil {
-		return fmt.Errorf("%w: %v", ErrDatabaseOperation, err)
+		return nil, translateError(err, ErrDatabaseOperation)
 	}
+	defer rows.Close()
 
-	// Check the number of rows affected
-	if result.RowsAffected() == 0 {
-		return ErrUserNotFound
+	found := false
+	balances := []model.WalletBalance{}
+	for rows.Next() {
+		found = true
+		var (
+			currency      *string
+			balance, held *int64
+		)
+		if err := rows.Scan(&currency, &balance, &held); err != nil {
+			return nil, translateError(err, ErrDatabaseOperation)
+		}
+		if currency == nil {
+			// The user has no wallet yet.
+			continue
+		}
+		balances = append(balances, model.WalletBalance{
+			Balance: money.Money{Currency: *currency, Minor: *balance},
+			Held:    money.Money{Currency: *currency, Minor: *held},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	if !found {
+		return nil, ErrUserNotFound
+	}
+	return balances, nil
+}
+
+// ListWalletTransactions returns up to limit transactions of the user's
+// wallet, newest first, starting before the transaction with ID
+// beforeID, or with the newest if beforeID is 0.
+func (s *PGUserStore) ListWalletTransactions(ctx context.Context, userID int32, beforeID int64, limit int) ([]model.WalletTransaction, error) {
+	rows, err := s.db.Query(ctx, `
+        SELECT transaction_id, user_id, kind, amount, currency, COALESCE(hold_id, 0), created_at
+        FROM wallet_transactions
+        WHERE user_id = $1 AND ($2::BIGINT = 0 OR transaction_id < $2)
+        ORDER BY transaction_id DESC
+        LIMIT $3
+    `, userID, beforeID, limit)
+	if err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	defer rows.Close()
+
+	transactions := []model.WalletTransaction{}
+	for rows.Next() {
+		var tx model.WalletTransaction
+		err := rows.Scan(&tx.ID, &tx.UserID, &tx.Kind, &tx.Amount.Minor, &tx.Amount.Currency, &tx.HoldID, &tx.CreatedAt)
+		if err != nil {
+			return nil, translateError(err, ErrDatabaseOperation)
+		}
+		transactions = append(transactions, tx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateError(err, ErrDatabaseOperation)
+	}
+	return transactions, nil
+}
+
+// HoldFunds sets amount aside in the user's wallet, failing with
+// ErrInsufficientFunds unless that much is available. Holding again with the
+// reference of an existing hold returns that hold, or ErrAlreadyExists if
+// its amount differs. The conditional update of the wallet row makes
+// concurrent holds never set aside more than its balance.
+func (s *PGUserStore) HoldFunds(ctx context.Context, userID int32, amount money.Money, reference string, now time.Time) (*model.Hold, error) {
+	var hold *model.Hold
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		existing, err := scanHold(tx.QueryRow(ctx, `SELECT `+holdColumns+` FROM wallet_holds WHERE user_id = $1 AND reference = $2`, userID, reference))
+		switch {
+		case err == nil && existing.Amount != amount:
+			return fmt.Errorf("%w: hold %q of user %d is for %s", ErrAlreadyExists, reference, userID, existing.Amount)
+		case err == nil:
+			hold = existing
+			return nil
+		case !errors.Is(err, pgx.ErrNoRows):
+			return err
+		}
+
+		result, err := tx.Exec(ctx, `
+            UPDATE wallets SET held = held + $3
+            WHERE user_id = $1 AND currency = $2 AND balance - held >= $3
+        `, userID, amount.Currency, amount.Minor)
+		if err != nil {
+			return err
+		}
+		if result.RowsAffected() == 0 {
+			return ErrInsufficientFunds
+		}
+
+		hold, err = scanHold(tx.QueryRow(ctx, `
+            INSERT INTO wallet_holds (user_id, currency, amount, status, reference, created_at, updated_at)
+            VALUES ($1, $2, $3, $4, $5, $6, $6)
+            RETURNING `+holdColumns,
+			userID, amount.Currency, amount.Minor, model.HoldHeld, reference, now))
+		if err != nil {
+			return err
+		}
+		_, err = insertTransaction(ctx, tx, userID, model.TransactionHold, money.Money{Currency: amount.Currency, Minor: -amount.Minor}, hold.ID, now)
+		return err
+	})
+	switch {
+	case errors.Is(err, ErrInsufficientFunds), errors.Is(err, ErrAlreadyExists):
+		return nil, err
+	case err != nil:
+		return nil, translateError(err, ErrDatabaseOperation)
 	}
+	return hold, nil
+}
+
+// CaptureHold takes amount of a held hold out of the wallet and releases the
+// rest; Money{} captures all of it. Capturing a captured hold again for the
+// same amount returns it.
+func (s *PGUserStore) CaptureHold(ctx context.Context, holdID int32, amount money.Money, now time.Time) (*model.Hold, error) {
+	var hold *model.Hold
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		var err error
+		hold, err = scanHold(tx.QueryRow(ctx, `SELECT `+holdColumns+` FROM wallet_holds WHERE hold_id = $1 FOR UPDATE`, holdID))
+		if err != nil {
+			return err
+		}
+		if amount == (money.Money{}) {
+			amount = hold.Amount
+		}
+		if amount.Currency != hold.Amount.Currency || amount.Minor > hold.Amount.Minor {
+			return fmt.Errorf("%w: capturing %s of %s", ErrInvalidCapture, amount, hold.Amount)
+		}
+		switch {
+		case hold.Status == model.HoldCaptured && hold.Captured == amount:
+			return nil
+		case hold.Status != model.HoldHeld:
+			return fmt.Errorf("%w: hold %d is %s", ErrHoldNotHeld, holdID, hold.Status)
+		}
+
+		_, err = tx.Exec(ctx, `
+            UPDATE wallets SET balance = balance - $3, held = held - $4
+            WHERE user_id = $1 AND currency = $2
+        `, hold.UserID, amount.Currency, amount.Minor, hold.Amount.Minor)
+		if err != nil {
+			return err
+		}
+		hold, err = scanHold(tx.QueryRow(ctx, `
+            UPDATE wallet_holds SET status = $2, captured = $3, updated_at = $4
+            WHERE hold_id = $1
+            RETURNING `+holdColumns,
+			holdID, model.HoldCaptured, amount.Minor, now))
+		if err != nil {
+			return err
+		}
+		_, err = insertTransaction(ctx, tx, hold.UserID, model.TransactionCapture, money.Money{Currency: amount.Currency, Minor: -amount.Minor}, holdID, now)
+		if err != nil {
+			return err
+		}
+		if rest := hold.Amount.Minor - amount.Minor; rest > 0 {
+			_, err = insertTransaction(ctx, tx, hold.UserID, model.TransactionRelease, money.Money{Currency: amount.Currency, Minor: rest}, holdID, now)
+		}
+		return err
+	})
+	switch {
+	case errors.Is(err, ErrInvalidCapture), errors.Is(err, ErrHoldNotHeld):
+		return nil, err
+	case err != nil:
+		// Only the hold lookup can match no rows.
+		return nil, translateError(err, ErrHoldNotFound)
+	}
+	return hold, nil
+}
+
+// ReleaseHold gives the money of a held hold back to the wallet. Releasing a
+// released hold again returns it.
+func (s *PGUserStore) ReleaseHold(ctx context.Context, holdID int32, now time.Time) (*model.Hold, error) {
+	var hold *model.Hold
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		var err error
+		hold, err = scanHold(tx.QueryRow(ctx, `SELECT `+holdColumns+` FROM wallet_holds WHERE hold_id = $1 FOR UPDATE`, holdID))
+		if err != nil {
+			return err
+		}
+		switch hold.Status {
+		case model.HoldReleased:
+			return nil
+		case model.HoldCaptured:
+			return fmt.Errorf("%w: hold %d is %s", ErrHoldNotHeld, holdID, hold.Status)
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE wallets SET held = held - $3 WHERE user_id = $1 AND currency = $2`,
+			hold.UserID, hold.Amount.Currency, hold.Amount.Minor)
+		if err != nil {
+			return err
+		}
+		hold, err = scanHold(tx.QueryRow(ctx, `
+            UPDATE wallet_holds SET status = $2, updated_at = $3
+            WHERE hold_id = $1
+            RETURNING `+holdColumns,
+			holdID, model.HoldReleased, now))
+		if err != nil {
+			return err
+		}
+		_, err = insertTransaction(ctx, tx, hold.UserID, model.TransactionRelease, hold.Amount, holdID, now)
+		return err
+	})
+	switch {
+	case errors.Is(err, ErrHoldNotHeld):
+		return nil, err
+	case err != nil:
+		// Only the hold lookup can match no rows.
+		return nil, translateError(err, ErrHoldNotFound)
+	}
+	return hold, nil
+}
+
+// RefundHold gives captured money of a hold back to the wallet until
+// refunded of it is refunded in total. A total no greater than what was
+// refunded already returns the hold unchanged.
+func (s *PGUserStore) RefundHold(ctx context.Context, holdID int32, refunded money.Money, now time.Time) (*model.Hold, error) {
+	var hold *model.Hold
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		var err error
+		hold, err = scanHold(tx.QueryRow(ctx, `SELECT `+holdColumns+` FROM wallet_holds WHERE hold_id = $1 FOR UPDATE`, holdID))
+		if err != nil {
+			return err
+		}
+		if hold.Status != model.HoldCaptured {
+			return fmt.Errorf("%w: hold %d is %s", ErrHoldNotCaptured, holdID, hold.Status)
+		}
+		if refunded.Currency != hold.Captured.Currency || refunded.Minor > hold.Captured.Minor {
+			return fmt.Errorf("%w: refunding %s of %s", ErrInvalidRefund, refunded, hold.Captured)
+		}
+		if refunded.Minor <= hold.Refunded.Minor {
+			return nil
+		}
+
+		amount := money.Money{Currency: refunded.Currency, Minor: refunded.Minor - hold.Refunded.Minor}
+		_, err = tx.Exec(ctx, `UPDATE wallets SET balance = balance + $3 WHERE user_id = $1 AND currency = $2`,
+			hold.UserID, amount.Currency, amount.Minor)
+		if err != nil {
+			return err
+		}
+		hold, err = scanHold(tx.QueryRow(ctx, `
+            UPDATE wallet_holds SET refunded = $2, updated_at = $3
+            WHERE hold_id = $1
+            RETURNING `+holdColumns,
+			holdID, refunded.Minor, now))
+		if err != nil {
+			return err
+		}
+		_, err = insertTransaction(ctx, tx, hold.UserID, model.TransactionRefund, amount, holdID, now)
+		return err
+	})
+	switch {
+	case errors.Is(err, ErrHoldNotCaptured), errors.Is(err, ErrInvalidRefund):
+		return nil, err
+	case err != nil:
+		// Only the hold lookup can match no rows.
+		return nil, translateError(err, ErrHoldNotFound)
+	}
+	return hold, nil
+}
+
+// holdColumns are the wallet_holds columns scanHold reads.
+const holdColumns = `hold_id, user_id, currency, amount, captured, status, reference, created_at, updated_at, refunded`
+
+// scanHold reads a hold selected with holdColumns.
+func scanHold(row pgx.Row) (*model.Hold, error) {
+	var (
+		hold               model.Hold
+		captured, refunded int64
+	)
+	err := row.Scan(&hold.ID, &hold.UserID, &hold.Amount.Currency, &hold.Amount.Minor, &captured, &hold.Status,
+		&hold.Reference, &hold.CreatedAt, &hold.UpdatedAt, &refunded)
+	if err != nil {
+		return nil, err
+	}
+	if hold.Status == model.HoldCaptured {
+		hold.Captured = money.Money{Currency: hold.Amount.Currency, Minor: captured}
+	}
+	if refunded != 0 {
+		hold.Refunded = money.Money{Currency: hold.Amount.Currency, Minor: refunded}
+	}
+	return &hold, nil
+}
 
-	return nil
+// insertTransaction records a wallet transaction in tx.
+func insertTransaction(ctx context.Context, tx pgx.Tx, userID int32, kind string, amount money.Money, holdID int32, now time.Time) (*model.WalletTransaction, error) {
+	t := &model.WalletTransaction{UserID: userID, Kind: kind, Amount: amount, HoldID: holdID}
+	err := tx.QueryRow(ctx, `
+        INSERT INTO wallet_transactions (user_id, kind, amount, currency, hold_id, created_at)
+        VALUES ($1, $2, $3, $4, NULLIF($5, 0), $6)
+        RETURNING transaction_id, created_at
+    `, userID, kind, amount.Minor, amount.Currency, holdID, now).Scan(&t.ID, &t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
 }
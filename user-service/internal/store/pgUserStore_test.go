@@ -0,0 +1,16 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/golang_falcon_task/user-service/internal/outbox"
+	"github.com/golang_falcon_task/user-service/internal/store"
+	"github.com/golang_falcon_task/user-service/internal/store/storetest"
+)
+
+func TestPGUserStore_Conformance(t *testing.T) {
+	storetest.RunUserStoreTests(t, func(t *testing.T) storetest.Harness {
+		pool := storetest.NewPGPool(t)
+		return storetest.Harness{Store: store.NewPGUserStore(pool), Outbox: outbox.NewPGStore(pool)}
+	})
+}
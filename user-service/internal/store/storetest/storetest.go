@@ -0,0 +1,382 @@
+// Package storetest contains the conformance suite every UserStore
+// implementation must pass, so the Postgres and in-memory stores stay
+// interchangeable.
+package storetest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang_falcon_task/user-service/internal/model"
+	"github.com/golang_falcon_task/user-service/internal/money"
+	"github.com/golang_falcon_task/user-service/internal/outbox"
+	"github.com/golang_falcon_task/user-service/internal/service"
+	"github.com/golang_falcon_task/user-service/internal/store"
+	pb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// Harness gives the suite a fresh store and a view of its outbox.
+type Harness struct {
+	Store service.UserStore
+
+	// Outbox reads the events the store records.
+	Outbox outbox.Store
+}
+
+// RunUserStoreTests runs the conformance suite. newHarness is called once per
+// subtest and must return an isolated store.
+func RunUserStoreTests(t *testing.T, newHarness func(t *testing.T) Harness) {
+	ctx := context.Background()
+
+	t.Run("CreateUser Assigns Increasing IDs", func(t *testing.T) {
+		s := newHarness(t).Store
+
+		first, err := s.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		second, err := s.CreateUser(ctx, "Jane Doe")
+		require.NoError(t, err)
+
+		require.Positive(t, first)
+		require.Greater(t, second, first)
+	})
+
+	t.Run("CreateUser And GetUser", func(t *testing.T) {
+		s := newHarness(t).Store
+
+		userID, err := s.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+
+		user, err := s.GetUser(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, userID, user.ID)
+		require.Equal(t, "John Doe", user.Name)
+	})
+
+	t.Run("GetUser Not Found", func(t *testing.T) {
+		s := newHarness(t).Store
+
+		_, err := s.GetUser(ctx, 1_000_000)
+		require.ErrorIs(t, err, store.ErrUserNotFound)
+	})
+
+	t.Run("DeleteUser", func(t *testing.T) {
+		s := newHarness(t).Store
+
+		userID, err := s.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+
+		require.NoError(t, s.DeleteUser(ctx, userID))
+
+		_, err = s.GetUser(ctx, userID)
+		require.ErrorIs(t, err, store.ErrUserNotFound)
+		require.ErrorIs(t, s.DeleteUser(ctx, userID), store.ErrUserNotFound)
+	})
+
+	t.Run("DeleteUser Records UserDeleted", func(t *testing.T) {
+		h := newHarness(t)
+
+		userID, err := h.Store.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+		require.ErrorIs(t, h.Store.DeleteUser(ctx, 1_000_000), store.ErrUserNotFound)
+		require.NoError(t, h.Store.DeleteUser(ctx, userID))
+
+		// Only the successful delete is recorded.
+		msgs, err := h.Outbox.Pending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.NotEmpty(t, msgs[0].ID)
+		require.Equal(t, "user.v1.UserDeleted", msgs[0].Topic)
+
+		var event pb.UserDeleted
+		require.NoError(t, proto.Unmarshal(msgs[0].Payload, &event))
+		require.Equal(t, userID, event.UserId)
+
+		require.NoError(t, h.Outbox.MarkPublished(ctx, []string{msgs[0].ID}))
+		msgs, err = h.Outbox.Pending(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+	})
+
+	t.Run("Wallet TopUp", func(t *testing.T) {
+		s := newHarness(t).Store
+		userID, err := s.CreateUser(ctx, "John Doe")
+		require.NoError(t, err)
+
+		balances, err := s.GetWallet(ctx, userID)
+		require.NoError(t, err)
+		require.Empty(t, balances)
+
+		now := time.Now().Truncate(time.Second)
+		tx, err := s.TopUp(ctx, userID, pkr(100000), now)
+		require.NoError(t, err)
+		require.Positive(t, tx.ID)
+		require.Equal(t, model.TransactionTopUp, tx.Kind)
+		require.Equal(t, pkr(100000), tx.Amount)
+		_, err = s.TopUp(ctx, userID, pkr(50000), now)
+		require.NoError(t, err)
+		_, err = s.TopUp(ctx, userID, money.Money{Currency: "USD", Minor: 1000}, now)
+		require.NoError(t, err)
+
+		balances, err = s.GetWallet(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, []model.WalletBalance{
+			{Balance: pkr(150000), Held: pkr(0)},
+			{Balance: money.Money{Currency: "USD", Minor: 1000}, Held: money.Money{Currency: "USD"}},
+		}, balances)
+
+		_, err = s.TopUp(ctx, 1_000_000, pkr(100), now)
+		require.ErrorIs(t, err, store.ErrUserNotFound)
+		_, err = s.GetWallet(ctx, 1_000_000)
+		require.ErrorIs(t, err, store.ErrUserNotFound)
+
+		// A user with money in a wallet cannot be deleted.
+		require.ErrorIs(t, s.DeleteUser(ctx, userID), store.ErrForeignKeyViolation)
+	})
+
+	t.Run("HoldFunds", func(t *testing.T) {
+		s := newHarness(t).Store
+		userID := newWallet(t, s, pkr(100000))
+		now := time.Now().Truncate(time.Second)
+
+		hold, err := s.HoldFunds(ctx, userID, pkr(60000), "booking-1", now)
+		require.NoError(t, err)
+		require.Positive(t, hold.ID)
+		require.Equal(t, model.HoldHeld, hold.Status)
+		require.Equal(t, pkr(60000), hold.Amount)
+
+		// Holds are idempotent per reference.
+		again, err := s.HoldFunds(ctx, userID, pkr(60000), "booking-1", now)
+		require.NoError(t, err)
+		require.Equal(t, hold.ID, again.ID)
+		_, err = s.HoldFunds(ctx, userID, pkr(50000), "booking-1", now)
+		require.ErrorIs(t, err, store.ErrAlreadyExists)
+
+		_, err = s.HoldFunds(ctx, userID, pkr(50000), "booking-2", now)
+		require.ErrorIs(t, err, store.ErrInsufficientFunds)
+		_, err = s.HoldFunds(ctx, userID, money.Money{Currency: "USD", Minor: 100}, "booking-3", now)
+		require.ErrorIs(t, err, store.ErrInsufficientFunds)
+
+		balances, err := s.GetWallet(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, []model.WalletBalance{{Balance: pkr(100000), Held: pkr(60000)}}, balances)
+		require.Equal(t, pkr(40000), balances[0].Available())
+	})
+
+	t.Run("CaptureHold", func(t *testing.T) {
+		s := newHarness(t).Store
+		userID := newWallet(t, s, pkr(100000))
+		now := time.Now().Truncate(time.Second)
+
+		hold, err := s.HoldFunds(ctx, userID, pkr(60000), "booking-1", now)
+		require.NoError(t, err)
+		_, err = s.CaptureHold(ctx, hold.ID, pkr(70000), now)
+		require.ErrorIs(t, err, store.ErrInvalidCapture)
+		_, err = s.CaptureHold(ctx, hold.ID, money.Money{Currency: "USD", Minor: 100}, now)
+		require.ErrorIs(t, err, store.ErrInvalidCapture)
+
+		// Capturing less than the hold releases the rest.
+		captured, err := s.CaptureHold(ctx, hold.ID, pkr(45000), now)
+		require.NoError(t, err)
+		require.Equal(t, model.HoldCaptured, captured.Status)
+		require.Equal(t, pkr(45000), captured.Captured)
+		balances, err := s.GetWallet(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, []model.WalletBalance{{Balance: pkr(55000), Held: pkr(0)}}, balances)
+
+		// Captures are idempotent, and a captured hold cannot be released.
+		again, err := s.CaptureHold(ctx, hold.ID, pkr(45000), now)
+		require.NoError(t, err)
+		require.Equal(t, captured.Captured, again.Captured)
+		_, err = s.CaptureHold(ctx, hold.ID, pkr(40000), now)
+		require.ErrorIs(t, err, store.ErrHoldNotHeld)
+		_, err = s.ReleaseHold(ctx, hold.ID, now)
+		require.ErrorIs(t, err, store.ErrHoldNotHeld)
+
+		_, err = s.CaptureHold(ctx, 1_000_000, money.Money{}, now)
+		require.ErrorIs(t, err, store.ErrHoldNotFound)
+	})
+
+	t.Run("ReleaseHold", func(t *testing.T) {
+		s := newHarness(t).Store
+		userID := newWallet(t, s, pkr(100000))
+		now := time.Now().Truncate(time.Second)
+
+		hold, err := s.HoldFunds(ctx, userID, pkr(60000), "booking-1", now)
+		require.NoError(t, err)
+		released, err := s.ReleaseHold(ctx, hold.ID, now)
+		require.NoError(t, err)
+		require.Equal(t, model.HoldReleased, released.Status)
+		balances, err := s.GetWallet(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, []model.WalletBalance{{Balance: pkr(100000), Held: pkr(0)}}, balances)
+
+		// Releases are idempotent, and a released hold cannot be captured.
+		_, err = s.ReleaseHold(ctx, hold.ID, now)
+		require.NoError(t, err)
+		_, err = s.CaptureHold(ctx, hold.ID, money.Money{}, now)
+		require.ErrorIs(t, err, store.ErrHoldNotHeld)
+		_, err = s.ReleaseHold(ctx, 1_000_000, now)
+		require.ErrorIs(t, err, store.ErrHoldNotFound)
+	})
+
+	t.Run("RefundHold", func(t *testing.T) {
+		s := newHarness(t).Store
+		userID := newWallet(t, s, pkr(100000))
+		now := time.Now().Truncate(time.Second)
+
+		hold, err := s.HoldFunds(ctx, userID, pkr(60000), "booking-1", now)
+		require.NoError(t, err)
+		_, err = s.RefundHold(ctx, hold.ID, pkr(10000), now)
+		require.ErrorIs(t, err, store.ErrHoldNotCaptured)
+		_, err = s.CaptureHold(ctx, hold.ID, pkr(45000), now)
+		require.NoError(t, err)
+		_, err = s.RefundHold(ctx, hold.ID, pkr(50000), now)
+		require.ErrorIs(t, err, store.ErrInvalidRefund)
+		_, err = s.RefundHold(ctx, hold.ID, money.Money{Currency: "USD", Minor: 100}, now)
+		require.ErrorIs(t, err, store.ErrInvalidRefund)
+
+		refunded, err := s.RefundHold(ctx, hold.ID, pkr(10000), now)
+		require.NoError(t, err)
+		require.Equal(t, pkr(10000), refunded.Refunded)
+		require.Equal(t, pkr(45000), refunded.Captured)
+
+		// Refunds name the total refunded, so retrying refunds nothing more.
+		again, err := s.RefundHold(ctx, hold.ID, pkr(10000), now)
+		require.NoError(t, err)
+		require.Equal(t, pkr(10000), again.Refunded)
+		refunded, err = s.RefundHold(ctx, hold.ID, pkr(45000), now)
+		require.NoError(t, err)
+		require.Equal(t, pkr(45000), refunded.Refunded)
+
+		balances, err := s.GetWallet(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, []model.WalletBalance{{Balance: pkr(100000), Held: pkr(0)}}, balances)
+		transactions, err := s.ListWalletTransactions(ctx, userID, 0, 2)
+		require.NoError(t, err)
+		require.Equal(t, model.TransactionRefund, transactions[0].Kind)
+		require.Equal(t, pkr(35000), transactions[0].Amount)
+		require.Equal(t, pkr(10000), transactions[1].Amount)
+
+		_, err = s.RefundHold(ctx, 1_000_000, pkr(100), now)
+		require.ErrorIs(t, err, store.ErrHoldNotFound)
+	})
+
+	t.Run("ListWalletTransactions", func(t *testing.T) {
+		s := newHarness(t).Store
+		userID := newWallet(t, s, pkr(100000))
+		otherID := newWallet(t, s, pkr(100))
+		now := time.Now().Truncate(time.Second)
+
+		hold, err := s.HoldFunds(ctx, userID, pkr(60000), "booking-1", now)
+		require.NoError(t, err)
+		_, err = s.CaptureHold(ctx, hold.ID, pkr(50000), now)
+		require.NoError(t, err)
+
+		transactions, err := s.ListWalletTransactions(ctx, userID, 0, 10)
+		require.NoError(t, err)
+		var kinds []string
+		var amounts []money.Money
+		for _, tx := range transactions {
+			require.Equal(t, userID, tx.UserID)
+			kinds = append(kinds, tx.Kind)
+			amounts = append(amounts, tx.Amount)
+		}
+		require.Equal(t, []string{model.TransactionRelease, model.TransactionCapture, model.TransactionHold, model.TransactionTopUp}, kinds)
+		require.Equal(t, []money.Money{pkr(10000), pkr(-50000), pkr(-60000), pkr(100000)}, amounts)
+		require.Equal(t, hold.ID, transactions[0].HoldID)
+		require.Zero(t, transactions[3].HoldID)
+
+		page, err := s.ListWalletTransactions(ctx, userID, transactions[1].ID, 1)
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		require.Equal(t, transactions[2].ID, page[0].ID)
+
+		other, err := s.ListWalletTransactions(ctx, otherID, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, other, 1)
+	})
+
+	t.Run("Concurrent HoldFunds", func(t *testing.T) {
+		s := newHarness(t).Store
+		userID := newWallet(t, s, pkr(100000))
+
+		// Only three holds of PKR 300 fit in PKR 1000.
+		const n = 10
+		errs := make([]error, n)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = s.HoldFunds(ctx, userID, pkr(30000), fmt.Sprintf("booking-%d", i), time.Now())
+			}(i)
+		}
+		wg.Wait()
+
+		held := 0
+		for _, err := range errs {
+			if err == nil {
+				held++
+				continue
+			}
+			require.ErrorIs(t, err, store.ErrInsufficientFunds)
+		}
+		require.Equal(t, 3, held)
+		balances, err := s.GetWallet(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, []model.WalletBalance{{Balance: pkr(100000), Held: pkr(90000)}}, balances)
+	})
+
+	t.Run("Canceled Context", func(t *testing.T) {
+		s := newHarness(t).Store
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := s.CreateUser(canceled, "John Doe")
+		require.ErrorIs(t, err, store.ErrCanceled)
+	})
+
+	t.Run("Concurrent CreateUser", func(t *testing.T) {
+		s := newHarness(t).Store
+
+		const n = 20
+		ids := make([]int32, n)
+		errs := make([]error, n)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				ids[i], errs[i] = s.CreateUser(ctx, "John Doe")
+			}(i)
+		}
+		wg.Wait()
+
+		seen := make(map[int32]bool)
+		for i, id := range ids {
+			require.NoError(t, errs[i])
+			require.False(t, seen[id], "duplicate id %d", id)
+			seen[id] = true
+		}
+	})
+}
+
+func pkr(minor int64) money.Money {
+	return money.Money{Currency: "PKR", Minor: minor}
+}
+
+// newWallet creates a user and tops up their wallet with amount.
+func newWallet(t *testing.T, s service.UserStore, amount money.Money) int32 {
+	t.Helper()
+	userID, err := s.CreateUser(context.Background(), "John Doe")
+	require.NoError(t, err)
+	_, err = s.TopUp(context.Background(), userID, amount, time.Now())
+	require.NoError(t, err)
+	return userID
+}
@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	validator := New()
+
+	tests := []struct {
+		name     string
+		req      proto.Message
+		expected []Violation
+	}{
+		{
+			name:     "Valid Create",
+			req:      &pb.CreateUserRequest{Name: "Bilal"},
+			expected: nil,
+		},
+		{
+			name: "Empty Name",
+			req:  &pb.CreateUserRequest{Name: ""},
+			expected: []Violation{
+				{Field: "name", RuleID: "string.min_len", Message: "value length must be at least 1 characters"},
+			},
+		},
+		{
+			name: "Name Too Long",
+			req:  &pb.CreateUserRequest{Name: strings.Repeat("a", 256)},
+			expected: []Violation{
+				{Field: "name", RuleID: "string.max_len", Message: "value length must be at most 255 characters"},
+			},
+		},
+		{
+			name: "Invalid User ID",
+			req:  &pb.DeleteUserRequest{UserId: -1},
+			expected: []Violation{
+				{Field: "user_id", RuleID: "int32.gt", Message: "value must be greater than 0"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := validator.Validate(tt.req)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, violations)
+		})
+	}
+}
@@ -0,0 +1,389 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: user/v1/user_service.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/golang_falcon_task/user-service/proto/user/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// UserServiceName is the fully-qualified name of the UserService service.
+	UserServiceName = "user.v1.UserService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// UserServiceGetUserProcedure is the fully-qualified name of the UserService's GetUser RPC.
+	UserServiceGetUserProcedure = "/user.v1.UserService/GetUser"
+	// UserServiceCreateUserProcedure is the fully-qualified name of the UserService's CreateUser RPC.
+	UserServiceCreateUserProcedure = "/user.v1.UserService/CreateUser"
+	// UserServiceDeleteUserProcedure is the fully-qualified name of the UserService's DeleteUser RPC.
+	UserServiceDeleteUserProcedure = "/user.v1.UserService/DeleteUser"
+	// UserServiceTopUpWalletProcedure is the fully-qualified name of the UserService's TopUpWallet RPC.
+	UserServiceTopUpWalletProcedure = "/user.v1.UserService/TopUpWallet"
+	// UserServiceGetWalletProcedure is the fully-qualified name of the UserService's GetWallet RPC.
+	UserServiceGetWalletProcedure = "/user.v1.UserService/GetWallet"
+	// UserServiceListWalletTransactionsProcedure is the fully-qualified name of the UserService's
+	// ListWalletTransactions RPC.
+	UserServiceListWalletTransactionsProcedure = "/user.v1.UserService/ListWalletTransactions"
+	// UserServiceHoldFundsProcedure is the fully-qualified name of the UserService's HoldFunds RPC.
+	UserServiceHoldFundsProcedure = "/user.v1.UserService/HoldFunds"
+	// UserServiceCaptureHoldProcedure is the fully-qualified name of the UserService's CaptureHold RPC.
+	UserServiceCaptureHoldProcedure = "/user.v1.UserService/CaptureHold"
+	// UserServiceReleaseHoldProcedure is the fully-qualified name of the UserService's ReleaseHold RPC.
+	UserServiceReleaseHoldProcedure = "/user.v1.UserService/ReleaseHold"
+	// UserServiceRefundHoldProcedure is the fully-qualified name of the UserService's RefundHold RPC.
+	UserServiceRefundHoldProcedure = "/user.v1.UserService/RefundHold"
+)
+
+// UserServiceClient is a client for the user.v1.UserService service.
+type UserServiceClient interface {
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	CreateUser(context.Context, *connect.Request[v1.CreateUserRequest]) (*connect.Response[v1.CreateUserResponse], error)
+	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	// TopUpWallet adds money to a user's wallet. It charges nothing itself, so
+	// it is only called by the payments integration once the rider was
+	// charged, and has no REST route.
+	TopUpWallet(context.Context, *connect.Request[v1.TopUpWalletRequest]) (*connect.Response[v1.TopUpWalletResponse], error)
+	// GetWallet returns a user's wallet balances.
+	GetWallet(context.Context, *connect.Request[v1.GetWalletRequest]) (*connect.Response[v1.GetWalletResponse], error)
+	// ListWalletTransactions lists the transactions of a user's wallet, newest
+	// first, a page at a time.
+	ListWalletTransactions(context.Context, *connect.Request[v1.ListWalletTransactionsRequest]) (*connect.Response[v1.ListWalletTransactionsResponse], error)
+	// HoldFunds sets money in a user's wallet aside until the hold is captured
+	// or released. Holds are idempotent per user and reference. Like the other
+	// hold methods, it is only called by BookingService and has no REST route.
+	HoldFunds(context.Context, *connect.Request[v1.HoldFundsRequest]) (*connect.Response[v1.HoldFundsResponse], error)
+	// CaptureHold takes held money out of the wallet and releases the rest of
+	// the hold.
+	CaptureHold(context.Context, *connect.Request[v1.CaptureHoldRequest]) (*connect.Response[v1.CaptureHoldResponse], error)
+	// ReleaseHold gives held money back to the wallet.
+	ReleaseHold(context.Context, *connect.Request[v1.ReleaseHoldRequest]) (*connect.Response[v1.ReleaseHoldResponse], error)
+	// RefundHold gives captured money back to the wallet. Refunds are
+	// idempotent: the request names the total refunded, not the increase.
+	RefundHold(context.Context, *connect.Request[v1.RefundHoldRequest]) (*connect.Response[v1.RefundHoldResponse], error)
+}
+
+// NewUserServiceClient constructs a client for the user.v1.UserService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) UserServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	userServiceMethods := v1.File_user_v1_user_service_proto.Services().ByName("UserService").Methods()
+	return &userServiceClient{
+		getUser: connect.NewClient[v1.GetUserRequest, v1.GetUserResponse](
+			httpClient,
+			baseURL+UserServiceGetUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUser")),
+			connect.WithClientOptions(opts...),
+		),
+		createUser: connect.NewClient[v1.CreateUserRequest, v1.CreateUserResponse](
+			httpClient,
+			baseURL+UserServiceCreateUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUser: connect.NewClient[v1.DeleteUserRequest, v1.DeleteUserResponse](
+			httpClient,
+			baseURL+UserServiceDeleteUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
+			connect.WithClientOptions(opts...),
+		),
+		topUpWallet: connect.NewClient[v1.TopUpWalletRequest, v1.TopUpWalletResponse](
+			httpClient,
+			baseURL+UserServiceTopUpWalletProcedure,
+			connect.WithSchema(userServiceMethods.ByName("TopUpWallet")),
+			connect.WithClientOptions(opts...),
+		),
+		getWallet: connect.NewClient[v1.GetWalletRequest, v1.GetWalletResponse](
+			httpClient,
+			baseURL+UserServiceGetWalletProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetWallet")),
+			connect.WithClientOptions(opts...),
+		),
+		listWalletTransactions: connect.NewClient[v1.ListWalletTransactionsRequest, v1.ListWalletTransactionsResponse](
+			httpClient,
+			baseURL+UserServiceListWalletTransactionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListWalletTransactions")),
+			connect.WithClientOptions(opts...),
+		),
+		holdFunds: connect.NewClient[v1.HoldFundsRequest, v1.HoldFundsResponse](
+			httpClient,
+			baseURL+UserServiceHoldFundsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("HoldFunds")),
+			connect.WithClientOptions(opts...),
+		),
+		captureHold: connect.NewClient[v1.CaptureHoldRequest, v1.CaptureHoldResponse](
+			httpClient,
+			baseURL+UserServiceCaptureHoldProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CaptureHold")),
+			connect.WithClientOptions(opts...),
+		),
+		releaseHold: connect.NewClient[v1.ReleaseHoldRequest, v1.ReleaseHoldResponse](
+			httpClient,
+			baseURL+UserServiceReleaseHoldProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ReleaseHold")),
+			connect.WithClientOptions(opts...),
+		),
+		refundHold: connect.NewClient[v1.RefundHoldRequest, v1.RefundHoldResponse](
+			httpClient,
+			baseURL+UserServiceRefundHoldProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RefundHold")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// userServiceClient implements UserServiceClient.
+type userServiceClient struct {
+	getUser                *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	createUser             *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
+	deleteUser             *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	topUpWallet            *connect.Client[v1.TopUpWalletRequest, v1.TopUpWalletResponse]
+	getWallet              *connect.Client[v1.GetWalletRequest, v1.GetWalletResponse]
+	listWalletTransactions *connect.Client[v1.ListWalletTransactionsRequest, v1.ListWalletTransactionsResponse]
+	holdFunds              *connect.Client[v1.HoldFundsRequest, v1.HoldFundsResponse]
+	captureHold            *connect.Client[v1.CaptureHoldRequest, v1.CaptureHoldResponse]
+	releaseHold            *connect.Client[v1.ReleaseHoldRequest, v1.ReleaseHoldResponse]
+	refundHold             *connect.Client[v1.RefundHoldRequest, v1.RefundHoldResponse]
+}
+
+// GetUser calls user.v1.UserService.GetUser.
+func (c *userServiceClient) GetUser(ctx context.Context, req *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return c.getUser.CallUnary(ctx, req)
+}
+
+// CreateUser calls user.v1.UserService.CreateUser.
+func (c *userServiceClient) CreateUser(ctx context.Context, req *connect.Request[v1.CreateUserRequest]) (*connect.Response[v1.CreateUserResponse], error) {
+	return c.createUser.CallUnary(ctx, req)
+}
+
+// DeleteUser calls user.v1.UserService.DeleteUser.
+func (c *userServiceClient) DeleteUser(ctx context.Context, req *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error) {
+	return c.deleteUser.CallUnary(ctx, req)
+}
+
+// TopUpWallet calls user.v1.UserService.TopUpWallet.
+func (c *userServiceClient) TopUpWallet(ctx context.Context, req *connect.Request[v1.TopUpWalletRequest]) (*connect.Response[v1.TopUpWalletResponse], error) {
+	return c.topUpWallet.CallUnary(ctx, req)
+}
+
+// GetWallet calls user.v1.UserService.GetWallet.
+func (c *userServiceClient) GetWallet(ctx context.Context, req *connect.Request[v1.GetWalletRequest]) (*connect.Response[v1.GetWalletResponse], error) {
+	return c.getWallet.CallUnary(ctx, req)
+}
+
+// ListWalletTransactions calls user.v1.UserService.ListWalletTransactions.
+func (c *userServiceClient) ListWalletTransactions(ctx context.Context, req *connect.Request[v1.ListWalletTransactionsRequest]) (*connect.Response[v1.ListWalletTransactionsResponse], error) {
+	return c.listWalletTransactions.CallUnary(ctx, req)
+}
+
+// HoldFunds calls user.v1.UserService.HoldFunds.
+func (c *userServiceClient) HoldFunds(ctx context.Context, req *connect.Request[v1.HoldFundsRequest]) (*connect.Response[v1.HoldFundsResponse], error) {
+	return c.holdFunds.CallUnary(ctx, req)
+}
+
+// CaptureHold calls user.v1.UserService.CaptureHold.
+func (c *userServiceClient) CaptureHold(ctx context.Context, req *connect.Request[v1.CaptureHoldRequest]) (*connect.Response[v1.CaptureHoldResponse], error) {
+	return c.captureHold.CallUnary(ctx, req)
+}
+
+// ReleaseHold calls user.v1.UserService.ReleaseHold.
+func (c *userServiceClient) ReleaseHold(ctx context.Context, req *connect.Request[v1.ReleaseHoldRequest]) (*connect.Response[v1.ReleaseHoldResponse], error) {
+	return c.releaseHold.CallUnary(ctx, req)
+}
+
+// RefundHold calls user.v1.UserService.RefundHold.
+func (c *userServiceClient) RefundHold(ctx context.Context, req *connect.Request[v1.RefundHoldRequest]) (*connect.Response[v1.RefundHoldResponse], error) {
+	return c.refundHold.CallUnary(ctx, req)
+}
+
+// UserServiceHandler is an implementation of the user.v1.UserService service.
+type UserServiceHandler interface {
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	CreateUser(context.Context, *connect.Request[v1.CreateUserRequest]) (*connect.Response[v1.CreateUserResponse], error)
+	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	// TopUpWallet adds money to a user's wallet. It charges nothing itself, so
+	// it is only called by the payments integration once the rider was
+	// charged, and has no REST route.
+	TopUpWallet(context.Context, *connect.Request[v1.TopUpWalletRequest]) (*connect.Response[v1.TopUpWalletResponse], error)
+	// GetWallet returns a user's wallet balances.
+	GetWallet(context.Context, *connect.Request[v1.GetWalletRequest]) (*connect.Response[v1.GetWalletResponse], error)
+	// ListWalletTransactions lists the transactions of a user's wallet, newest
+	// first, a page at a time.
+	ListWalletTransactions(context.Context, *connect.Request[v1.ListWalletTransactionsRequest]) (*connect.Response[v1.ListWalletTransactionsResponse], error)
+	// HoldFunds sets money in a user's wallet aside until the hold is captured
+	// or released. Holds are idempotent per user and reference. Like the other
+	// hold methods, it is only called by BookingService and has no REST route.
+	HoldFunds(context.Context, *connect.Request[v1.HoldFundsRequest]) (*connect.Response[v1.HoldFundsResponse], error)
+	// CaptureHold takes held money out of the wallet and releases the rest of
+	// the hold.
+	CaptureHold(context.Context, *connect.Request[v1.CaptureHoldRequest]) (*connect.Response[v1.CaptureHoldResponse], error)
+	// ReleaseHold gives held money back to the wallet.
+	ReleaseHold(context.Context, *connect.Request[v1.ReleaseHoldRequest]) (*connect.Response[v1.ReleaseHoldResponse], error)
+	// RefundHold gives captured money back to the wallet. Refunds are
+	// idempotent: the request names the total refunded, not the increase.
+	RefundHold(context.Context, *connect.Request[v1.RefundHoldRequest]) (*connect.Response[v1.RefundHoldResponse], error)
+}
+
+// NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	userServiceMethods := v1.File_user_v1_user_service_proto.Services().ByName("UserService").Methods()
+	userServiceGetUserHandler := connect.NewUnaryHandler(
+		UserServiceGetUserProcedure,
+		svc.GetUser,
+		connect.WithSchema(userServiceMethods.ByName("GetUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateUserHandler := connect.NewUnaryHandler(
+		UserServiceCreateUserProcedure,
+		svc.CreateUser,
+		connect.WithSchema(userServiceMethods.ByName("CreateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserHandler := connect.NewUnaryHandler(
+		UserServiceDeleteUserProcedure,
+		svc.DeleteUser,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceTopUpWalletHandler := connect.NewUnaryHandler(
+		UserServiceTopUpWalletProcedure,
+		svc.TopUpWallet,
+		connect.WithSchema(userServiceMethods.ByName("TopUpWallet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetWalletHandler := connect.NewUnaryHandler(
+		UserServiceGetWalletProcedure,
+		svc.GetWallet,
+		connect.WithSchema(userServiceMethods.ByName("GetWallet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListWalletTransactionsHandler := connect.NewUnaryHandler(
+		UserServiceListWalletTransactionsProcedure,
+		svc.ListWalletTransactions,
+		connect.WithSchema(userServiceMethods.ByName("ListWalletTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceHoldFundsHandler := connect.NewUnaryHandler(
+		UserServiceHoldFundsProcedure,
+		svc.HoldFunds,
+		connect.WithSchema(userServiceMethods.ByName("HoldFunds")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCaptureHoldHandler := connect.NewUnaryHandler(
+		UserServiceCaptureHoldProcedure,
+		svc.CaptureHold,
+		connect.WithSchema(userServiceMethods.ByName("CaptureHold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceReleaseHoldHandler := connect.NewUnaryHandler(
+		UserServiceReleaseHoldProcedure,
+		svc.ReleaseHold,
+		connect.WithSchema(userServiceMethods.ByName("ReleaseHold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRefundHoldHandler := connect.NewUnaryHandler(
+		UserServiceRefundHoldProcedure,
+		svc.RefundHold,
+		connect.WithSchema(userServiceMethods.ByName("RefundHold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/user.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case UserServiceGetUserProcedure:
+			userServiceGetUserHandler.ServeHTTP(w, r)
+		case UserServiceCreateUserProcedure:
+			userServiceCreateUserHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserProcedure:
+			userServiceDeleteUserHandler.ServeHTTP(w, r)
+		case UserServiceTopUpWalletProcedure:
+			userServiceTopUpWalletHandler.ServeHTTP(w, r)
+		case UserServiceGetWalletProcedure:
+			userServiceGetWalletHandler.ServeHTTP(w, r)
+		case UserServiceListWalletTransactionsProcedure:
+			userServiceListWalletTransactionsHandler.ServeHTTP(w, r)
+		case UserServiceHoldFundsProcedure:
+			userServiceHoldFundsHandler.ServeHTTP(w, r)
+		case UserServiceCaptureHoldProcedure:
+			userServiceCaptureHoldHandler.ServeHTTP(w, r)
+		case UserServiceReleaseHoldProcedure:
+			userServiceReleaseHoldHandler.ServeHTTP(w, r)
+		case UserServiceRefundHoldProcedure:
+			userServiceRefundHoldHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedUserServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedUserServiceHandler struct{}
+
+func (UnimplementedUserServiceHandler) GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateUser(context.Context, *connect.Request[v1.CreateUserRequest]) (*connect.Response[v1.CreateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.CreateUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.DeleteUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) TopUpWallet(context.Context, *connect.Request[v1.TopUpWalletRequest]) (*connect.Response[v1.TopUpWalletResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.TopUpWallet is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetWallet(context.Context, *connect.Request[v1.GetWalletRequest]) (*connect.Response[v1.GetWalletResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetWallet is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListWalletTransactions(context.Context, *connect.Request[v1.ListWalletTransactionsRequest]) (*connect.Response[v1.ListWalletTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListWalletTransactions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) HoldFunds(context.Context, *connect.Request[v1.HoldFundsRequest]) (*connect.Response[v1.HoldFundsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.HoldFunds is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CaptureHold(context.Context, *connect.Request[v1.CaptureHoldRequest]) (*connect.Response[v1.CaptureHoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.CaptureHold is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ReleaseHold(context.Context, *connect.Request[v1.ReleaseHoldRequest]) (*connect.Response[v1.ReleaseHoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ReleaseHold is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RefundHold(context.Context, *connect.Request[v1.RefundHoldRequest]) (*connect.Response[v1.RefundHoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.RefundHold is not implemented"))
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	pb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/golang_falcon_task/user-service/proto/user/v1/v1connect"
+)
+
+// connectService exposes UserService to the generated Connect handler,
+// which serves both the Connect and gRPC-Web protocols.
+type connectService struct {
+	svc pb.UserServiceServer
+}
+
+var _ v1connect.UserServiceHandler = (*connectService)(nil)
+
+func (s *connectService) GetUser(ctx context.Context, req *connect.Request[pb.GetUserRequest]) (*connect.Response[pb.GetUserResponse], error) {
+	res, err := s.svc.GetUser(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) CreateUser(ctx context.Context, req *connect.Request[pb.CreateUserRequest]) (*connect.Response[pb.CreateUserResponse], error) {
+	res, err := s.svc.CreateUser(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) DeleteUser(ctx context.Context, req *connect.Request[pb.DeleteUserRequest]) (*connect.Response[pb.DeleteUserResponse], error) {
+	res, err := s.svc.DeleteUser(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) TopUpWallet(ctx context.Context, req *connect.Request[pb.TopUpWalletRequest]) (*connect.Response[pb.TopUpWalletResponse], error) {
+	res, err := s.svc.TopUpWallet(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) GetWallet(ctx context.Context, req *connect.Request[pb.GetWalletRequest]) (*connect.Response[pb.GetWalletResponse], error) {
+	res, err := s.svc.GetWallet(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) ListWalletTransactions(ctx context.Context, req *connect.Request[pb.ListWalletTransactionsRequest]) (*connect.Response[pb.ListWalletTransactionsResponse], error) {
+	res, err := s.svc.ListWalletTransactions(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) HoldFunds(ctx context.Context, req *connect.Request[pb.HoldFundsRequest]) (*connect.Response[pb.HoldFundsResponse], error) {
+	res, err := s.svc.HoldFunds(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) CaptureHold(ctx context.Context, req *connect.Request[pb.CaptureHoldRequest]) (*connect.Response[pb.CaptureHoldResponse], error) {
+	res, err := s.svc.CaptureHold(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) ReleaseHold(ctx context.Context, req *connect.Request[pb.ReleaseHoldRequest]) (*connect.Response[pb.ReleaseHoldResponse], error) {
+	res, err := s.svc.ReleaseHold(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *connectService) RefundHold(ctx context.Context, req *connect.Request[pb.RefundHoldRequest]) (*connect.Response[pb.RefundHoldResponse], error) {
+	res, err := s.svc.RefundHold(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
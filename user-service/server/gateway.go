@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/golang_falcon_task/user-service/internal/grpcerr"
+	pb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// NewGateway returns an HTTP handler serving the REST/JSON mapping of
+// UserService declared by the google.api.http annotations. Calls are
+// forwarded over conn, so they pass through the same interceptors as native
+// gRPC clients. Fields use their proto names, matching the grpcurl examples.
+func NewGateway(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+		}),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+	)
+	if err := pb.RegisterUserServiceHandler(ctx, mux, conn); err != nil {
+		return nil, fmt.Errorf("failed to register gateway handlers: %v", err)
+	}
+	return mux, nil
+}
+
+// gatewayErrorHandler writes every error as a google.rpc.Status JSON body.
+// Errors raised by the gateway itself (malformed JSON, unknown routes) carry
+// no ErrorInfo, so one is added to keep the shape identical to service errors.
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	if len(st.Details()) == 0 {
+		if st.Code() == codes.InvalidArgument {
+			err = grpcerr.InvalidArgument(grpcerr.FieldViolation("", st.Message()))
+		} else {
+			err = grpcerr.New(st.Code(), grpcerr.ReasonGatewayError, st.Message())
+		}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, err)
+}
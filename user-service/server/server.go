@@ -0,0 +1,121 @@
+// Package server wires UserService into a gRPC server with the production
+// interceptor chain, and serves it over Connect and gRPC-Web on the same port.
+// It is used by cmd/main.go and by the end-to-end harness.
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/golang_falcon_task/user-service/internal/middleware"
+	"github.com/golang_falcon_task/user-service/internal/outbox"
+	"github.com/golang_falcon_task/user-service/internal/service"
+	"github.com/golang_falcon_task/user-service/internal/store"
+	pb "github.com/golang_falcon_task/user-service/proto/user/v1"
+	"github.com/golang_falcon_task/user-service/proto/user/v1/v1connect"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// Options configures the server.
+type Options struct {
+	Logger *logrus.Logger
+
+	// DB backs the Postgres store. When nil, an in-memory store seeded with
+	// the docker/init.sql fixtures is used instead.
+	DB *pgxpool.Pool
+
+	// Broker receives the domain events relayed from the outbox. When nil,
+	// an in-process broker without subscribers is used.
+	Broker outbox.Broker
+
+	// OutboxPollInterval is how often the relay publishes pending events.
+	// Defaults to one second.
+	OutboxPollInterval time.Duration
+}
+
+// Server is UserService behind the logging, metrics and validation
+// interceptors. The embedded gRPC server serves native gRPC clients; Handler
+// adds the Connect and gRPC-Web protocols for browsers.
+type Server struct {
+	*grpc.Server
+
+	// Relay publishes the events recorded by the store; run it with Relay.Run.
+	Relay *outbox.Relay
+
+	connect *http.ServeMux
+}
+
+// New creates a Server with UserService registered.
+func New(opts Options) (*Server, error) {
+	var (
+		userStore service.UserStore
+		events    outbox.Store
+	)
+	if opts.DB != nil {
+		userStore = store.NewPGUserStore(opts.DB)
+		events = outbox.NewPGStore(opts.DB)
+	} else {
+		memStore := store.NewMemUserStore()
+		if err := memStore.SeedDemoData(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to seed in-memory store: %v", err)
+		}
+		userStore = memStore
+		events = memStore.Outbox()
+	}
+	userService := service.NewUserService(userStore, opts.Logger)
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		middleware.LoggingInterceptor(opts.Logger), // Logs all requests and responses
+		middleware.MetricsInterceptor(),            // Captures Prometheus metrics
+		middleware.ValidationInterceptor(),         // Enforces buf.validate rules from the .proto files
+	}
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	pb.RegisterUserServiceServer(grpcServer, userService)
+
+	// Enable reflection for testing
+	reflection.Register(grpcServer)
+
+	connectMux := http.NewServeMux()
+	connectMux.Handle(v1connect.NewUserServiceHandler(
+		&connectService{svc: userService},
+		connect.WithInterceptors(middleware.ConnectInterceptor(interceptors...)),
+	))
+
+	broker := opts.Broker
+	if broker == nil {
+		broker = outbox.NewInProcessBroker()
+	}
+	interval := opts.OutboxPollInterval
+	if interval == 0 {
+		interval = time.Second
+	}
+	relay := outbox.NewRelay(events, broker, interval, opts.Logger)
+
+	return &Server{Server: grpcServer, Relay: relay, connect: connectMux}, nil
+}
+
+// Handler serves native gRPC, Connect and gRPC-Web on one port, over HTTP/1.1
+// and cleartext HTTP/2 (h2c). Native gRPC requests go to the embedded gRPC
+// server; everything else is handled by the Connect handler.
+func (s *Server) Handler() http.Handler {
+	return h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType := r.Header.Get("Content-Type")
+		if r.ProtoMajor == 2 && strings.HasPrefix(contentType, "application/grpc") &&
+			!strings.HasPrefix(contentType, "application/grpc-web") {
+			s.Server.ServeHTTP(w, r)
+			return
+		}
+		s.connect.ServeHTTP(w, r)
+	}), &http2.Server{})
+}